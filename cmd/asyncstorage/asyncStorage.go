@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	mc "github.com/multiversx/mx-chain-scenario-go/controller"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/asyncstorage"
+)
+
+const usage = `usage: asyncstorage [flags] <scenario file>
+
+Decodes the async contexts persisted in the storage of the accounts set by the "setState" steps
+of the scenario, and reports the ones which break the invariants of the async context. Orphaned
+contexts, whose async calls or callbacks can no longer complete them, can be removed with -gc.
+Exits with an error if any problem is found.
+`
+
+// asyncstorage inspects the async contexts persisted in a scenario state.
+func main() {
+	protectedKeyPrefix := flag.String("protected-key-prefix", "ELROND", "the protected key prefix the VM was configured with")
+	gcOutputPath := flag.String("gc", "", "write the scenario, without the orphaned async contexts, to this file")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	inspector, err := asyncstorage.NewInspector([]byte(*protectedKeyPrefix))
+	if err != nil {
+		exitWithError(err)
+	}
+	scenario, err := mc.ParseScenariosScenarioDefaultParser(args[0])
+	if err != nil {
+		exitWithError(err)
+	}
+
+	accounts := accountsFromScenario(scenario)
+	persisted := inspector.Inspect(accounts)
+	err = asyncstorage.WriteReport(os.Stdout, persisted)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	if len(*gcOutputPath) > 0 {
+		orphans := inspector.CollectGarbage(accounts)
+		removeFromScenario(scenario, orphans)
+		err = mc.WriteScenariosScenario(scenario, *gcOutputPath)
+		if err != nil {
+			exitWithError(err)
+		}
+		fmt.Printf("removed %d orphaned async contexts, written to %s\n", len(orphans), *gcOutputPath)
+		return
+	}
+
+	for _, persistedContext := range persisted {
+		if len(persistedContext.Problems) > 0 {
+			os.Exit(1)
+		}
+	}
+}
+
+func exitWithError(err error) {
+	fmt.Printf("ERROR: %s\n", err.Error())
+	os.Exit(1)
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	mj "github.com/multiversx/mx-chain-scenario-go/model"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/asyncstorage"
+)
+
+// accountsFromScenario merges the storage set by all the "setState" steps of the scenario, in order.
+func accountsFromScenario(scenario *mj.Scenario) []*asyncstorage.AccountStorage {
+	accounts := make([]*asyncstorage.AccountStorage, 0)
+	accountsByAddress := make(map[string]*asyncstorage.AccountStorage)
+	for _, step := range scenario.Steps {
+		setStateStep, isSetState := step.(*mj.SetStateStep)
+		if !isSetState {
+			continue
+		}
+
+		for _, scenarioAccount := range setStateStep.Accounts {
+			address := scenarioAccount.Address.Value
+			account, found := accountsByAddress[string(address)]
+			if !found {
+				account = &asyncstorage.AccountStorage{
+					Address: address,
+					Storage: make(map[string][]byte),
+				}
+				accountsByAddress[string(address)] = account
+				accounts = append(accounts, account)
+			}
+			for _, keyValue := range scenarioAccount.Storage {
+				account.Storage[string(keyValue.Key.Value)] = keyValue.Value.Value
+			}
+		}
+	}
+
+	return accounts
+}
+
+// removeFromScenario removes the storage entries of the given async contexts from all the "setState" steps.
+func removeFromScenario(scenario *mj.Scenario, removed []*asyncstorage.PersistedContext) {
+	removedKeys := make(map[string]bool)
+	for _, persistedContext := range removed {
+		removedKeys[string(persistedContext.Address)+string(persistedContext.StorageKey)] = true
+	}
+
+	for _, step := range scenario.Steps {
+		setStateStep, isSetState := step.(*mj.SetStateStep)
+		if !isSetState {
+			continue
+		}
+
+		for _, scenarioAccount := range setStateStep.Accounts {
+			remaining := make([]*mj.StorageKeyValuePair, 0, len(scenarioAccount.Storage))
+			for _, keyValue := range scenarioAccount.Storage {
+				if !removedKeys[string(scenarioAccount.Address.Value)+string(keyValue.Key.Value)] {
+					remaining = append(remaining, keyValue)
+				}
+			}
+			scenarioAccount.Storage = remaining
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/interpreter"
+	gasSchedules "github.com/multiversx/mx-chain-vm-go/scenarioexec/gasSchedules"
+	"github.com/multiversx/mx-chain-vm-go/wasmer"
+)
+
+const usage = `usage: gasdiff [flags] <old gas schedule .toml> <new gas schedule .toml> [scenario files or directories...]
+
+Prints the costs that differ between the two gas schedules, then runs the scenarios under both of them
+and reports the gas used by every transaction whose cost changed, together with the "expect.gas" checks
+that would fail under the new gas schedule. Exits with an error if any such check would fail.
+`
+
+// gasdiff shows the impact of a new gas schedule on a set of scenarios.
+func main() {
+	useWasmer1 := flag.Bool("wasmer1", false, "use the wasmer1 executor")
+	useInterpreter := flag.Bool("interpreter", false, "use the pure Go interpreter executor")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) < 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	oldSchedule, err := loadGasScheduleFile(args[0])
+	if err != nil {
+		exitWithError(err)
+	}
+	newSchedule, err := loadGasScheduleFile(args[1])
+	if err != nil {
+		exitWithError(err)
+	}
+
+	changes := config.DiffGasSchedules(oldSchedule, newSchedule)
+	fmt.Printf("Gas schedule changes: %d\n", len(changes))
+	for _, change := range changes {
+		fmt.Printf("  %s\n", change)
+	}
+
+	if len(args) == 2 {
+		return
+	}
+	scenarioPaths, err := findScenarioFiles(args[2:])
+	if err != nil {
+		exitWithError(err)
+	}
+
+	var executorFactory executor.ExecutorAbstractFactory
+	if *useWasmer1 {
+		executorFactory = wasmer.ExecutorFactory()
+	}
+	if *useInterpreter {
+		executorFactory = interpreter.ExecutorFactory()
+	}
+
+	oldRuns := runScenarios(scenarioPaths, oldSchedule, executorFactory)
+	newRuns := runScenarios(scenarioPaths, newSchedule, executorFactory)
+	numBrokenChecks := writeScenarioReport(os.Stdout, oldRuns, newRuns)
+	if numBrokenChecks > 0 {
+		fmt.Printf("ERROR: %d gas checks would fail under the new gas schedule\n", numBrokenChecks)
+		os.Exit(1)
+	}
+}
+
+// loadGasScheduleFile reads a gas schedule in the TOML format of the node configuration,
+// and checks that it contains all the costs needed by the VM.
+func loadGasScheduleFile(path string) (config.GasScheduleMap, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	gasSchedule, err := gasSchedules.LoadGasScheduleConfig(string(contents))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	_, err = config.CreateGasConfig(gasSchedule)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return gasSchedule, nil
+}
+
+func exitWithError(err error) {
+	fmt.Printf("ERROR: %s\n", err.Error())
+	os.Exit(1)
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	mc "github.com/multiversx/mx-chain-scenario-go/controller"
+	mj "github.com/multiversx/mx-chain-scenario-go/model"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	am "github.com/multiversx/mx-chain-vm-go/scenarioexec"
+)
+
+const scenarioFileSuffix = ".scen.json"
+
+// txGas is the gas of one transaction of a scenario, under one gas schedule.
+type txGas struct {
+	txIdent      string
+	gasLimit     uint64
+	gasUsed      uint64
+	gasRemaining uint64
+	expectedGas  mj.JSONCheckUint64
+	gasChecked   bool
+}
+
+// checksGas tells whether the scenario expects a specific remaining gas for the transaction.
+func (tx *txGas) checksGas() bool {
+	return tx.gasChecked && !tx.expectedGas.IsUnspecified() && !tx.expectedGas.IsStar
+}
+
+// passesGasCheck tells whether the remaining gas matches the expectation of the scenario, if any.
+func (tx *txGas) passesGasCheck() bool {
+	return !tx.checksGas() || tx.expectedGas.Check(tx.gasRemaining)
+}
+
+// scenarioGasRun holds the transactions of one scenario, in execution order, including those of external steps.
+type scenarioGasRun struct {
+	path string
+	txs  []*txGas
+	err  error
+}
+
+// ObserveTx records the gas of a transaction.
+func (run *scenarioGasRun) ObserveTx(step *mj.TxStep, output *vmcommon.VMOutput, gasChecked bool) {
+	tx := &txGas{
+		txIdent:      step.TxIdent,
+		gasLimit:     step.Tx.GasLimit.Value,
+		gasRemaining: output.GasRemaining,
+		gasChecked:   gasChecked,
+	}
+	if output.GasRemaining < tx.gasLimit {
+		tx.gasUsed = tx.gasLimit - output.GasRemaining
+	}
+	if step.ExpectedResult != nil {
+		tx.expectedGas = step.ExpectedResult.Gas
+	} else {
+		tx.expectedGas = mj.JSONCheckUint64{Unspecified: true}
+	}
+	run.txs = append(run.txs, tx)
+}
+
+// findScenarioFiles expands the directories into the scenario files they contain, sorted.
+func findScenarioFiles(paths []string) ([]string, error) {
+	scenarioPaths := make([]string, 0)
+	for _, path := range paths {
+		err := filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if filePath == path && !info.IsDir() {
+				scenarioPaths = append(scenarioPaths, filePath)
+				return nil
+			}
+			if !info.IsDir() && strings.HasSuffix(filePath, scenarioFileSuffix) {
+				scenarioPaths = append(scenarioPaths, filePath)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(scenarioPaths)
+	return scenarioPaths, nil
+}
+
+// runScenarios runs every scenario under the gas schedule, on a clean world. The gas checks are not
+// enforced, so that all the transactions run even if the gas schedule breaks the expectations.
+func runScenarios(
+	scenarioPaths []string,
+	gasSchedule config.GasScheduleMap,
+	executorFactory executor.ExecutorAbstractFactory,
+) []*scenarioGasRun {
+	runs := make([]*scenarioGasRun, 0, len(scenarioPaths))
+	for _, path := range scenarioPaths {
+		run := &scenarioGasRun{path: path}
+		run.err = runScenario(run, gasSchedule, executorFactory)
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+func runScenario(
+	run *scenarioGasRun,
+	gasSchedule config.GasScheduleMap,
+	executorFactory executor.ExecutorAbstractFactory,
+) error {
+	executor, err := am.NewVMTestExecutor()
+	if err != nil {
+		return err
+	}
+	defer executor.Close()
+
+	executor.OverrideVMExecutor = executorFactory
+	executor.OverrideGasSchedule = gasSchedule
+	executor.TxObserver = run
+	executor.SkipGasChecks = true
+
+	controller := mc.NewScenarioController(executor, mc.NewDefaultFileResolver())
+	controller.RunsNewTest = true
+	return controller.RunSingleJSONScenario(run.path, mc.DefaultRunScenarioOptions())
+}
+
+// writeScenarioReport prints, for every scenario, the transactions whose gas changed, and
+// returns the number of gas checks that pass under the old gas schedule but not under the new one.
+func writeScenarioReport(writer io.Writer, oldRuns []*scenarioGasRun, newRuns []*scenarioGasRun) int {
+	numTxs, numChangedTxs, numBrokenChecks := 0, 0, 0
+	for i, oldRun := range oldRuns {
+		newRun := newRuns[i]
+		lines := make([]string, 0)
+		if oldRun.err != nil {
+			lines = append(lines, fmt.Sprintf("fails under the old gas schedule: %s", oldRun.err.Error()))
+		}
+		if newRun.err != nil {
+			lines = append(lines, fmt.Sprintf("fails under the new gas schedule: %s", newRun.err.Error()))
+		}
+		if len(oldRun.txs) != len(newRun.txs) {
+			lines = append(lines, fmt.Sprintf("ran %d transactions under the old gas schedule and %d under the new one",
+				len(oldRun.txs), len(newRun.txs)))
+		}
+
+		for txIndex := 0; txIndex < len(oldRun.txs) && txIndex < len(newRun.txs); txIndex++ {
+			oldTx, newTx := oldRun.txs[txIndex], newRun.txs[txIndex]
+			numTxs++
+			if oldTx.gasUsed == newTx.gasUsed {
+				continue
+			}
+			numChangedTxs++
+
+			line := fmt.Sprintf("tx '%s': %d -> %d (%+d)",
+				newTx.txIdent, oldTx.gasUsed, newTx.gasUsed, int64(newTx.gasUsed)-int64(oldTx.gasUsed))
+			if oldTx.passesGasCheck() && !newTx.passesGasCheck() {
+				numBrokenChecks++
+				line += fmt.Sprintf(", BREAKS expect.gas %s, remaining gas would be %d",
+					newTx.expectedGas.Original, newTx.gasRemaining)
+			}
+			lines = append(lines, line)
+		}
+
+		if len(lines) == 0 {
+			continue
+		}
+		_, _ = fmt.Fprintf(writer, "Scenario: %s\n", oldRun.path)
+		for _, line := range lines {
+			_, _ = fmt.Fprintf(writer, "  %s\n", line)
+		}
+	}
+
+	_, _ = fmt.Fprintf(writer, "Done. Transactions: %d. Gas changed: %d. Broken gas checks: %d.\n",
+		numTxs, numChangedTxs, numBrokenChecks)
+	return numBrokenChecks
+}
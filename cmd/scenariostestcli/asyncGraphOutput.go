@@ -0,0 +1,62 @@
+package scenariostestcli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	mj "github.com/multiversx/mx-chain-scenario-go/model"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/asyncgraph"
+)
+
+// asyncGraphOutput writes the async call graph of every transaction into a directory,
+// as <index>-<tx id>.json and <index>-<tx id>.dot.
+type asyncGraphOutput struct {
+	dir      string
+	recorder *asyncgraph.Recorder
+	txCount  int
+	err      error
+}
+
+// newAsyncGraphOutput creates the output directory, if needed.
+func newAsyncGraphOutput(dir string) (*asyncGraphOutput, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &asyncGraphOutput{
+		dir:      dir,
+		recorder: asyncgraph.NewRecorder(),
+	}, nil
+}
+
+// ObserveTx writes the graph recorded for the transaction, then starts recording the next one.
+// After the first error, the graphs are discarded.
+func (output *asyncGraphOutput) ObserveTx(step *mj.TxStep, _ *vmcommon.VMOutput, _ bool) {
+	graph := output.recorder.Graph()
+	output.recorder.Reset()
+	if output.err != nil || len(graph.Roots) == 0 {
+		return
+	}
+
+	output.txCount++
+	path := filepath.Join(output.dir, fmt.Sprintf("%04d-%s", output.txCount, fileNameFromTxID(step.TxIdent)))
+	output.err = writeProfileFile(path+".json", graph.WriteJSON)
+	if output.err != nil {
+		return
+	}
+	output.err = writeProfileFile(path+".dot", graph.WriteDOT)
+}
+
+func fileNameFromTxID(txID string) string {
+	return strings.Map(func(char rune) rune {
+		if unicode.IsLetter(char) || unicode.IsDigit(char) || char == '-' || char == '_' {
+			return char
+		}
+		return '_'
+	}, txID)
+}
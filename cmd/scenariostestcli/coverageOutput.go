@@ -0,0 +1,15 @@
+package scenariostestcli
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/vmhost/coverage"
+)
+
+// writeCoverage writes the coverage report both as JSON and as HTML, using the path as prefix.
+func writeCoverage(executionCoverage *coverage.Coverage, path string) error {
+	report := executionCoverage.Report()
+	err := writeProfileFile(path+".json", report.WriteJSON)
+	if err != nil {
+		return err
+	}
+	return writeProfileFile(path+".html", report.WriteHTML)
+}
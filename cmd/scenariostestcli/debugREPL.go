@@ -0,0 +1,216 @@
+package scenariostestcli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost/hostCore"
+)
+
+var errDebugInputClosed = errors.New("debugger input closed before running the scenarios")
+
+const debugHelp = `breakpoint commands, available at any time:
+  break-hook <name>     pause before the VM hook, e.g. break-hook bigIntAdd
+  break-func <name>     pause before the exported contract function
+  delete-hook <name>    remove a VM hook breakpoint
+  delete-func <name>    remove a function breakpoint
+  breakpoints           list the breakpoints
+  run                   start the scenarios (only before the first pause)
+commands available while paused:
+  continue | c          resume until the next breakpoint
+  step | s              resume until the next function or VM hook call
+  abort                 fail the current execution
+  gas                   show the gas left
+  mem [offset length]   dump the WASM memory
+  bigints               list the big ints
+  bigint <handle>       show a big int
+  buffers               list the managed buffers
+  buffer <handle>       show a managed buffer
+  storage               list the storage updates of the transaction
+  where                 show the pause location`
+
+// debugREPL drives a hostCore.Debugger from a terminal.
+type debugREPL struct {
+	debugger *hostCore.Debugger
+	input    *bufio.Scanner
+	output   io.Writer
+}
+
+func newDebugREPL(debugger *hostCore.Debugger, input io.Reader, output io.Writer) *debugREPL {
+	return &debugREPL{
+		debugger: debugger,
+		input:    bufio.NewScanner(input),
+		output:   output,
+	}
+}
+
+// run reads the initial breakpoints, then calls runScenarios and handles all the pauses until it returns.
+func (repl *debugREPL) run(runScenarios func() error) error {
+	repl.printf("set breakpoints, then type \"run\"; \"help\" lists all commands\n")
+	for {
+		command, ok := repl.readCommand()
+		if !ok {
+			return errDebugInputClosed
+		}
+		if command[0] == "run" {
+			break
+		}
+		repl.executeBreakpointCommand(command)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- runScenarios()
+	}()
+
+	for {
+		select {
+		case err := <-done:
+			return err
+		case paused := <-repl.debugger.Pauses():
+			repl.inspect(paused)
+		}
+	}
+}
+
+// inspect executes commands on the paused execution, until one of them resumes it.
+func (repl *debugREPL) inspect(paused *hostCore.PausedExecution) {
+	repl.printf("paused at %s\n", paused)
+	for {
+		command, ok := repl.readCommand()
+		if !ok {
+			paused.Abort()
+			return
+		}
+
+		switch command[0] {
+		case "continue", "c":
+			paused.Continue()
+			return
+		case "step", "s":
+			paused.Step()
+			return
+		case "abort":
+			paused.Abort()
+			return
+		case "where":
+			repl.printf("%s, contract %s\n", paused, hex.EncodeToString(paused.ContractAddress()))
+		case "gas":
+			repl.printf("gas left: %d\n", paused.GasLeft())
+		case "mem":
+			repl.printMemory(paused, command[1:])
+		case "bigints":
+			for _, handle := range paused.BigIntHandles() {
+				value, _ := paused.BigInt(handle)
+				repl.printf("%d: %s\n", handle, value)
+			}
+		case "bigint":
+			repl.printHandleValue(command, func(handle int32) (string, error) {
+				value, err := paused.BigInt(handle)
+				if err != nil {
+					return "", err
+				}
+				return value.String(), nil
+			})
+		case "buffers":
+			for _, handle := range paused.ManagedBufferHandles() {
+				value, _ := paused.ManagedBuffer(handle)
+				repl.printf("%d: 0x%s\n", handle, hex.EncodeToString(value))
+			}
+		case "buffer":
+			repl.printHandleValue(command, func(handle int32) (string, error) {
+				value, err := paused.ManagedBuffer(handle)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("0x%s %q", hex.EncodeToString(value), value), nil
+			})
+		case "storage":
+			for _, update := range paused.StorageUpdates() {
+				repl.printf("%s: 0x%s = 0x%s\n",
+					hex.EncodeToString(update.Address),
+					hex.EncodeToString(update.Key),
+					hex.EncodeToString(update.Value))
+			}
+		default:
+			repl.executeBreakpointCommand(command)
+		}
+	}
+}
+
+func (repl *debugREPL) executeBreakpointCommand(command []string) {
+	switch {
+	case command[0] == "help":
+		repl.printf("%s\n", debugHelp)
+	case command[0] == "breakpoints":
+		hookNames, functionNames := repl.debugger.Breakpoints()
+		repl.printf("VM hooks: %s\nfunctions: %s\n", strings.Join(hookNames, " "), strings.Join(functionNames, " "))
+	case len(command) != 2:
+		repl.printf("unknown command or wrong number of arguments: %s\n", strings.Join(command, " "))
+	case command[0] == "break-hook":
+		repl.debugger.AddVMHookBreakpoint(command[1])
+	case command[0] == "break-func":
+		repl.debugger.AddFunctionBreakpoint(command[1])
+	case command[0] == "delete-hook":
+		repl.debugger.RemoveVMHookBreakpoint(command[1])
+	case command[0] == "delete-func":
+		repl.debugger.RemoveFunctionBreakpoint(command[1])
+	default:
+		repl.printf("unknown command: %s\n", command[0])
+	}
+}
+
+func (repl *debugREPL) printMemory(paused *hostCore.PausedExecution, args []string) {
+	memory := paused.MemDump()
+	start, end := 0, len(memory)
+	if len(args) == 2 {
+		offset, errOffset := strconv.Atoi(args[0])
+		length, errLength := strconv.Atoi(args[1])
+		if errOffset != nil || errLength != nil || offset < 0 || length < 0 || offset+length > len(memory) {
+			repl.printf("invalid memory range, the memory size is %d\n", len(memory))
+			return
+		}
+		start, end = offset, offset+length
+	}
+	repl.printf("%s", hex.Dump(memory[start:end]))
+}
+
+func (repl *debugREPL) printHandleValue(command []string, getValue func(handle int32) (string, error)) {
+	if len(command) != 2 {
+		repl.printf("expected a handle\n")
+		return
+	}
+	handle, err := strconv.ParseInt(command[1], 10, 32)
+	if err != nil {
+		repl.printf("invalid handle: %s\n", command[1])
+		return
+	}
+	value, err := getValue(int32(handle))
+	if err != nil {
+		repl.printf("%s\n", err)
+		return
+	}
+	repl.printf("%s\n", value)
+}
+
+func (repl *debugREPL) readCommand() ([]string, bool) {
+	for {
+		repl.printf("(debug) ")
+		if !repl.input.Scan() {
+			return nil, false
+		}
+		command := strings.Fields(repl.input.Text())
+		if len(command) > 0 {
+			return command, true
+		}
+	}
+}
+
+func (repl *debugREPL) printf(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(repl.output, format, args...)
+}
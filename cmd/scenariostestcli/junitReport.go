@@ -0,0 +1,87 @@
+package scenariostestcli
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// JUnit XML elements, as understood by the common CI systems.
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Time      string           `xml:"time,attr"`
+	TestCases []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnitReport writes the report as a single test suite, with one test case per scenario.
+// The class name of a test case is the directory of the scenario, with dots instead of slashes.
+func writeJUnitReport(report *scenarioReport, suiteName string, outputPath string) error {
+	suite := &junitTestSuite{
+		Name:      suiteName,
+		Tests:     len(report.results),
+		Failures:  report.numFailed,
+		Time:      junitTime(report.duration),
+		TestCases: make([]*junitTestCase, 0, len(report.results)),
+	}
+	for _, result := range report.results {
+		directory, fileName := path.Split(result.path)
+		className := suiteName
+		if len(directory) > 0 {
+			className += "." + strings.ReplaceAll(strings.TrimSuffix(directory, "/"), "/", ".")
+		}
+
+		testCase := &junitTestCase{
+			Name:      strings.TrimSuffix(fileName, scenarioFileSuffix),
+			ClassName: className,
+			Time:      junitTime(result.duration),
+		}
+		if result.err != nil {
+			testCase.Failure = &junitFailure{
+				Message: result.err.Error(),
+				Text:    result.err.Error(),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	encoded, err := xml.MarshalIndent(&junitTestSuites{
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Time:     suite.Time,
+		Suites:   []*junitTestSuite{suite},
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(outputPath, append([]byte(xml.Header), append(encoded, '\n')...), 0644)
+}
+
+func junitTime(duration time.Duration) string {
+	return fmt.Sprintf("%.3f", duration.Seconds())
+}
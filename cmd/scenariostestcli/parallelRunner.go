@@ -0,0 +1,200 @@
+package scenariostestcli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	mc "github.com/multiversx/mx-chain-scenario-go/controller"
+	am "github.com/multiversx/mx-chain-vm-go/scenarioexec"
+)
+
+const scenarioFileSuffix = ".scen.json"
+
+var errScenariosFailed = errors.New("some tests failed")
+
+// scenarioResult is the outcome of running one scenario file.
+type scenarioResult struct {
+	// path is relative to the directory of the run, with forward slashes
+	path     string
+	err      error
+	duration time.Duration
+}
+
+// scenarioReport holds the results of all the scenarios of a run, sorted by path.
+type scenarioReport struct {
+	results   []*scenarioResult
+	numFailed int
+	duration  time.Duration
+}
+
+// runScenariosInParallel runs all the scenarios of the directory on options.parallel workers.
+// Each worker has its own VMTestExecutor, and therefore its own VM host and world, and takes
+// the next scenario file as soon as it finishes the previous one. The report is only printed
+// once all the scenarios are done, so it does not depend on the scheduling.
+func runScenariosInParallel(
+	newExecutor func() (*am.VMTestExecutor, error),
+	directory string,
+	options *cliOptions,
+) error {
+	paths, err := findScenarioFiles(directory)
+	if err != nil {
+		return err
+	}
+
+	numWorkers := options.parallel
+	if numWorkers > len(paths) {
+		numWorkers = len(paths)
+	}
+	if numWorkers < 1 {
+		numWorkers = 1
+	}
+
+	executors := make([]*am.VMTestExecutor, numWorkers)
+	for i := range executors {
+		executors[i], err = newExecutor()
+		if err != nil {
+			return err
+		}
+	}
+
+	startTime := time.Now()
+	results := make([]*scenarioResult, len(paths))
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for _, workerExecutor := range executors {
+		go func(executor *am.VMTestExecutor) {
+			defer wg.Done()
+			for index := range indexes {
+				var replaceExecutor bool
+				results[index], replaceExecutor = runScenarioFile(executor, directory, paths[index], options)
+				if replaceExecutor {
+					executor = replaceWorkerExecutor(newExecutor, executor)
+				}
+			}
+		}(workerExecutor)
+	}
+	for index := range paths {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	report := newScenarioReport(results, time.Since(startTime))
+	report.write(os.Stdout)
+	if len(options.junitPath) > 0 {
+		err = writeJUnitReport(report, filepath.Base(directory), options.junitPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if report.numFailed > 0 {
+		return errScenariosFailed
+	}
+	return nil
+}
+
+// findScenarioFiles lists the scenario files of the directory and its subdirectories, in lexical order.
+func findScenarioFiles(directory string) ([]string, error) {
+	paths := make([]string, 0)
+	err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(path, scenarioFileSuffix) {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	return paths, err
+}
+
+// runScenarioFile runs one scenario on a clean world. A scenario that panics fails, and the
+// executor which ran it is reported as no longer usable.
+func runScenarioFile(
+	executor *am.VMTestExecutor,
+	directory string,
+	path string,
+	options *cliOptions,
+) (result *scenarioResult, panicked bool) {
+	result = &scenarioResult{path: relativeScenarioPath(directory, path)}
+	startTime := time.Now()
+	defer func() {
+		result.duration = time.Since(startTime)
+		recovered := recover()
+		if recovered != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "scenario %s panicked: %v\n%s", result.path, recovered, debug.Stack())
+			result.err = fmt.Errorf("panic: %v", recovered)
+			panicked = true
+		}
+	}()
+
+	executor.Reset()
+	controller := mc.NewScenarioController(executor, mc.NewDefaultFileResolver())
+	controller.RunsNewTest = true
+	result.err = controller.RunSingleJSONScenario(path, options.scenarioOptions)
+	return result, false
+}
+
+func replaceWorkerExecutor(
+	newExecutor func() (*am.VMTestExecutor, error),
+	previousExecutor *am.VMTestExecutor,
+) *am.VMTestExecutor {
+	executor, err := newExecutor()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "could not replace the VM test executor: %s\n", err.Error())
+		return previousExecutor
+	}
+	return executor
+}
+
+func relativeScenarioPath(directory string, path string) string {
+	relativePath, err := filepath.Rel(directory, path)
+	if err != nil {
+		relativePath = path
+	}
+	return filepath.ToSlash(relativePath)
+}
+
+func newScenarioReport(results []*scenarioResult, duration time.Duration) *scenarioReport {
+	sortedResults := append([]*scenarioResult{}, results...)
+	sort.Slice(sortedResults, func(i, j int) bool {
+		return sortedResults[i].path < sortedResults[j].path
+	})
+
+	numFailed := 0
+	for _, result := range sortedResults {
+		if result.err != nil {
+			numFailed++
+		}
+	}
+
+	return &scenarioReport{
+		results:   sortedResults,
+		numFailed: numFailed,
+		duration:  duration,
+	}
+}
+
+// write prints one line per scenario and a summary, in the format of the sequential runner.
+// The durations are left out, so that the same results always produce the same output.
+func (report *scenarioReport) write(writer io.Writer) {
+	for _, result := range report.results {
+		status := "ok"
+		if result.err != nil {
+			status = "FAIL: " + result.err.Error()
+		}
+		_, _ = fmt.Fprintf(writer, "Scenario: %s ...   %s\n", result.path, status)
+	}
+	_, _ = fmt.Fprintf(writer, "Done. Passed: %d. Failed: %d. Skipped: 0.\n",
+		len(report.results)-report.numFailed, report.numFailed)
+}
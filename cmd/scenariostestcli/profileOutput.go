@@ -0,0 +1,39 @@
+package scenariostestcli
+
+import (
+	"io"
+	"os"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost/profiler"
+)
+
+// writeProfile writes the pprof profile and the flame graph data next to each other, using the path as prefix.
+func writeProfile(executionProfiler *profiler.Profiler, path string) error {
+	err := writeProfileFile(path+".pb.gz", executionProfiler.WritePprof)
+	if err != nil {
+		return err
+	}
+	err = writeProfileFile(path+".gas.folded", func(writer io.Writer) error {
+		return executionProfiler.WriteFoldedStacks(writer, profiler.ProfileGas)
+	})
+	if err != nil {
+		return err
+	}
+	return writeProfileFile(path+".time.folded", func(writer io.Writer) error {
+		return executionProfiler.WriteFoldedStacks(writer, profiler.ProfileWallTime)
+	})
+}
+
+func writeProfileFile(path string, write func(writer io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = write(file)
+	closeErr := file.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
@@ -8,11 +8,19 @@ import (
 	"strings"
 
 	mc "github.com/multiversx/mx-chain-scenario-go/controller"
+	"github.com/multiversx/mx-chain-vm-go/interpreter"
 	am "github.com/multiversx/mx-chain-vm-go/scenarioexec"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/codestore"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/coverage"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/hostCore"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/profiler"
 	"github.com/multiversx/mx-chain-vm-go/wasmer"
 	"github.com/multiversx/mx-chain-vm-go/wasmer2"
 )
 
+// maxCompiledCodeDirSize limits the size of the -compiled-code-dir directory.
+const maxCompiledCodeDirSize = 1 << 30
+
 func resolveArgument(exeDir string, arg string) (string, bool, error) {
 	fi, err := os.Stat(arg)
 	if os.IsNotExist(err) {
@@ -26,16 +34,50 @@ func resolveArgument(exeDir string, arg string) (string, bool, error) {
 	return arg, fi.IsDir(), nil
 }
 
-func parseOptionFlags() *mc.RunScenarioOptions {
+// cliOptions holds the scenario options, together with the options that only concern the CLI.
+type cliOptions struct {
+	scenarioOptions *mc.RunScenarioOptions
+	useInterpreter  bool
+	debug           bool
+	compiledCodeDir string
+	profilePath     string
+	tracePath       string
+	coveragePath    string
+	asyncGraphDir   string
+	parallel        int
+	junitPath       string
+}
+
+func parseOptionFlags() *cliOptions {
 	forceTraceGas := flag.Bool("force-trace-gas", false, "overrides the traceGas option in the scenarios")
 	useWasmer1 := flag.Bool("wasmer1", false, "use the wasmer1 executor")
 	useWasmer2 := flag.Bool("wasmer2", false, "use the wasmer2 executor")
+	useInterpreter := flag.Bool("interpreter", false, "use the pure Go interpreter executor")
+	debug := flag.Bool("debug", false, "run the scenarios in an interactive debugger")
+	compiledCodeDir := flag.String("compiled-code-dir", "", "directory where compiled contracts are kept between runs")
+	profilePath := flag.String("profile", "", "profile gas and time, writing <path>.pb.gz for pprof and <path>.gas.folded, <path>.time.folded for flame graphs")
+	tracePath := flag.String("trace", "", "record every contract call, with its VM hook calls, into a JSON lines trace file")
+	coveragePath := flag.String("coverage", "", "record which contract functions and VM hooks were called, writing <path>.json and <path>.html")
+	asyncGraphDir := flag.String("async-graph", "", "write the async call graph of every transaction into this directory, as JSON and Graphviz DOT")
+	parallel := flag.Int("parallel", 1, "run the scenarios of a directory on this many workers, each with its own VM")
+	junitPath := flag.String("junit", "", "write the results of the scenarios of a directory as a JUnit XML report")
 	flag.Parse()
 
-	return &mc.RunScenarioOptions{
-		ForceTraceGas: *forceTraceGas,
-		UseWasmer1:    *useWasmer1,
-		UseWasmer2:    *useWasmer2,
+	return &cliOptions{
+		scenarioOptions: &mc.RunScenarioOptions{
+			ForceTraceGas: *forceTraceGas,
+			UseWasmer1:    *useWasmer1,
+			UseWasmer2:    *useWasmer2,
+		},
+		useInterpreter:  *useInterpreter,
+		debug:           *debug,
+		compiledCodeDir: *compiledCodeDir,
+		profilePath:     *profilePath,
+		tracePath:       *tracePath,
+		coveragePath:    *coveragePath,
+		asyncGraphDir:   *asyncGraphDir,
+		parallel:        *parallel,
+		junitPath:       *junitPath,
 	}
 }
 
@@ -62,49 +104,160 @@ func ScenariosTestCLI() {
 	}
 
 	// init
-	executor, err := am.NewVMTestExecutor()
-	if err != nil {
-		panic("Could not instantiate VM VM")
+	// the trace records of concurrent workers would interleave, and the trace could not be replayed
+	if options.parallel > 1 && (options.debug || len(options.profilePath) > 0 || len(options.tracePath) > 0 || len(options.asyncGraphDir) > 0) {
+		fmt.Println("-parallel cannot be combined with -debug, -profile, -trace or -async-graph")
+		os.Exit(1)
+	}
+	var compiledCodeStore *codestore.FileCompiledCodeStore
+	if len(options.compiledCodeDir) > 0 {
+		compiledCodeStore, err = codestore.NewFileCompiledCodeStore(options.compiledCodeDir, maxCompiledCodeDirSize)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+	var executionProfiler *profiler.Profiler
+	if len(options.profilePath) > 0 {
+		executionProfiler = profiler.NewProfiler()
+	}
+	var executionCoverage *coverage.Coverage
+	if len(options.coveragePath) > 0 {
+		executionCoverage = coverage.NewCoverage()
+	}
+	var asyncGraph *asyncGraphOutput
+	if len(options.asyncGraphDir) > 0 {
+		asyncGraph, err = newAsyncGraphOutput(options.asyncGraphDir)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+	var debugger *hostCore.Debugger
+	if options.debug {
+		debugger = hostCore.NewDebugger()
 	}
-	if options.UseWasmer1 {
-		executor.OverrideVMExecutor = wasmer.ExecutorFactory()
+	var executionTrace *traceOutput
+	if len(options.tracePath) > 0 {
+		executionTrace, err = newTraceOutput(options.tracePath)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 	}
-	if options.UseWasmer2 {
-		executor.OverrideVMExecutor = wasmer2.ExecutorFactory()
+
+	// every VM test executor has its own VM host, the other components are shared
+	newExecutor := func() (*am.VMTestExecutor, error) {
+		executor, err := am.NewVMTestExecutor()
+		if err != nil {
+			return nil, err
+		}
+		if options.scenarioOptions.UseWasmer1 {
+			executor.OverrideVMExecutor = wasmer.ExecutorFactory()
+		}
+		if options.scenarioOptions.UseWasmer2 {
+			executor.OverrideVMExecutor = wasmer2.ExecutorFactory()
+		}
+		if options.useInterpreter {
+			executor.OverrideVMExecutor = interpreter.ExecutorFactory()
+		}
+		if executionTrace != nil {
+			executor.OverrideVMExecutor = executionTrace.wrap(executor.OverrideVMExecutor)
+		}
+		if compiledCodeStore != nil {
+			executor.CompiledCodeStore = compiledCodeStore
+		}
+		if executionProfiler != nil {
+			executor.Profiler = executionProfiler
+		}
+		if executionCoverage != nil {
+			executor.Coverage = executionCoverage
+		}
+		if asyncGraph != nil {
+			executor.AsyncCallGraph = asyncGraph.recorder
+			executor.TxObserver = asyncGraph
+		}
+		if debugger != nil {
+			executor.Debugger = debugger
+		}
+		return executor, nil
 	}
 
 	// execute
+	runScenarios := func() error {
+		if isDir && (options.parallel > 1 || len(options.junitPath) > 0) {
+			return runScenariosInParallel(newExecutor, jsonFilePath, options)
+		}
+		executor, err := newExecutor()
+		if err != nil {
+			return err
+		}
+		return runJSONFile(executor, jsonFilePath, isDir, options)
+	}
+	if debugger != nil {
+		err = newDebugREPL(debugger, os.Stdin, os.Stdout).run(runScenarios)
+	} else {
+		err = runScenarios()
+	}
+
+	if executionProfiler != nil {
+		profileErr := writeProfile(executionProfiler, options.profilePath)
+		if profileErr != nil {
+			fmt.Printf("could not write profile: %s\n", profileErr.Error())
+		}
+	}
+
+	if executionCoverage != nil {
+		coverageErr := writeCoverage(executionCoverage, options.coveragePath)
+		if coverageErr != nil {
+			fmt.Printf("could not write coverage: %s\n", coverageErr.Error())
+		}
+	}
+
+	if asyncGraph != nil && asyncGraph.err != nil {
+		fmt.Printf("could not write async call graph: %s\n", asyncGraph.err.Error())
+	}
+
+	if executionTrace != nil {
+		traceErr := executionTrace.close()
+		if traceErr != nil {
+			fmt.Printf("could not write trace: %s\n", traceErr.Error())
+		}
+	}
+
+	// print result
+	if err == nil {
+		fmt.Println("SUCCESS")
+	} else {
+		fmt.Printf("ERROR: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func runJSONFile(executor *am.VMTestExecutor, jsonFilePath string, isDir bool, options *cliOptions) error {
 	switch {
 	case isDir:
 		runner := mc.NewScenarioController(
 			executor,
 			mc.NewDefaultFileResolver(),
 		)
-		err = runner.RunAllJSONScenariosInDirectory(
+		return runner.RunAllJSONScenariosInDirectory(
 			jsonFilePath,
 			"",
 			".scen.json",
 			[]string{},
-			options)
+			options.scenarioOptions)
 	case strings.HasSuffix(jsonFilePath, ".scen.json"):
 		runner := mc.NewScenarioController(
 			executor,
 			mc.NewDefaultFileResolver(),
 		)
-		err = runner.RunSingleJSONScenario(jsonFilePath, options)
+		return runner.RunSingleJSONScenario(jsonFilePath, options.scenarioOptions)
 	default:
 		runner := mc.NewTestRunner(
 			executor,
 			mc.NewDefaultFileResolver(),
 		)
-		err = runner.RunSingleJSONTest(jsonFilePath)
-	}
-
-	// print result
-	if err == nil {
-		fmt.Println("SUCCESS")
-	} else {
-		fmt.Printf("ERROR: %s\n", err.Error())
-		os.Exit(1)
+		return runner.RunSingleJSONTest(jsonFilePath)
 	}
 }
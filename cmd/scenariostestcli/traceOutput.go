@@ -0,0 +1,47 @@
+package scenariostestcli
+
+import (
+	"os"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
+)
+
+// traceOutput records the contract executions of the scenarios into a JSON lines trace file.
+type traceOutput struct {
+	file   *os.File
+	writer *executorwrapper.JSONTraceWriter
+}
+
+// newTraceOutput creates the trace file.
+func newTraceOutput(path string) (*traceOutput, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &traceOutput{
+		file:   file,
+		writer: executorwrapper.NewJSONTraceWriter(file),
+	}, nil
+}
+
+// wrap yields an executor factory that records into the trace file the executions of the given one,
+// or of the default executor if nil.
+func (output *traceOutput) wrap(wrappedFactory executor.ExecutorAbstractFactory) executor.ExecutorAbstractFactory {
+	if wrappedFactory == nil {
+		wrappedFactory = wasmer2.ExecutorFactory()
+	}
+	return executorwrapper.NewTraceRecorderExecutorFactory(output.writer, wrappedFactory)
+}
+
+// close closes the trace file, reporting the first error encountered while writing it.
+func (output *traceOutput) close() error {
+	closeErr := output.file.Close()
+	err := output.writer.Err()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	"github.com/multiversx/mx-chain-vm-go/interpreter"
+	gasSchedules "github.com/multiversx/mx-chain-vm-go/scenarioexec/gasSchedules"
+	"github.com/multiversx/mx-chain-vm-go/wasmer"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
+)
+
+// tracereplay re-executes the frames of a trace recorded with "scenariostest -trace",
+// against the recorded VM hook results, and reports the first difference for each frame.
+func main() {
+	tracePath := flag.String("trace", "", "the JSON lines trace file")
+	codePath := flag.String("code", "", "the WASM code of the contract that executed the replayed frames")
+	frameIndex := flag.Int("frame", -1, "replay only the frame with this index, as shown by -list; by default, all the root frames are replayed")
+	list := flag.Bool("list", false, "list the frames of the trace, including the nested ones")
+	useWasmer1 := flag.Bool("wasmer1", false, "use the wasmer1 executor")
+	useInterpreter := flag.Bool("interpreter", false, "use the pure Go interpreter executor")
+	gasScheduleName := flag.String("gas-schedule", "v4", "the gas schedule of the recording, v3 or v4")
+	flag.Parse()
+
+	if len(*tracePath) == 0 {
+		exitWithError(fmt.Errorf("the -trace argument is required"))
+	}
+	frames, err := readTrace(*tracePath)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	allFrames := executorwrapper.FlattenTraceFrames(frames)
+	if *list {
+		for i, frame := range allFrames {
+			fmt.Printf("%d: %s, instance %s, %d VM hook calls\n", i, frame.FunctionName, frame.InstanceID, len(frame.HookCalls))
+		}
+		return
+	}
+
+	if len(*codePath) == 0 {
+		exitWithError(fmt.Errorf("the -code argument is required"))
+	}
+	code, err := ioutil.ReadFile(*codePath)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	replayedFrames := frames
+	if *frameIndex >= 0 {
+		if *frameIndex >= len(allFrames) {
+			exitWithError(fmt.Errorf("frame %d not found, the trace has %d frames", *frameIndex, len(allFrames)))
+		}
+		replayedFrames = []*executorwrapper.TraceFrame{allFrames[*frameIndex]}
+	}
+
+	executorFactory := executor.ExecutorAbstractFactory(wasmer2.ExecutorFactory())
+	if *useWasmer1 {
+		executorFactory = wasmer.ExecutorFactory()
+	}
+	if *useInterpreter {
+		executorFactory = interpreter.ExecutorFactory()
+	}
+	opcodeCosts, err := loadOpcodeCosts(*gasScheduleName)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	replayer := executorwrapper.NewTraceReplayer(executorFactory, opcodeCosts)
+	numMismatches := 0
+	for _, frame := range replayedFrames {
+		mismatch, err := replayer.ReplayFrame(code, frame)
+		if err != nil {
+			exitWithError(err)
+		}
+		if mismatch != nil {
+			numMismatches++
+			fmt.Print(mismatch.String())
+		}
+	}
+
+	if numMismatches > 0 {
+		fmt.Printf("ERROR: %d of %d frames did not match the trace\n", numMismatches, len(replayedFrames))
+		os.Exit(1)
+	}
+	fmt.Println("SUCCESS")
+}
+
+func readTrace(path string) ([]*executorwrapper.TraceFrame, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return executorwrapper.ReadTraceFrames(file)
+}
+
+// loadOpcodeCosts yields the opcode costs of one of the scenarios gas schedules.
+func loadOpcodeCosts(gasScheduleName string) (*executor.WASMOpcodeCost, error) {
+	var gasScheduleContents string
+	switch gasScheduleName {
+	case "v3":
+		gasScheduleContents = gasSchedules.GetV3()
+	case "v4":
+		gasScheduleContents = gasSchedules.GetV4()
+	default:
+		return nil, fmt.Errorf("unknown gas schedule %s", gasScheduleName)
+	}
+
+	gasSchedule, err := gasSchedules.LoadGasScheduleConfig(gasScheduleContents)
+	if err != nil {
+		return nil, err
+	}
+	gasCost, err := config.CreateGasConfig(gasSchedule)
+	if err != nil {
+		return nil, err
+	}
+	return gasCost.WASMOpcodeCost, nil
+}
+
+func exitWithError(err error) {
+	fmt.Printf("ERROR: %s\n", err.Error())
+	os.Exit(1)
+}
@@ -13,6 +13,7 @@ type GasCost struct {
 	ManagedBufferAPICost ManagedBufferAPICost
 	ManagedMapAPICost    ManagedMapAPICost
 	CryptoAPICost        CryptoAPICost
+	MaxPerTransaction    MaxPerTransaction
 	WASMOpcodeCost       *executor.WASMOpcodeCost
 }
 
@@ -29,55 +30,58 @@ type BaseOperationCost struct {
 
 // BaseOpsAPICost defines the API operations gas cost config structure
 type BaseOpsAPICost struct {
-	GetSCAddress            uint64
-	GetOwnerAddress         uint64
-	IsSmartContract         uint64
-	GetShardOfAddress       uint64
-	GetExternalBalance      uint64
-	GetBlockHash            uint64
-	GetOriginalTxHash       uint64
-	GetCurrentTxHash        uint64
-	GetPrevTxHash           uint64
-	TransferValue           uint64
-	GetArgument             uint64
-	GetFunction             uint64
-	GetNumArguments         uint64
-	StorageStore            uint64
-	StorageLoad             uint64
-	CachedStorageLoad       uint64
-	GetCaller               uint64
-	GetCallValue            uint64
-	Log                     uint64
-	Finish                  uint64
-	SignalError             uint64
-	GetBlockTimeStamp       uint64
-	GetGasLeft              uint64
-	Int64GetArgument        uint64
-	Int64StorageStore       uint64
-	Int64StorageLoad        uint64
-	Int64Finish             uint64
-	GetStateRootHash        uint64
-	GetBlockNonce           uint64
-	GetBlockEpoch           uint64
-	GetBlockRound           uint64
-	GetBlockRandomSeed      uint64
-	ExecuteOnSameContext    uint64
-	ExecuteOnDestContext    uint64
-	DelegateExecution       uint64
-	ExecuteReadOnly         uint64
-	AsyncCallStep           uint64
-	AsyncCallbackGasLock    uint64
-	CreateAsyncCall         uint64
-	SetAsyncCallback        uint64
-	SetAsyncGroupCallback   uint64
-	SetAsyncContextCallback uint64
-	GetCallbackClosure      uint64
-	CreateContract          uint64
-	GetReturnData           uint64
-	GetNumReturnData        uint64
-	GetReturnDataSize       uint64
-	CleanReturnData         uint64
-	DeleteFromReturnData    uint64
+	GetSCAddress                uint64
+	GetOwnerAddress             uint64
+	IsSmartContract             uint64
+	GetShardOfAddress           uint64
+	GetExternalBalance          uint64
+	GetBlockHash                uint64
+	GetOriginalTxHash           uint64
+	GetCurrentTxHash            uint64
+	GetPrevTxHash               uint64
+	TransferValue               uint64
+	GetArgument                 uint64
+	GetFunction                 uint64
+	GetNumArguments             uint64
+	StorageStore                uint64
+	StorageLoad                 uint64
+	CachedStorageLoad           uint64
+	GetCaller                   uint64
+	GetCallValue                uint64
+	Log                         uint64
+	Finish                      uint64
+	SignalError                 uint64
+	GetBlockTimeStamp           uint64
+	GetGasLeft                  uint64
+	Int64GetArgument            uint64
+	Int64StorageStore           uint64
+	Int64StorageLoad            uint64
+	Int64Finish                 uint64
+	GetStateRootHash            uint64
+	GetBlockNonce               uint64
+	GetBlockEpoch               uint64
+	GetBlockRound               uint64
+	GetBlockRandomSeed          uint64
+	ExecuteOnSameContext        uint64
+	ExecuteOnDestContext        uint64
+	DelegateExecution           uint64
+	ExecuteReadOnly             uint64
+	AsyncCallStep               uint64
+	AsyncCallbackGasLock        uint64
+	CreateAsyncCall             uint64
+	CreateAsyncCallWithDeadline uint64
+	SetAsyncCallback            uint64
+	SetAsyncGroupCallback       uint64
+	SetAsyncContextCallback     uint64
+	CancelAsyncCallGroup        uint64
+	GetCallbackClosure          uint64
+	IsCallbackTimedOut          uint64
+	CreateContract              uint64
+	GetReturnData               uint64
+	GetNumReturnData            uint64
+	GetReturnDataSize           uint64
+	CleanReturnData             uint64
+	DeleteFromReturnData        uint64
 }
 
 // BigIntAPICost defines the big int operations gas cost config structure
@@ -147,23 +151,50 @@ type BigFloatAPICost struct {
 
 // CryptoAPICost defines the crypto operations gas cost config structure
 type CryptoAPICost struct {
-	SHA256                 uint64
-	Keccak256              uint64
-	Ripemd160              uint64
-	VerifyBLS              uint64
-	VerifyEd25519          uint64
-	VerifySecp256k1        uint64
-	EllipticCurveNew       uint64
-	AddECC                 uint64
-	DoubleECC              uint64
-	IsOnCurveECC           uint64
-	ScalarMultECC          uint64
-	MarshalECC             uint64
-	MarshalCompressedECC   uint64
-	UnmarshalECC           uint64
-	UnmarshalCompressedECC uint64
-	GenerateKeyECC         uint64
-	EncodeDERSig           uint64
+	SHA256                           uint64
+	Keccak256                        uint64
+	Ripemd160                        uint64
+	SHA512                           uint64
+	SHA512PerByte                    uint64
+	SHA3256                          uint64
+	SHA3256PerByte                   uint64
+	SHA3512                          uint64
+	SHA3512PerByte                   uint64
+	Blake2b256                       uint64
+	Blake2b256PerByte                uint64
+	Blake2b512                       uint64
+	Blake2b512PerByte                uint64
+	Poseidon                         uint64
+	PoseidonPerFieldMultiplication   uint64
+	VerifyBLS                        uint64
+	VerifyBLSAggregated              uint64
+	VerifyBLSAggregatedPerKey        uint64
+	AggregateBLSKeys                 uint64
+	AggregateBLSKeysPerKey           uint64
+	VerifyEd25519                    uint64
+	VerifyEd25519Batch               uint64
+	VerifyEd25519BatchPerSignature   uint64
+	VerifySecp256k1                  uint64
+	VerifySecp256r1                  uint64
+	VerifySecp256r1Batch             uint64
+	VerifySecp256r1BatchPerSignature uint64
+	EllipticCurveNew                 uint64
+	EllipticCurveNewCustom           uint64
+	EllipticCurveNewCustomPerByte    uint64
+	AddECC                           uint64
+	DoubleECC                        uint64
+	IsOnCurveECC                     uint64
+	ScalarMultECC                    uint64
+	MarshalECC                       uint64
+	MarshalCompressedECC             uint64
+	UnmarshalECC                     uint64
+	UnmarshalCompressedECC           uint64
+	GenerateKeyECC                   uint64
+	EncodeDERSig                     uint64
+	AddBN254G1                       uint64
+	ScalarMulBN254G1                 uint64
+	PairingCheckBN254                uint64
+	PairingCheckBN254PerPair         uint64
 }
 
 // ManagedBufferAPICost defines the managed buffer operations gas cost config structure
@@ -198,3 +229,10 @@ type ManagedMapAPICost struct {
 	ManagedMapRemove   uint64
 	ManagedMapContains uint64
 }
+
+// MaxPerTransaction defines the limits applied to a single transaction
+type MaxPerTransaction struct {
+	// MemoryBudget bounds the memory held by a transaction, in bytes, counting its managed values and the
+	// WASM memory of all the instances on its call stack. Zero means no bound.
+	MemoryBudget uint64
+}
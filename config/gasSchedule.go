@@ -14,6 +14,9 @@ const GasValueForTests = 1
 // AsyncCallbackGasLockForTests defines the gas lock for tests
 var AsyncCallbackGasLockForTests = uint64(100_000)
 
+// DefaultTransactionMemoryBudget is the memory budget of a transaction, in bytes, used by the generated gas maps
+const DefaultTransactionMemoryBudget = uint64(256 * 1024 * 1024)
+
 // GasScheduleMap (alias) is the map for gas schedule
 type GasScheduleMap = map[string]map[string]uint64
 
@@ -85,6 +88,13 @@ func CreateGasConfig(gasMap GasScheduleMap) (*GasCost, error) {
 		return nil, err
 	}
 
+	// the limits per transaction are not checked against zero, older gas schedules do not define them
+	maxPerTransaction := &MaxPerTransaction{}
+	err = mapstructure.Decode(gasMap["MaxPerTransaction"], maxPerTransaction)
+	if err != nil {
+		return nil, err
+	}
+
 	wasmOps := &executor.WASMOpcodeCost{}
 	err = mapstructure.Decode(gasMap["WASMOpcodeCost"], wasmOps)
 	if err != nil {
@@ -103,6 +113,7 @@ func CreateGasConfig(gasMap GasScheduleMap) (*GasCost, error) {
 		BaseOpsAPICost:       *baseOpsAPI,
 		CryptoAPICost:        *cryptOps,
 		ManagedBufferAPICost: *MBufferOps,
+		MaxPerTransaction:    *maxPerTransaction,
 		WASMOpcodeCost:       wasmOps,
 	}
 
@@ -143,6 +154,7 @@ func FillGasMap(gasMap GasScheduleMap, value, asyncCallbackGasLock uint64) GasSc
 	gasMap["BigFloatAPICost"] = FillGasMapBigFloatAPICosts(value)
 	gasMap["CryptoAPICost"] = FillGasMapCryptoAPICosts(value)
 	gasMap["ManagedBufferAPICost"] = FillGasMapManagedBufferAPICosts(value)
+	gasMap["MaxPerTransaction"] = FillGasMapMaxPerTransaction()
 	gasMap["WASMOpcodeCost"] = FillGasMapWASMOpcodeValues(value)
 
 	customFillGasMapWASMOpcodeCosts(gasMap["WASMOpcodeCost"])
@@ -187,6 +199,14 @@ func FillGasMapBaseOperationCosts(value uint64) map[string]uint64 {
 	return gasMap
 }
 
+// FillGasMapMaxPerTransaction fills the limits per transaction, which do not depend on the gas costs
+func FillGasMapMaxPerTransaction() map[string]uint64 {
+	gasMap := make(map[string]uint64)
+	gasMap["MemoryBudget"] = DefaultTransactionMemoryBudget
+
+	return gasMap
+}
+
 // FillGasMapBaseOpsAPICosts fills the API calls costs
 func FillGasMapBaseOpsAPICosts(value, asyncCallbackGasLock uint64) map[string]uint64 {
 	gasMap := make(map[string]uint64)
@@ -229,10 +249,13 @@ func FillGasMapBaseOpsAPICosts(value, asyncCallbackGasLock uint64) map[string]ui
 	gasMap["AsyncCallStep"] = value
 	gasMap["AsyncCallbackGasLock"] = asyncCallbackGasLock
 	gasMap["CreateAsyncCall"] = value
+	gasMap["CreateAsyncCallWithDeadline"] = value
 	gasMap["SetAsyncCallback"] = value
 	gasMap["SetAsyncGroupCallback"] = value
 	gasMap["SetAsyncContextCallback"] = value
+	gasMap["CancelAsyncCallGroup"] = value
 	gasMap["GetCallbackClosure"] = value
+	gasMap["IsCallbackTimedOut"] = value
 	gasMap["CreateContract"] = value
 	gasMap["GetReturnData"] = value
 	gasMap["GetNumReturnData"] = value
@@ -360,10 +383,33 @@ func FillGasMapCryptoAPICosts(value uint64) map[string]uint64 {
 	gasMap["SHA256"] = value
 	gasMap["Keccak256"] = value
 	gasMap["Ripemd160"] = value
+	gasMap["SHA512"] = value
+	gasMap["SHA512PerByte"] = value
+	gasMap["SHA3256"] = value
+	gasMap["SHA3256PerByte"] = value
+	gasMap["SHA3512"] = value
+	gasMap["SHA3512PerByte"] = value
+	gasMap["Blake2b256"] = value
+	gasMap["Blake2b256PerByte"] = value
+	gasMap["Blake2b512"] = value
+	gasMap["Blake2b512PerByte"] = value
+	gasMap["Poseidon"] = value
+	gasMap["PoseidonPerFieldMultiplication"] = value
 	gasMap["VerifyBLS"] = value
+	gasMap["VerifyBLSAggregated"] = value
+	gasMap["VerifyBLSAggregatedPerKey"] = value
+	gasMap["AggregateBLSKeys"] = value
+	gasMap["AggregateBLSKeysPerKey"] = value
 	gasMap["VerifyEd25519"] = value
+	gasMap["VerifyEd25519Batch"] = value
+	gasMap["VerifyEd25519BatchPerSignature"] = value
 	gasMap["VerifySecp256k1"] = value
+	gasMap["VerifySecp256r1"] = value
+	gasMap["VerifySecp256r1Batch"] = value
+	gasMap["VerifySecp256r1BatchPerSignature"] = value
 	gasMap["EllipticCurveNew"] = value
+	gasMap["EllipticCurveNewCustom"] = value
+	gasMap["EllipticCurveNewCustomPerByte"] = value
 	gasMap["AddECC"] = value
 	gasMap["DoubleECC"] = value
 	gasMap["IsOnCurveECC"] = value
@@ -374,6 +420,10 @@ func FillGasMapCryptoAPICosts(value uint64) map[string]uint64 {
 	gasMap["UnmarshalCompressedECC"] = value
 	gasMap["GenerateKeyECC"] = value
 	gasMap["EncodeDERSig"] = value
+	gasMap["AddBN254G1"] = value
+	gasMap["ScalarMulBN254G1"] = value
+	gasMap["PairingCheckBN254"] = value
+	gasMap["PairingCheckBN254PerPair"] = value
 
 	return gasMap
 }
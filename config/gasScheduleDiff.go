@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+)
+
+// GasScheduleChange is a cost that differs between two gas schedules.
+type GasScheduleChange struct {
+	Section   string
+	Name      string
+	OldValue  uint64
+	NewValue  uint64
+	IsAdded   bool
+	IsRemoved bool
+}
+
+// String yields a one-line description of the change, e.g. "BaseOperationCost.StorePerByte: 50 -> 100 (+50, +100.00%)".
+func (change *GasScheduleChange) String() string {
+	name := change.Section + "." + change.Name
+	switch {
+	case change.IsAdded:
+		return fmt.Sprintf("%s: added, %d", name, change.NewValue)
+	case change.IsRemoved:
+		return fmt.Sprintf("%s: removed, was %d", name, change.OldValue)
+	}
+
+	delta := int64(change.NewValue) - int64(change.OldValue)
+	if change.OldValue == 0 {
+		return fmt.Sprintf("%s: %d -> %d (%+d)", name, change.OldValue, change.NewValue, delta)
+	}
+	percentage := float64(delta) * 100 / float64(change.OldValue)
+	return fmt.Sprintf("%s: %d -> %d (%+d, %+.2f%%)", name, change.OldValue, change.NewValue, delta, percentage)
+}
+
+// DiffGasSchedules lists the costs that were changed, added or removed by the new gas schedule,
+// sorted by section and name.
+func DiffGasSchedules(oldSchedule GasScheduleMap, newSchedule GasScheduleMap) []*GasScheduleChange {
+	changes := make([]*GasScheduleChange, 0)
+	for _, section := range sortedSectionNames(oldSchedule, newSchedule) {
+		oldCosts := oldSchedule[section]
+		newCosts := newSchedule[section]
+		for _, name := range sortedCostNames(oldCosts, newCosts) {
+			oldValue, inOld := oldCosts[name]
+			newValue, inNew := newCosts[name]
+			if inOld && inNew && oldValue == newValue {
+				continue
+			}
+			changes = append(changes, &GasScheduleChange{
+				Section:   section,
+				Name:      name,
+				OldValue:  oldValue,
+				NewValue:  newValue,
+				IsAdded:   !inOld,
+				IsRemoved: !inNew,
+			})
+		}
+	}
+	return changes
+}
+
+func sortedSectionNames(first GasScheduleMap, second GasScheduleMap) []string {
+	names := make(map[string]struct{})
+	for name := range first {
+		names[name] = struct{}{}
+	}
+	for name := range second {
+		names[name] = struct{}{}
+	}
+	return sortedNames(names)
+}
+
+func sortedCostNames(first map[string]uint64, second map[string]uint64) []string {
+	names := make(map[string]struct{})
+	for name := range first {
+		names[name] = struct{}{}
+	}
+	for name := range second {
+		names[name] = struct{}{}
+	}
+	return sortedNames(names)
+}
+
+func sortedNames(names map[string]struct{}) []string {
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffGasSchedules(t *testing.T) {
+	oldSchedule := GasScheduleMap{
+		"BaseOperationCost": {"StorePerByte": 50, "ReleasePerByte": 10, "DataCopyPerByte": 1},
+		"WASMOpcodeCost":    {"I32Add": 1, "I64Add": 2},
+		"Removed":           {"Cost": 7},
+	}
+	newSchedule := GasScheduleMap{
+		"BaseOperationCost": {"StorePerByte": 100, "ReleasePerByte": 10, "DataCopyPerByte": 1},
+		"WASMOpcodeCost":    {"I32Add": 1, "I64Add": 1, "I64Mul": 3},
+	}
+
+	changes := DiffGasSchedules(oldSchedule, newSchedule)
+	require.Equal(t, []*GasScheduleChange{
+		{Section: "BaseOperationCost", Name: "StorePerByte", OldValue: 50, NewValue: 100},
+		{Section: "Removed", Name: "Cost", OldValue: 7, IsRemoved: true},
+		{Section: "WASMOpcodeCost", Name: "I64Add", OldValue: 2, NewValue: 1},
+		{Section: "WASMOpcodeCost", Name: "I64Mul", NewValue: 3, IsAdded: true},
+	}, changes)
+
+	require.Equal(t, "BaseOperationCost.StorePerByte: 50 -> 100 (+50, +100.00%)", changes[0].String())
+	require.Equal(t, "Removed.Cost: removed, was 7", changes[1].String())
+	require.Equal(t, "WASMOpcodeCost.I64Add: 2 -> 1 (-1, -50.00%)", changes[2].String())
+	require.Equal(t, "WASMOpcodeCost.I64Mul: added, 3", changes[3].String())
+}
+
+func TestDiffGasSchedules_Identical(t *testing.T) {
+	schedule := MakeGasMapForTests()
+	require.Empty(t, DiffGasSchedules(schedule, schedule))
+}
@@ -0,0 +1,138 @@
+package bn254
+
+import (
+	"math/big"
+
+	gnark "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+const coordinateLength = fp.Bytes
+
+// G1PointLength is the length of an encoded G1 point: x and y, as 32 bytes big endian each
+const G1PointLength = 2 * coordinateLength
+
+// G2PointLength is the length of an encoded G2 point: the imaginary and real parts of x, then those of y
+const G2PointLength = 4 * coordinateLength
+
+type bn254 struct {
+}
+
+// NewBN254 returns the component able to operate on the points of the BN254 (alt_bn128) curve. Points
+// are encoded as in the Ethereum precompiles, with the point at infinity encoded as zeros.
+func NewBN254() *bn254 {
+	return &bn254{}
+}
+
+// AddBN254G1 adds two points of G1
+func (b *bn254) AddBN254G1(point1 []byte, point2 []byte) ([]byte, error) {
+	p1, err := decodeG1(point1)
+	if err != nil {
+		return nil, err
+	}
+	p2, err := decodeG1(point2)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := new(gnark.G1Affine).Add(p1, p2)
+	return encodeG1(sum), nil
+}
+
+// ScalarMulBN254G1 multiplies a point of G1 by a scalar, given as big endian bytes
+func (b *bn254) ScalarMulBN254G1(point []byte, scalar []byte) ([]byte, error) {
+	p, err := decodeG1(point)
+	if err != nil {
+		return nil, err
+	}
+
+	k := new(big.Int).SetBytes(scalar)
+	k.Mod(k, fr.Modulus())
+	product := new(gnark.G1Affine).ScalarMultiplication(p, k)
+	return encodeG1(product), nil
+}
+
+// PairingCheckBN254 returns true if the product of the pairings e(g1Points[i], g2Points[i]) is 1,
+// which is also the case when there are no points
+func (b *bn254) PairingCheckBN254(g1Points [][]byte, g2Points [][]byte) (bool, error) {
+	if len(g1Points) != len(g2Points) {
+		return false, ErrPairingInputsMismatch
+	}
+	if len(g1Points) == 0 {
+		return true, nil
+	}
+
+	decodedG1Points := make([]gnark.G1Affine, 0, len(g1Points))
+	decodedG2Points := make([]gnark.G2Affine, 0, len(g2Points))
+	for i := range g1Points {
+		p, err := decodeG1(g1Points[i])
+		if err != nil {
+			return false, err
+		}
+		q, err := decodeG2(g2Points[i])
+		if err != nil {
+			return false, err
+		}
+		decodedG1Points = append(decodedG1Points, *p)
+		decodedG2Points = append(decodedG2Points, *q)
+	}
+
+	return gnark.PairingCheck(decodedG1Points, decodedG2Points)
+}
+
+// decodeG1 decodes a point of G1, which is the whole curve, since its cofactor is 1
+func decodeG1(data []byte) (*gnark.G1Affine, error) {
+	if len(data) != G1PointLength {
+		return nil, ErrInvalidPointLength
+	}
+
+	p := &gnark.G1Affine{}
+	err := decodeCoordinates(data, &p.X, &p.Y)
+	if err != nil {
+		return nil, err
+	}
+	if !p.IsOnCurve() {
+		return nil, ErrPointNotOnCurve
+	}
+	return p, nil
+}
+
+func decodeG2(data []byte) (*gnark.G2Affine, error) {
+	if len(data) != G2PointLength {
+		return nil, ErrInvalidPointLength
+	}
+
+	q := &gnark.G2Affine{}
+	err := decodeCoordinates(data, &q.X.A1, &q.X.A0, &q.Y.A1, &q.Y.A0)
+	if err != nil {
+		return nil, err
+	}
+	if !q.IsOnCurve() {
+		return nil, ErrPointNotOnCurve
+	}
+	if !q.IsInSubGroup() {
+		return nil, ErrPointNotInSubgroup
+	}
+	return q, nil
+}
+
+// decodeCoordinates sets the given field elements from consecutive big endian coordinates, all zero coordinates
+// decoding to the point at infinity
+func decodeCoordinates(data []byte, coordinates ...*fp.Element) error {
+	for i, coordinate := range coordinates {
+		err := coordinate.SetBytesCanonical(data[i*coordinateLength : (i+1)*coordinateLength])
+		if err != nil {
+			return ErrInvalidCoordinate
+		}
+	}
+	return nil
+}
+
+func encodeG1(p *gnark.G1Affine) []byte {
+	result := make([]byte, 0, G1PointLength)
+	x := p.X.Bytes()
+	y := p.Y.Bytes()
+	result = append(result, x[:]...)
+	return append(result, y[:]...)
+}
@@ -0,0 +1,302 @@
+package bn254
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gnark "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const g1Generator = "0000000000000000000000000000000000000000000000000000000000000001" +
+	"0000000000000000000000000000000000000000000000000000000000000002"
+
+const g1GeneratorDoubled = "030644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd3" +
+	"15ed738c0e0a7c92e7845f96b2ae9c0a68a6a449e3538fc7ff3ebf7a5a18a2c4"
+
+const g2Generator = "198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c2" +
+	"1800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+	"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b" +
+	"12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa"
+
+// g2NotInSubgroup is the point of the twist with x = 1, which is not in G2
+const g2NotInSubgroup = "0000000000000000000000000000000000000000000000000000000000000000" +
+	"0000000000000000000000000000000000000000000000000000000000000001" +
+	"0d1271953ed9ea0836846e70a1934187998c7f790cb4d7511b7f8da82de048a4" +
+	"2869111d5381f072f8e2728fdb825a51aadd70e52c9830e9ab4b871c0531f1bb"
+
+// precompileVector is a test vector of the Ethereum BN254 precompiles (EIP-196 and EIP-197),
+// as found in testdata, which is copied from go-ethereum
+type precompileVector struct {
+	Input    string
+	Expected string
+	Name     string
+}
+
+func TestBN254_AddBN254G1(t *testing.T) {
+	t.Parallel()
+
+	b := NewBN254()
+	generator := decodeHex(t, g1Generator)
+	infinity := make([]byte, G1PointLength)
+
+	sum, err := b.AddBN254G1(generator, generator)
+	require.Nil(t, err)
+	assert.Equal(t, g1GeneratorDoubled, hex.EncodeToString(sum))
+
+	sum, err = b.AddBN254G1(generator, infinity)
+	require.Nil(t, err)
+	assert.Equal(t, generator, sum)
+
+	negated := encodeG1(new(gnark.G1Affine).Neg(mustDecodeG1(t, g1Generator)))
+	sum, err = b.AddBN254G1(generator, negated)
+	require.Nil(t, err)
+	assert.Equal(t, infinity, sum)
+
+	_, err = b.AddBN254G1(generator, generator[1:])
+	assert.Equal(t, ErrInvalidPointLength, err)
+
+	notOnCurve := decodeHex(t, g1Generator)
+	notOnCurve[G1PointLength-1] = 3
+	_, err = b.AddBN254G1(generator, notOnCurve)
+	assert.Equal(t, ErrPointNotOnCurve, err)
+
+	invalidCoordinate := decodeHex(t, g1Generator)
+	fp.Modulus().FillBytes(invalidCoordinate[:coordinateLength])
+	_, err = b.AddBN254G1(invalidCoordinate, generator)
+	assert.Equal(t, ErrInvalidCoordinate, err)
+}
+
+func TestBN254_AddBN254G1_EIP196Vectors(t *testing.T) {
+	t.Parallel()
+
+	b := NewBN254()
+	for _, vector := range loadPrecompileVectors(t, "bn256Add.json") {
+		input := rightPadded(decodeHex(t, vector.Input), 2*G1PointLength)
+		sum, err := b.AddBN254G1(input[:G1PointLength], input[G1PointLength:])
+		require.Nil(t, err, vector.Name)
+		assert.Equal(t, vector.Expected, hex.EncodeToString(sum), vector.Name)
+	}
+}
+
+func TestBN254_ScalarMulBN254G1(t *testing.T) {
+	t.Parallel()
+
+	b := NewBN254()
+	generator := decodeHex(t, g1Generator)
+	infinity := make([]byte, G1PointLength)
+
+	product, err := b.ScalarMulBN254G1(generator, []byte{2})
+	require.Nil(t, err)
+	assert.Equal(t, g1GeneratorDoubled, hex.EncodeToString(product))
+
+	product, err = b.ScalarMulBN254G1(generator, []byte{0, 0, 1})
+	require.Nil(t, err)
+	assert.Equal(t, generator, product)
+
+	product, err = b.ScalarMulBN254G1(generator, nil)
+	require.Nil(t, err)
+	assert.Equal(t, infinity, product)
+
+	product, err = b.ScalarMulBN254G1(generator, fr.Modulus().Bytes())
+	require.Nil(t, err)
+	assert.Equal(t, infinity, product)
+
+	// scalars are reduced modulo the group order
+	scalar := new(big.Int).Add(fr.Modulus(), big.NewInt(2))
+	product, err = b.ScalarMulBN254G1(generator, scalar.Bytes())
+	require.Nil(t, err)
+	assert.Equal(t, g1GeneratorDoubled, hex.EncodeToString(product))
+
+	_, err = b.ScalarMulBN254G1(generator[:coordinateLength], []byte{2})
+	assert.Equal(t, ErrInvalidPointLength, err)
+}
+
+func TestBN254_ScalarMulBN254G1_EIP196Vectors(t *testing.T) {
+	t.Parallel()
+
+	b := NewBN254()
+	for _, vector := range loadPrecompileVectors(t, "bn256ScalarMul.json") {
+		input := rightPadded(decodeHex(t, vector.Input), G1PointLength+coordinateLength)
+		product, err := b.ScalarMulBN254G1(input[:G1PointLength], input[G1PointLength:])
+		require.Nil(t, err, vector.Name)
+		assert.Equal(t, vector.Expected, hex.EncodeToString(product), vector.Name)
+	}
+}
+
+func TestBN254_PairingCheckBN254(t *testing.T) {
+	t.Parallel()
+
+	b := NewBN254()
+	g1 := mustDecodeG1(t, g1Generator)
+	g2 := mustDecodeG2(t, g2Generator)
+
+	// e(a*P, b*Q) * e(-a*b*P, Q) = 1
+	a := big.NewInt(1234567)
+	c := big.NewInt(7654321)
+	ac := new(big.Int).Mul(a, c)
+	negatedACG1 := new(gnark.G1Affine).ScalarMultiplication(g1, ac)
+	negatedACG1.Neg(negatedACG1)
+	g1Points := [][]byte{
+		encodeG1(new(gnark.G1Affine).ScalarMultiplication(g1, a)),
+		encodeG1(negatedACG1),
+	}
+	g2Points := [][]byte{
+		encodeG2(new(gnark.G2Affine).ScalarMultiplication(g2, c)),
+		encodeG2(g2),
+	}
+	ok, err := b.PairingCheckBN254(g1Points, g2Points)
+	require.Nil(t, err)
+	assert.True(t, ok)
+
+	g1Points[1] = encodeG1(new(gnark.G1Affine).ScalarMultiplication(g1, ac))
+	ok, err = b.PairingCheckBN254(g1Points, g2Points)
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	ok, err = b.PairingCheckBN254(g1Points[:1], g2Points[:1])
+	require.Nil(t, err)
+	assert.False(t, ok)
+
+	ok, err = b.PairingCheckBN254(nil, nil)
+	require.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = b.PairingCheckBN254([][]byte{make([]byte, G1PointLength)}, g2Points[:1])
+	require.Nil(t, err)
+	assert.True(t, ok)
+
+	_, err = b.PairingCheckBN254(g1Points, g2Points[:1])
+	assert.Equal(t, ErrPairingInputsMismatch, err)
+
+	_, err = b.PairingCheckBN254(g1Points[:1], [][]byte{decodeHex(t, g2NotInSubgroup)})
+	assert.Equal(t, ErrPointNotInSubgroup, err)
+
+	notOnTwist := decodeHex(t, g2Generator)
+	notOnTwist[G2PointLength-1]++
+	_, err = b.PairingCheckBN254(g1Points[:1], [][]byte{notOnTwist})
+	assert.Equal(t, ErrPointNotOnCurve, err)
+}
+
+func TestBN254_PairingCheckBN254_EIP197Vectors(t *testing.T) {
+	t.Parallel()
+
+	b := NewBN254()
+	pairLength := G1PointLength + G2PointLength
+	for _, vector := range loadPrecompileVectors(t, "bn256Pairing.json") {
+		input := decodeHex(t, vector.Input)
+		require.Zero(t, len(input)%pairLength, vector.Name)
+
+		g1Points := make([][]byte, 0, len(input)/pairLength)
+		g2Points := make([][]byte, 0, len(input)/pairLength)
+		for i := 0; i < len(input); i += pairLength {
+			g1Points = append(g1Points, input[i:i+G1PointLength])
+			g2Points = append(g2Points, input[i+G1PointLength:i+pairLength])
+		}
+
+		ok, err := b.PairingCheckBN254(g1Points, g2Points)
+		require.Nil(t, err, vector.Name)
+		expected := new(big.Int).SetBytes(decodeHex(t, vector.Expected))
+		assert.Equal(t, expected.Sign() != 0, ok, vector.Name)
+	}
+}
+
+func BenchmarkBN254_AddBN254G1(b *testing.B) {
+	bn := NewBN254()
+	point1 := decodeHex(b, g1Generator)
+	point2 := decodeHex(b, g1GeneratorDoubled)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = bn.AddBN254G1(point1, point2)
+	}
+}
+
+func BenchmarkBN254_ScalarMulBN254G1(b *testing.B) {
+	bn := NewBN254()
+	point := decodeHex(b, g1GeneratorDoubled)
+	scalar := new(big.Int).Sub(fr.Modulus(), big.NewInt(1)).Bytes()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = bn.ScalarMulBN254G1(point, scalar)
+	}
+}
+
+func BenchmarkBN254_PairingCheckBN254_OnePair(b *testing.B) {
+	benchmarkPairingCheck(b, 1)
+}
+
+func BenchmarkBN254_PairingCheckBN254_TwoPairs(b *testing.B) {
+	benchmarkPairingCheck(b, 2)
+}
+
+func BenchmarkBN254_PairingCheckBN254_TenPairs(b *testing.B) {
+	benchmarkPairingCheck(b, 10)
+}
+
+func benchmarkPairingCheck(b *testing.B, numPairs int) {
+	bn := NewBN254()
+	g1Points := make([][]byte, numPairs)
+	g2Points := make([][]byte, numPairs)
+	for i := 0; i < numPairs; i++ {
+		g1Points[i] = decodeHex(b, g1Generator)
+		g2Points[i] = decodeHex(b, g2Generator)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = bn.PairingCheckBN254(g1Points, g2Points)
+	}
+}
+
+func loadPrecompileVectors(t testing.TB, fileName string) []precompileVector {
+	data, err := os.ReadFile(filepath.Join("testdata", fileName))
+	require.Nil(t, err)
+
+	var vectors []precompileVector
+	require.Nil(t, json.Unmarshal(data, &vectors))
+	require.NotEmpty(t, vectors)
+	return vectors
+}
+
+// rightPadded pads the input of a precompile with zeros up to the given length, as the EVM does
+func rightPadded(input []byte, length int) []byte {
+	result := make([]byte, length)
+	copy(result, input)
+	return result
+}
+
+func mustDecodeG1(t testing.TB, encoded string) *gnark.G1Affine {
+	p, err := decodeG1(decodeHex(t, encoded))
+	require.Nil(t, err)
+	return p
+}
+
+func mustDecodeG2(t testing.TB, encoded string) *gnark.G2Affine {
+	q, err := decodeG2(decodeHex(t, encoded))
+	require.Nil(t, err)
+	return q
+}
+
+func encodeG2(q *gnark.G2Affine) []byte {
+	result := make([]byte, 0, G2PointLength)
+	for _, coordinate := range []fp.Element{q.X.A1, q.X.A0, q.Y.A1, q.Y.A0} {
+		coordinateBytes := coordinate.Bytes()
+		result = append(result, coordinateBytes[:]...)
+	}
+	return result
+}
+
+func decodeHex(t testing.TB, encoded string) []byte {
+	decoded, err := hex.DecodeString(encoded)
+	require.Nil(t, err)
+	return decoded
+}
@@ -0,0 +1,18 @@
+package bn254
+
+import "errors"
+
+// ErrInvalidPointLength signals that an encoded point does not have the expected length
+var ErrInvalidPointLength = errors.New("invalid BN254 point length")
+
+// ErrInvalidCoordinate signals that a coordinate of an encoded point is not an element of the base field
+var ErrInvalidCoordinate = errors.New("invalid BN254 point coordinate")
+
+// ErrPointNotOnCurve signals that an encoded point is not on the curve
+var ErrPointNotOnCurve = errors.New("BN254 point is not on curve")
+
+// ErrPointNotInSubgroup signals that an encoded G2 point is on the twist, but not in the subgroup of order r
+var ErrPointNotInSubgroup = errors.New("BN254 point is not in the correct subgroup")
+
+// ErrPairingInputsMismatch signals that a pairing check received different numbers of G1 and G2 points
+var ErrPairingInputsMismatch = errors.New("different number of G1 and G2 points in BN254 pairing")
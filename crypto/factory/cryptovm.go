@@ -2,10 +2,12 @@ package factory
 
 import (
 	"github.com/multiversx/mx-chain-vm-go/crypto"
+	"github.com/multiversx/mx-chain-vm-go/crypto/bn254"
 	"github.com/multiversx/mx-chain-vm-go/crypto/hashing"
 	"github.com/multiversx/mx-chain-vm-go/crypto/signing/bls"
 	"github.com/multiversx/mx-chain-vm-go/crypto/signing/ed25519"
 	"github.com/multiversx/mx-chain-vm-go/crypto/signing/secp256k1"
+	"github.com/multiversx/mx-chain-vm-go/crypto/signing/secp256r1"
 )
 
 // NewVMCrypto returns a composite struct containing VMCrypto functionality implementations
@@ -15,10 +17,14 @@ func NewVMCrypto() crypto.VMCrypto {
 		crypto.Ed25519
 		crypto.BLS
 		crypto.Secp256k1
+		crypto.Secp256r1
+		crypto.BN254
 	}{
 		Hasher:    hashing.NewHasher(),
 		Ed25519:   ed25519.NewEd25519Signer(),
 		BLS:       bls.NewBLS(),
 		Secp256k1: secp256k1.NewSecp256k1(),
+		Secp256r1: secp256r1.NewSecp256r1(),
+		BN254:     bn254.NewBN254(),
 	}
 }
@@ -0,0 +1,9 @@
+package hashing
+
+import "errors"
+
+// ErrInvalidPoseidonInputLength signals that the input of a Poseidon hash is not a sequence of 1 to 16 field elements
+var ErrInvalidPoseidonInputLength = errors.New("invalid Poseidon input length")
+
+// ErrPoseidonInputNotInField signals that an input of a Poseidon hash is not an element of the BN254 scalar field
+var ErrPoseidonInputNotInField = errors.New("Poseidon input is not an element of the BN254 scalar field")
@@ -3,7 +3,10 @@ package hashing
 
 import (
 	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
 
+	"golang.org/x/crypto/blake2b"
 	"golang.org/x/crypto/ripemd160"
 	"golang.org/x/crypto/sha3"
 )
@@ -51,3 +54,48 @@ func (h *hasher) Ripemd160(data []byte) ([]byte, error) {
 	result := hash.Sum(nil)
 	return result, nil
 }
+
+// Sha512 returns a sha 512 hash of the input string
+func (h *hasher) Sha512(data []byte) ([]byte, error) {
+	return computeHash(sha512.New(), data)
+}
+
+// Sha3256 returns a sha3 256 hash of the input string, as standardized in FIPS 202, unlike Keccak256
+func (h *hasher) Sha3256(data []byte) ([]byte, error) {
+	return computeHash(sha3.New256(), data)
+}
+
+// Sha3512 returns a sha3 512 hash of the input string, as standardized in FIPS 202
+func (h *hasher) Sha3512(data []byte) ([]byte, error) {
+	return computeHash(sha3.New512(), data)
+}
+
+// Blake2b256 returns an unkeyed blake2b hash of the input string, with a 256 bits digest
+func (h *hasher) Blake2b256(data []byte) ([]byte, error) {
+	hash, err := blake2b.New256(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeHash(hash, data)
+}
+
+// Blake2b512 returns an unkeyed blake2b hash of the input string, with a 512 bits digest
+func (h *hasher) Blake2b512(data []byte) ([]byte, error) {
+	hash, err := blake2b.New512(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeHash(hash, data)
+}
+
+func computeHash(hash hash.Hash, data []byte) ([]byte, error) {
+	_, err := hash.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := hash.Sum(nil)
+	return result, nil
+}
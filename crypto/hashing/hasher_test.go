@@ -0,0 +1,98 @@
+package hashing
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasher_Digests(t *testing.T) {
+	t.Parallel()
+
+	h := NewHasher()
+	data := []byte("abc")
+	testCases := []struct {
+		name     string
+		hash     func([]byte) ([]byte, error)
+		expected string
+	}{
+		{"sha512", h.Sha512, "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"},
+		{"sha3-256", h.Sha3256, "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532"},
+		{"sha3-512", h.Sha3512, "b751850b1a57168a5693cd924b6b096e08f621827444f70d884f5d0240d2712e10e116e9192af3c91a7ec57647e3934057340b4cf408d5a56592f8274eec53f0"},
+		{"blake2b-256", h.Blake2b256, "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319"},
+		{"blake2b-512", h.Blake2b512, "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923"},
+	}
+
+	for _, testCase := range testCases {
+		result, err := testCase.hash(data)
+		require.Nil(t, err, testCase.name)
+		require.Equal(t, testCase.expected, hex.EncodeToString(result), testCase.name)
+	}
+
+	keccak, _ := h.Keccak256(data)
+	sha3, _ := h.Sha3256(data)
+	require.NotEqual(t, keccak, sha3)
+}
+
+func TestHasher_Poseidon(t *testing.T) {
+	t.Parallel()
+
+	h := NewHasher()
+	testCases := []struct {
+		inputs   []int64
+		expected string
+	}{
+		{[]int64{1}, "18586133768512220936620570745912940619677854269274689475585506675881198879027"},
+		{[]int64{1, 2}, "7853200120776062878684798364095072458815029376092732009249414926327459813530"},
+		{[]int64{1, 2, 0, 0, 0}, "1018317224307729531995786483840663576608797660851238720571059489595066344487"},
+	}
+
+	for _, testCase := range testCases {
+		result, err := h.Poseidon(encodePoseidonInputs(testCase.inputs...))
+		require.Nil(t, err)
+		require.Len(t, result, PoseidonElementLength)
+		require.Equal(t, testCase.expected, new(big.Int).SetBytes(result).String())
+	}
+
+	maxInputs := make([]int64, MaxPoseidonInputs)
+	_, err := h.Poseidon(encodePoseidonInputs(maxInputs...))
+	require.Nil(t, err)
+}
+
+func TestPoseidonFieldMultiplications(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, uint64((8+56)*2*2), PoseidonFieldMultiplications(1))
+	require.Equal(t, uint64((8+57)*3*3), PoseidonFieldMultiplications(2))
+	require.Equal(t, uint64((8+68)*17*17), PoseidonFieldMultiplications(MaxPoseidonInputs))
+	require.Zero(t, PoseidonFieldMultiplications(0))
+	require.Zero(t, PoseidonFieldMultiplications(MaxPoseidonInputs+1))
+}
+
+func TestHasher_PoseidonInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	h := NewHasher()
+
+	_, err := h.Poseidon(nil)
+	require.Equal(t, ErrInvalidPoseidonInputLength, err)
+
+	_, err = h.Poseidon(make([]byte, PoseidonElementLength+1))
+	require.Equal(t, ErrInvalidPoseidonInputLength, err)
+
+	_, err = h.Poseidon(make([]byte, (MaxPoseidonInputs+1)*PoseidonElementLength))
+	require.Equal(t, ErrInvalidPoseidonInputLength, err)
+
+	_, err = h.Poseidon(scalarField.FillBytes(make([]byte, PoseidonElementLength)))
+	require.Equal(t, ErrPoseidonInputNotInField, err)
+}
+
+func encodePoseidonInputs(inputs ...int64) []byte {
+	data := make([]byte, 0, len(inputs)*PoseidonElementLength)
+	for _, input := range inputs {
+		data = append(data, big.NewInt(input).FillBytes(make([]byte, PoseidonElementLength))...)
+	}
+	return data
+}
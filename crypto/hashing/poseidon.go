@@ -0,0 +1,240 @@
+package hashing
+
+import (
+	"math/big"
+)
+
+// PoseidonElementLength is the length of a field element in the input and the output of a Poseidon hash, big endian
+const PoseidonElementLength = 32
+
+// MaxPoseidonInputs is the maximum number of field elements hashed at once by Poseidon
+const MaxPoseidonInputs = 16
+
+const poseidonFullRounds = 8
+
+// poseidonPartialRounds holds the number of partial rounds for each state width, starting with 2
+var poseidonPartialRounds = [MaxPoseidonInputs]int{56, 57, 56, 60, 60, 63, 64, 63, 60, 66, 60, 65, 70, 60, 64, 68}
+
+var scalarField, _ = new(big.Int).SetString("21888242871839275222246405745257275088548364400416034343698204186575808495617", 10)
+
+type poseidonParams struct {
+	width          int
+	partialRounds  int
+	roundConstants []*big.Int
+	mds            [][]*big.Int
+}
+
+// poseidonParamsByWidth holds the parameters for each state width, starting with 2, generated once at startup
+var poseidonParamsByWidth = generateAllPoseidonParams()
+
+// PoseidonFieldMultiplications returns the number of field multiplications done by the MDS matrix while hashing
+// the given number of field elements, i.e. the number of rounds times the square of the state width, which
+// dominates the cost of the hash. Returns 0 if the number of elements cannot be hashed.
+func PoseidonFieldMultiplications(numInputs int) uint64 {
+	if numInputs <= 0 || numInputs > MaxPoseidonInputs {
+		return 0
+	}
+	params := poseidonParamsByWidth[numInputs-1]
+	numRounds := poseidonFullRounds + params.partialRounds
+	return uint64(numRounds * params.width * params.width)
+}
+
+// Poseidon returns the Poseidon hash of a sequence of BN254 scalar field elements, each encoded as 32
+// bytes big endian. The hash is the same as the one of circomlib: the state holds a zero capacity
+// element followed by the inputs, and has x^5 S-boxes, 8 full rounds and the partial rounds
+// recommended for its width.
+func (h *hasher) Poseidon(data []byte) ([]byte, error) {
+	numInputs := len(data) / PoseidonElementLength
+	if len(data)%PoseidonElementLength != 0 || numInputs == 0 || numInputs > MaxPoseidonInputs {
+		return nil, ErrInvalidPoseidonInputLength
+	}
+
+	state := make([]*big.Int, numInputs+1)
+	state[0] = big.NewInt(0)
+	for i := 0; i < numInputs; i++ {
+		element := new(big.Int).SetBytes(data[i*PoseidonElementLength : (i+1)*PoseidonElementLength])
+		if element.Cmp(scalarField) >= 0 {
+			return nil, ErrPoseidonInputNotInField
+		}
+		state[i+1] = element
+	}
+
+	params := poseidonParamsByWidth[numInputs-1]
+	params.permute(state)
+
+	result := make([]byte, PoseidonElementLength)
+	return state[0].FillBytes(result), nil
+}
+
+func (params *poseidonParams) permute(state []*big.Int) {
+	halfFullRounds := poseidonFullRounds / 2
+	numRounds := poseidonFullRounds + params.partialRounds
+	mixed := make([]*big.Int, params.width)
+	for i := range mixed {
+		mixed[i] = new(big.Int)
+	}
+	product := new(big.Int)
+
+	for round := 0; round < numRounds; round++ {
+		for i := range state {
+			state[i].Add(state[i], params.roundConstants[round*params.width+i])
+		}
+
+		isFullRound := round < halfFullRounds || round >= halfFullRounds+params.partialRounds
+		if isFullRound {
+			for i := range state {
+				fifthPower(state[i])
+			}
+		} else {
+			fifthPower(state[0])
+		}
+
+		for i := range mixed {
+			mixed[i].SetInt64(0)
+			for j := range state {
+				product.Mul(params.mds[i][j], state[j])
+				mixed[i].Add(mixed[i], product)
+			}
+			mixed[i].Mod(mixed[i], scalarField)
+		}
+		for i := range state {
+			state[i].Set(mixed[i])
+		}
+	}
+}
+
+func fifthPower(element *big.Int) {
+	element.Mod(element, scalarField)
+	square := new(big.Int).Mul(element, element)
+	square.Mod(square, scalarField)
+	square.Mul(square, square)
+	square.Mod(square, scalarField)
+	element.Mul(element, square)
+	element.Mod(element, scalarField)
+}
+
+func generateAllPoseidonParams() []*poseidonParams {
+	allParams := make([]*poseidonParams, MaxPoseidonInputs)
+	for i := range allParams {
+		allParams[i] = generatePoseidonParams(i + 2)
+	}
+	return allParams
+}
+
+// generatePoseidonParams derives the round constants and the MDS matrix for a state width, using the
+// Grain LFSR of the reference implementation of Poseidon, as circomlib does
+func generatePoseidonParams(width int) *poseidonParams {
+	partialRounds := poseidonPartialRounds[width-2]
+	lfsr := newGrainLFSR(width, poseidonFullRounds, partialRounds)
+
+	numConstants := (poseidonFullRounds + partialRounds) * width
+	roundConstants := make([]*big.Int, numConstants)
+	for i := range roundConstants {
+		roundConstants[i] = lfsr.nextFieldElement()
+	}
+
+	return &poseidonParams{
+		width:          width,
+		partialRounds:  partialRounds,
+		roundConstants: roundConstants,
+		mds:            generateCauchyMatrix(lfsr, width),
+	}
+}
+
+// generateCauchyMatrix builds the matrix M[i][j] = 1 / (x[i] + y[j]), from 2 * width distinct random values
+func generateCauchyMatrix(lfsr *grainLFSR, width int) [][]*big.Int {
+	var values []*big.Int
+	for !allDistinct(values) || len(values) != 2*width {
+		values = make([]*big.Int, 2*width)
+		for i := range values {
+			values[i] = lfsr.nextBits(scalarField.BitLen())
+			values[i].Mod(values[i], scalarField)
+		}
+	}
+
+	matrix := make([][]*big.Int, width)
+	for i := range matrix {
+		matrix[i] = make([]*big.Int, width)
+		for j := range matrix[i] {
+			sum := new(big.Int).Add(values[i], values[width+j])
+			sum.Mod(sum, scalarField)
+			matrix[i][j] = sum.ModInverse(sum, scalarField)
+		}
+	}
+	return matrix
+}
+
+func allDistinct(values []*big.Int) bool {
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		seen[value.String()] = struct{}{}
+	}
+	return len(seen) == len(values)
+}
+
+// grainLFSR is the 80 bits self-shrinking LFSR used to generate the Poseidon parameters
+type grainLFSR struct {
+	state [80]bool
+}
+
+func newGrainLFSR(width int, fullRounds int, partialRounds int) *grainLFSR {
+	lfsr := &grainLFSR{}
+	position := 0
+	appendBits := func(value int, numBits int) {
+		for i := numBits - 1; i >= 0; i-- {
+			lfsr.state[position] = (value>>i)&1 == 1
+			position++
+		}
+	}
+
+	appendBits(1, 2) // prime field
+	appendBits(0, 4) // x^alpha S-box
+	appendBits(scalarField.BitLen(), 12)
+	appendBits(width, 12)
+	appendBits(fullRounds, 10)
+	appendBits(partialRounds, 10)
+	appendBits(1<<30-1, 30)
+
+	for i := 0; i < 160; i++ {
+		lfsr.step()
+	}
+	return lfsr
+}
+
+func (lfsr *grainLFSR) step() bool {
+	s := &lfsr.state
+	bit := s[62] != s[51] != s[38] != s[23] != s[13] != s[0]
+	copy(s[:], s[1:])
+	s[len(s)-1] = bit
+	return bit
+}
+
+func (lfsr *grainLFSR) nextBit() bool {
+	for {
+		keep := lfsr.step()
+		bit := lfsr.step()
+		if keep {
+			return bit
+		}
+	}
+}
+
+func (lfsr *grainLFSR) nextBits(numBits int) *big.Int {
+	value := new(big.Int)
+	for i := 0; i < numBits; i++ {
+		value.Lsh(value, 1)
+		if lfsr.nextBit() {
+			value.SetBit(value, 0, 1)
+		}
+	}
+	return value
+}
+
+func (lfsr *grainLFSR) nextFieldElement() *big.Int {
+	for {
+		value := lfsr.nextBits(scalarField.BitLen())
+		if value.Cmp(scalarField) < 0 {
+			return value
+		}
+	}
+}
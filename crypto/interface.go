@@ -5,16 +5,26 @@ type Hasher interface {
 	Sha256(data []byte) ([]byte, error)
 	Keccak256(data []byte) ([]byte, error)
 	Ripemd160(data []byte) ([]byte, error)
+	Sha512(data []byte) ([]byte, error)
+	Sha3256(data []byte) ([]byte, error)
+	Sha3512(data []byte) ([]byte, error)
+	Blake2b256(data []byte) ([]byte, error)
+	Blake2b512(data []byte) ([]byte, error)
+	Poseidon(data []byte) ([]byte, error)
 }
 
-// BLS defines the functionality of a component able to verify BLS signatures
+// BLS defines the functionality of a component able to verify BLS signatures, including aggregated ones
 type BLS interface {
 	VerifyBLS(key []byte, msg []byte, sig []byte) error
+	VerifyAggregatedBLS(keys [][]byte, msg []byte, aggregatedSig []byte) error
+	AggregateBLSPublicKeys(keys [][]byte) ([]byte, error)
 }
 
-// Ed25519 defines the functionality of a component able to verify Ed25519 signatures
+// Ed25519 defines the functionality of a component able to verify Ed25519 signatures, one by one or in batches,
+// where the signatures of a batch are still verified one after the other
 type Ed25519 interface {
 	VerifyEd25519(key []byte, msg []byte, sig []byte) error
+	VerifyEd25519Batch(keys [][]byte, msgs [][]byte, sigs [][]byte) ([]bool, error)
 }
 
 // Secp256k1 defines the functionality of a component able to verify and encode Secp256k1 signatures
@@ -23,10 +33,27 @@ type Secp256k1 interface {
 	EncodeSecp256k1DERSignature(r, s []byte) []byte
 }
 
+// Secp256r1 defines the functionality of a component able to verify Secp256r1 (NIST P-256) signatures, one by one or in batches,
+// where the signatures of a batch are still verified one after the other
+type Secp256r1 interface {
+	VerifySecp256r1(key []byte, msg []byte, sig []byte) error
+	VerifySecp256r1Batch(keys [][]byte, msgs [][]byte, sigs [][]byte) ([]bool, error)
+}
+
+// BN254 defines the functionality of a component able to add and multiply points of the BN254 pairing-friendly
+// curve, and to check that a product of pairings is one, as needed to verify zero-knowledge proofs
+type BN254 interface {
+	AddBN254G1(point1 []byte, point2 []byte) ([]byte, error)
+	ScalarMulBN254G1(point []byte, scalar []byte) ([]byte, error)
+	PairingCheckBN254(g1Points [][]byte, g2Points [][]byte) (bool, error)
+}
+
 // VMCrypto will provide the interface to the main crypto functionalities of the vm
 type VMCrypto interface {
 	Hasher
 	Ed25519
 	BLS
 	Secp256k1
+	Secp256r1
+	BN254
 }
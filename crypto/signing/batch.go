@@ -0,0 +1,25 @@
+package signing
+
+// VerifyBatch verifies the signatures of a batch of (key, message, signature) triples with the given function,
+// returning for each of them whether the signature is valid.
+//
+// The signatures are verified one after the other, not with a batch verification equation: the batch equation
+// of Ed25519 is cofactored, while the single verification is not, so some signatures would be accepted by one and
+// rejected by the other. A batch therefore costs as much as verifying its signatures separately.
+func VerifyBatch(
+	keys [][]byte,
+	messages [][]byte,
+	sigs [][]byte,
+	verify func(key []byte, msg []byte, sig []byte) error,
+) ([]bool, error) {
+	if len(keys) != len(messages) || len(keys) != len(sigs) {
+		return nil, ErrBatchLengthMismatch
+	}
+
+	results := make([]bool, len(keys))
+	for i := range keys {
+		results[i] = verify(keys[i], messages[i], sigs[i]) == nil
+	}
+
+	return results, nil
+}
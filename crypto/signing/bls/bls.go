@@ -1,23 +1,34 @@
 package bls
 
 import (
+	"encoding/hex"
+
+	herumiBLS "github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
 )
 
 type bls struct {
+	suite        crypto.Suite
 	keyGenerator crypto.KeyGenerator
 	signer       crypto.SingleSigner
+	hasher       hashing.Hasher
+	multiSigner  crypto.LowLevelSignerBLS
 }
 
 // NewBLS returns the component able to verify BLS signatures
 func NewBLS() *bls {
 	b := &bls{}
-	suite := mcl.NewSuiteBLS12()
-	b.keyGenerator = signing.NewKeyGenerator(suite)
+	b.suite = mcl.NewSuiteBLS12()
+	b.keyGenerator = signing.NewKeyGenerator(b.suite)
 	b.signer = singlesig.NewBlsSigner()
+	b.hasher, _ = blake2b.NewBlake2bWithSize(multisig.HasherOutputSize)
+	b.multiSigner = &multisig.BlsMultiSigner{Hasher: b.hasher}
 
 	return b
 }
@@ -31,3 +42,89 @@ func (b *bls) VerifyBLS(key []byte, msg []byte, sig []byte) error {
 
 	return b.signer.Verify(publicKey, msg, sig)
 }
+
+// VerifyAggregatedBLS verifies a BLS multi-signature aggregated from the signatures of all the given keys over the same
+// message; every key and signature is weighted by a coefficient hashed from all the keys, which prevents rogue key attacks
+func (b *bls) VerifyAggregatedBLS(keys [][]byte, msg []byte, aggregatedSig []byte) error {
+	publicKeys, err := b.publicKeysFromByteArrays(keys)
+	if err != nil {
+		return err
+	}
+
+	return b.multiSigner.VerifyAggregatedSig(b.suite, publicKeys, aggregatedSig, msg)
+}
+
+// AggregateBLSPublicKeys returns the sum of the given public keys, each weighted by the same coefficient used by
+// VerifyAggregatedBLS, so that the result verifies the multi-signature of all of them as a single signature
+func (b *bls) AggregateBLSPublicKeys(keys [][]byte) ([]byte, error) {
+	publicKeys, err := b.publicKeysFromByteArrays(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	concatenatedKeys := make([]byte, 0, len(keys)*b.suite.PointLen())
+	for _, publicKey := range publicKeys {
+		pointBytes, err := publicKey.Point().MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		concatenatedKeys = append(concatenatedKeys, pointBytes...)
+	}
+
+	aggregatedPoint := b.suite.CreatePoint().Null()
+	for _, publicKey := range publicKeys {
+		weightedPoint, err := b.weightPublicKey(publicKey.Point(), concatenatedKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		aggregatedPoint, err = aggregatedPoint.Add(weightedPoint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return aggregatedPoint.MarshalBinary()
+}
+
+// weightPublicKey multiplies the key with t = H(key, {key_1, ..., key_n}), computed as in multisig.BlsMultiSigner
+func (b *bls) weightPublicKey(point crypto.Point, concatenatedKeys []byte) (crypto.Point, error) {
+	g2Point, ok := point.GetUnderlyingObj().(*herumiBLS.G2)
+	if !ok {
+		return nil, crypto.ErrInvalidPoint
+	}
+
+	hash := b.hasher.Compute(g2Point.GetString(16) + string(concatenatedKeys))
+	coefficient := make([]byte, 32)
+	copy(coefficient[multisig.HasherOutputSize:], hash)
+
+	scalar := b.suite.CreateScalar()
+	mclScalar, ok := scalar.(*mcl.Scalar)
+	if !ok {
+		return nil, crypto.ErrInvalidScalar
+	}
+
+	err := mclScalar.Scalar.SetString(hex.EncodeToString(coefficient), 16)
+	if err != nil {
+		return nil, err
+	}
+
+	return point.Mul(scalar)
+}
+
+func (b *bls) publicKeysFromByteArrays(keys [][]byte) ([]crypto.PublicKey, error) {
+	if len(keys) == 0 {
+		return nil, crypto.ErrNilPublicKeys
+	}
+
+	publicKeys := make([]crypto.PublicKey, 0, len(keys))
+	for _, key := range keys {
+		publicKey, err := b.keyGenerator.PublicKeyFromByteArray(key)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys, nil
+}
@@ -5,6 +5,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -20,6 +23,97 @@ func TestBls_VerifyBLS(t *testing.T) {
 	assert.NotNil(t, b.VerifyBLS(splitString(t, checkNOK)))
 }
 
+func TestBls_VerifyAggregatedBLS(t *testing.T) {
+	t.Parallel()
+
+	b := NewBLS()
+	msg := []byte("message signed by all the keys")
+	keys, aggregatedSig := createAggregatedSignature(t, b, 3, msg)
+
+	assert.Nil(t, b.VerifyAggregatedBLS(keys, msg, aggregatedSig))
+	assert.NotNil(t, b.VerifyAggregatedBLS(keys, []byte("other message"), aggregatedSig))
+	assert.NotNil(t, b.VerifyAggregatedBLS(keys[:2], msg, aggregatedSig))
+	assert.Equal(t, crypto.ErrNilPublicKeys, b.VerifyAggregatedBLS(nil, msg, aggregatedSig))
+	assert.NotNil(t, b.VerifyAggregatedBLS([][]byte{[]byte("invalid key")}, msg, aggregatedSig))
+}
+
+func TestBls_AggregateBLSPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	b := NewBLS()
+	msg := []byte("message signed by all the keys")
+	keys, aggregatedSig := createAggregatedSignature(t, b, 3, msg)
+
+	aggregatedKey, err := b.AggregateBLSPublicKeys(keys)
+	require.Nil(t, err)
+	assert.Nil(t, b.VerifyBLS(aggregatedKey, msg, aggregatedSig))
+
+	reorderedKey, err := b.AggregateBLSPublicKeys([][]byte{keys[2], keys[0], keys[1]})
+	require.Nil(t, err)
+	assert.NotEqual(t, aggregatedKey, reorderedKey)
+
+	singleKey, err := b.AggregateBLSPublicKeys(keys[:1])
+	require.Nil(t, err)
+	assert.NotEqual(t, keys[0], singleKey)
+
+	_, err = b.AggregateBLSPublicKeys(nil)
+	assert.Equal(t, crypto.ErrNilPublicKeys, err)
+	_, err = b.AggregateBLSPublicKeys([][]byte{keys[0], []byte("invalid key")})
+	assert.NotNil(t, err)
+}
+
+func TestBls_VerifyAggregatedBLSRejectsRogueKey(t *testing.T) {
+	t.Parallel()
+
+	b := NewBLS()
+	msg := []byte("message signed by all the keys")
+	keys, _ := createAggregatedSignature(t, b, 1, msg)
+	honestPublicKey, err := b.keyGenerator.PublicKeyFromByteArray(keys[0])
+	require.Nil(t, err)
+
+	// the attacker knows the secret key of attackerPoint and publishes rogueKey = attackerPoint - honestKey,
+	// so that the plain sum of the two keys is attackerPoint
+	attackerPrivateKey, attackerPublicKey := signing.NewKeyGenerator(b.suite).GeneratePair()
+	rogueKeyPoint, err := attackerPublicKey.Point().Sub(honestPublicKey.Point())
+	require.Nil(t, err)
+	rogueKey, err := rogueKeyPoint.MarshalBinary()
+	require.Nil(t, err)
+
+	forgedSig, err := b.signer.Sign(attackerPrivateKey, msg)
+	require.Nil(t, err)
+
+	assert.NotNil(t, b.VerifyAggregatedBLS([][]byte{keys[0], rogueKey}, msg, forgedSig))
+	aggregatedKey, err := b.AggregateBLSPublicKeys([][]byte{keys[0], rogueKey})
+	require.Nil(t, err)
+	assert.NotNil(t, b.VerifyBLS(aggregatedKey, msg, forgedSig))
+}
+
+func createAggregatedSignature(t testing.TB, b *bls, numKeys int, msg []byte) ([][]byte, []byte) {
+	keyGenerator := signing.NewKeyGenerator(b.suite)
+	multiSigner := &multisig.BlsMultiSigner{Hasher: b.hasher}
+
+	keys := make([][]byte, 0, numKeys)
+	publicKeys := make([]crypto.PublicKey, 0, numKeys)
+	sigs := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		privateKey, publicKey := keyGenerator.GeneratePair()
+		sig, err := multiSigner.SignShare(privateKey, msg)
+		require.Nil(t, err)
+
+		key, err := publicKey.ToByteArray()
+		require.Nil(t, err)
+
+		keys = append(keys, key)
+		publicKeys = append(publicKeys, publicKey)
+		sigs = append(sigs, sig)
+	}
+
+	aggregatedSig, err := multiSigner.AggregateSignatures(b.suite, sigs, publicKeys)
+	require.Nil(t, err)
+
+	return keys, aggregatedSig
+}
+
 func splitString(t testing.TB, str string) ([]byte, []byte, []byte) {
 	split := strings.Split(str, "@")
 	pkBuff, err := hex.DecodeString(split[0])
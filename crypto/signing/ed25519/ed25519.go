@@ -27,3 +27,8 @@ func (e *ed25519) VerifyEd25519(key []byte, msg []byte, sig []byte) error {
 
 	return nil
 }
+
+// VerifyEd25519Batch verifies a batch of Ed25519 signatures one after the other, returning for each of them whether it is valid
+func (e *ed25519) VerifyEd25519Batch(keys [][]byte, msgs [][]byte, sigs [][]byte) ([]bool, error) {
+	return signing.VerifyBatch(keys, msgs, sigs, e.VerifyEd25519)
+}
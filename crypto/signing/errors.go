@@ -12,3 +12,6 @@ var ErrInvalidSignature = errors.New("invalid signature")
 
 // ErrHasherNotSupported will be returned when a provided hasher type is not supported by the signature scheme
 var ErrHasherNotSupported = errors.New("hasher not supported")
+
+// ErrBatchLengthMismatch will be returned when the keys, messages and signatures of a batch verification have different lengths
+var ErrBatchLengthMismatch = errors.New("keys, messages and signatures of the batch have different lengths")
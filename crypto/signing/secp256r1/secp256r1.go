@@ -0,0 +1,60 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-vm-go/crypto/signing"
+)
+
+const rawSignatureLength = 64
+
+type secp256r1 struct {
+}
+
+// NewSecp256r1 returns the component able to verify Secp256r1 (NIST P-256) signatures
+func NewSecp256r1() *secp256r1 {
+	return &secp256r1{}
+}
+
+// VerifySecp256r1 checks an ECDSA signature over the SHA-256 hash of the message, as used by WebAuthn passkeys.
+// The key is a compressed or uncompressed SEC 1 point, and the signature is the 64 bytes r || s, big endian;
+// DER encoded signatures, as produced by WebAuthn authenticators, need to be converted by the caller.
+func (sec *secp256r1) VerifySecp256r1(key []byte, msg []byte, sig []byte) error {
+	pubKey, err := parsePublicKey(key)
+	if err != nil {
+		return err
+	}
+	if len(sig) != rawSignatureLength {
+		return signing.ErrInvalidSignature
+	}
+
+	messageHash := sha256.Sum256(msg)
+	r := new(big.Int).SetBytes(sig[:rawSignatureLength/2])
+	s := new(big.Int).SetBytes(sig[rawSignatureLength/2:])
+	if !ecdsa.Verify(pubKey, messageHash[:], r, s) {
+		return signing.ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifySecp256r1Batch verifies a batch of Secp256r1 signatures one after the other, returning for each of them whether it is valid
+func (sec *secp256r1) VerifySecp256r1Batch(keys [][]byte, msgs [][]byte, sigs [][]byte) ([]bool, error) {
+	return signing.VerifyBatch(keys, msgs, sigs, sec.VerifySecp256r1)
+}
+
+func parsePublicKey(key []byte) (*ecdsa.PublicKey, error) {
+	curve := elliptic.P256()
+
+	x, y := elliptic.Unmarshal(curve, key)
+	if x == nil {
+		x, y = elliptic.UnmarshalCompressed(curve, key)
+	}
+	if x == nil {
+		return nil, signing.ErrInvalidPublicKey
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
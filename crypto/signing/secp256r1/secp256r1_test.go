@@ -0,0 +1,71 @@
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/crypto/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecp256r1_VerifySecp256r1(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	key := elliptic.Marshal(elliptic.P256(), privateKey.X, privateKey.Y)
+	compressedKey := elliptic.MarshalCompressed(elliptic.P256(), privateKey.X, privateKey.Y)
+
+	msg := []byte("authenticator data and client data hash")
+	rawSig := signRaw(t, privateKey, msg)
+	hash := sha256.Sum256(msg)
+	derSig, err := ecdsa.SignASN1(rand.Reader, privateKey, hash[:])
+	require.Nil(t, err)
+
+	verifier := NewSecp256r1()
+	assert.Nil(t, verifier.VerifySecp256r1(key, msg, rawSig))
+	assert.Nil(t, verifier.VerifySecp256r1(compressedKey, msg, rawSig))
+
+	assert.Equal(t, signing.ErrInvalidSignature, verifier.VerifySecp256r1(key, []byte("other message"), rawSig))
+	assert.Equal(t, signing.ErrInvalidSignature, verifier.VerifySecp256r1(key, msg, rawSig[1:]))
+	assert.Equal(t, signing.ErrInvalidSignature, verifier.VerifySecp256r1(key, msg, derSig))
+	assert.Equal(t, signing.ErrInvalidPublicKey, verifier.VerifySecp256r1(key[1:], msg, rawSig))
+}
+
+func TestSecp256r1_VerifySecp256r1Batch(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	key := elliptic.Marshal(elliptic.P256(), privateKey.X, privateKey.Y)
+
+	msgs := [][]byte{[]byte("first"), []byte("second"), []byte("third")}
+	sigs := make([][]byte, 0, len(msgs))
+	for _, msg := range msgs {
+		sigs = append(sigs, signRaw(t, privateKey, msg))
+	}
+	sigs[1], sigs[2] = sigs[2], sigs[1]
+
+	verifier := NewSecp256r1()
+	results, err := verifier.VerifySecp256r1Batch([][]byte{key, key, key}, msgs, sigs)
+	require.Nil(t, err)
+	assert.Equal(t, []bool{true, false, false}, results)
+
+	_, err = verifier.VerifySecp256r1Batch([][]byte{key}, msgs, sigs)
+	assert.Equal(t, signing.ErrBatchLengthMismatch, err)
+}
+
+func signRaw(t testing.TB, privateKey *ecdsa.PrivateKey, msg []byte) []byte {
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, privateKey, hash[:])
+	require.Nil(t, err)
+
+	sig := make([]byte, rawSignatureLength)
+	r.FillBytes(sig[:rawSignatureLength/2])
+	s.FillBytes(sig[rawSignatureLength/2:])
+	return sig
+}
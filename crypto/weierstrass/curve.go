@@ -0,0 +1,332 @@
+package weierstrass
+
+import (
+	"crypto/elliptic"
+	"math/big"
+)
+
+var (
+	bigThree       = big.NewInt(3)
+	bigFour        = big.NewInt(4)
+	bigTwentySeven = big.NewInt(27)
+)
+
+// Curve is a short Weierstrass curve y² = x³ + ax + b over a prime field, for any coefficient a. As for
+// elliptic.CurveParams, the point at infinity is encoded as (0, 0), so b must not be zero.
+type Curve struct {
+	*elliptic.CurveParams
+	A *big.Int
+
+	aIsMinusThree bool
+}
+
+// NewCurve returns the curve with the given parameters and coefficient a, which is reduced modulo the field order
+func NewCurve(params *elliptic.CurveParams, a *big.Int) *Curve {
+	reducedA := new(big.Int).Mod(a, params.P)
+	aPlusThree := new(big.Int).Add(reducedA, bigThree)
+
+	return &Curve{
+		CurveParams:   params,
+		A:             reducedA,
+		aIsMinusThree: aPlusThree.Mod(aPlusThree, params.P).Sign() == 0,
+	}
+}
+
+// NewCurveFromParams returns the curve with the given parameters and a = -3, as assumed by elliptic.CurveParams
+func NewCurveFromParams(params *elliptic.CurveParams) *Curve {
+	return NewCurve(params, big.NewInt(-3))
+}
+
+// Params returns the parameters of the curve, without the coefficient a
+func (curve *Curve) Params() *elliptic.CurveParams {
+	return curve.CurveParams
+}
+
+// IsOnCurve reports whether the given (x, y) lies on the curve
+func (curve *Curve) IsOnCurve(x, y *big.Int) bool {
+	if curve.aIsMinusThree {
+		return curve.CurveParams.IsOnCurve(x, y)
+	}
+
+	if x.Sign() < 0 || x.Cmp(curve.P) >= 0 ||
+		y.Sign() < 0 || y.Cmp(curve.P) >= 0 {
+		return false
+	}
+
+	y2 := new(big.Int).Mul(y, y)
+	y2.Mod(y2, curve.P)
+
+	return curve.polynomial(x).Cmp(y2) == 0
+}
+
+// IsNonSingular reports whether the discriminant 4a³ + 27b² of the curve is not zero modulo the field order
+func (curve *Curve) IsNonSingular() bool {
+	a3 := new(big.Int).Exp(curve.A, bigThree, curve.P)
+	a3.Mul(a3, bigFour)
+
+	b2 := new(big.Int).Mul(curve.B, curve.B)
+	b2.Mul(b2, bigTwentySeven)
+
+	discriminant := a3.Add(a3, b2)
+	return discriminant.Mod(discriminant, curve.P).Sign() != 0
+}
+
+// Add returns the sum of (x1,y1) and (x2,y2)
+func (curve *Curve) Add(x1, y1, x2, y2 *big.Int) (*big.Int, *big.Int) {
+	if curve.aIsMinusThree {
+		return curve.CurveParams.Add(x1, y1, x2, y2)
+	}
+
+	z1 := zForAffine(x1, y1)
+	z2 := zForAffine(x2, y2)
+	return curve.affineFromJacobian(curve.addJacobian(x1, y1, z1, x2, y2, z2))
+}
+
+// Double returns 2*(x,y)
+func (curve *Curve) Double(x1, y1 *big.Int) (*big.Int, *big.Int) {
+	if curve.aIsMinusThree {
+		return curve.CurveParams.Double(x1, y1)
+	}
+
+	z1 := zForAffine(x1, y1)
+	return curve.affineFromJacobian(curve.doubleJacobian(x1, y1, z1))
+}
+
+// ScalarMult returns k*(x,y) where k is an integer in big-endian form
+func (curve *Curve) ScalarMult(x1, y1 *big.Int, k []byte) (*big.Int, *big.Int) {
+	if curve.aIsMinusThree {
+		return curve.CurveParams.ScalarMult(x1, y1, k)
+	}
+
+	z1 := zForAffine(x1, y1)
+	x, y, z := new(big.Int), new(big.Int), new(big.Int)
+	for _, b := range k {
+		for bitNum := 0; bitNum < 8; bitNum++ {
+			x, y, z = curve.doubleJacobian(x, y, z)
+			if b&0x80 == 0x80 {
+				x, y, z = curve.addJacobian(x1, y1, z1, x, y, z)
+			}
+			b <<= 1
+		}
+	}
+
+	return curve.affineFromJacobian(x, y, z)
+}
+
+// ScalarBaseMult returns k*G, where G is the base point of the curve and k is an integer in big-endian form
+func (curve *Curve) ScalarBaseMult(k []byte) (*big.Int, *big.Int) {
+	if curve.aIsMinusThree {
+		return curve.CurveParams.ScalarBaseMult(k)
+	}
+
+	return curve.ScalarMult(curve.Gx, curve.Gy, k)
+}
+
+// Unmarshal converts a point, serialized by elliptic.Marshal, into an x, y pair. On error, x = nil.
+func (curve *Curve) Unmarshal(data []byte) (*big.Int, *big.Int) {
+	if curve.aIsMinusThree {
+		return elliptic.Unmarshal(curve.CurveParams, data)
+	}
+
+	byteLen := (curve.BitSize + 7) / 8
+	if len(data) != 1+2*byteLen || data[0] != 4 {
+		return nil, nil
+	}
+
+	x := new(big.Int).SetBytes(data[1 : 1+byteLen])
+	y := new(big.Int).SetBytes(data[1+byteLen:])
+	if !curve.IsOnCurve(x, y) {
+		return nil, nil
+	}
+
+	return x, y
+}
+
+// UnmarshalCompressed converts a point, serialized by elliptic.MarshalCompressed, into an x, y pair. On error, x = nil.
+func (curve *Curve) UnmarshalCompressed(data []byte) (*big.Int, *big.Int) {
+	if curve.aIsMinusThree {
+		return elliptic.UnmarshalCompressed(curve.CurveParams, data)
+	}
+
+	byteLen := (curve.BitSize + 7) / 8
+	if len(data) != 1+byteLen || (data[0] != 2 && data[0] != 3) {
+		return nil, nil
+	}
+
+	x := new(big.Int).SetBytes(data[1:])
+	if x.Cmp(curve.P) >= 0 {
+		return nil, nil
+	}
+
+	y := new(big.Int).ModSqrt(curve.polynomial(x), curve.P)
+	if y == nil {
+		return nil, nil
+	}
+	if byte(y.Bit(0)) != data[0]&1 {
+		y.Neg(y).Mod(y, curve.P)
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, nil
+	}
+
+	return x, y
+}
+
+// polynomial returns x³ + ax + b
+func (curve *Curve) polynomial(x *big.Int) *big.Int {
+	x3 := new(big.Int).Mul(x, x)
+	x3.Mul(x3, x)
+
+	ax := new(big.Int).Mul(curve.A, x)
+
+	x3.Add(x3, ax)
+	x3.Add(x3, curve.B)
+
+	return x3.Mod(x3, curve.P)
+}
+
+// zForAffine returns a Jacobian Z value for the affine point (x, y), which is zero for the point at infinity
+func zForAffine(x, y *big.Int) *big.Int {
+	z := new(big.Int)
+	if x.Sign() != 0 || y.Sign() != 0 {
+		z.SetInt64(1)
+	}
+	return z
+}
+
+// affineFromJacobian reverses the Jacobian transform
+func (curve *Curve) affineFromJacobian(x, y, z *big.Int) (*big.Int, *big.Int) {
+	if z.Sign() == 0 {
+		return new(big.Int), new(big.Int)
+	}
+
+	zinv := new(big.Int).ModInverse(z, curve.P)
+	zinvsq := new(big.Int).Mul(zinv, zinv)
+
+	xOut := new(big.Int).Mul(x, zinvsq)
+	xOut.Mod(xOut, curve.P)
+	zinvsq.Mul(zinvsq, zinv)
+	yOut := new(big.Int).Mul(y, zinvsq)
+	yOut.Mod(yOut, curve.P)
+
+	return xOut, yOut
+}
+
+// addJacobian takes two points in Jacobian coordinates, (x1, y1, z1) and (x2, y2, z2) and returns their sum,
+// also in Jacobian form. See https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian.html#addition-add-2007-bl
+func (curve *Curve) addJacobian(x1, y1, z1, x2, y2, z2 *big.Int) (*big.Int, *big.Int, *big.Int) {
+	x3, y3, z3 := new(big.Int), new(big.Int), new(big.Int)
+	if z1.Sign() == 0 {
+		x3.Set(x2)
+		y3.Set(y2)
+		z3.Set(z2)
+		return x3, y3, z3
+	}
+	if z2.Sign() == 0 {
+		x3.Set(x1)
+		y3.Set(y1)
+		z3.Set(z1)
+		return x3, y3, z3
+	}
+
+	z1z1 := new(big.Int).Mul(z1, z1)
+	z1z1.Mod(z1z1, curve.P)
+	z2z2 := new(big.Int).Mul(z2, z2)
+	z2z2.Mod(z2z2, curve.P)
+
+	u1 := new(big.Int).Mul(x1, z2z2)
+	u1.Mod(u1, curve.P)
+	u2 := new(big.Int).Mul(x2, z1z1)
+	u2.Mod(u2, curve.P)
+	h := new(big.Int).Sub(u2, u1)
+	xEqual := h.Sign() == 0
+	if h.Sign() == -1 {
+		h.Add(h, curve.P)
+	}
+	i := new(big.Int).Lsh(h, 1)
+	i.Mul(i, i)
+	j := new(big.Int).Mul(h, i)
+
+	s1 := new(big.Int).Mul(y1, z2)
+	s1.Mul(s1, z2z2)
+	s1.Mod(s1, curve.P)
+	s2 := new(big.Int).Mul(y2, z1)
+	s2.Mul(s2, z1z1)
+	s2.Mod(s2, curve.P)
+	r := new(big.Int).Sub(s2, s1)
+	if r.Sign() == -1 {
+		r.Add(r, curve.P)
+	}
+	yEqual := r.Sign() == 0
+	if xEqual && yEqual {
+		return curve.doubleJacobian(x1, y1, z1)
+	}
+	r.Lsh(r, 1)
+	v := new(big.Int).Mul(u1, i)
+
+	x3.Set(r)
+	x3.Mul(x3, x3)
+	x3.Sub(x3, j)
+	x3.Sub(x3, v)
+	x3.Sub(x3, v)
+	x3.Mod(x3, curve.P)
+
+	y3.Set(r)
+	v.Sub(v, x3)
+	y3.Mul(y3, v)
+	s1.Mul(s1, j)
+	s1.Lsh(s1, 1)
+	y3.Sub(y3, s1)
+	y3.Mod(y3, curve.P)
+
+	z3.Add(z1, z2)
+	z3.Mul(z3, z3)
+	z3.Sub(z3, z1z1)
+	z3.Sub(z3, z2z2)
+	z3.Mul(z3, h)
+	z3.Mod(z3, curve.P)
+
+	return x3, y3, z3
+}
+
+// doubleJacobian takes a point in Jacobian coordinates, (x, y, z), and returns its double, also in Jacobian form.
+// See https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian.html#doubling-dbl-2007-bl
+func (curve *Curve) doubleJacobian(x, y, z *big.Int) (*big.Int, *big.Int, *big.Int) {
+	xx := new(big.Int).Mul(x, x)
+	xx.Mod(xx, curve.P)
+	yy := new(big.Int).Mul(y, y)
+	yy.Mod(yy, curve.P)
+	yyyy := new(big.Int).Mul(yy, yy)
+	yyyy.Mod(yyyy, curve.P)
+	zz := new(big.Int).Mul(z, z)
+	zz.Mod(zz, curve.P)
+
+	s := new(big.Int).Add(x, yy)
+	s.Mul(s, s)
+	s.Sub(s, xx)
+	s.Sub(s, yyyy)
+	s.Lsh(s, 1)
+	s.Mod(s, curve.P)
+
+	m := new(big.Int).Mul(zz, zz)
+	m.Mul(m, curve.A)
+	m.Add(m, new(big.Int).Mul(xx, bigThree))
+	m.Mod(m, curve.P)
+
+	x3 := new(big.Int).Mul(m, m)
+	x3.Sub(x3, new(big.Int).Lsh(s, 1))
+	x3.Mod(x3, curve.P)
+
+	y3 := new(big.Int).Sub(s, x3)
+	y3.Mul(y3, m)
+	y3.Sub(y3, new(big.Int).Lsh(yyyy, 3))
+	y3.Mod(y3, curve.P)
+
+	z3 := new(big.Int).Add(y, z)
+	z3.Mul(z3, z3)
+	z3.Sub(z3, yy)
+	z3.Sub(z3, zz)
+	z3.Mod(z3, curve.P)
+
+	return x3, y3, z3
+}
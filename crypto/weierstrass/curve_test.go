@@ -0,0 +1,142 @@
+package weierstrass
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func secp256k1Curve() *Curve {
+	params := *btcec.S256().Params()
+	return NewCurve(&params, big.NewInt(0))
+}
+
+func starkCurve() *Curve {
+	p, _ := new(big.Int).SetString("800000000000011000000000000000000000000000000000000000000000001", 16)
+	n, _ := new(big.Int).SetString("800000000000010ffffffffffffffffb781126dcae7b2321e66a241adc64d2f", 16)
+	b, _ := new(big.Int).SetString("6f21413efbe40de150e596d72f7a8c5609ad26c15c915c1f4cdfcb99cee9e89", 16)
+	gx, _ := new(big.Int).SetString("1ef15c18599971b7beced415a40f0c7deacfd9b0d1819e03d723d8bc943cfca", 16)
+	gy, _ := new(big.Int).SetString("5668060aa49730b7be4801df46ec62de53ecd11abe43a32873000c36e8dc1f", 16)
+
+	params := &elliptic.CurveParams{P: p, N: n, B: b, Gx: gx, Gy: gy, BitSize: p.BitLen(), Name: "stark"}
+	return NewCurve(params, big.NewInt(1))
+}
+
+func TestCurve_Secp256k1MatchesBtcec(t *testing.T) {
+	t.Parallel()
+
+	curve := secp256k1Curve()
+	reference := btcec.S256()
+	require.True(t, curve.IsOnCurve(curve.Gx, curve.Gy))
+	require.True(t, curve.IsNonSingular())
+
+	for _, k := range []string{"01", "02", "03", "ff", "0123456789abcdef", "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140"} {
+		scalar, _ := new(big.Int).SetString(k, 16)
+		expectedX, expectedY := reference.ScalarBaseMult(scalar.Bytes())
+		x, y := curve.ScalarBaseMult(scalar.Bytes())
+		require.Equal(t, expectedX, x, k)
+		require.Equal(t, expectedY, y, k)
+		require.True(t, curve.IsOnCurve(x, y))
+	}
+
+	x1, y1 := curve.ScalarBaseMult([]byte{5})
+	x2, y2 := curve.ScalarBaseMult([]byte{7})
+	expectedX, expectedY := reference.Add(x1, y1, x2, y2)
+	x, y := curve.Add(x1, y1, x2, y2)
+	require.Equal(t, expectedX, x)
+	require.Equal(t, expectedY, y)
+
+	expectedX, expectedY = reference.Double(x1, y1)
+	x, y = curve.Double(x1, y1)
+	require.Equal(t, expectedX, x)
+	require.Equal(t, expectedY, y)
+}
+
+func TestCurve_PointAtInfinity(t *testing.T) {
+	t.Parallel()
+
+	for _, curve := range []*Curve{secp256k1Curve(), starkCurve()} {
+		x, y := curve.ScalarBaseMult(curve.N.Bytes())
+		require.Zero(t, x.Sign(), curve.Name)
+		require.Zero(t, y.Sign(), curve.Name)
+
+		negY := new(big.Int).Sub(curve.P, curve.Gy)
+		x, y = curve.Add(curve.Gx, curve.Gy, curve.Gx, negY)
+		require.Zero(t, x.Sign(), curve.Name)
+		require.Zero(t, y.Sign(), curve.Name)
+
+		x, y = curve.Add(curve.Gx, curve.Gy, new(big.Int), new(big.Int))
+		require.Equal(t, curve.Gx, x, curve.Name)
+		require.Equal(t, curve.Gy, y, curve.Name)
+	}
+}
+
+func TestCurve_StarkArithmetic(t *testing.T) {
+	t.Parallel()
+
+	curve := starkCurve()
+	require.True(t, curve.IsOnCurve(curve.Gx, curve.Gy))
+	require.True(t, curve.IsNonSingular())
+
+	x2, y2 := curve.Double(curve.Gx, curve.Gy)
+	x3, y3 := curve.Add(x2, y2, curve.Gx, curve.Gy)
+	expectedX, expectedY := curve.ScalarBaseMult([]byte{3})
+	require.Equal(t, expectedX, x3)
+	require.Equal(t, expectedY, y3)
+	require.True(t, curve.IsOnCurve(x3, y3))
+
+	nMinusOne := new(big.Int).Sub(curve.N, big.NewInt(1))
+	x, y := curve.ScalarBaseMult(nMinusOne.Bytes())
+	require.Equal(t, curve.Gx, x)
+	require.Equal(t, new(big.Int).Sub(curve.P, curve.Gy), y)
+}
+
+func TestCurve_MinusThreeUsesCurveParams(t *testing.T) {
+	t.Parallel()
+
+	params := elliptic.P256().Params()
+	curve := NewCurveFromParams(params)
+	general := &Curve{CurveParams: params, A: big.NewInt(-3)}
+
+	scalar := []byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}
+	expectedX, expectedY := params.ScalarBaseMult(scalar)
+	x, y := curve.ScalarBaseMult(scalar)
+	require.Equal(t, expectedX, x)
+	require.Equal(t, expectedY, y)
+
+	x, y = general.ScalarBaseMult(scalar)
+	require.Equal(t, expectedX.Bytes(), x.Bytes())
+	require.Equal(t, expectedY.Bytes(), y.Bytes())
+}
+
+func TestCurve_IsNonSingular(t *testing.T) {
+	t.Parallel()
+
+	params := &elliptic.CurveParams{P: big.NewInt(23), B: big.NewInt(2)}
+	require.False(t, NewCurve(params, big.NewInt(-3)).IsNonSingular())
+	require.True(t, NewCurve(params, big.NewInt(1)).IsNonSingular())
+}
+
+func TestCurve_Unmarshal(t *testing.T) {
+	t.Parallel()
+
+	for _, curve := range []*Curve{secp256k1Curve(), starkCurve(), NewCurveFromParams(elliptic.P224().Params())} {
+		x, y := curve.ScalarBaseMult([]byte{42})
+
+		decodedX, decodedY := elliptic.Unmarshal(curve, elliptic.Marshal(curve, x, y))
+		require.Equal(t, x, decodedX, curve.Name)
+		require.Equal(t, y, decodedY, curve.Name)
+
+		decodedX, decodedY = elliptic.UnmarshalCompressed(curve, elliptic.MarshalCompressed(curve, x, y))
+		require.Equal(t, x, decodedX, curve.Name)
+		require.Equal(t, y, decodedY, curve.Name)
+
+		notOnCurve := elliptic.Marshal(curve, x, y)
+		notOnCurve[len(notOnCurve)-1] ^= 1
+		decodedX, _ = elliptic.Unmarshal(curve, notOnCurve)
+		require.Nil(t, decodedX, curve.Name)
+	}
+}
@@ -37,3 +37,20 @@ type Executor interface {
 		compiledCode []byte,
 		options CompilationOptions) (Instance, error)
 }
+
+// VersionedExecutor is implemented by the executors that identify the format of their compiled code.
+// Only the compiled code of such executors can be persisted and reused by other processes.
+type VersionedExecutor interface {
+	// CompiledCodeVersion identifies the format of the compiled code produced by the instances.
+	CompiledCodeVersion() string
+}
+
+// CompiledCodeVersion yields the compiled code version of the executor,
+// or an empty string if the executor does not provide one.
+func CompiledCodeVersion(executor Executor) string {
+	versionedExecutor, ok := executor.(VersionedExecutor)
+	if !ok {
+		return ""
+	}
+	return versionedExecutor.CompiledCodeVersion()
+}
@@ -117,6 +117,9 @@ type ManagedVMHooks interface {
 	ManagedGetESDTTokenData(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32, propertiesHandle int32, hashHandle int32, nameHandle int32, attributesHandle int32, creatorHandle int32, royaltiesHandle int32, urisHandle int32)
 	ManagedAsyncCall(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32)
 	ManagedCreateAsyncCall(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset MemPtr, successLength MemLength, errorOffset MemPtr, errorLength MemLength, gas int64, extraGasForCallback int64, callbackClosureHandle int32) int32
+	ManagedCreateAsyncCallWithDeadline(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset MemPtr, successLength MemLength, errorOffset MemPtr, errorLength MemLength, gas int64, extraGasForCallback int64, callbackClosureHandle int32, groupHandle int32, deadlineRound int64) int32
+	ManagedCancelAsyncCallGroup(groupHandle int32) int32
+	ManagedIsCallbackTimedOut() int32
 	ManagedGetCallbackClosure(callbackClosureHandle int32)
 	ManagedUpgradeFromSourceContract(destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32)
 	ManagedUpgradeContract(destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32)
@@ -260,14 +263,27 @@ type CryptoVMHooks interface {
 	ManagedKeccak256(inputHandle int32, outputHandle int32) int32
 	Ripemd160(dataOffset MemPtr, length MemLength, resultOffset MemPtr) int32
 	ManagedRipemd160(inputHandle int32, outputHandle int32) int32
+	ManagedSha512(inputHandle int32, outputHandle int32) int32
+	ManagedSha3256(inputHandle int32, outputHandle int32) int32
+	ManagedSha3512(inputHandle int32, outputHandle int32) int32
+	ManagedBlake2b256(inputHandle int32, outputHandle int32) int32
+	ManagedBlake2b512(inputHandle int32, outputHandle int32) int32
+	ManagedPoseidon(inputHandle int32, outputHandle int32) int32
 	VerifyBLS(keyOffset MemPtr, messageOffset MemPtr, messageLength MemLength, sigOffset MemPtr) int32
 	ManagedVerifyBLS(keyHandle int32, messageHandle int32, sigHandle int32) int32
+	ManagedVerifyBLSAggregated(keysHandle int32, messageHandle int32, sigHandle int32) int32
+	ManagedAggregateBLSPublicKeys(keysHandle int32, resultHandle int32) int32
 	VerifyEd25519(keyOffset MemPtr, messageOffset MemPtr, messageLength MemLength, sigOffset MemPtr) int32
 	ManagedVerifyEd25519(keyHandle int32, messageHandle int32, sigHandle int32) int32
+	ManagedVerifyEd25519Batch(keysHandle int32, messagesHandle int32, sigsHandle int32) int32
+	ManagedVerifyEd25519BatchBitmap(keysHandle int32, messagesHandle int32, sigsHandle int32, resultHandle int32) int32
 	VerifyCustomSecp256k1(keyOffset MemPtr, keyLength MemLength, messageOffset MemPtr, messageLength MemLength, sigOffset MemPtr, hashType int32) int32
 	ManagedVerifyCustomSecp256k1(keyHandle int32, messageHandle int32, sigHandle int32, hashType int32) int32
 	VerifySecp256k1(keyOffset MemPtr, keyLength MemLength, messageOffset MemPtr, messageLength MemLength, sigOffset MemPtr) int32
 	ManagedVerifySecp256k1(keyHandle int32, messageHandle int32, sigHandle int32) int32
+	ManagedVerifySecp256r1(keyHandle int32, messageHandle int32, sigHandle int32) int32
+	ManagedVerifySecp256r1Batch(keysHandle int32, messagesHandle int32, sigsHandle int32) int32
+	ManagedVerifySecp256r1BatchBitmap(keysHandle int32, messagesHandle int32, sigsHandle int32, resultHandle int32) int32
 	EncodeSecp256k1DerSignature(rOffset MemPtr, rLength MemLength, sOffset MemPtr, sLength MemLength, sigOffset MemPtr) int32
 	ManagedEncodeSecp256k1DerSignature(rHandle int32, sHandle int32, sigHandle int32) int32
 	AddEC(xResultHandle int32, yResultHandle int32, ecHandle int32, fstPointXHandle int32, fstPointYHandle int32, sndPointXHandle int32, sndPointYHandle int32)
@@ -289,7 +305,11 @@ type CryptoVMHooks interface {
 	ManagedGenerateKeyEC(xPubKeyHandle int32, yPubKeyHandle int32, ecHandle int32, resultHandle int32) int32
 	CreateEC(dataOffset MemPtr, dataLength MemLength) int32
 	ManagedCreateEC(dataHandle int32) int32
+	ManagedCreateCustomEC(fieldOrderHandle int32, basePointOrderHandle int32, eqCoefficientHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32, sizeOfField int32) int32
 	GetCurveLengthEC(ecHandle int32) int32
 	GetPrivKeyByteLengthEC(ecHandle int32) int32
 	EllipticCurveGetValues(ecHandle int32, fieldOrderHandle int32, basePointOrderHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32) int32
+	ManagedAddBN254G1(point1Handle int32, point2Handle int32, resultHandle int32) int32
+	ManagedScalarMulBN254G1(pointHandle int32, scalarHandle int32, resultHandle int32) int32
+	ManagedPairingCheckBN254(g1PointsHandle int32, g2PointsHandle int32) int32
 }
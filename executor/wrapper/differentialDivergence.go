@@ -0,0 +1,69 @@
+package executorwrapper
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DivergenceKind classifies the differences found by the DifferentialExecutor.
+type DivergenceKind string
+
+const (
+	// DivergenceInstantiation means that only one of the backends could instantiate the contract.
+	DivergenceInstantiation DivergenceKind = "instantiation"
+
+	// DivergenceHookCall means that the backends called different VM hooks, or called them with different arguments.
+	DivergenceHookCall DivergenceKind = "hook call"
+
+	// DivergenceGas means that the backends reported different gas points used.
+	DivergenceGas DivergenceKind = "gas"
+
+	// DivergenceBreakpoint means that the backends ended with different breakpoint values.
+	DivergenceBreakpoint DivergenceKind = "breakpoint"
+
+	// DivergenceMemory means that the WASM memory contents differ between the backends.
+	DivergenceMemory DivergenceKind = "memory"
+
+	// DivergenceError means that the call failed on only one of the backends.
+	DivergenceError DivergenceKind = "error"
+)
+
+// maxDivergenceContextCalls is the number of VM hook calls preceding the divergence kept in the report.
+const maxDivergenceContextCalls = 10
+
+// Divergence describes the first difference observed between the primary and the shadow backend during a call.
+type Divergence struct {
+	Kind         DivergenceKind
+	InstanceID   string
+	FunctionName string
+
+	// HookCallIndex is the index of the VM hook call at which the divergence was observed,
+	// or the total number of hook calls if it was only observed at the end of the call.
+	HookCallIndex int
+	Primary       string
+	Shadow        string
+
+	// PrecedingHookCalls holds the last VM hook calls before the divergence, as seen by the primary backend.
+	PrecedingHookCalls []string
+}
+
+// Error makes a Divergence usable as an error.
+func (divergence *Divergence) Error() string {
+	return divergence.String()
+}
+
+// String yields a multi-line report of the divergence.
+func (divergence *Divergence) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("executor divergence (%s) in %s, instance %s, at VM hook call #%d\n",
+		divergence.Kind, divergence.FunctionName, divergence.InstanceID, divergence.HookCallIndex))
+	sb.WriteString(fmt.Sprintf("\tprimary: %s\n", divergence.Primary))
+	sb.WriteString(fmt.Sprintf("\tshadow:  %s\n", divergence.Shadow))
+	if len(divergence.PrecedingHookCalls) > 0 {
+		sb.WriteString("\tpreceding VM hook calls:\n")
+		for _, call := range divergence.PrecedingHookCalls {
+			sb.WriteString(fmt.Sprintf("\t\t%s\n", call))
+		}
+	}
+	return sb.String()
+}
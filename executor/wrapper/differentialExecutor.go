@@ -0,0 +1,197 @@
+package executorwrapper
+
+import (
+	"encoding/binary"
+	"errors"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+var _ executor.Executor = (*DifferentialExecutor)(nil)
+
+// differentialCacheMagic prefixes the compiled code of differential instances, which holds the code of both backends.
+const differentialCacheMagic = "DIFF"
+
+// ErrInvalidDifferentialCache signals that the compiled code was not produced by a DifferentialInstance
+var ErrInvalidDifferentialCache = errors.New("invalid differential executor compiled code")
+
+// DifferentialExecutor runs every contract on two backends, a primary and a shadow.
+// Only the primary calls the actual VM hooks. The shadow receives the results
+// recorded from the primary, and every difference between the two is reported.
+// It is meant for validating new executors against existing ones.
+type DifferentialExecutor struct {
+	reporter        DivergenceReporter
+	primaryExecutor executor.Executor
+	shadowExecutor  executor.Executor
+
+	activeExecutions []*activeExecution
+}
+
+// activeExecution identifies the instance and backend that currently receive the VM hook calls.
+type activeExecution struct {
+	instance *DifferentialInstance
+	isShadow bool
+}
+
+// SetOpcodeCosts sets the gas costs on both backends.
+func (dexec *DifferentialExecutor) SetOpcodeCosts(opcodeCosts *executor.WASMOpcodeCost) {
+	dexec.primaryExecutor.SetOpcodeCosts(opcodeCosts)
+	dexec.shadowExecutor.SetOpcodeCosts(opcodeCosts)
+}
+
+// FunctionNames returns the function names of the primary backend.
+func (dexec *DifferentialExecutor) FunctionNames() vmcommon.FunctionNames {
+	return dexec.primaryExecutor.FunctionNames()
+}
+
+// NewInstanceWithOptions instantiates the contract on both backends.
+func (dexec *DifferentialExecutor) NewInstanceWithOptions(
+	contractCode []byte,
+	options executor.CompilationOptions,
+) (executor.Instance, error) {
+	primaryInstance, primaryErr := dexec.primaryExecutor.NewInstanceWithOptions(contractCode, options)
+	shadowInstance, shadowErr := dexec.shadowExecutor.NewInstanceWithOptions(contractCode, options)
+	return dexec.newDifferentialInstance(primaryInstance, primaryErr, shadowInstance, shadowErr)
+}
+
+// NewInstanceFromCompiledCodeWithOptions restores the instances of both backends, from the output of Cache().
+func (dexec *DifferentialExecutor) NewInstanceFromCompiledCodeWithOptions(
+	compiledCode []byte,
+	options executor.CompilationOptions,
+) (executor.Instance, error) {
+	primaryCode, shadowCode, err := decodeDifferentialCache(compiledCode)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryInstance, primaryErr := dexec.primaryExecutor.NewInstanceFromCompiledCodeWithOptions(primaryCode, options)
+	if len(shadowCode) == 0 {
+		// the shadow backend could not instantiate this contract in the first place
+		return dexec.newDifferentialInstance(primaryInstance, primaryErr, nil, nil)
+	}
+	shadowInstance, shadowErr := dexec.shadowExecutor.NewInstanceFromCompiledCodeWithOptions(shadowCode, options)
+	return dexec.newDifferentialInstance(primaryInstance, primaryErr, shadowInstance, shadowErr)
+}
+
+func (dexec *DifferentialExecutor) newDifferentialInstance(
+	primaryInstance executor.Instance,
+	primaryErr error,
+	shadowInstance executor.Instance,
+	shadowErr error,
+) (executor.Instance, error) {
+	if (primaryErr == nil) != (shadowErr == nil) {
+		dexec.reporter.ReportDivergence(&Divergence{
+			Kind:    DivergenceInstantiation,
+			Primary: errorDescription(primaryErr),
+			Shadow:  errorDescription(shadowErr),
+		})
+	}
+	if primaryErr != nil {
+		if shadowErr == nil && shadowInstance != nil {
+			shadowInstance.Clean()
+		}
+		return nil, primaryErr
+	}
+	if shadowErr != nil {
+		shadowInstance = nil
+	}
+
+	return &DifferentialInstance{
+		executor:        dexec,
+		primaryInstance: primaryInstance,
+		shadowInstance:  shadowInstance,
+	}, nil
+}
+
+func (dexec *DifferentialExecutor) pushExecution(instance *DifferentialInstance, isShadow bool) {
+	dexec.activeExecutions = append(dexec.activeExecutions, &activeExecution{
+		instance: instance,
+		isShadow: isShadow,
+	})
+}
+
+func (dexec *DifferentialExecutor) popExecution() {
+	dexec.activeExecutions = dexec.activeExecutions[:len(dexec.activeExecutions)-1]
+}
+
+func (dexec *DifferentialExecutor) currentExecution() *activeExecution {
+	if len(dexec.activeExecutions) == 0 {
+		return nil
+	}
+	return dexec.activeExecutions[len(dexec.activeExecutions)-1]
+}
+
+// CompiledCodeVersion combines the compiled code versions of both backends.
+// It is empty if either of them is not versioned.
+func (dexec *DifferentialExecutor) CompiledCodeVersion() string {
+	primaryVersion := executor.CompiledCodeVersion(dexec.primaryExecutor)
+	shadowVersion := executor.CompiledCodeVersion(dexec.shadowExecutor)
+	if len(primaryVersion) == 0 || len(shadowVersion) == 0 {
+		return ""
+	}
+	return differentialCacheMagic + "(" + primaryVersion + "," + shadowVersion + ")"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dexec *DifferentialExecutor) IsInterfaceNil() bool {
+	return dexec == nil
+}
+
+// primaryInterceptor calls the actual VM hooks and records them for the shadow.
+type primaryInterceptor struct {
+	executor *DifferentialExecutor
+}
+
+// InterceptVMHookCall records the VM hook call, its result and its side effects on the instance.
+func (interceptor *primaryInterceptor) InterceptVMHookCall(call *VMHookCall, invoke func() int64) int64 {
+	execution := interceptor.executor.currentExecution()
+	if execution == nil || execution.isShadow {
+		return invoke()
+	}
+	return execution.instance.recordHookCall(call, invoke)
+}
+
+// shadowInterceptor replays the VM hook calls recorded from the primary.
+type shadowInterceptor struct {
+	executor *DifferentialExecutor
+}
+
+// InterceptVMHookCall checks the VM hook call against the recording and replays its result.
+func (interceptor *shadowInterceptor) InterceptVMHookCall(call *VMHookCall, _ func() int64) int64 {
+	execution := interceptor.executor.currentExecution()
+	if execution == nil || !execution.isShadow {
+		return 0
+	}
+	return execution.instance.replayHookCall(call)
+}
+
+func encodeDifferentialCache(primaryCode []byte, shadowCode []byte) []byte {
+	encoded := make([]byte, 0, len(differentialCacheMagic)+4+len(primaryCode)+len(shadowCode))
+	encoded = append(encoded, differentialCacheMagic...)
+	lengthBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(lengthBytes, uint32(len(primaryCode)))
+	encoded = append(encoded, lengthBytes...)
+	encoded = append(encoded, primaryCode...)
+	return append(encoded, shadowCode...)
+}
+
+func decodeDifferentialCache(compiledCode []byte) ([]byte, []byte, error) {
+	headerLength := len(differentialCacheMagic) + 4
+	if len(compiledCode) < headerLength || string(compiledCode[:len(differentialCacheMagic)]) != differentialCacheMagic {
+		return nil, nil, ErrInvalidDifferentialCache
+	}
+	primaryLength := binary.BigEndian.Uint32(compiledCode[len(differentialCacheMagic):headerLength])
+	if uint64(primaryLength) > uint64(len(compiledCode)-headerLength) {
+		return nil, nil, ErrInvalidDifferentialCache
+	}
+	primaryEnd := headerLength + int(primaryLength)
+	return compiledCode[headerLength:primaryEnd], compiledCode[primaryEnd:], nil
+}
+
+func errorDescription(err error) string {
+	if err == nil {
+		return "no error"
+	}
+	return err.Error()
+}
@@ -0,0 +1,69 @@
+package executorwrapper
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+var _ executor.ExecutorAbstractFactory = (*DifferentialExecutorFactory)(nil)
+
+// DifferentialExecutorFactory is the factory for the DifferentialExecutor.
+type DifferentialExecutorFactory struct {
+	reporter       DivergenceReporter
+	primaryFactory executor.ExecutorAbstractFactory
+	shadowFactory  executor.ExecutorAbstractFactory
+
+	// LastCreatedExecutor gives access to the created Executor
+	LastCreatedExecutor *DifferentialExecutor
+}
+
+// NewDifferentialExecutorFactory yields a new DifferentialExecutor factory.
+// The primary backend is the one whose results are visible to the VM,
+// the shadow backend only replays the VM hook results of the primary.
+func NewDifferentialExecutorFactory(
+	reporter DivergenceReporter,
+	primaryFactory executor.ExecutorAbstractFactory,
+	shadowFactory executor.ExecutorAbstractFactory,
+) *DifferentialExecutorFactory {
+	return &DifferentialExecutorFactory{
+		reporter:       reporter,
+		primaryFactory: primaryFactory,
+		shadowFactory:  shadowFactory,
+	}
+}
+
+// CreateExecutor creates a new Executor instance.
+func (factory *DifferentialExecutorFactory) CreateExecutor(args executor.ExecutorFactoryArgs) (executor.Executor, error) {
+	differentialExecutor := &DifferentialExecutor{
+		reporter: factory.reporter,
+	}
+
+	primaryExecutor, err := factory.primaryFactory.CreateExecutor(executor.ExecutorFactoryArgs{
+		VMHooks:                  NewInterceptorVMHooks(&primaryInterceptor{executor: differentialExecutor}, args.VMHooks),
+		OpcodeCosts:              args.OpcodeCosts,
+		RkyvSerializationEnabled: args.RkyvSerializationEnabled,
+		WasmerSIGSEGVPassthrough: args.WasmerSIGSEGVPassthrough,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shadowExecutor, err := factory.shadowFactory.CreateExecutor(executor.ExecutorFactoryArgs{
+		VMHooks:                  NewInterceptorVMHooks(&shadowInterceptor{executor: differentialExecutor}, nil),
+		OpcodeCosts:              args.OpcodeCosts,
+		RkyvSerializationEnabled: args.RkyvSerializationEnabled,
+		WasmerSIGSEGVPassthrough: args.WasmerSIGSEGVPassthrough,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	differentialExecutor.primaryExecutor = primaryExecutor
+	differentialExecutor.shadowExecutor = shadowExecutor
+	factory.LastCreatedExecutor = differentialExecutor
+	return differentialExecutor, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (factory *DifferentialExecutorFactory) IsInterfaceNil() bool {
+	return factory == nil
+}
@@ -0,0 +1,153 @@
+package executorwrapper
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/interpreter"
+	"github.com/stretchr/testify/require"
+)
+
+// counterVMHooks implements the VM hooks used by the counter test contract.
+type counterVMHooks struct {
+	executor.VMHooks
+	instance executor.Instance
+	storage  map[string]int64
+	finished []int64
+}
+
+func (hooks *counterVMHooks) Int64storageLoad(keyOffset executor.MemPtr, keyLength executor.MemLength) int64 {
+	key, _ := hooks.instance.MemLoad(keyOffset, keyLength)
+	return hooks.storage[string(key)]
+}
+
+func (hooks *counterVMHooks) Int64storageStore(keyOffset executor.MemPtr, keyLength executor.MemLength, value int64) int32 {
+	key, _ := hooks.instance.MemLoad(keyOffset, keyLength)
+	hooks.storage[string(key)] = value
+	return 0
+}
+
+func (hooks *counterVMHooks) Int64finish(value int64) {
+	hooks.finished = append(hooks.finished, value)
+}
+
+// costOverrideFactory changes the opcode costs received by the wrapped factory.
+type costOverrideFactory struct {
+	wrappedFactory executor.ExecutorAbstractFactory
+	i64AddCost     uint32
+}
+
+func (factory *costOverrideFactory) CreateExecutor(args executor.ExecutorFactoryArgs) (executor.Executor, error) {
+	opcodeCosts := *args.OpcodeCosts
+	opcodeCosts.I64Add = factory.i64AddCost
+	args.OpcodeCosts = &opcodeCosts
+	return factory.wrappedFactory.CreateExecutor(args)
+}
+
+func (factory *costOverrideFactory) IsInterfaceNil() bool {
+	return factory == nil
+}
+
+func testOpcodeCosts() *executor.WASMOpcodeCost {
+	return &executor.WASMOpcodeCost{
+		Call: 1, Drop: 1, End: 1, I32Const: 1, I64Const: 1, I64Add: 1, LocalGet: 1, LocalTee: 1,
+	}
+}
+
+func newCounterInstance(
+	t *testing.T,
+	shadowFactory executor.ExecutorAbstractFactory,
+) (*DifferentialInstance, *counterVMHooks, *DivergenceCollector) {
+	code, err := ioutil.ReadFile("../../test/contracts/counter/output/counter.wasm")
+	require.Nil(t, err)
+
+	hooks := &counterVMHooks{storage: make(map[string]int64)}
+	collector := NewDivergenceCollector()
+	factory := NewDifferentialExecutorFactory(collector, interpreter.ExecutorFactory(), shadowFactory)
+	differentialExecutor, err := factory.CreateExecutor(executor.ExecutorFactoryArgs{
+		VMHooks:     hooks,
+		OpcodeCosts: testOpcodeCosts(),
+	})
+	require.Nil(t, err)
+
+	instance, err := differentialExecutor.NewInstanceWithOptions(code, executor.CompilationOptions{
+		GasLimit:           1000000,
+		Metering:           true,
+		RuntimeBreakpoints: true,
+	})
+	require.Nil(t, err)
+	hooks.instance = instance
+	return instance.(*DifferentialInstance), hooks, collector
+}
+
+func TestDifferentialExecutor_NoDivergence(t *testing.T) {
+	instance, hooks, collector := newCounterInstance(t, interpreter.ExecutorFactory())
+
+	require.Nil(t, instance.CallFunction("init"))
+	require.Nil(t, instance.CallFunction("increment"))
+	require.Nil(t, instance.CallFunction("increment"))
+	require.Nil(t, instance.CallFunction("get"))
+
+	require.Nil(t, collector.FirstDivergence())
+	require.Equal(t, []int64{2, 3, 3}, hooks.finished)
+	require.Equal(t, instance.primaryInstance.GetPointsUsed(), instance.shadowInstance.GetPointsUsed())
+}
+
+func TestDifferentialExecutor_GasDivergence(t *testing.T) {
+	shadowFactory := &costOverrideFactory{
+		wrappedFactory: interpreter.ExecutorFactory(),
+		i64AddCost:     5,
+	}
+	instance, hooks, collector := newCounterInstance(t, shadowFactory)
+
+	require.Nil(t, instance.CallFunction("init"))
+	require.Nil(t, collector.FirstDivergence())
+
+	require.Nil(t, instance.CallFunction("increment"))
+	divergence := collector.FirstDivergence()
+	require.NotNil(t, divergence)
+	require.Equal(t, DivergenceGas, divergence.Kind)
+	require.Equal(t, "increment", divergence.FunctionName)
+	require.Equal(t, 1, divergence.HookCallIndex)
+	require.Equal(t, []string{"int64storageLoad(1024, 7)"}, divergence.PrecedingHookCalls)
+
+	// the VM only sees the primary, whose execution is complete
+	require.Equal(t, []int64{2}, hooks.finished)
+	require.Equal(t, int64(2), hooks.storage["COUNTER"])
+	require.Equal(t, instance.primaryInstance.GetPointsUsed(), instance.shadowInstance.GetPointsUsed())
+}
+
+func TestDifferentialExecutor_MemoryDivergence(t *testing.T) {
+	instance, hooks, collector := newCounterInstance(t, interpreter.ExecutorFactory())
+	require.Nil(t, instance.shadowInstance.MemStore(1024, []byte("X")))
+
+	require.Nil(t, instance.CallFunction("get"))
+	divergence := collector.FirstDivergence()
+	require.NotNil(t, divergence)
+	require.Equal(t, DivergenceMemory, divergence.Kind)
+	require.Equal(t, 0, divergence.HookCallIndex)
+	require.Equal(t, []int64{0}, hooks.finished)
+
+	// the shadow was synchronized after the divergence
+	require.Equal(t, instance.primaryInstance.MemDump(), instance.shadowInstance.MemDump())
+	require.Nil(t, instance.CallFunction("get"))
+	require.Len(t, collector.Divergences, 1)
+}
+
+func TestDifferentialExecutor_Cache(t *testing.T) {
+	instance, _, collector := newCounterInstance(t, interpreter.ExecutorFactory())
+
+	compiledCode, err := instance.Cache()
+	require.Nil(t, err)
+	restored, err := instance.executor.NewInstanceFromCompiledCodeWithOptions(compiledCode, executor.CompilationOptions{
+		GasLimit: 1000000,
+		Metering: true,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, restored.(*DifferentialInstance).shadowInstance)
+
+	_, err = instance.executor.NewInstanceFromCompiledCodeWithOptions([]byte("garbage"), executor.CompilationOptions{})
+	require.Equal(t, ErrInvalidDifferentialCache, err)
+	require.Nil(t, collector.FirstDivergence())
+}
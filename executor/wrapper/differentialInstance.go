@@ -0,0 +1,470 @@
+package executorwrapper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+var _ executor.Instance = (*DifferentialInstance)(nil)
+
+// Breakpoint values used by the DifferentialInstance. They mirror vmhost.BreakpointValue.
+const (
+	breakpointExecutionFailed = 1
+	breakpointOutOfGas        = 4
+)
+
+const wasmPageSize = 65536
+
+// DifferentialInstance holds the instances of the same contract on the primary and on the shadow backend.
+// All operations from the VM are applied to both, except for CallFunction,
+// which is first executed on the primary and then replayed on the shadow.
+type DifferentialInstance struct {
+	executor        *DifferentialExecutor
+	primaryInstance executor.Instance
+	shadowInstance  executor.Instance
+
+	recording *callRecording
+}
+
+// callRecording holds the VM hook calls made by the primary during one CallFunction.
+type callRecording struct {
+	functionName string
+	hookCalls    []*recordedHookCall
+	currentCall  *recordedHookCall
+	replayIndex  int
+	divergence   *Divergence
+}
+
+// recordedHookCall is a VM hook call, together with everything needed to replay it.
+type recordedHookCall struct {
+	call         *VMHookCall
+	pointsBefore uint64
+	result       int64
+	effects      []*instanceEffect
+}
+
+type instanceEffectKind int
+
+const (
+	effectMemLoad instanceEffectKind = iota
+	effectMemStore
+	effectSetPointsUsed
+	effectSetBreakpointValue
+)
+
+// instanceEffect is an operation performed by a VM hook on the instance.
+type instanceEffect struct {
+	kind   instanceEffectKind
+	memPtr executor.MemPtr
+	data   []byte
+	value  uint64
+}
+
+// GetPointsUsed returns the points used by the primary.
+func (inst *DifferentialInstance) GetPointsUsed() uint64 {
+	return inst.primaryInstance.GetPointsUsed()
+}
+
+// SetPointsUsed sets the points used on both backends.
+func (inst *DifferentialInstance) SetPointsUsed(points uint64) {
+	inst.primaryInstance.SetPointsUsed(points)
+	if inst.recordEffect(&instanceEffect{kind: effectSetPointsUsed, value: points}) {
+		return
+	}
+	if inst.shadowInstance != nil {
+		inst.shadowInstance.SetPointsUsed(points)
+	}
+}
+
+// SetGasLimit sets the gas limit on both backends.
+func (inst *DifferentialInstance) SetGasLimit(gasLimit uint64) {
+	inst.primaryInstance.SetGasLimit(gasLimit)
+	if inst.shadowInstance != nil {
+		inst.shadowInstance.SetGasLimit(gasLimit)
+	}
+}
+
+// SetBreakpointValue sets the breakpoint value on both backends.
+func (inst *DifferentialInstance) SetBreakpointValue(value uint64) {
+	inst.primaryInstance.SetBreakpointValue(value)
+	if inst.recordEffect(&instanceEffect{kind: effectSetBreakpointValue, value: value}) {
+		return
+	}
+	if inst.shadowInstance != nil {
+		inst.shadowInstance.SetBreakpointValue(value)
+	}
+}
+
+// GetBreakpointValue returns the breakpoint value of the primary.
+func (inst *DifferentialInstance) GetBreakpointValue() uint64 {
+	return inst.primaryInstance.GetBreakpointValue()
+}
+
+// Cache returns the compiled code of both backends.
+func (inst *DifferentialInstance) Cache() ([]byte, error) {
+	primaryCode, err := inst.primaryInstance.Cache()
+	if err != nil {
+		return nil, err
+	}
+	var shadowCode []byte
+	if inst.shadowInstance != nil {
+		shadowCode, err = inst.shadowInstance.Cache()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return encodeDifferentialCache(primaryCode, shadowCode), nil
+}
+
+// Clean cleans both instances.
+func (inst *DifferentialInstance) Clean() bool {
+	if inst.shadowInstance != nil {
+		inst.shadowInstance.Clean()
+	}
+	return inst.primaryInstance.Clean()
+}
+
+// IsAlreadyCleaned returns the state of the primary.
+func (inst *DifferentialInstance) IsAlreadyCleaned() bool {
+	return inst.primaryInstance.IsAlreadyCleaned()
+}
+
+// CallFunction executes the function on the primary, then replays it on the shadow and compares the outcomes.
+// Only the outcome of the primary is returned.
+func (inst *DifferentialInstance) CallFunction(functionName string) error {
+	if inst.shadowInstance == nil {
+		return inst.primaryInstance.CallFunction(functionName)
+	}
+
+	previousRecording := inst.recording
+	recording := &callRecording{functionName: functionName}
+	inst.recording = recording
+	defer func() {
+		inst.recording = previousRecording
+	}()
+
+	inst.executor.pushExecution(inst, false)
+	primaryErr := inst.primaryInstance.CallFunction(functionName)
+	inst.executor.popExecution()
+
+	inst.executor.pushExecution(inst, true)
+	shadowErr := inst.shadowInstance.CallFunction(functionName)
+	inst.executor.popExecution()
+
+	if recording.divergence == nil {
+		inst.compareOutcome(primaryErr, shadowErr)
+	}
+	if recording.divergence != nil {
+		inst.executor.reporter.ReportDivergence(recording.divergence)
+		inst.synchronizeShadow()
+	}
+
+	return primaryErr
+}
+
+func (inst *DifferentialInstance) compareOutcome(primaryErr error, shadowErr error) {
+	recording := inst.recording
+	if recording.replayIndex < len(recording.hookCalls) {
+		inst.diverge(DivergenceHookCall,
+			recording.hookCalls[recording.replayIndex].call.String(),
+			"no more VM hook calls")
+		return
+	}
+
+	if (primaryErr == nil) != (shadowErr == nil) {
+		inst.diverge(DivergenceError, errorDescription(primaryErr), errorDescription(shadowErr))
+		return
+	}
+
+	primaryBreakpoint := inst.primaryInstance.GetBreakpointValue()
+	shadowBreakpoint := inst.shadowInstance.GetBreakpointValue()
+	if primaryBreakpoint != shadowBreakpoint {
+		inst.diverge(DivergenceBreakpoint, fmt.Sprintf("%d", primaryBreakpoint), fmt.Sprintf("%d", shadowBreakpoint))
+		return
+	}
+
+	// after running out of gas, the points used are not relevant and differ between backends
+	if primaryBreakpoint != breakpointOutOfGas {
+		primaryPoints := inst.primaryInstance.GetPointsUsed()
+		shadowPoints := inst.shadowInstance.GetPointsUsed()
+		if primaryPoints != shadowPoints {
+			inst.diverge(DivergenceGas, fmt.Sprintf("%d points used", primaryPoints), fmt.Sprintf("%d points used", shadowPoints))
+			return
+		}
+	}
+
+	inst.compareMemory()
+}
+
+func (inst *DifferentialInstance) compareMemory() {
+	primaryMemory := inst.primaryInstance.MemDump()
+	shadowMemory := inst.shadowInstance.MemDump()
+	if bytes.Equal(primaryMemory, shadowMemory) {
+		return
+	}
+	if len(primaryMemory) != len(shadowMemory) {
+		inst.diverge(DivergenceMemory,
+			fmt.Sprintf("memory length %d", len(primaryMemory)),
+			fmt.Sprintf("memory length %d", len(shadowMemory)))
+		return
+	}
+	offset := 0
+	for primaryMemory[offset] == shadowMemory[offset] {
+		offset++
+	}
+	inst.diverge(DivergenceMemory,
+		fmt.Sprintf("byte 0x%02x at offset %d", primaryMemory[offset], offset),
+		fmt.Sprintf("byte 0x%02x at offset %d", shadowMemory[offset], offset))
+}
+
+// synchronizeShadow brings the shadow back to the state of the primary, after a divergence.
+func (inst *DifferentialInstance) synchronizeShadow() {
+	inst.shadowInstance.SetPointsUsed(inst.primaryInstance.GetPointsUsed())
+	inst.shadowInstance.SetBreakpointValue(inst.primaryInstance.GetBreakpointValue())
+
+	primaryMemory := inst.primaryInstance.MemDump()
+	shadowLength := inst.shadowInstance.MemLength()
+	if uint32(len(primaryMemory)) > shadowLength {
+		pages := (uint32(len(primaryMemory)) - shadowLength) / wasmPageSize
+		err := inst.shadowInstance.MemGrow(pages)
+		if err != nil {
+			log.Warn("differential executor: could not synchronize shadow memory", "error", err)
+			return
+		}
+	}
+	err := inst.shadowInstance.MemStore(0, primaryMemory)
+	if err != nil {
+		log.Warn("differential executor: could not synchronize shadow memory", "error", err)
+	}
+}
+
+// recordHookCall calls the VM hook on behalf of the primary and records the call.
+func (inst *DifferentialInstance) recordHookCall(call *VMHookCall, invoke func() int64) int64 {
+	recording := inst.recording
+	hookCall := &recordedHookCall{
+		call:         call,
+		pointsBefore: inst.primaryInstance.GetPointsUsed(),
+	}
+	recording.hookCalls = append(recording.hookCalls, hookCall)
+
+	previousCall := recording.currentCall
+	recording.currentCall = hookCall
+	hookCall.result = invoke()
+	recording.currentCall = previousCall
+
+	return hookCall.result
+}
+
+// recordEffect adds an operation to the VM hook call currently executed by the primary, if any.
+func (inst *DifferentialInstance) recordEffect(effect *instanceEffect) bool {
+	if inst.recording == nil || inst.recording.currentCall == nil {
+		return false
+	}
+	inst.recording.currentCall.effects = append(inst.recording.currentCall.effects, effect)
+	return true
+}
+
+// replayHookCall checks a VM hook call of the shadow against the recording and replays its effects.
+func (inst *DifferentialInstance) replayHookCall(call *VMHookCall) int64 {
+	recording := inst.recording
+	if recording.divergence != nil {
+		inst.abortShadow()
+		return 0
+	}
+
+	if recording.replayIndex >= len(recording.hookCalls) {
+		inst.diverge(DivergenceHookCall, "no more VM hook calls", call.String())
+		inst.abortShadow()
+		return 0
+	}
+
+	hookCall := recording.hookCalls[recording.replayIndex]
+	if hookCall.call.String() != call.String() {
+		inst.diverge(DivergenceHookCall, hookCall.call.String(), call.String())
+		inst.abortShadow()
+		return 0
+	}
+
+	shadowPoints := inst.shadowInstance.GetPointsUsed()
+	if shadowPoints != hookCall.pointsBefore {
+		inst.diverge(DivergenceGas,
+			fmt.Sprintf("%d points used before %s", hookCall.pointsBefore, call.Name),
+			fmt.Sprintf("%d points used before %s", shadowPoints, call.Name))
+		inst.abortShadow()
+		return 0
+	}
+
+	for _, effect := range hookCall.effects {
+		if !inst.replayEffect(effect) {
+			inst.abortShadow()
+			return 0
+		}
+	}
+
+	recording.replayIndex++
+	return hookCall.result
+}
+
+func (inst *DifferentialInstance) replayEffect(effect *instanceEffect) bool {
+	switch effect.kind {
+	case effectMemLoad:
+		shadowData, err := inst.shadowInstance.MemLoad(effect.memPtr, int32(len(effect.data)))
+		if err != nil || !bytes.Equal(shadowData, effect.data) {
+			inst.diverge(DivergenceMemory,
+				fmt.Sprintf("loaded %x from offset %d", effect.data, effect.memPtr),
+				fmt.Sprintf("loaded %x from offset %d", shadowData, effect.memPtr))
+			return false
+		}
+	case effectMemStore:
+		err := inst.shadowInstance.MemStore(effect.memPtr, effect.data)
+		if err != nil {
+			inst.diverge(DivergenceMemory,
+				fmt.Sprintf("stored %d bytes at offset %d", len(effect.data), effect.memPtr),
+				errorDescription(err))
+			return false
+		}
+	case effectSetPointsUsed:
+		inst.shadowInstance.SetPointsUsed(effect.value)
+	case effectSetBreakpointValue:
+		inst.shadowInstance.SetBreakpointValue(effect.value)
+	}
+	return true
+}
+
+// abortShadow stops the shadow execution at the next breakpoint check.
+func (inst *DifferentialInstance) abortShadow() {
+	inst.shadowInstance.SetBreakpointValue(breakpointExecutionFailed)
+}
+
+func (inst *DifferentialInstance) diverge(kind DivergenceKind, primary string, shadow string) {
+	recording := inst.recording
+	if recording.divergence != nil {
+		return
+	}
+
+	hookCallIndex := recording.replayIndex
+	firstContextCall := hookCallIndex - maxDivergenceContextCalls
+	if firstContextCall < 0 {
+		firstContextCall = 0
+	}
+	precedingCalls := make([]string, 0, hookCallIndex-firstContextCall)
+	for _, hookCall := range recording.hookCalls[firstContextCall:hookCallIndex] {
+		precedingCalls = append(precedingCalls, hookCall.call.String())
+	}
+
+	recording.divergence = &Divergence{
+		Kind:               kind,
+		InstanceID:         inst.primaryInstance.ID(),
+		FunctionName:       recording.functionName,
+		HookCallIndex:      hookCallIndex,
+		Primary:            primary,
+		Shadow:             shadow,
+		PrecedingHookCalls: precedingCalls,
+	}
+}
+
+// HasFunction checks the primary.
+func (inst *DifferentialInstance) HasFunction(functionName string) bool {
+	return inst.primaryInstance.HasFunction(functionName)
+}
+
+// GetFunctionNames returns the function names of the primary.
+func (inst *DifferentialInstance) GetFunctionNames() []string {
+	return inst.primaryInstance.GetFunctionNames()
+}
+
+// ValidateFunctionArities validates the primary.
+func (inst *DifferentialInstance) ValidateFunctionArities() error {
+	return inst.primaryInstance.ValidateFunctionArities()
+}
+
+// HasMemory checks the primary.
+func (inst *DifferentialInstance) HasMemory() bool {
+	return inst.primaryInstance.HasMemory()
+}
+
+// MemLoad returns the contents from the given offset of the WASM memory of the primary.
+func (inst *DifferentialInstance) MemLoad(memPtr executor.MemPtr, length executor.MemLength) ([]byte, error) {
+	data, err := inst.primaryInstance.MemLoad(memPtr, length)
+	if err == nil {
+		inst.recordEffect(&instanceEffect{kind: effectMemLoad, memPtr: memPtr, data: data})
+	}
+	return data, err
+}
+
+// MemStore stores the given data in the WASM memory of both backends.
+func (inst *DifferentialInstance) MemStore(memPtr executor.MemPtr, data []byte) error {
+	err := inst.primaryInstance.MemStore(memPtr, data)
+	if err != nil {
+		return err
+	}
+	storedData := make([]byte, len(data))
+	copy(storedData, data)
+	if inst.recordEffect(&instanceEffect{kind: effectMemStore, memPtr: memPtr, data: storedData}) {
+		return nil
+	}
+	if inst.shadowInstance != nil {
+		return inst.shadowInstance.MemStore(memPtr, data)
+	}
+	return nil
+}
+
+// MemLength returns the memory length of the primary.
+func (inst *DifferentialInstance) MemLength() uint32 {
+	return inst.primaryInstance.MemLength()
+}
+
+// MemGrow grows the memory of both backends.
+func (inst *DifferentialInstance) MemGrow(pages uint32) error {
+	err := inst.primaryInstance.MemGrow(pages)
+	if err != nil {
+		return err
+	}
+	if inst.shadowInstance != nil {
+		return inst.shadowInstance.MemGrow(pages)
+	}
+	return nil
+}
+
+// MemDump yields the memory of the primary.
+func (inst *DifferentialInstance) MemDump() []byte {
+	return inst.primaryInstance.MemDump()
+}
+
+// IsFunctionImported checks the primary.
+func (inst *DifferentialInstance) IsFunctionImported(name string) bool {
+	return inst.primaryInstance.IsFunctionImported(name)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface.
+func (inst *DifferentialInstance) IsInterfaceNil() bool {
+	return inst == nil
+}
+
+// Reset resets both instances.
+func (inst *DifferentialInstance) Reset() bool {
+	if inst.shadowInstance != nil {
+		inst.shadowInstance.Reset()
+	}
+	return inst.primaryInstance.Reset()
+}
+
+// SetVMHooksPtr sets the VM hooks pointer on both instances.
+func (inst *DifferentialInstance) SetVMHooksPtr(vmHooksPtr uintptr) {
+	inst.primaryInstance.SetVMHooksPtr(vmHooksPtr)
+	if inst.shadowInstance != nil {
+		inst.shadowInstance.SetVMHooksPtr(vmHooksPtr)
+	}
+}
+
+// GetVMHooksPtr returns the VM hooks pointer of the primary.
+func (inst *DifferentialInstance) GetVMHooksPtr() uintptr {
+	return inst.primaryInstance.GetVMHooksPtr()
+}
+
+// ID returns the ID of the primary.
+func (inst *DifferentialInstance) ID() string {
+	return inst.primaryInstance.ID()
+}
@@ -0,0 +1,30 @@
+package executorwrapper
+
+// DivergenceReporter receives the divergences found by the DifferentialExecutor.
+type DivergenceReporter interface {
+	ReportDivergence(divergence *Divergence)
+}
+
+// DivergenceCollector is a DivergenceReporter that logs and keeps all divergences.
+type DivergenceCollector struct {
+	Divergences []*Divergence
+}
+
+// NewDivergenceCollector creates a new, empty DivergenceCollector.
+func NewDivergenceCollector() *DivergenceCollector {
+	return &DivergenceCollector{}
+}
+
+// ReportDivergence logs the divergence and keeps it.
+func (collector *DivergenceCollector) ReportDivergence(divergence *Divergence) {
+	log.Warn("executor divergence", "report", divergence.String())
+	collector.Divergences = append(collector.Divergences, divergence)
+}
+
+// FirstDivergence yields the first divergence reported, or nil if there was none.
+func (collector *DivergenceCollector) FirstDivergence() *Divergence {
+	if len(collector.Divergences) == 0 {
+		return nil
+	}
+	return collector.Divergences[0]
+}
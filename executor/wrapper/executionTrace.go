@@ -0,0 +1,219 @@
+package executorwrapper
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// ErrInvalidTrace signals that the trace could not be decoded
+var ErrInvalidTrace = errors.New("invalid execution trace")
+
+// maxTraceLineLength bounds the length of a single trace line, i.e. of one root frame with all its nested frames.
+const maxTraceLineLength = 1 << 30
+
+// TraceEffectKind identifies an operation performed by a VM hook on the contract instance.
+type TraceEffectKind string
+
+const (
+	// TraceEffectMemLoad means that the VM hook read from the WASM memory.
+	TraceEffectMemLoad TraceEffectKind = "memLoad"
+
+	// TraceEffectMemStore means that the VM hook wrote to the WASM memory.
+	TraceEffectMemStore TraceEffectKind = "memStore"
+
+	// TraceEffectSetPointsUsed means that the VM hook changed the gas points used.
+	TraceEffectSetPointsUsed TraceEffectKind = "setPointsUsed"
+
+	// TraceEffectSetBreakpointValue means that the VM hook set a breakpoint, e.g. to signal an error.
+	TraceEffectSetBreakpointValue TraceEffectKind = "setBreakpointValue"
+)
+
+// TraceFrame is the execution of one exported contract function, with all the VM hook calls it made.
+// Contract calls made from VM hooks, e.g. by ExecuteOnDestContext or ExecuteOnSameContext,
+// appear as nested frames of the VM hook call that made them.
+type TraceFrame struct {
+	FunctionName          string                      `json:"function"`
+	InstanceID            string                      `json:"instanceId"`
+	Options               executor.CompilationOptions `json:"options"`
+	GasLimit              uint64                      `json:"gasLimit"`
+	PointsUsedBefore      uint64                      `json:"pointsUsedBefore"`
+	PointsUsedAfter       uint64                      `json:"pointsUsedAfter"`
+	BreakpointValueBefore uint64                      `json:"breakpointValueBefore"`
+	BreakpointValueAfter  uint64                      `json:"breakpointValueAfter"`
+	Error                 string                      `json:"error,omitempty"`
+	HookCalls             []*TraceHookCall            `json:"hookCalls"`
+}
+
+// TraceHookCall is a VM hook call, with its typed arguments, its result and its effects on the instance.
+type TraceHookCall struct {
+	Name             string           `json:"name"`
+	Family           string           `json:"family,omitempty"`
+	Args             []*TraceArgument `json:"args"`
+	Result           int64            `json:"result"`
+	ResultType       string           `json:"resultType,omitempty"`
+	PointsUsedBefore uint64           `json:"pointsUsedBefore"`
+	PointsUsedAfter  uint64           `json:"pointsUsedAfter"`
+	Effects          []*TraceEffect   `json:"effects,omitempty"`
+	NestedFrames     []*TraceFrame    `json:"nestedFrames,omitempty"`
+}
+
+// TraceArgument is a VM hook argument. The type is one of those described by VMHookMetadata.
+type TraceArgument struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value int64  `json:"value"`
+}
+
+// TraceEffect is an operation performed by a VM hook on the instance. The data is hex-encoded.
+type TraceEffect struct {
+	Kind   TraceEffectKind `json:"kind"`
+	Offset int32           `json:"offset,omitempty"`
+	Data   string          `json:"data,omitempty"`
+	Value  uint64          `json:"value,omitempty"`
+}
+
+// Call yields the VM hook call, with untyped arguments.
+func (hookCall *TraceHookCall) Call() *VMHookCall {
+	args := make([]int64, len(hookCall.Args))
+	for i, arg := range hookCall.Args {
+		args[i] = arg.Value
+	}
+	return &VMHookCall{Name: hookCall.Name, Args: args}
+}
+
+func newTraceHookCall(call *VMHookCall, pointsUsedBefore uint64) *TraceHookCall {
+	metadata := GetVMHookMetadata(call.Name)
+	hookCall := &TraceHookCall{
+		Name:             call.Name,
+		Args:             make([]*TraceArgument, len(call.Args)),
+		PointsUsedBefore: pointsUsedBefore,
+	}
+	for i, value := range call.Args {
+		hookCall.Args[i] = &TraceArgument{Value: value}
+	}
+	if metadata == nil {
+		return hookCall
+	}
+
+	hookCall.Family = metadata.Family
+	hookCall.ResultType = metadata.ResultType
+	for i, arg := range hookCall.Args {
+		if i < len(metadata.ArgNames) {
+			arg.Name = metadata.ArgNames[i]
+			arg.Type = metadata.ArgTypes[i]
+		}
+	}
+	return hookCall
+}
+
+// String yields a one-line description of the effect.
+func (effect *TraceEffect) String() string {
+	switch effect.Kind {
+	case TraceEffectMemLoad:
+		return fmt.Sprintf("loaded %s from offset %d", effect.Data, effect.Offset)
+	case TraceEffectMemStore:
+		return fmt.Sprintf("stored %s at offset %d", effect.Data, effect.Offset)
+	default:
+		return fmt.Sprintf("%s %d", effect.Kind, effect.Value)
+	}
+}
+
+// TraceSink receives the root frames recorded by the TraceRecorderExecutor, once they are complete.
+type TraceSink interface {
+	RecordTraceFrame(frame *TraceFrame)
+}
+
+// JSONTraceWriter is a TraceSink that writes the frames as JSON lines, one root frame per line.
+type JSONTraceWriter struct {
+	mutWriter sync.Mutex
+	encoder   *json.Encoder
+	err       error
+}
+
+// NewJSONTraceWriter creates a JSONTraceWriter on top of the given writer.
+func NewJSONTraceWriter(writer io.Writer) *JSONTraceWriter {
+	return &JSONTraceWriter{
+		encoder: json.NewEncoder(writer),
+	}
+}
+
+// RecordTraceFrame writes the frame on a new line. After the first error, all frames are discarded.
+func (traceWriter *JSONTraceWriter) RecordTraceFrame(frame *TraceFrame) {
+	traceWriter.mutWriter.Lock()
+	defer traceWriter.mutWriter.Unlock()
+
+	if traceWriter.err != nil {
+		return
+	}
+	traceWriter.err = traceWriter.encoder.Encode(frame)
+	if traceWriter.err != nil {
+		log.Warn("could not write execution trace", "error", traceWriter.err)
+	}
+}
+
+// Err yields the first error encountered while writing, if any.
+func (traceWriter *JSONTraceWriter) Err() error {
+	traceWriter.mutWriter.Lock()
+	defer traceWriter.mutWriter.Unlock()
+
+	return traceWriter.err
+}
+
+// ReadTraceFrames reads all the root frames written by a JSONTraceWriter.
+func ReadTraceFrames(reader io.Reader) ([]*TraceFrame, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxTraceLineLength)
+
+	frames := make([]*TraceFrame, 0)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 {
+			continue
+		}
+		frame := &TraceFrame{}
+		err := json.Unmarshal([]byte(line), frame)
+		if err != nil {
+			return nil, fmt.Errorf("%w on line %d: %s", ErrInvalidTrace, lineNumber, err.Error())
+		}
+		frames = append(frames, frame)
+	}
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+	return frames, nil
+}
+
+// FlattenTraceFrames lists the frames and all their nested frames, depth-first, each parent before its nested frames.
+func FlattenTraceFrames(frames []*TraceFrame) []*TraceFrame {
+	flattened := make([]*TraceFrame, 0, len(frames))
+	for _, frame := range frames {
+		flattened = append(flattened, frame)
+		for _, hookCall := range frame.HookCalls {
+			flattened = append(flattened, FlattenTraceFrames(hookCall.NestedFrames)...)
+		}
+	}
+	return flattened
+}
+
+func encodeTraceData(data []byte) string {
+	return hex.EncodeToString(data)
+}
+
+func decodeTraceData(data string) ([]byte, error) {
+	decoded, err := hex.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTrace, err.Error())
+	}
+	return decoded, nil
+}
@@ -0,0 +1,2475 @@
+package executorwrapper
+
+// Code generated by vmhooks generator. DO NOT EDIT.
+
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// !!!!!!!!!!!!!!!!!!!!!! AUTO-GENERATED FILE !!!!!!!!!!!!!!!!!!!!!!
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// InterceptorVMHooks passes all VM hook calls through an interceptor,
+// which decides whether and how the wrapped VM hooks are called.
+type InterceptorVMHooks struct {
+	interceptor    VMHookInterceptor
+	wrappedVMHooks executor.VMHooks
+}
+
+// NewInterceptorVMHooks creates a new InterceptorVMHooks.
+// The wrapped VM hooks can be nil, if the interceptor never invokes them.
+func NewInterceptorVMHooks(interceptor VMHookInterceptor, wrappedVMHooks executor.VMHooks) *InterceptorVMHooks {
+	return &InterceptorVMHooks{
+		interceptor:    interceptor,
+		wrappedVMHooks: wrappedVMHooks,
+	}
+}
+
+// GetGasLeft VM hook interceptor
+func (w *InterceptorVMHooks) GetGasLeft() int64 {
+	call := &VMHookCall{Name: "getGasLeft", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetGasLeft())
+	})
+	return int64(result)
+}
+
+// GetSCAddress VM hook interceptor
+func (w *InterceptorVMHooks) GetSCAddress(resultOffset executor.MemPtr) {
+	call := &VMHookCall{Name: "getSCAddress", Args: []int64{int64(resultOffset)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.GetSCAddress(resultOffset)
+		return 0
+	})
+}
+
+// GetOwnerAddress VM hook interceptor
+func (w *InterceptorVMHooks) GetOwnerAddress(resultOffset executor.MemPtr) {
+	call := &VMHookCall{Name: "getOwnerAddress", Args: []int64{int64(resultOffset)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.GetOwnerAddress(resultOffset)
+		return 0
+	})
+}
+
+// GetShardOfAddress VM hook interceptor
+func (w *InterceptorVMHooks) GetShardOfAddress(addressOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getShardOfAddress", Args: []int64{int64(addressOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetShardOfAddress(addressOffset))
+	})
+	return int32(result)
+}
+
+// IsSmartContract VM hook interceptor
+func (w *InterceptorVMHooks) IsSmartContract(addressOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "isSmartContract", Args: []int64{int64(addressOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.IsSmartContract(addressOffset))
+	})
+	return int32(result)
+}
+
+// SignalError VM hook interceptor
+func (w *InterceptorVMHooks) SignalError(messageOffset executor.MemPtr, messageLength executor.MemLength) {
+	call := &VMHookCall{Name: "signalError", Args: []int64{int64(messageOffset), int64(messageLength)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.SignalError(messageOffset, messageLength)
+		return 0
+	})
+}
+
+// GetExternalBalance VM hook interceptor
+func (w *InterceptorVMHooks) GetExternalBalance(addressOffset executor.MemPtr, resultOffset executor.MemPtr) {
+	call := &VMHookCall{Name: "getExternalBalance", Args: []int64{int64(addressOffset), int64(resultOffset)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.GetExternalBalance(addressOffset, resultOffset)
+		return 0
+	})
+}
+
+// GetBlockHash VM hook interceptor
+func (w *InterceptorVMHooks) GetBlockHash(nonce int64, resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getBlockHash", Args: []int64{int64(nonce), int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetBlockHash(nonce, resultOffset))
+	})
+	return int32(result)
+}
+
+// GetESDTBalance VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTBalance(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64, resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getESDTBalance", Args: []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce), int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTBalance(addressOffset, tokenIDOffset, tokenIDLen, nonce, resultOffset))
+	})
+	return int32(result)
+}
+
+// GetESDTNFTNameLength VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTNFTNameLength(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64) int32 {
+	call := &VMHookCall{Name: "getESDTNFTNameLength", Args: []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTNFTNameLength(addressOffset, tokenIDOffset, tokenIDLen, nonce))
+	})
+	return int32(result)
+}
+
+// GetESDTNFTAttributeLength VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTNFTAttributeLength(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64) int32 {
+	call := &VMHookCall{Name: "getESDTNFTAttributeLength", Args: []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTNFTAttributeLength(addressOffset, tokenIDOffset, tokenIDLen, nonce))
+	})
+	return int32(result)
+}
+
+// GetESDTNFTURILength VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTNFTURILength(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64) int32 {
+	call := &VMHookCall{Name: "getESDTNFTURILength", Args: []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTNFTURILength(addressOffset, tokenIDOffset, tokenIDLen, nonce))
+	})
+	return int32(result)
+}
+
+// GetESDTTokenData VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTTokenData(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64, valueHandle int32, propertiesOffset executor.MemPtr, hashOffset executor.MemPtr, nameOffset executor.MemPtr, attributesOffset executor.MemPtr, creatorOffset executor.MemPtr, royaltiesHandle int32, urisOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getESDTTokenData", Args: []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce), int64(valueHandle), int64(propertiesOffset), int64(hashOffset), int64(nameOffset), int64(attributesOffset), int64(creatorOffset), int64(royaltiesHandle), int64(urisOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTTokenData(addressOffset, tokenIDOffset, tokenIDLen, nonce, valueHandle, propertiesOffset, hashOffset, nameOffset, attributesOffset, creatorOffset, royaltiesHandle, urisOffset))
+	})
+	return int32(result)
+}
+
+// GetESDTLocalRoles VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTLocalRoles(tokenIdHandle int32) int64 {
+	call := &VMHookCall{Name: "getESDTLocalRoles", Args: []int64{int64(tokenIdHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTLocalRoles(tokenIdHandle))
+	})
+	return int64(result)
+}
+
+// ValidateTokenIdentifier VM hook interceptor
+func (w *InterceptorVMHooks) ValidateTokenIdentifier(tokenIdHandle int32) int32 {
+	call := &VMHookCall{Name: "validateTokenIdentifier", Args: []int64{int64(tokenIdHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ValidateTokenIdentifier(tokenIdHandle))
+	})
+	return int32(result)
+}
+
+// TransferValue VM hook interceptor
+func (w *InterceptorVMHooks) TransferValue(destOffset executor.MemPtr, valueOffset executor.MemPtr, dataOffset executor.MemPtr, length executor.MemLength) int32 {
+	call := &VMHookCall{Name: "transferValue", Args: []int64{int64(destOffset), int64(valueOffset), int64(dataOffset), int64(length)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.TransferValue(destOffset, valueOffset, dataOffset, length))
+	})
+	return int32(result)
+}
+
+// TransferValueExecute VM hook interceptor
+func (w *InterceptorVMHooks) TransferValueExecute(destOffset executor.MemPtr, valueOffset executor.MemPtr, gasLimit int64, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "transferValueExecute", Args: []int64{int64(destOffset), int64(valueOffset), int64(gasLimit), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.TransferValueExecute(destOffset, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset))
+	})
+	return int32(result)
+}
+
+// TransferESDTExecute VM hook interceptor
+func (w *InterceptorVMHooks) TransferESDTExecute(destOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, valueOffset executor.MemPtr, gasLimit int64, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "transferESDTExecute", Args: []int64{int64(destOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(valueOffset), int64(gasLimit), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.TransferESDTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset))
+	})
+	return int32(result)
+}
+
+// TransferESDTNFTExecute VM hook interceptor
+func (w *InterceptorVMHooks) TransferESDTNFTExecute(destOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, valueOffset executor.MemPtr, nonce int64, gasLimit int64, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "transferESDTNFTExecute", Args: []int64{int64(destOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(valueOffset), int64(nonce), int64(gasLimit), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.TransferESDTNFTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, nonce, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset))
+	})
+	return int32(result)
+}
+
+// MultiTransferESDTNFTExecute VM hook interceptor
+func (w *InterceptorVMHooks) MultiTransferESDTNFTExecute(destOffset executor.MemPtr, numTokenTransfers int32, tokenTransfersArgsLengthOffset executor.MemPtr, tokenTransferDataOffset executor.MemPtr, gasLimit int64, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "multiTransferESDTNFTExecute", Args: []int64{int64(destOffset), int64(numTokenTransfers), int64(tokenTransfersArgsLengthOffset), int64(tokenTransferDataOffset), int64(gasLimit), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MultiTransferESDTNFTExecute(destOffset, numTokenTransfers, tokenTransfersArgsLengthOffset, tokenTransferDataOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset))
+	})
+	return int32(result)
+}
+
+// CreateAsyncCall VM hook interceptor
+func (w *InterceptorVMHooks) CreateAsyncCall(destOffset executor.MemPtr, valueOffset executor.MemPtr, dataOffset executor.MemPtr, dataLength executor.MemLength, successOffset executor.MemPtr, successLength executor.MemLength, errorOffset executor.MemPtr, errorLength executor.MemLength, gas int64, extraGasForCallback int64) int32 {
+	call := &VMHookCall{Name: "createAsyncCall", Args: []int64{int64(destOffset), int64(valueOffset), int64(dataOffset), int64(dataLength), int64(successOffset), int64(successLength), int64(errorOffset), int64(errorLength), int64(gas), int64(extraGasForCallback)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.CreateAsyncCall(destOffset, valueOffset, dataOffset, dataLength, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback))
+	})
+	return int32(result)
+}
+
+// SetAsyncContextCallback VM hook interceptor
+func (w *InterceptorVMHooks) SetAsyncContextCallback(callback executor.MemPtr, callbackLength executor.MemLength, data executor.MemPtr, dataLength executor.MemLength, gas int64) int32 {
+	call := &VMHookCall{Name: "setAsyncContextCallback", Args: []int64{int64(callback), int64(callbackLength), int64(data), int64(dataLength), int64(gas)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.SetAsyncContextCallback(callback, callbackLength, data, dataLength, gas))
+	})
+	return int32(result)
+}
+
+// UpgradeContract VM hook interceptor
+func (w *InterceptorVMHooks) UpgradeContract(destOffset executor.MemPtr, gasLimit int64, valueOffset executor.MemPtr, codeOffset executor.MemPtr, codeMetadataOffset executor.MemPtr, length executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) {
+	call := &VMHookCall{Name: "upgradeContract", Args: []int64{int64(destOffset), int64(gasLimit), int64(valueOffset), int64(codeOffset), int64(codeMetadataOffset), int64(length), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.UpgradeContract(destOffset, gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, numArguments, argumentsLengthOffset, dataOffset)
+		return 0
+	})
+}
+
+// UpgradeFromSourceContract VM hook interceptor
+func (w *InterceptorVMHooks) UpgradeFromSourceContract(destOffset executor.MemPtr, gasLimit int64, valueOffset executor.MemPtr, sourceContractAddressOffset executor.MemPtr, codeMetadataOffset executor.MemPtr, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) {
+	call := &VMHookCall{Name: "upgradeFromSourceContract", Args: []int64{int64(destOffset), int64(gasLimit), int64(valueOffset), int64(sourceContractAddressOffset), int64(codeMetadataOffset), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.UpgradeFromSourceContract(destOffset, gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, numArguments, argumentsLengthOffset, dataOffset)
+		return 0
+	})
+}
+
+// DeleteContract VM hook interceptor
+func (w *InterceptorVMHooks) DeleteContract(destOffset executor.MemPtr, gasLimit int64, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) {
+	call := &VMHookCall{Name: "deleteContract", Args: []int64{int64(destOffset), int64(gasLimit), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.DeleteContract(destOffset, gasLimit, numArguments, argumentsLengthOffset, dataOffset)
+		return 0
+	})
+}
+
+// AsyncCall VM hook interceptor
+func (w *InterceptorVMHooks) AsyncCall(destOffset executor.MemPtr, valueOffset executor.MemPtr, dataOffset executor.MemPtr, length executor.MemLength) {
+	call := &VMHookCall{Name: "asyncCall", Args: []int64{int64(destOffset), int64(valueOffset), int64(dataOffset), int64(length)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.AsyncCall(destOffset, valueOffset, dataOffset, length)
+		return 0
+	})
+}
+
+// GetArgumentLength VM hook interceptor
+func (w *InterceptorVMHooks) GetArgumentLength(id int32) int32 {
+	call := &VMHookCall{Name: "getArgumentLength", Args: []int64{int64(id)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetArgumentLength(id))
+	})
+	return int32(result)
+}
+
+// GetArgument VM hook interceptor
+func (w *InterceptorVMHooks) GetArgument(id int32, argOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getArgument", Args: []int64{int64(id), int64(argOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetArgument(id, argOffset))
+	})
+	return int32(result)
+}
+
+// GetFunction VM hook interceptor
+func (w *InterceptorVMHooks) GetFunction(functionOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getFunction", Args: []int64{int64(functionOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetFunction(functionOffset))
+	})
+	return int32(result)
+}
+
+// GetNumArguments VM hook interceptor
+func (w *InterceptorVMHooks) GetNumArguments() int32 {
+	call := &VMHookCall{Name: "getNumArguments", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetNumArguments())
+	})
+	return int32(result)
+}
+
+// StorageStore VM hook interceptor
+func (w *InterceptorVMHooks) StorageStore(keyOffset executor.MemPtr, keyLength executor.MemLength, dataOffset executor.MemPtr, dataLength executor.MemLength) int32 {
+	call := &VMHookCall{Name: "storageStore", Args: []int64{int64(keyOffset), int64(keyLength), int64(dataOffset), int64(dataLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.StorageStore(keyOffset, keyLength, dataOffset, dataLength))
+	})
+	return int32(result)
+}
+
+// StorageLoadLength VM hook interceptor
+func (w *InterceptorVMHooks) StorageLoadLength(keyOffset executor.MemPtr, keyLength executor.MemLength) int32 {
+	call := &VMHookCall{Name: "storageLoadLength", Args: []int64{int64(keyOffset), int64(keyLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.StorageLoadLength(keyOffset, keyLength))
+	})
+	return int32(result)
+}
+
+// StorageLoadFromAddress VM hook interceptor
+func (w *InterceptorVMHooks) StorageLoadFromAddress(addressOffset executor.MemPtr, keyOffset executor.MemPtr, keyLength executor.MemLength, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "storageLoadFromAddress", Args: []int64{int64(addressOffset), int64(keyOffset), int64(keyLength), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.StorageLoadFromAddress(addressOffset, keyOffset, keyLength, dataOffset))
+	})
+	return int32(result)
+}
+
+// StorageLoad VM hook interceptor
+func (w *InterceptorVMHooks) StorageLoad(keyOffset executor.MemPtr, keyLength executor.MemLength, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "storageLoad", Args: []int64{int64(keyOffset), int64(keyLength), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.StorageLoad(keyOffset, keyLength, dataOffset))
+	})
+	return int32(result)
+}
+
+// SetStorageLock VM hook interceptor
+func (w *InterceptorVMHooks) SetStorageLock(keyOffset executor.MemPtr, keyLength executor.MemLength, lockTimestamp int64) int32 {
+	call := &VMHookCall{Name: "setStorageLock", Args: []int64{int64(keyOffset), int64(keyLength), int64(lockTimestamp)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.SetStorageLock(keyOffset, keyLength, lockTimestamp))
+	})
+	return int32(result)
+}
+
+// GetStorageLock VM hook interceptor
+func (w *InterceptorVMHooks) GetStorageLock(keyOffset executor.MemPtr, keyLength executor.MemLength) int64 {
+	call := &VMHookCall{Name: "getStorageLock", Args: []int64{int64(keyOffset), int64(keyLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetStorageLock(keyOffset, keyLength))
+	})
+	return int64(result)
+}
+
+// IsStorageLocked VM hook interceptor
+func (w *InterceptorVMHooks) IsStorageLocked(keyOffset executor.MemPtr, keyLength executor.MemLength) int32 {
+	call := &VMHookCall{Name: "isStorageLocked", Args: []int64{int64(keyOffset), int64(keyLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.IsStorageLocked(keyOffset, keyLength))
+	})
+	return int32(result)
+}
+
+// ClearStorageLock VM hook interceptor
+func (w *InterceptorVMHooks) ClearStorageLock(keyOffset executor.MemPtr, keyLength executor.MemLength) int32 {
+	call := &VMHookCall{Name: "clearStorageLock", Args: []int64{int64(keyOffset), int64(keyLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ClearStorageLock(keyOffset, keyLength))
+	})
+	return int32(result)
+}
+
+// GetCaller VM hook interceptor
+func (w *InterceptorVMHooks) GetCaller(resultOffset executor.MemPtr) {
+	call := &VMHookCall{Name: "getCaller", Args: []int64{int64(resultOffset)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.GetCaller(resultOffset)
+		return 0
+	})
+}
+
+// CheckNoPayment VM hook interceptor
+func (w *InterceptorVMHooks) CheckNoPayment() {
+	call := &VMHookCall{Name: "checkNoPayment", Args: []int64{}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.CheckNoPayment()
+		return 0
+	})
+}
+
+// GetCallValue VM hook interceptor
+func (w *InterceptorVMHooks) GetCallValue(resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getCallValue", Args: []int64{int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetCallValue(resultOffset))
+	})
+	return int32(result)
+}
+
+// GetESDTValue VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTValue(resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getESDTValue", Args: []int64{int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTValue(resultOffset))
+	})
+	return int32(result)
+}
+
+// GetESDTValueByIndex VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTValueByIndex(resultOffset executor.MemPtr, index int32) int32 {
+	call := &VMHookCall{Name: "getESDTValueByIndex", Args: []int64{int64(resultOffset), int64(index)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTValueByIndex(resultOffset, index))
+	})
+	return int32(result)
+}
+
+// GetESDTTokenName VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTTokenName(resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getESDTTokenName", Args: []int64{int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTTokenName(resultOffset))
+	})
+	return int32(result)
+}
+
+// GetESDTTokenNameByIndex VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTTokenNameByIndex(resultOffset executor.MemPtr, index int32) int32 {
+	call := &VMHookCall{Name: "getESDTTokenNameByIndex", Args: []int64{int64(resultOffset), int64(index)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTTokenNameByIndex(resultOffset, index))
+	})
+	return int32(result)
+}
+
+// GetESDTTokenNonce VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTTokenNonce() int64 {
+	call := &VMHookCall{Name: "getESDTTokenNonce", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTTokenNonce())
+	})
+	return int64(result)
+}
+
+// GetESDTTokenNonceByIndex VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTTokenNonceByIndex(index int32) int64 {
+	call := &VMHookCall{Name: "getESDTTokenNonceByIndex", Args: []int64{int64(index)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTTokenNonceByIndex(index))
+	})
+	return int64(result)
+}
+
+// GetCurrentESDTNFTNonce VM hook interceptor
+func (w *InterceptorVMHooks) GetCurrentESDTNFTNonce(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength) int64 {
+	call := &VMHookCall{Name: "getCurrentESDTNFTNonce", Args: []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetCurrentESDTNFTNonce(addressOffset, tokenIDOffset, tokenIDLen))
+	})
+	return int64(result)
+}
+
+// GetESDTTokenType VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTTokenType() int32 {
+	call := &VMHookCall{Name: "getESDTTokenType", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTTokenType())
+	})
+	return int32(result)
+}
+
+// GetESDTTokenTypeByIndex VM hook interceptor
+func (w *InterceptorVMHooks) GetESDTTokenTypeByIndex(index int32) int32 {
+	call := &VMHookCall{Name: "getESDTTokenTypeByIndex", Args: []int64{int64(index)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetESDTTokenTypeByIndex(index))
+	})
+	return int32(result)
+}
+
+// GetNumESDTTransfers VM hook interceptor
+func (w *InterceptorVMHooks) GetNumESDTTransfers() int32 {
+	call := &VMHookCall{Name: "getNumESDTTransfers", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetNumESDTTransfers())
+	})
+	return int32(result)
+}
+
+// GetCallValueTokenName VM hook interceptor
+func (w *InterceptorVMHooks) GetCallValueTokenName(callValueOffset executor.MemPtr, tokenNameOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getCallValueTokenName", Args: []int64{int64(callValueOffset), int64(tokenNameOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetCallValueTokenName(callValueOffset, tokenNameOffset))
+	})
+	return int32(result)
+}
+
+// GetCallValueTokenNameByIndex VM hook interceptor
+func (w *InterceptorVMHooks) GetCallValueTokenNameByIndex(callValueOffset executor.MemPtr, tokenNameOffset executor.MemPtr, index int32) int32 {
+	call := &VMHookCall{Name: "getCallValueTokenNameByIndex", Args: []int64{int64(callValueOffset), int64(tokenNameOffset), int64(index)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetCallValueTokenNameByIndex(callValueOffset, tokenNameOffset, index))
+	})
+	return int32(result)
+}
+
+// WriteLog VM hook interceptor
+func (w *InterceptorVMHooks) WriteLog(dataPointer executor.MemPtr, dataLength executor.MemLength, topicPtr executor.MemPtr, numTopics int32) {
+	call := &VMHookCall{Name: "writeLog", Args: []int64{int64(dataPointer), int64(dataLength), int64(topicPtr), int64(numTopics)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.WriteLog(dataPointer, dataLength, topicPtr, numTopics)
+		return 0
+	})
+}
+
+// WriteEventLog VM hook interceptor
+func (w *InterceptorVMHooks) WriteEventLog(numTopics int32, topicLengthsOffset executor.MemPtr, topicOffset executor.MemPtr, dataOffset executor.MemPtr, dataLength executor.MemLength) {
+	call := &VMHookCall{Name: "writeEventLog", Args: []int64{int64(numTopics), int64(topicLengthsOffset), int64(topicOffset), int64(dataOffset), int64(dataLength)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.WriteEventLog(numTopics, topicLengthsOffset, topicOffset, dataOffset, dataLength)
+		return 0
+	})
+}
+
+// GetBlockTimestamp VM hook interceptor
+func (w *InterceptorVMHooks) GetBlockTimestamp() int64 {
+	call := &VMHookCall{Name: "getBlockTimestamp", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetBlockTimestamp())
+	})
+	return int64(result)
+}
+
+// GetBlockNonce VM hook interceptor
+func (w *InterceptorVMHooks) GetBlockNonce() int64 {
+	call := &VMHookCall{Name: "getBlockNonce", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetBlockNonce())
+	})
+	return int64(result)
+}
+
+// GetBlockRound VM hook interceptor
+func (w *InterceptorVMHooks) GetBlockRound() int64 {
+	call := &VMHookCall{Name: "getBlockRound", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetBlockRound())
+	})
+	return int64(result)
+}
+
+// GetBlockEpoch VM hook interceptor
+func (w *InterceptorVMHooks) GetBlockEpoch() int64 {
+	call := &VMHookCall{Name: "getBlockEpoch", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetBlockEpoch())
+	})
+	return int64(result)
+}
+
+// GetBlockRandomSeed VM hook interceptor
+func (w *InterceptorVMHooks) GetBlockRandomSeed(pointer executor.MemPtr) {
+	call := &VMHookCall{Name: "getBlockRandomSeed", Args: []int64{int64(pointer)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.GetBlockRandomSeed(pointer)
+		return 0
+	})
+}
+
+// GetStateRootHash VM hook interceptor
+func (w *InterceptorVMHooks) GetStateRootHash(pointer executor.MemPtr) {
+	call := &VMHookCall{Name: "getStateRootHash", Args: []int64{int64(pointer)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.GetStateRootHash(pointer)
+		return 0
+	})
+}
+
+// GetPrevBlockTimestamp VM hook interceptor
+func (w *InterceptorVMHooks) GetPrevBlockTimestamp() int64 {
+	call := &VMHookCall{Name: "getPrevBlockTimestamp", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetPrevBlockTimestamp())
+	})
+	return int64(result)
+}
+
+// GetPrevBlockNonce VM hook interceptor
+func (w *InterceptorVMHooks) GetPrevBlockNonce() int64 {
+	call := &VMHookCall{Name: "getPrevBlockNonce", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetPrevBlockNonce())
+	})
+	return int64(result)
+}
+
+// GetPrevBlockRound VM hook interceptor
+func (w *InterceptorVMHooks) GetPrevBlockRound() int64 {
+	call := &VMHookCall{Name: "getPrevBlockRound", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetPrevBlockRound())
+	})
+	return int64(result)
+}
+
+// GetPrevBlockEpoch VM hook interceptor
+func (w *InterceptorVMHooks) GetPrevBlockEpoch() int64 {
+	call := &VMHookCall{Name: "getPrevBlockEpoch", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetPrevBlockEpoch())
+	})
+	return int64(result)
+}
+
+// GetPrevBlockRandomSeed VM hook interceptor
+func (w *InterceptorVMHooks) GetPrevBlockRandomSeed(pointer executor.MemPtr) {
+	call := &VMHookCall{Name: "getPrevBlockRandomSeed", Args: []int64{int64(pointer)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.GetPrevBlockRandomSeed(pointer)
+		return 0
+	})
+}
+
+// Finish VM hook interceptor
+func (w *InterceptorVMHooks) Finish(pointer executor.MemPtr, length executor.MemLength) {
+	call := &VMHookCall{Name: "finish", Args: []int64{int64(pointer), int64(length)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.Finish(pointer, length)
+		return 0
+	})
+}
+
+// ExecuteOnSameContext VM hook interceptor
+func (w *InterceptorVMHooks) ExecuteOnSameContext(gasLimit int64, addressOffset executor.MemPtr, valueOffset executor.MemPtr, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "executeOnSameContext", Args: []int64{int64(gasLimit), int64(addressOffset), int64(valueOffset), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ExecuteOnSameContext(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset))
+	})
+	return int32(result)
+}
+
+// ExecuteOnDestContext VM hook interceptor
+func (w *InterceptorVMHooks) ExecuteOnDestContext(gasLimit int64, addressOffset executor.MemPtr, valueOffset executor.MemPtr, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "executeOnDestContext", Args: []int64{int64(gasLimit), int64(addressOffset), int64(valueOffset), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ExecuteOnDestContext(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset))
+	})
+	return int32(result)
+}
+
+// ExecuteReadOnly VM hook interceptor
+func (w *InterceptorVMHooks) ExecuteReadOnly(gasLimit int64, addressOffset executor.MemPtr, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "executeReadOnly", Args: []int64{int64(gasLimit), int64(addressOffset), int64(functionOffset), int64(functionLength), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ExecuteReadOnly(gasLimit, addressOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset))
+	})
+	return int32(result)
+}
+
+// CreateContract VM hook interceptor
+func (w *InterceptorVMHooks) CreateContract(gasLimit int64, valueOffset executor.MemPtr, codeOffset executor.MemPtr, codeMetadataOffset executor.MemPtr, length executor.MemLength, resultOffset executor.MemPtr, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "createContract", Args: []int64{int64(gasLimit), int64(valueOffset), int64(codeOffset), int64(codeMetadataOffset), int64(length), int64(resultOffset), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.CreateContract(gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, resultOffset, numArguments, argumentsLengthOffset, dataOffset))
+	})
+	return int32(result)
+}
+
+// DeployFromSourceContract VM hook interceptor
+func (w *InterceptorVMHooks) DeployFromSourceContract(gasLimit int64, valueOffset executor.MemPtr, sourceContractAddressOffset executor.MemPtr, codeMetadataOffset executor.MemPtr, resultAddressOffset executor.MemPtr, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "deployFromSourceContract", Args: []int64{int64(gasLimit), int64(valueOffset), int64(sourceContractAddressOffset), int64(codeMetadataOffset), int64(resultAddressOffset), int64(numArguments), int64(argumentsLengthOffset), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.DeployFromSourceContract(gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, resultAddressOffset, numArguments, argumentsLengthOffset, dataOffset))
+	})
+	return int32(result)
+}
+
+// GetNumReturnData VM hook interceptor
+func (w *InterceptorVMHooks) GetNumReturnData() int32 {
+	call := &VMHookCall{Name: "getNumReturnData", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetNumReturnData())
+	})
+	return int32(result)
+}
+
+// GetReturnDataSize VM hook interceptor
+func (w *InterceptorVMHooks) GetReturnDataSize(resultID int32) int32 {
+	call := &VMHookCall{Name: "getReturnDataSize", Args: []int64{int64(resultID)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetReturnDataSize(resultID))
+	})
+	return int32(result)
+}
+
+// GetReturnData VM hook interceptor
+func (w *InterceptorVMHooks) GetReturnData(resultID int32, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "getReturnData", Args: []int64{int64(resultID), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetReturnData(resultID, dataOffset))
+	})
+	return int32(result)
+}
+
+// CleanReturnData VM hook interceptor
+func (w *InterceptorVMHooks) CleanReturnData() {
+	call := &VMHookCall{Name: "cleanReturnData", Args: []int64{}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.CleanReturnData()
+		return 0
+	})
+}
+
+// DeleteFromReturnData VM hook interceptor
+func (w *InterceptorVMHooks) DeleteFromReturnData(resultID int32) {
+	call := &VMHookCall{Name: "deleteFromReturnData", Args: []int64{int64(resultID)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.DeleteFromReturnData(resultID)
+		return 0
+	})
+}
+
+// GetOriginalTxHash VM hook interceptor
+func (w *InterceptorVMHooks) GetOriginalTxHash(dataOffset executor.MemPtr) {
+	call := &VMHookCall{Name: "getOriginalTxHash", Args: []int64{int64(dataOffset)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.GetOriginalTxHash(dataOffset)
+		return 0
+	})
+}
+
+// GetCurrentTxHash VM hook interceptor
+func (w *InterceptorVMHooks) GetCurrentTxHash(dataOffset executor.MemPtr) {
+	call := &VMHookCall{Name: "getCurrentTxHash", Args: []int64{int64(dataOffset)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.GetCurrentTxHash(dataOffset)
+		return 0
+	})
+}
+
+// GetPrevTxHash VM hook interceptor
+func (w *InterceptorVMHooks) GetPrevTxHash(dataOffset executor.MemPtr) {
+	call := &VMHookCall{Name: "getPrevTxHash", Args: []int64{int64(dataOffset)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.GetPrevTxHash(dataOffset)
+		return 0
+	})
+}
+
+// ManagedSCAddress VM hook interceptor
+func (w *InterceptorVMHooks) ManagedSCAddress(destinationHandle int32) {
+	call := &VMHookCall{Name: "managedSCAddress", Args: []int64{int64(destinationHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedSCAddress(destinationHandle)
+		return 0
+	})
+}
+
+// ManagedOwnerAddress VM hook interceptor
+func (w *InterceptorVMHooks) ManagedOwnerAddress(destinationHandle int32) {
+	call := &VMHookCall{Name: "managedOwnerAddress", Args: []int64{int64(destinationHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedOwnerAddress(destinationHandle)
+		return 0
+	})
+}
+
+// ManagedCaller VM hook interceptor
+func (w *InterceptorVMHooks) ManagedCaller(destinationHandle int32) {
+	call := &VMHookCall{Name: "managedCaller", Args: []int64{int64(destinationHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedCaller(destinationHandle)
+		return 0
+	})
+}
+
+// ManagedSignalError VM hook interceptor
+func (w *InterceptorVMHooks) ManagedSignalError(errHandle int32) {
+	call := &VMHookCall{Name: "managedSignalError", Args: []int64{int64(errHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedSignalError(errHandle)
+		return 0
+	})
+}
+
+// ManagedWriteLog VM hook interceptor
+func (w *InterceptorVMHooks) ManagedWriteLog(topicsHandle int32, dataHandle int32) {
+	call := &VMHookCall{Name: "managedWriteLog", Args: []int64{int64(topicsHandle), int64(dataHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedWriteLog(topicsHandle, dataHandle)
+		return 0
+	})
+}
+
+// ManagedGetOriginalTxHash VM hook interceptor
+func (w *InterceptorVMHooks) ManagedGetOriginalTxHash(resultHandle int32) {
+	call := &VMHookCall{Name: "managedGetOriginalTxHash", Args: []int64{int64(resultHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedGetOriginalTxHash(resultHandle)
+		return 0
+	})
+}
+
+// ManagedGetStateRootHash VM hook interceptor
+func (w *InterceptorVMHooks) ManagedGetStateRootHash(resultHandle int32) {
+	call := &VMHookCall{Name: "managedGetStateRootHash", Args: []int64{int64(resultHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedGetStateRootHash(resultHandle)
+		return 0
+	})
+}
+
+// ManagedGetBlockRandomSeed VM hook interceptor
+func (w *InterceptorVMHooks) ManagedGetBlockRandomSeed(resultHandle int32) {
+	call := &VMHookCall{Name: "managedGetBlockRandomSeed", Args: []int64{int64(resultHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedGetBlockRandomSeed(resultHandle)
+		return 0
+	})
+}
+
+// ManagedGetPrevBlockRandomSeed VM hook interceptor
+func (w *InterceptorVMHooks) ManagedGetPrevBlockRandomSeed(resultHandle int32) {
+	call := &VMHookCall{Name: "managedGetPrevBlockRandomSeed", Args: []int64{int64(resultHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedGetPrevBlockRandomSeed(resultHandle)
+		return 0
+	})
+}
+
+// ManagedGetReturnData VM hook interceptor
+func (w *InterceptorVMHooks) ManagedGetReturnData(resultID int32, resultHandle int32) {
+	call := &VMHookCall{Name: "managedGetReturnData", Args: []int64{int64(resultID), int64(resultHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedGetReturnData(resultID, resultHandle)
+		return 0
+	})
+}
+
+// ManagedGetMultiESDTCallValue VM hook interceptor
+func (w *InterceptorVMHooks) ManagedGetMultiESDTCallValue(multiCallValueHandle int32) {
+	call := &VMHookCall{Name: "managedGetMultiESDTCallValue", Args: []int64{int64(multiCallValueHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedGetMultiESDTCallValue(multiCallValueHandle)
+		return 0
+	})
+}
+
+// ManagedGetESDTBalance VM hook interceptor
+func (w *InterceptorVMHooks) ManagedGetESDTBalance(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32) {
+	call := &VMHookCall{Name: "managedGetESDTBalance", Args: []int64{int64(addressHandle), int64(tokenIDHandle), int64(nonce), int64(valueHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedGetESDTBalance(addressHandle, tokenIDHandle, nonce, valueHandle)
+		return 0
+	})
+}
+
+// ManagedGetESDTTokenData VM hook interceptor
+func (w *InterceptorVMHooks) ManagedGetESDTTokenData(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32, propertiesHandle int32, hashHandle int32, nameHandle int32, attributesHandle int32, creatorHandle int32, royaltiesHandle int32, urisHandle int32) {
+	call := &VMHookCall{Name: "managedGetESDTTokenData", Args: []int64{int64(addressHandle), int64(tokenIDHandle), int64(nonce), int64(valueHandle), int64(propertiesHandle), int64(hashHandle), int64(nameHandle), int64(attributesHandle), int64(creatorHandle), int64(royaltiesHandle), int64(urisHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedGetESDTTokenData(addressHandle, tokenIDHandle, nonce, valueHandle, propertiesHandle, hashHandle, nameHandle, attributesHandle, creatorHandle, royaltiesHandle, urisHandle)
+		return 0
+	})
+}
+
+// ManagedAsyncCall VM hook interceptor
+func (w *InterceptorVMHooks) ManagedAsyncCall(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32) {
+	call := &VMHookCall{Name: "managedAsyncCall", Args: []int64{int64(destHandle), int64(valueHandle), int64(functionHandle), int64(argumentsHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle)
+		return 0
+	})
+}
+
+// ManagedCreateAsyncCall VM hook interceptor
+func (w *InterceptorVMHooks) ManagedCreateAsyncCall(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset executor.MemPtr, successLength executor.MemLength, errorOffset executor.MemPtr, errorLength executor.MemLength, gas int64, extraGasForCallback int64, callbackClosureHandle int32) int32 {
+	call := &VMHookCall{Name: "managedCreateAsyncCall", Args: []int64{int64(destHandle), int64(valueHandle), int64(functionHandle), int64(argumentsHandle), int64(successOffset), int64(successLength), int64(errorOffset), int64(errorLength), int64(gas), int64(extraGasForCallback), int64(callbackClosureHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedCreateAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback, callbackClosureHandle))
+	})
+	return int32(result)
+}
+
+// ManagedCreateAsyncCallWithDeadline VM hook interceptor
+func (w *InterceptorVMHooks) ManagedCreateAsyncCallWithDeadline(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset executor.MemPtr, successLength executor.MemLength, errorOffset executor.MemPtr, errorLength executor.MemLength, gas int64, extraGasForCallback int64, callbackClosureHandle int32, groupHandle int32, deadlineRound int64) int32 {
+	call := &VMHookCall{Name: "managedCreateAsyncCallWithDeadline", Args: []int64{int64(destHandle), int64(valueHandle), int64(functionHandle), int64(argumentsHandle), int64(successOffset), int64(successLength), int64(errorOffset), int64(errorLength), int64(gas), int64(extraGasForCallback), int64(callbackClosureHandle), int64(groupHandle), int64(deadlineRound)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedCreateAsyncCallWithDeadline(destHandle, valueHandle, functionHandle, argumentsHandle, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback, callbackClosureHandle, groupHandle, deadlineRound))
+	})
+	return int32(result)
+}
+
+// ManagedCancelAsyncCallGroup VM hook interceptor
+func (w *InterceptorVMHooks) ManagedCancelAsyncCallGroup(groupHandle int32) int32 {
+	call := &VMHookCall{Name: "managedCancelAsyncCallGroup", Args: []int64{int64(groupHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedCancelAsyncCallGroup(groupHandle))
+	})
+	return int32(result)
+}
+
+// ManagedIsCallbackTimedOut VM hook interceptor
+func (w *InterceptorVMHooks) ManagedIsCallbackTimedOut() int32 {
+	call := &VMHookCall{Name: "managedIsCallbackTimedOut", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedIsCallbackTimedOut())
+	})
+	return int32(result)
+}
+
+// ManagedGetCallbackClosure VM hook interceptor
+func (w *InterceptorVMHooks) ManagedGetCallbackClosure(callbackClosureHandle int32) {
+	call := &VMHookCall{Name: "managedGetCallbackClosure", Args: []int64{int64(callbackClosureHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedGetCallbackClosure(callbackClosureHandle)
+		return 0
+	})
+}
+
+// ManagedUpgradeFromSourceContract VM hook interceptor
+func (w *InterceptorVMHooks) ManagedUpgradeFromSourceContract(destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32) {
+	call := &VMHookCall{Name: "managedUpgradeFromSourceContract", Args: []int64{int64(destHandle), int64(gas), int64(valueHandle), int64(addressHandle), int64(codeMetadataHandle), int64(argumentsHandle), int64(resultHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedUpgradeFromSourceContract(destHandle, gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultHandle)
+		return 0
+	})
+}
+
+// ManagedUpgradeContract VM hook interceptor
+func (w *InterceptorVMHooks) ManagedUpgradeContract(destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32) {
+	call := &VMHookCall{Name: "managedUpgradeContract", Args: []int64{int64(destHandle), int64(gas), int64(valueHandle), int64(codeHandle), int64(codeMetadataHandle), int64(argumentsHandle), int64(resultHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedUpgradeContract(destHandle, gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultHandle)
+		return 0
+	})
+}
+
+// ManagedDeleteContract VM hook interceptor
+func (w *InterceptorVMHooks) ManagedDeleteContract(destHandle int32, gasLimit int64, argumentsHandle int32) {
+	call := &VMHookCall{Name: "managedDeleteContract", Args: []int64{int64(destHandle), int64(gasLimit), int64(argumentsHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedDeleteContract(destHandle, gasLimit, argumentsHandle)
+		return 0
+	})
+}
+
+// ManagedDeployFromSourceContract VM hook interceptor
+func (w *InterceptorVMHooks) ManagedDeployFromSourceContract(gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedDeployFromSourceContract", Args: []int64{int64(gas), int64(valueHandle), int64(addressHandle), int64(codeMetadataHandle), int64(argumentsHandle), int64(resultAddressHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedDeployFromSourceContract(gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// ManagedCreateContract VM hook interceptor
+func (w *InterceptorVMHooks) ManagedCreateContract(gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedCreateContract", Args: []int64{int64(gas), int64(valueHandle), int64(codeHandle), int64(codeMetadataHandle), int64(argumentsHandle), int64(resultAddressHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedCreateContract(gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// ManagedExecuteReadOnly VM hook interceptor
+func (w *InterceptorVMHooks) ManagedExecuteReadOnly(gas int64, addressHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedExecuteReadOnly", Args: []int64{int64(gas), int64(addressHandle), int64(functionHandle), int64(argumentsHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedExecuteReadOnly(gas, addressHandle, functionHandle, argumentsHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// ManagedExecuteOnSameContext VM hook interceptor
+func (w *InterceptorVMHooks) ManagedExecuteOnSameContext(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedExecuteOnSameContext", Args: []int64{int64(gas), int64(addressHandle), int64(valueHandle), int64(functionHandle), int64(argumentsHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedExecuteOnSameContext(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// ManagedExecuteOnDestContext VM hook interceptor
+func (w *InterceptorVMHooks) ManagedExecuteOnDestContext(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedExecuteOnDestContext", Args: []int64{int64(gas), int64(addressHandle), int64(valueHandle), int64(functionHandle), int64(argumentsHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedExecuteOnDestContext(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// ManagedMultiTransferESDTNFTExecute VM hook interceptor
+func (w *InterceptorVMHooks) ManagedMultiTransferESDTNFTExecute(dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32 {
+	call := &VMHookCall{Name: "managedMultiTransferESDTNFTExecute", Args: []int64{int64(dstHandle), int64(tokenTransfersHandle), int64(gasLimit), int64(functionHandle), int64(argumentsHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedMultiTransferESDTNFTExecute(dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle))
+	})
+	return int32(result)
+}
+
+// ManagedTransferValueExecute VM hook interceptor
+func (w *InterceptorVMHooks) ManagedTransferValueExecute(dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32 {
+	call := &VMHookCall{Name: "managedTransferValueExecute", Args: []int64{int64(dstHandle), int64(valueHandle), int64(gasLimit), int64(functionHandle), int64(argumentsHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedTransferValueExecute(dstHandle, valueHandle, gasLimit, functionHandle, argumentsHandle))
+	})
+	return int32(result)
+}
+
+// ManagedIsESDTFrozen VM hook interceptor
+func (w *InterceptorVMHooks) ManagedIsESDTFrozen(addressHandle int32, tokenIDHandle int32, nonce int64) int32 {
+	call := &VMHookCall{Name: "managedIsESDTFrozen", Args: []int64{int64(addressHandle), int64(tokenIDHandle), int64(nonce)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedIsESDTFrozen(addressHandle, tokenIDHandle, nonce))
+	})
+	return int32(result)
+}
+
+// ManagedIsESDTLimitedTransfer VM hook interceptor
+func (w *InterceptorVMHooks) ManagedIsESDTLimitedTransfer(tokenIDHandle int32) int32 {
+	call := &VMHookCall{Name: "managedIsESDTLimitedTransfer", Args: []int64{int64(tokenIDHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedIsESDTLimitedTransfer(tokenIDHandle))
+	})
+	return int32(result)
+}
+
+// ManagedIsESDTPaused VM hook interceptor
+func (w *InterceptorVMHooks) ManagedIsESDTPaused(tokenIDHandle int32) int32 {
+	call := &VMHookCall{Name: "managedIsESDTPaused", Args: []int64{int64(tokenIDHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedIsESDTPaused(tokenIDHandle))
+	})
+	return int32(result)
+}
+
+// ManagedBufferToHex VM hook interceptor
+func (w *InterceptorVMHooks) ManagedBufferToHex(sourceHandle int32, destHandle int32) {
+	call := &VMHookCall{Name: "managedBufferToHex", Args: []int64{int64(sourceHandle), int64(destHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.ManagedBufferToHex(sourceHandle, destHandle)
+		return 0
+	})
+}
+
+// BigFloatNewFromParts VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatNewFromParts(integralPart int32, fractionalPart int32, exponent int32) int32 {
+	call := &VMHookCall{Name: "bigFloatNewFromParts", Args: []int64{int64(integralPart), int64(fractionalPart), int64(exponent)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigFloatNewFromParts(integralPart, fractionalPart, exponent))
+	})
+	return int32(result)
+}
+
+// BigFloatNewFromFrac VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatNewFromFrac(numerator int64, denominator int64) int32 {
+	call := &VMHookCall{Name: "bigFloatNewFromFrac", Args: []int64{int64(numerator), int64(denominator)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigFloatNewFromFrac(numerator, denominator))
+	})
+	return int32(result)
+}
+
+// BigFloatNewFromSci VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatNewFromSci(significand int64, exponent int64) int32 {
+	call := &VMHookCall{Name: "bigFloatNewFromSci", Args: []int64{int64(significand), int64(exponent)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigFloatNewFromSci(significand, exponent))
+	})
+	return int32(result)
+}
+
+// BigFloatAdd VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatAdd(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigFloatAdd", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatAdd(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigFloatSub VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatSub(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigFloatSub", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatSub(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigFloatMul VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatMul(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigFloatMul", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatMul(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigFloatDiv VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatDiv(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigFloatDiv", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatDiv(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigFloatNeg VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatNeg(destinationHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigFloatNeg", Args: []int64{int64(destinationHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatNeg(destinationHandle, opHandle)
+		return 0
+	})
+}
+
+// BigFloatClone VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatClone(destinationHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigFloatClone", Args: []int64{int64(destinationHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatClone(destinationHandle, opHandle)
+		return 0
+	})
+}
+
+// BigFloatCmp VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatCmp(op1Handle int32, op2Handle int32) int32 {
+	call := &VMHookCall{Name: "bigFloatCmp", Args: []int64{int64(op1Handle), int64(op2Handle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigFloatCmp(op1Handle, op2Handle))
+	})
+	return int32(result)
+}
+
+// BigFloatAbs VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatAbs(destinationHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigFloatAbs", Args: []int64{int64(destinationHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatAbs(destinationHandle, opHandle)
+		return 0
+	})
+}
+
+// BigFloatSign VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatSign(opHandle int32) int32 {
+	call := &VMHookCall{Name: "bigFloatSign", Args: []int64{int64(opHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigFloatSign(opHandle))
+	})
+	return int32(result)
+}
+
+// BigFloatSqrt VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatSqrt(destinationHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigFloatSqrt", Args: []int64{int64(destinationHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatSqrt(destinationHandle, opHandle)
+		return 0
+	})
+}
+
+// BigFloatPow VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatPow(destinationHandle int32, opHandle int32, exponent int32) {
+	call := &VMHookCall{Name: "bigFloatPow", Args: []int64{int64(destinationHandle), int64(opHandle), int64(exponent)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatPow(destinationHandle, opHandle, exponent)
+		return 0
+	})
+}
+
+// BigFloatFloor VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatFloor(destBigIntHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigFloatFloor", Args: []int64{int64(destBigIntHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatFloor(destBigIntHandle, opHandle)
+		return 0
+	})
+}
+
+// BigFloatCeil VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatCeil(destBigIntHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigFloatCeil", Args: []int64{int64(destBigIntHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatCeil(destBigIntHandle, opHandle)
+		return 0
+	})
+}
+
+// BigFloatTruncate VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatTruncate(destBigIntHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigFloatTruncate", Args: []int64{int64(destBigIntHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatTruncate(destBigIntHandle, opHandle)
+		return 0
+	})
+}
+
+// BigFloatSetInt64 VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatSetInt64(destinationHandle int32, value int64) {
+	call := &VMHookCall{Name: "bigFloatSetInt64", Args: []int64{int64(destinationHandle), int64(value)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatSetInt64(destinationHandle, value)
+		return 0
+	})
+}
+
+// BigFloatIsInt VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatIsInt(opHandle int32) int32 {
+	call := &VMHookCall{Name: "bigFloatIsInt", Args: []int64{int64(opHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigFloatIsInt(opHandle))
+	})
+	return int32(result)
+}
+
+// BigFloatSetBigInt VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatSetBigInt(destinationHandle int32, bigIntHandle int32) {
+	call := &VMHookCall{Name: "bigFloatSetBigInt", Args: []int64{int64(destinationHandle), int64(bigIntHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatSetBigInt(destinationHandle, bigIntHandle)
+		return 0
+	})
+}
+
+// BigFloatGetConstPi VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatGetConstPi(destinationHandle int32) {
+	call := &VMHookCall{Name: "bigFloatGetConstPi", Args: []int64{int64(destinationHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatGetConstPi(destinationHandle)
+		return 0
+	})
+}
+
+// BigFloatGetConstE VM hook interceptor
+func (w *InterceptorVMHooks) BigFloatGetConstE(destinationHandle int32) {
+	call := &VMHookCall{Name: "bigFloatGetConstE", Args: []int64{int64(destinationHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigFloatGetConstE(destinationHandle)
+		return 0
+	})
+}
+
+// BigIntGetUnsignedArgument VM hook interceptor
+func (w *InterceptorVMHooks) BigIntGetUnsignedArgument(id int32, destinationHandle int32) {
+	call := &VMHookCall{Name: "bigIntGetUnsignedArgument", Args: []int64{int64(id), int64(destinationHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntGetUnsignedArgument(id, destinationHandle)
+		return 0
+	})
+}
+
+// BigIntGetSignedArgument VM hook interceptor
+func (w *InterceptorVMHooks) BigIntGetSignedArgument(id int32, destinationHandle int32) {
+	call := &VMHookCall{Name: "bigIntGetSignedArgument", Args: []int64{int64(id), int64(destinationHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntGetSignedArgument(id, destinationHandle)
+		return 0
+	})
+}
+
+// BigIntStorageStoreUnsigned VM hook interceptor
+func (w *InterceptorVMHooks) BigIntStorageStoreUnsigned(keyOffset executor.MemPtr, keyLength executor.MemLength, sourceHandle int32) int32 {
+	call := &VMHookCall{Name: "bigIntStorageStoreUnsigned", Args: []int64{int64(keyOffset), int64(keyLength), int64(sourceHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntStorageStoreUnsigned(keyOffset, keyLength, sourceHandle))
+	})
+	return int32(result)
+}
+
+// BigIntStorageLoadUnsigned VM hook interceptor
+func (w *InterceptorVMHooks) BigIntStorageLoadUnsigned(keyOffset executor.MemPtr, keyLength executor.MemLength, destinationHandle int32) int32 {
+	call := &VMHookCall{Name: "bigIntStorageLoadUnsigned", Args: []int64{int64(keyOffset), int64(keyLength), int64(destinationHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntStorageLoadUnsigned(keyOffset, keyLength, destinationHandle))
+	})
+	return int32(result)
+}
+
+// BigIntGetCallValue VM hook interceptor
+func (w *InterceptorVMHooks) BigIntGetCallValue(destinationHandle int32) {
+	call := &VMHookCall{Name: "bigIntGetCallValue", Args: []int64{int64(destinationHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntGetCallValue(destinationHandle)
+		return 0
+	})
+}
+
+// BigIntGetESDTCallValue VM hook interceptor
+func (w *InterceptorVMHooks) BigIntGetESDTCallValue(destination int32) {
+	call := &VMHookCall{Name: "bigIntGetESDTCallValue", Args: []int64{int64(destination)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntGetESDTCallValue(destination)
+		return 0
+	})
+}
+
+// BigIntGetESDTCallValueByIndex VM hook interceptor
+func (w *InterceptorVMHooks) BigIntGetESDTCallValueByIndex(destinationHandle int32, index int32) {
+	call := &VMHookCall{Name: "bigIntGetESDTCallValueByIndex", Args: []int64{int64(destinationHandle), int64(index)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntGetESDTCallValueByIndex(destinationHandle, index)
+		return 0
+	})
+}
+
+// BigIntGetExternalBalance VM hook interceptor
+func (w *InterceptorVMHooks) BigIntGetExternalBalance(addressOffset executor.MemPtr, result int32) {
+	call := &VMHookCall{Name: "bigIntGetExternalBalance", Args: []int64{int64(addressOffset), int64(result)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntGetExternalBalance(addressOffset, result)
+		return 0
+	})
+}
+
+// BigIntGetESDTExternalBalance VM hook interceptor
+func (w *InterceptorVMHooks) BigIntGetESDTExternalBalance(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64, resultHandle int32) {
+	call := &VMHookCall{Name: "bigIntGetESDTExternalBalance", Args: []int64{int64(addressOffset), int64(tokenIDOffset), int64(tokenIDLen), int64(nonce), int64(resultHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntGetESDTExternalBalance(addressOffset, tokenIDOffset, tokenIDLen, nonce, resultHandle)
+		return 0
+	})
+}
+
+// BigIntNew VM hook interceptor
+func (w *InterceptorVMHooks) BigIntNew(smallValue int64) int32 {
+	call := &VMHookCall{Name: "bigIntNew", Args: []int64{int64(smallValue)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntNew(smallValue))
+	})
+	return int32(result)
+}
+
+// BigIntUnsignedByteLength VM hook interceptor
+func (w *InterceptorVMHooks) BigIntUnsignedByteLength(referenceHandle int32) int32 {
+	call := &VMHookCall{Name: "bigIntUnsignedByteLength", Args: []int64{int64(referenceHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntUnsignedByteLength(referenceHandle))
+	})
+	return int32(result)
+}
+
+// BigIntSignedByteLength VM hook interceptor
+func (w *InterceptorVMHooks) BigIntSignedByteLength(referenceHandle int32) int32 {
+	call := &VMHookCall{Name: "bigIntSignedByteLength", Args: []int64{int64(referenceHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntSignedByteLength(referenceHandle))
+	})
+	return int32(result)
+}
+
+// BigIntGetUnsignedBytes VM hook interceptor
+func (w *InterceptorVMHooks) BigIntGetUnsignedBytes(referenceHandle int32, byteOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "bigIntGetUnsignedBytes", Args: []int64{int64(referenceHandle), int64(byteOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntGetUnsignedBytes(referenceHandle, byteOffset))
+	})
+	return int32(result)
+}
+
+// BigIntGetSignedBytes VM hook interceptor
+func (w *InterceptorVMHooks) BigIntGetSignedBytes(referenceHandle int32, byteOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "bigIntGetSignedBytes", Args: []int64{int64(referenceHandle), int64(byteOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntGetSignedBytes(referenceHandle, byteOffset))
+	})
+	return int32(result)
+}
+
+// BigIntSetUnsignedBytes VM hook interceptor
+func (w *InterceptorVMHooks) BigIntSetUnsignedBytes(destinationHandle int32, byteOffset executor.MemPtr, byteLength executor.MemLength) {
+	call := &VMHookCall{Name: "bigIntSetUnsignedBytes", Args: []int64{int64(destinationHandle), int64(byteOffset), int64(byteLength)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntSetUnsignedBytes(destinationHandle, byteOffset, byteLength)
+		return 0
+	})
+}
+
+// BigIntSetSignedBytes VM hook interceptor
+func (w *InterceptorVMHooks) BigIntSetSignedBytes(destinationHandle int32, byteOffset executor.MemPtr, byteLength executor.MemLength) {
+	call := &VMHookCall{Name: "bigIntSetSignedBytes", Args: []int64{int64(destinationHandle), int64(byteOffset), int64(byteLength)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntSetSignedBytes(destinationHandle, byteOffset, byteLength)
+		return 0
+	})
+}
+
+// BigIntIsInt64 VM hook interceptor
+func (w *InterceptorVMHooks) BigIntIsInt64(destinationHandle int32) int32 {
+	call := &VMHookCall{Name: "bigIntIsInt64", Args: []int64{int64(destinationHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntIsInt64(destinationHandle))
+	})
+	return int32(result)
+}
+
+// BigIntGetInt64 VM hook interceptor
+func (w *InterceptorVMHooks) BigIntGetInt64(destinationHandle int32) int64 {
+	call := &VMHookCall{Name: "bigIntGetInt64", Args: []int64{int64(destinationHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntGetInt64(destinationHandle))
+	})
+	return int64(result)
+}
+
+// BigIntSetInt64 VM hook interceptor
+func (w *InterceptorVMHooks) BigIntSetInt64(destinationHandle int32, value int64) {
+	call := &VMHookCall{Name: "bigIntSetInt64", Args: []int64{int64(destinationHandle), int64(value)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntSetInt64(destinationHandle, value)
+		return 0
+	})
+}
+
+// BigIntAdd VM hook interceptor
+func (w *InterceptorVMHooks) BigIntAdd(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntAdd", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntAdd(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntSub VM hook interceptor
+func (w *InterceptorVMHooks) BigIntSub(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntSub", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntSub(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntMul VM hook interceptor
+func (w *InterceptorVMHooks) BigIntMul(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntMul", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntMul(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntTDiv VM hook interceptor
+func (w *InterceptorVMHooks) BigIntTDiv(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntTDiv", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntTDiv(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntTMod VM hook interceptor
+func (w *InterceptorVMHooks) BigIntTMod(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntTMod", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntTMod(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntEDiv VM hook interceptor
+func (w *InterceptorVMHooks) BigIntEDiv(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntEDiv", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntEDiv(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntEMod VM hook interceptor
+func (w *InterceptorVMHooks) BigIntEMod(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntEMod", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntEMod(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntSqrt VM hook interceptor
+func (w *InterceptorVMHooks) BigIntSqrt(destinationHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigIntSqrt", Args: []int64{int64(destinationHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntSqrt(destinationHandle, opHandle)
+		return 0
+	})
+}
+
+// BigIntPow VM hook interceptor
+func (w *InterceptorVMHooks) BigIntPow(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntPow", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntPow(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntLog2 VM hook interceptor
+func (w *InterceptorVMHooks) BigIntLog2(op1Handle int32) int32 {
+	call := &VMHookCall{Name: "bigIntLog2", Args: []int64{int64(op1Handle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntLog2(op1Handle))
+	})
+	return int32(result)
+}
+
+// BigIntAbs VM hook interceptor
+func (w *InterceptorVMHooks) BigIntAbs(destinationHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigIntAbs", Args: []int64{int64(destinationHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntAbs(destinationHandle, opHandle)
+		return 0
+	})
+}
+
+// BigIntNeg VM hook interceptor
+func (w *InterceptorVMHooks) BigIntNeg(destinationHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigIntNeg", Args: []int64{int64(destinationHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntNeg(destinationHandle, opHandle)
+		return 0
+	})
+}
+
+// BigIntSign VM hook interceptor
+func (w *InterceptorVMHooks) BigIntSign(opHandle int32) int32 {
+	call := &VMHookCall{Name: "bigIntSign", Args: []int64{int64(opHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntSign(opHandle))
+	})
+	return int32(result)
+}
+
+// BigIntCmp VM hook interceptor
+func (w *InterceptorVMHooks) BigIntCmp(op1Handle int32, op2Handle int32) int32 {
+	call := &VMHookCall{Name: "bigIntCmp", Args: []int64{int64(op1Handle), int64(op2Handle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.BigIntCmp(op1Handle, op2Handle))
+	})
+	return int32(result)
+}
+
+// BigIntNot VM hook interceptor
+func (w *InterceptorVMHooks) BigIntNot(destinationHandle int32, opHandle int32) {
+	call := &VMHookCall{Name: "bigIntNot", Args: []int64{int64(destinationHandle), int64(opHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntNot(destinationHandle, opHandle)
+		return 0
+	})
+}
+
+// BigIntAnd VM hook interceptor
+func (w *InterceptorVMHooks) BigIntAnd(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntAnd", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntAnd(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntOr VM hook interceptor
+func (w *InterceptorVMHooks) BigIntOr(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntOr", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntOr(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntXor VM hook interceptor
+func (w *InterceptorVMHooks) BigIntXor(destinationHandle int32, op1Handle int32, op2Handle int32) {
+	call := &VMHookCall{Name: "bigIntXor", Args: []int64{int64(destinationHandle), int64(op1Handle), int64(op2Handle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntXor(destinationHandle, op1Handle, op2Handle)
+		return 0
+	})
+}
+
+// BigIntShr VM hook interceptor
+func (w *InterceptorVMHooks) BigIntShr(destinationHandle int32, opHandle int32, bits int32) {
+	call := &VMHookCall{Name: "bigIntShr", Args: []int64{int64(destinationHandle), int64(opHandle), int64(bits)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntShr(destinationHandle, opHandle, bits)
+		return 0
+	})
+}
+
+// BigIntShl VM hook interceptor
+func (w *InterceptorVMHooks) BigIntShl(destinationHandle int32, opHandle int32, bits int32) {
+	call := &VMHookCall{Name: "bigIntShl", Args: []int64{int64(destinationHandle), int64(opHandle), int64(bits)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntShl(destinationHandle, opHandle, bits)
+		return 0
+	})
+}
+
+// BigIntFinishUnsigned VM hook interceptor
+func (w *InterceptorVMHooks) BigIntFinishUnsigned(referenceHandle int32) {
+	call := &VMHookCall{Name: "bigIntFinishUnsigned", Args: []int64{int64(referenceHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntFinishUnsigned(referenceHandle)
+		return 0
+	})
+}
+
+// BigIntFinishSigned VM hook interceptor
+func (w *InterceptorVMHooks) BigIntFinishSigned(referenceHandle int32) {
+	call := &VMHookCall{Name: "bigIntFinishSigned", Args: []int64{int64(referenceHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntFinishSigned(referenceHandle)
+		return 0
+	})
+}
+
+// BigIntToString VM hook interceptor
+func (w *InterceptorVMHooks) BigIntToString(bigIntHandle int32, destinationHandle int32) {
+	call := &VMHookCall{Name: "bigIntToString", Args: []int64{int64(bigIntHandle), int64(destinationHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.BigIntToString(bigIntHandle, destinationHandle)
+		return 0
+	})
+}
+
+// MBufferNew VM hook interceptor
+func (w *InterceptorVMHooks) MBufferNew() int32 {
+	call := &VMHookCall{Name: "mBufferNew", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferNew())
+	})
+	return int32(result)
+}
+
+// MBufferNewFromBytes VM hook interceptor
+func (w *InterceptorVMHooks) MBufferNewFromBytes(dataOffset executor.MemPtr, dataLength executor.MemLength) int32 {
+	call := &VMHookCall{Name: "mBufferNewFromBytes", Args: []int64{int64(dataOffset), int64(dataLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferNewFromBytes(dataOffset, dataLength))
+	})
+	return int32(result)
+}
+
+// MBufferGetLength VM hook interceptor
+func (w *InterceptorVMHooks) MBufferGetLength(mBufferHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferGetLength", Args: []int64{int64(mBufferHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferGetLength(mBufferHandle))
+	})
+	return int32(result)
+}
+
+// MBufferGetBytes VM hook interceptor
+func (w *InterceptorVMHooks) MBufferGetBytes(mBufferHandle int32, resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "mBufferGetBytes", Args: []int64{int64(mBufferHandle), int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferGetBytes(mBufferHandle, resultOffset))
+	})
+	return int32(result)
+}
+
+// MBufferGetByteSlice VM hook interceptor
+func (w *InterceptorVMHooks) MBufferGetByteSlice(sourceHandle int32, startingPosition int32, sliceLength int32, resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "mBufferGetByteSlice", Args: []int64{int64(sourceHandle), int64(startingPosition), int64(sliceLength), int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferGetByteSlice(sourceHandle, startingPosition, sliceLength, resultOffset))
+	})
+	return int32(result)
+}
+
+// MBufferCopyByteSlice VM hook interceptor
+func (w *InterceptorVMHooks) MBufferCopyByteSlice(sourceHandle int32, startingPosition int32, sliceLength int32, destinationHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferCopyByteSlice", Args: []int64{int64(sourceHandle), int64(startingPosition), int64(sliceLength), int64(destinationHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferCopyByteSlice(sourceHandle, startingPosition, sliceLength, destinationHandle))
+	})
+	return int32(result)
+}
+
+// MBufferEq VM hook interceptor
+func (w *InterceptorVMHooks) MBufferEq(mBufferHandle1 int32, mBufferHandle2 int32) int32 {
+	call := &VMHookCall{Name: "mBufferEq", Args: []int64{int64(mBufferHandle1), int64(mBufferHandle2)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferEq(mBufferHandle1, mBufferHandle2))
+	})
+	return int32(result)
+}
+
+// MBufferSetBytes VM hook interceptor
+func (w *InterceptorVMHooks) MBufferSetBytes(mBufferHandle int32, dataOffset executor.MemPtr, dataLength executor.MemLength) int32 {
+	call := &VMHookCall{Name: "mBufferSetBytes", Args: []int64{int64(mBufferHandle), int64(dataOffset), int64(dataLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferSetBytes(mBufferHandle, dataOffset, dataLength))
+	})
+	return int32(result)
+}
+
+// MBufferSetByteSlice VM hook interceptor
+func (w *InterceptorVMHooks) MBufferSetByteSlice(mBufferHandle int32, startingPosition int32, dataLength executor.MemLength, dataOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "mBufferSetByteSlice", Args: []int64{int64(mBufferHandle), int64(startingPosition), int64(dataLength), int64(dataOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferSetByteSlice(mBufferHandle, startingPosition, dataLength, dataOffset))
+	})
+	return int32(result)
+}
+
+// MBufferAppend VM hook interceptor
+func (w *InterceptorVMHooks) MBufferAppend(accumulatorHandle int32, dataHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferAppend", Args: []int64{int64(accumulatorHandle), int64(dataHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferAppend(accumulatorHandle, dataHandle))
+	})
+	return int32(result)
+}
+
+// MBufferAppendBytes VM hook interceptor
+func (w *InterceptorVMHooks) MBufferAppendBytes(accumulatorHandle int32, dataOffset executor.MemPtr, dataLength executor.MemLength) int32 {
+	call := &VMHookCall{Name: "mBufferAppendBytes", Args: []int64{int64(accumulatorHandle), int64(dataOffset), int64(dataLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferAppendBytes(accumulatorHandle, dataOffset, dataLength))
+	})
+	return int32(result)
+}
+
+// MBufferToBigIntUnsigned VM hook interceptor
+func (w *InterceptorVMHooks) MBufferToBigIntUnsigned(mBufferHandle int32, bigIntHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferToBigIntUnsigned", Args: []int64{int64(mBufferHandle), int64(bigIntHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferToBigIntUnsigned(mBufferHandle, bigIntHandle))
+	})
+	return int32(result)
+}
+
+// MBufferToBigIntSigned VM hook interceptor
+func (w *InterceptorVMHooks) MBufferToBigIntSigned(mBufferHandle int32, bigIntHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferToBigIntSigned", Args: []int64{int64(mBufferHandle), int64(bigIntHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferToBigIntSigned(mBufferHandle, bigIntHandle))
+	})
+	return int32(result)
+}
+
+// MBufferFromBigIntUnsigned VM hook interceptor
+func (w *InterceptorVMHooks) MBufferFromBigIntUnsigned(mBufferHandle int32, bigIntHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferFromBigIntUnsigned", Args: []int64{int64(mBufferHandle), int64(bigIntHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferFromBigIntUnsigned(mBufferHandle, bigIntHandle))
+	})
+	return int32(result)
+}
+
+// MBufferFromBigIntSigned VM hook interceptor
+func (w *InterceptorVMHooks) MBufferFromBigIntSigned(mBufferHandle int32, bigIntHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferFromBigIntSigned", Args: []int64{int64(mBufferHandle), int64(bigIntHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferFromBigIntSigned(mBufferHandle, bigIntHandle))
+	})
+	return int32(result)
+}
+
+// MBufferToBigFloat VM hook interceptor
+func (w *InterceptorVMHooks) MBufferToBigFloat(mBufferHandle int32, bigFloatHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferToBigFloat", Args: []int64{int64(mBufferHandle), int64(bigFloatHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferToBigFloat(mBufferHandle, bigFloatHandle))
+	})
+	return int32(result)
+}
+
+// MBufferFromBigFloat VM hook interceptor
+func (w *InterceptorVMHooks) MBufferFromBigFloat(mBufferHandle int32, bigFloatHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferFromBigFloat", Args: []int64{int64(mBufferHandle), int64(bigFloatHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferFromBigFloat(mBufferHandle, bigFloatHandle))
+	})
+	return int32(result)
+}
+
+// MBufferStorageStore VM hook interceptor
+func (w *InterceptorVMHooks) MBufferStorageStore(keyHandle int32, sourceHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferStorageStore", Args: []int64{int64(keyHandle), int64(sourceHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferStorageStore(keyHandle, sourceHandle))
+	})
+	return int32(result)
+}
+
+// MBufferStorageLoad VM hook interceptor
+func (w *InterceptorVMHooks) MBufferStorageLoad(keyHandle int32, destinationHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferStorageLoad", Args: []int64{int64(keyHandle), int64(destinationHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferStorageLoad(keyHandle, destinationHandle))
+	})
+	return int32(result)
+}
+
+// MBufferStorageLoadFromAddress VM hook interceptor
+func (w *InterceptorVMHooks) MBufferStorageLoadFromAddress(addressHandle int32, keyHandle int32, destinationHandle int32) {
+	call := &VMHookCall{Name: "mBufferStorageLoadFromAddress", Args: []int64{int64(addressHandle), int64(keyHandle), int64(destinationHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.MBufferStorageLoadFromAddress(addressHandle, keyHandle, destinationHandle)
+		return 0
+	})
+}
+
+// MBufferGetArgument VM hook interceptor
+func (w *InterceptorVMHooks) MBufferGetArgument(id int32, destinationHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferGetArgument", Args: []int64{int64(id), int64(destinationHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferGetArgument(id, destinationHandle))
+	})
+	return int32(result)
+}
+
+// MBufferFinish VM hook interceptor
+func (w *InterceptorVMHooks) MBufferFinish(sourceHandle int32) int32 {
+	call := &VMHookCall{Name: "mBufferFinish", Args: []int64{int64(sourceHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferFinish(sourceHandle))
+	})
+	return int32(result)
+}
+
+// MBufferSetRandom VM hook interceptor
+func (w *InterceptorVMHooks) MBufferSetRandom(destinationHandle int32, length int32) int32 {
+	call := &VMHookCall{Name: "mBufferSetRandom", Args: []int64{int64(destinationHandle), int64(length)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MBufferSetRandom(destinationHandle, length))
+	})
+	return int32(result)
+}
+
+// ManagedMapNew VM hook interceptor
+func (w *InterceptorVMHooks) ManagedMapNew() int32 {
+	call := &VMHookCall{Name: "managedMapNew", Args: []int64{}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedMapNew())
+	})
+	return int32(result)
+}
+
+// ManagedMapPut VM hook interceptor
+func (w *InterceptorVMHooks) ManagedMapPut(mMapHandle int32, keyHandle int32, valueHandle int32) int32 {
+	call := &VMHookCall{Name: "managedMapPut", Args: []int64{int64(mMapHandle), int64(keyHandle), int64(valueHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedMapPut(mMapHandle, keyHandle, valueHandle))
+	})
+	return int32(result)
+}
+
+// ManagedMapGet VM hook interceptor
+func (w *InterceptorVMHooks) ManagedMapGet(mMapHandle int32, keyHandle int32, outValueHandle int32) int32 {
+	call := &VMHookCall{Name: "managedMapGet", Args: []int64{int64(mMapHandle), int64(keyHandle), int64(outValueHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedMapGet(mMapHandle, keyHandle, outValueHandle))
+	})
+	return int32(result)
+}
+
+// ManagedMapRemove VM hook interceptor
+func (w *InterceptorVMHooks) ManagedMapRemove(mMapHandle int32, keyHandle int32, outValueHandle int32) int32 {
+	call := &VMHookCall{Name: "managedMapRemove", Args: []int64{int64(mMapHandle), int64(keyHandle), int64(outValueHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedMapRemove(mMapHandle, keyHandle, outValueHandle))
+	})
+	return int32(result)
+}
+
+// ManagedMapContains VM hook interceptor
+func (w *InterceptorVMHooks) ManagedMapContains(mMapHandle int32, keyHandle int32) int32 {
+	call := &VMHookCall{Name: "managedMapContains", Args: []int64{int64(mMapHandle), int64(keyHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedMapContains(mMapHandle, keyHandle))
+	})
+	return int32(result)
+}
+
+// SmallIntGetUnsignedArgument VM hook interceptor
+func (w *InterceptorVMHooks) SmallIntGetUnsignedArgument(id int32) int64 {
+	call := &VMHookCall{Name: "smallIntGetUnsignedArgument", Args: []int64{int64(id)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.SmallIntGetUnsignedArgument(id))
+	})
+	return int64(result)
+}
+
+// SmallIntGetSignedArgument VM hook interceptor
+func (w *InterceptorVMHooks) SmallIntGetSignedArgument(id int32) int64 {
+	call := &VMHookCall{Name: "smallIntGetSignedArgument", Args: []int64{int64(id)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.SmallIntGetSignedArgument(id))
+	})
+	return int64(result)
+}
+
+// SmallIntFinishUnsigned VM hook interceptor
+func (w *InterceptorVMHooks) SmallIntFinishUnsigned(value int64) {
+	call := &VMHookCall{Name: "smallIntFinishUnsigned", Args: []int64{int64(value)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.SmallIntFinishUnsigned(value)
+		return 0
+	})
+}
+
+// SmallIntFinishSigned VM hook interceptor
+func (w *InterceptorVMHooks) SmallIntFinishSigned(value int64) {
+	call := &VMHookCall{Name: "smallIntFinishSigned", Args: []int64{int64(value)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.SmallIntFinishSigned(value)
+		return 0
+	})
+}
+
+// SmallIntStorageStoreUnsigned VM hook interceptor
+func (w *InterceptorVMHooks) SmallIntStorageStoreUnsigned(keyOffset executor.MemPtr, keyLength executor.MemLength, value int64) int32 {
+	call := &VMHookCall{Name: "smallIntStorageStoreUnsigned", Args: []int64{int64(keyOffset), int64(keyLength), int64(value)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.SmallIntStorageStoreUnsigned(keyOffset, keyLength, value))
+	})
+	return int32(result)
+}
+
+// SmallIntStorageStoreSigned VM hook interceptor
+func (w *InterceptorVMHooks) SmallIntStorageStoreSigned(keyOffset executor.MemPtr, keyLength executor.MemLength, value int64) int32 {
+	call := &VMHookCall{Name: "smallIntStorageStoreSigned", Args: []int64{int64(keyOffset), int64(keyLength), int64(value)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.SmallIntStorageStoreSigned(keyOffset, keyLength, value))
+	})
+	return int32(result)
+}
+
+// SmallIntStorageLoadUnsigned VM hook interceptor
+func (w *InterceptorVMHooks) SmallIntStorageLoadUnsigned(keyOffset executor.MemPtr, keyLength executor.MemLength) int64 {
+	call := &VMHookCall{Name: "smallIntStorageLoadUnsigned", Args: []int64{int64(keyOffset), int64(keyLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.SmallIntStorageLoadUnsigned(keyOffset, keyLength))
+	})
+	return int64(result)
+}
+
+// SmallIntStorageLoadSigned VM hook interceptor
+func (w *InterceptorVMHooks) SmallIntStorageLoadSigned(keyOffset executor.MemPtr, keyLength executor.MemLength) int64 {
+	call := &VMHookCall{Name: "smallIntStorageLoadSigned", Args: []int64{int64(keyOffset), int64(keyLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.SmallIntStorageLoadSigned(keyOffset, keyLength))
+	})
+	return int64(result)
+}
+
+// Int64getArgument VM hook interceptor
+func (w *InterceptorVMHooks) Int64getArgument(id int32) int64 {
+	call := &VMHookCall{Name: "int64getArgument", Args: []int64{int64(id)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.Int64getArgument(id))
+	})
+	return int64(result)
+}
+
+// Int64finish VM hook interceptor
+func (w *InterceptorVMHooks) Int64finish(value int64) {
+	call := &VMHookCall{Name: "int64finish", Args: []int64{int64(value)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.Int64finish(value)
+		return 0
+	})
+}
+
+// Int64storageStore VM hook interceptor
+func (w *InterceptorVMHooks) Int64storageStore(keyOffset executor.MemPtr, keyLength executor.MemLength, value int64) int32 {
+	call := &VMHookCall{Name: "int64storageStore", Args: []int64{int64(keyOffset), int64(keyLength), int64(value)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.Int64storageStore(keyOffset, keyLength, value))
+	})
+	return int32(result)
+}
+
+// Int64storageLoad VM hook interceptor
+func (w *InterceptorVMHooks) Int64storageLoad(keyOffset executor.MemPtr, keyLength executor.MemLength) int64 {
+	call := &VMHookCall{Name: "int64storageLoad", Args: []int64{int64(keyOffset), int64(keyLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.Int64storageLoad(keyOffset, keyLength))
+	})
+	return int64(result)
+}
+
+// Sha256 VM hook interceptor
+func (w *InterceptorVMHooks) Sha256(dataOffset executor.MemPtr, length executor.MemLength, resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "sha256", Args: []int64{int64(dataOffset), int64(length), int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.Sha256(dataOffset, length, resultOffset))
+	})
+	return int32(result)
+}
+
+// ManagedSha256 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedSha256(inputHandle int32, outputHandle int32) int32 {
+	call := &VMHookCall{Name: "managedSha256", Args: []int64{int64(inputHandle), int64(outputHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedSha256(inputHandle, outputHandle))
+	})
+	return int32(result)
+}
+
+// Keccak256 VM hook interceptor
+func (w *InterceptorVMHooks) Keccak256(dataOffset executor.MemPtr, length executor.MemLength, resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "keccak256", Args: []int64{int64(dataOffset), int64(length), int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.Keccak256(dataOffset, length, resultOffset))
+	})
+	return int32(result)
+}
+
+// ManagedKeccak256 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedKeccak256(inputHandle int32, outputHandle int32) int32 {
+	call := &VMHookCall{Name: "managedKeccak256", Args: []int64{int64(inputHandle), int64(outputHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedKeccak256(inputHandle, outputHandle))
+	})
+	return int32(result)
+}
+
+// Ripemd160 VM hook interceptor
+func (w *InterceptorVMHooks) Ripemd160(dataOffset executor.MemPtr, length executor.MemLength, resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "ripemd160", Args: []int64{int64(dataOffset), int64(length), int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.Ripemd160(dataOffset, length, resultOffset))
+	})
+	return int32(result)
+}
+
+// ManagedRipemd160 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedRipemd160(inputHandle int32, outputHandle int32) int32 {
+	call := &VMHookCall{Name: "managedRipemd160", Args: []int64{int64(inputHandle), int64(outputHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedRipemd160(inputHandle, outputHandle))
+	})
+	return int32(result)
+}
+
+// ManagedSha512 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedSha512(inputHandle int32, outputHandle int32) int32 {
+	call := &VMHookCall{Name: "managedSha512", Args: []int64{int64(inputHandle), int64(outputHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedSha512(inputHandle, outputHandle))
+	})
+	return int32(result)
+}
+
+// ManagedSha3256 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedSha3256(inputHandle int32, outputHandle int32) int32 {
+	call := &VMHookCall{Name: "managedSha3256", Args: []int64{int64(inputHandle), int64(outputHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedSha3256(inputHandle, outputHandle))
+	})
+	return int32(result)
+}
+
+// ManagedSha3512 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedSha3512(inputHandle int32, outputHandle int32) int32 {
+	call := &VMHookCall{Name: "managedSha3512", Args: []int64{int64(inputHandle), int64(outputHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedSha3512(inputHandle, outputHandle))
+	})
+	return int32(result)
+}
+
+// ManagedBlake2b256 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedBlake2b256(inputHandle int32, outputHandle int32) int32 {
+	call := &VMHookCall{Name: "managedBlake2b256", Args: []int64{int64(inputHandle), int64(outputHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedBlake2b256(inputHandle, outputHandle))
+	})
+	return int32(result)
+}
+
+// ManagedBlake2b512 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedBlake2b512(inputHandle int32, outputHandle int32) int32 {
+	call := &VMHookCall{Name: "managedBlake2b512", Args: []int64{int64(inputHandle), int64(outputHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedBlake2b512(inputHandle, outputHandle))
+	})
+	return int32(result)
+}
+
+// ManagedPoseidon VM hook interceptor
+func (w *InterceptorVMHooks) ManagedPoseidon(inputHandle int32, outputHandle int32) int32 {
+	call := &VMHookCall{Name: "managedPoseidon", Args: []int64{int64(inputHandle), int64(outputHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedPoseidon(inputHandle, outputHandle))
+	})
+	return int32(result)
+}
+
+// VerifyBLS VM hook interceptor
+func (w *InterceptorVMHooks) VerifyBLS(keyOffset executor.MemPtr, messageOffset executor.MemPtr, messageLength executor.MemLength, sigOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "verifyBLS", Args: []int64{int64(keyOffset), int64(messageOffset), int64(messageLength), int64(sigOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.VerifyBLS(keyOffset, messageOffset, messageLength, sigOffset))
+	})
+	return int32(result)
+}
+
+// ManagedVerifyBLS VM hook interceptor
+func (w *InterceptorVMHooks) ManagedVerifyBLS(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
+	call := &VMHookCall{Name: "managedVerifyBLS", Args: []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedVerifyBLS(keyHandle, messageHandle, sigHandle))
+	})
+	return int32(result)
+}
+
+// ManagedVerifyBLSAggregated VM hook interceptor
+func (w *InterceptorVMHooks) ManagedVerifyBLSAggregated(keysHandle int32, messageHandle int32, sigHandle int32) int32 {
+	call := &VMHookCall{Name: "managedVerifyBLSAggregated", Args: []int64{int64(keysHandle), int64(messageHandle), int64(sigHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedVerifyBLSAggregated(keysHandle, messageHandle, sigHandle))
+	})
+	return int32(result)
+}
+
+// ManagedAggregateBLSPublicKeys VM hook interceptor
+func (w *InterceptorVMHooks) ManagedAggregateBLSPublicKeys(keysHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedAggregateBLSPublicKeys", Args: []int64{int64(keysHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedAggregateBLSPublicKeys(keysHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// VerifyEd25519 VM hook interceptor
+func (w *InterceptorVMHooks) VerifyEd25519(keyOffset executor.MemPtr, messageOffset executor.MemPtr, messageLength executor.MemLength, sigOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "verifyEd25519", Args: []int64{int64(keyOffset), int64(messageOffset), int64(messageLength), int64(sigOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.VerifyEd25519(keyOffset, messageOffset, messageLength, sigOffset))
+	})
+	return int32(result)
+}
+
+// ManagedVerifyEd25519 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedVerifyEd25519(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
+	call := &VMHookCall{Name: "managedVerifyEd25519", Args: []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedVerifyEd25519(keyHandle, messageHandle, sigHandle))
+	})
+	return int32(result)
+}
+
+// ManagedVerifyEd25519Batch VM hook interceptor
+func (w *InterceptorVMHooks) ManagedVerifyEd25519Batch(keysHandle int32, messagesHandle int32, sigsHandle int32) int32 {
+	call := &VMHookCall{Name: "managedVerifyEd25519Batch", Args: []int64{int64(keysHandle), int64(messagesHandle), int64(sigsHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedVerifyEd25519Batch(keysHandle, messagesHandle, sigsHandle))
+	})
+	return int32(result)
+}
+
+// ManagedVerifyEd25519BatchBitmap VM hook interceptor
+func (w *InterceptorVMHooks) ManagedVerifyEd25519BatchBitmap(keysHandle int32, messagesHandle int32, sigsHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedVerifyEd25519BatchBitmap", Args: []int64{int64(keysHandle), int64(messagesHandle), int64(sigsHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedVerifyEd25519BatchBitmap(keysHandle, messagesHandle, sigsHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// VerifyCustomSecp256k1 VM hook interceptor
+func (w *InterceptorVMHooks) VerifyCustomSecp256k1(keyOffset executor.MemPtr, keyLength executor.MemLength, messageOffset executor.MemPtr, messageLength executor.MemLength, sigOffset executor.MemPtr, hashType int32) int32 {
+	call := &VMHookCall{Name: "verifyCustomSecp256k1", Args: []int64{int64(keyOffset), int64(keyLength), int64(messageOffset), int64(messageLength), int64(sigOffset), int64(hashType)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.VerifyCustomSecp256k1(keyOffset, keyLength, messageOffset, messageLength, sigOffset, hashType))
+	})
+	return int32(result)
+}
+
+// ManagedVerifyCustomSecp256k1 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedVerifyCustomSecp256k1(keyHandle int32, messageHandle int32, sigHandle int32, hashType int32) int32 {
+	call := &VMHookCall{Name: "managedVerifyCustomSecp256k1", Args: []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle), int64(hashType)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedVerifyCustomSecp256k1(keyHandle, messageHandle, sigHandle, hashType))
+	})
+	return int32(result)
+}
+
+// VerifySecp256k1 VM hook interceptor
+func (w *InterceptorVMHooks) VerifySecp256k1(keyOffset executor.MemPtr, keyLength executor.MemLength, messageOffset executor.MemPtr, messageLength executor.MemLength, sigOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "verifySecp256k1", Args: []int64{int64(keyOffset), int64(keyLength), int64(messageOffset), int64(messageLength), int64(sigOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.VerifySecp256k1(keyOffset, keyLength, messageOffset, messageLength, sigOffset))
+	})
+	return int32(result)
+}
+
+// ManagedVerifySecp256k1 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedVerifySecp256k1(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
+	call := &VMHookCall{Name: "managedVerifySecp256k1", Args: []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedVerifySecp256k1(keyHandle, messageHandle, sigHandle))
+	})
+	return int32(result)
+}
+
+// ManagedVerifySecp256r1 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedVerifySecp256r1(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
+	call := &VMHookCall{Name: "managedVerifySecp256r1", Args: []int64{int64(keyHandle), int64(messageHandle), int64(sigHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedVerifySecp256r1(keyHandle, messageHandle, sigHandle))
+	})
+	return int32(result)
+}
+
+// ManagedVerifySecp256r1Batch VM hook interceptor
+func (w *InterceptorVMHooks) ManagedVerifySecp256r1Batch(keysHandle int32, messagesHandle int32, sigsHandle int32) int32 {
+	call := &VMHookCall{Name: "managedVerifySecp256r1Batch", Args: []int64{int64(keysHandle), int64(messagesHandle), int64(sigsHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedVerifySecp256r1Batch(keysHandle, messagesHandle, sigsHandle))
+	})
+	return int32(result)
+}
+
+// ManagedVerifySecp256r1BatchBitmap VM hook interceptor
+func (w *InterceptorVMHooks) ManagedVerifySecp256r1BatchBitmap(keysHandle int32, messagesHandle int32, sigsHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedVerifySecp256r1BatchBitmap", Args: []int64{int64(keysHandle), int64(messagesHandle), int64(sigsHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedVerifySecp256r1BatchBitmap(keysHandle, messagesHandle, sigsHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// EncodeSecp256k1DerSignature VM hook interceptor
+func (w *InterceptorVMHooks) EncodeSecp256k1DerSignature(rOffset executor.MemPtr, rLength executor.MemLength, sOffset executor.MemPtr, sLength executor.MemLength, sigOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "encodeSecp256k1DerSignature", Args: []int64{int64(rOffset), int64(rLength), int64(sOffset), int64(sLength), int64(sigOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.EncodeSecp256k1DerSignature(rOffset, rLength, sOffset, sLength, sigOffset))
+	})
+	return int32(result)
+}
+
+// ManagedEncodeSecp256k1DerSignature VM hook interceptor
+func (w *InterceptorVMHooks) ManagedEncodeSecp256k1DerSignature(rHandle int32, sHandle int32, sigHandle int32) int32 {
+	call := &VMHookCall{Name: "managedEncodeSecp256k1DerSignature", Args: []int64{int64(rHandle), int64(sHandle), int64(sigHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedEncodeSecp256k1DerSignature(rHandle, sHandle, sigHandle))
+	})
+	return int32(result)
+}
+
+// AddEC VM hook interceptor
+func (w *InterceptorVMHooks) AddEC(xResultHandle int32, yResultHandle int32, ecHandle int32, fstPointXHandle int32, fstPointYHandle int32, sndPointXHandle int32, sndPointYHandle int32) {
+	call := &VMHookCall{Name: "addEC", Args: []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(fstPointXHandle), int64(fstPointYHandle), int64(sndPointXHandle), int64(sndPointYHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.AddEC(xResultHandle, yResultHandle, ecHandle, fstPointXHandle, fstPointYHandle, sndPointXHandle, sndPointYHandle)
+		return 0
+	})
+}
+
+// DoubleEC VM hook interceptor
+func (w *InterceptorVMHooks) DoubleEC(xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32) {
+	call := &VMHookCall{Name: "doubleEC", Args: []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(pointXHandle), int64(pointYHandle)}}
+	w.interceptor.InterceptVMHookCall(call, func() int64 {
+		w.wrappedVMHooks.DoubleEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle)
+		return 0
+	})
+}
+
+// IsOnCurveEC VM hook interceptor
+func (w *InterceptorVMHooks) IsOnCurveEC(ecHandle int32, pointXHandle int32, pointYHandle int32) int32 {
+	call := &VMHookCall{Name: "isOnCurveEC", Args: []int64{int64(ecHandle), int64(pointXHandle), int64(pointYHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.IsOnCurveEC(ecHandle, pointXHandle, pointYHandle))
+	})
+	return int32(result)
+}
+
+// ScalarBaseMultEC VM hook interceptor
+func (w *InterceptorVMHooks) ScalarBaseMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset executor.MemPtr, length executor.MemLength) int32 {
+	call := &VMHookCall{Name: "scalarBaseMultEC", Args: []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataOffset), int64(length)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ScalarBaseMultEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length))
+	})
+	return int32(result)
+}
+
+// ManagedScalarBaseMultEC VM hook interceptor
+func (w *InterceptorVMHooks) ManagedScalarBaseMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataHandle int32) int32 {
+	call := &VMHookCall{Name: "managedScalarBaseMultEC", Args: []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedScalarBaseMultEC(xResultHandle, yResultHandle, ecHandle, dataHandle))
+	})
+	return int32(result)
+}
+
+// ScalarMultEC VM hook interceptor
+func (w *InterceptorVMHooks) ScalarMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32, dataOffset executor.MemPtr, length executor.MemLength) int32 {
+	call := &VMHookCall{Name: "scalarMultEC", Args: []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(pointXHandle), int64(pointYHandle), int64(dataOffset), int64(length)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ScalarMultEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle, dataOffset, length))
+	})
+	return int32(result)
+}
+
+// ManagedScalarMultEC VM hook interceptor
+func (w *InterceptorVMHooks) ManagedScalarMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32, dataHandle int32) int32 {
+	call := &VMHookCall{Name: "managedScalarMultEC", Args: []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(pointXHandle), int64(pointYHandle), int64(dataHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedScalarMultEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle, dataHandle))
+	})
+	return int32(result)
+}
+
+// MarshalEC VM hook interceptor
+func (w *InterceptorVMHooks) MarshalEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "marshalEC", Args: []int64{int64(xPairHandle), int64(yPairHandle), int64(ecHandle), int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MarshalEC(xPairHandle, yPairHandle, ecHandle, resultOffset))
+	})
+	return int32(result)
+}
+
+// ManagedMarshalEC VM hook interceptor
+func (w *InterceptorVMHooks) ManagedMarshalEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedMarshalEC", Args: []int64{int64(xPairHandle), int64(yPairHandle), int64(ecHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedMarshalEC(xPairHandle, yPairHandle, ecHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// MarshalCompressedEC VM hook interceptor
+func (w *InterceptorVMHooks) MarshalCompressedEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "marshalCompressedEC", Args: []int64{int64(xPairHandle), int64(yPairHandle), int64(ecHandle), int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.MarshalCompressedEC(xPairHandle, yPairHandle, ecHandle, resultOffset))
+	})
+	return int32(result)
+}
+
+// ManagedMarshalCompressedEC VM hook interceptor
+func (w *InterceptorVMHooks) ManagedMarshalCompressedEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedMarshalCompressedEC", Args: []int64{int64(xPairHandle), int64(yPairHandle), int64(ecHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedMarshalCompressedEC(xPairHandle, yPairHandle, ecHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// UnmarshalEC VM hook interceptor
+func (w *InterceptorVMHooks) UnmarshalEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset executor.MemPtr, length executor.MemLength) int32 {
+	call := &VMHookCall{Name: "unmarshalEC", Args: []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataOffset), int64(length)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.UnmarshalEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length))
+	})
+	return int32(result)
+}
+
+// ManagedUnmarshalEC VM hook interceptor
+func (w *InterceptorVMHooks) ManagedUnmarshalEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataHandle int32) int32 {
+	call := &VMHookCall{Name: "managedUnmarshalEC", Args: []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedUnmarshalEC(xResultHandle, yResultHandle, ecHandle, dataHandle))
+	})
+	return int32(result)
+}
+
+// UnmarshalCompressedEC VM hook interceptor
+func (w *InterceptorVMHooks) UnmarshalCompressedEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset executor.MemPtr, length executor.MemLength) int32 {
+	call := &VMHookCall{Name: "unmarshalCompressedEC", Args: []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataOffset), int64(length)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.UnmarshalCompressedEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length))
+	})
+	return int32(result)
+}
+
+// ManagedUnmarshalCompressedEC VM hook interceptor
+func (w *InterceptorVMHooks) ManagedUnmarshalCompressedEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataHandle int32) int32 {
+	call := &VMHookCall{Name: "managedUnmarshalCompressedEC", Args: []int64{int64(xResultHandle), int64(yResultHandle), int64(ecHandle), int64(dataHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedUnmarshalCompressedEC(xResultHandle, yResultHandle, ecHandle, dataHandle))
+	})
+	return int32(result)
+}
+
+// GenerateKeyEC VM hook interceptor
+func (w *InterceptorVMHooks) GenerateKeyEC(xPubKeyHandle int32, yPubKeyHandle int32, ecHandle int32, resultOffset executor.MemPtr) int32 {
+	call := &VMHookCall{Name: "generateKeyEC", Args: []int64{int64(xPubKeyHandle), int64(yPubKeyHandle), int64(ecHandle), int64(resultOffset)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GenerateKeyEC(xPubKeyHandle, yPubKeyHandle, ecHandle, resultOffset))
+	})
+	return int32(result)
+}
+
+// ManagedGenerateKeyEC VM hook interceptor
+func (w *InterceptorVMHooks) ManagedGenerateKeyEC(xPubKeyHandle int32, yPubKeyHandle int32, ecHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedGenerateKeyEC", Args: []int64{int64(xPubKeyHandle), int64(yPubKeyHandle), int64(ecHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedGenerateKeyEC(xPubKeyHandle, yPubKeyHandle, ecHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// CreateEC VM hook interceptor
+func (w *InterceptorVMHooks) CreateEC(dataOffset executor.MemPtr, dataLength executor.MemLength) int32 {
+	call := &VMHookCall{Name: "createEC", Args: []int64{int64(dataOffset), int64(dataLength)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.CreateEC(dataOffset, dataLength))
+	})
+	return int32(result)
+}
+
+// ManagedCreateEC VM hook interceptor
+func (w *InterceptorVMHooks) ManagedCreateEC(dataHandle int32) int32 {
+	call := &VMHookCall{Name: "managedCreateEC", Args: []int64{int64(dataHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedCreateEC(dataHandle))
+	})
+	return int32(result)
+}
+
+// ManagedCreateCustomEC VM hook interceptor
+func (w *InterceptorVMHooks) ManagedCreateCustomEC(fieldOrderHandle int32, basePointOrderHandle int32, eqCoefficientHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32, sizeOfField int32) int32 {
+	call := &VMHookCall{Name: "managedCreateCustomEC", Args: []int64{int64(fieldOrderHandle), int64(basePointOrderHandle), int64(eqCoefficientHandle), int64(eqConstantHandle), int64(xBasePointHandle), int64(yBasePointHandle), int64(sizeOfField)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedCreateCustomEC(fieldOrderHandle, basePointOrderHandle, eqCoefficientHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle, sizeOfField))
+	})
+	return int32(result)
+}
+
+// GetCurveLengthEC VM hook interceptor
+func (w *InterceptorVMHooks) GetCurveLengthEC(ecHandle int32) int32 {
+	call := &VMHookCall{Name: "getCurveLengthEC", Args: []int64{int64(ecHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetCurveLengthEC(ecHandle))
+	})
+	return int32(result)
+}
+
+// GetPrivKeyByteLengthEC VM hook interceptor
+func (w *InterceptorVMHooks) GetPrivKeyByteLengthEC(ecHandle int32) int32 {
+	call := &VMHookCall{Name: "getPrivKeyByteLengthEC", Args: []int64{int64(ecHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.GetPrivKeyByteLengthEC(ecHandle))
+	})
+	return int32(result)
+}
+
+// EllipticCurveGetValues VM hook interceptor
+func (w *InterceptorVMHooks) EllipticCurveGetValues(ecHandle int32, fieldOrderHandle int32, basePointOrderHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32) int32 {
+	call := &VMHookCall{Name: "ellipticCurveGetValues", Args: []int64{int64(ecHandle), int64(fieldOrderHandle), int64(basePointOrderHandle), int64(eqConstantHandle), int64(xBasePointHandle), int64(yBasePointHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.EllipticCurveGetValues(ecHandle, fieldOrderHandle, basePointOrderHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle))
+	})
+	return int32(result)
+}
+
+// ManagedAddBN254G1 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedAddBN254G1(point1Handle int32, point2Handle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedAddBN254G1", Args: []int64{int64(point1Handle), int64(point2Handle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedAddBN254G1(point1Handle, point2Handle, resultHandle))
+	})
+	return int32(result)
+}
+
+// ManagedScalarMulBN254G1 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedScalarMulBN254G1(pointHandle int32, scalarHandle int32, resultHandle int32) int32 {
+	call := &VMHookCall{Name: "managedScalarMulBN254G1", Args: []int64{int64(pointHandle), int64(scalarHandle), int64(resultHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedScalarMulBN254G1(pointHandle, scalarHandle, resultHandle))
+	})
+	return int32(result)
+}
+
+// ManagedPairingCheckBN254 VM hook interceptor
+func (w *InterceptorVMHooks) ManagedPairingCheckBN254(g1PointsHandle int32, g2PointsHandle int32) int32 {
+	call := &VMHookCall{Name: "managedPairingCheckBN254", Args: []int64{int64(g1PointsHandle), int64(g2PointsHandle)}}
+	result := w.interceptor.InterceptVMHookCall(call, func() int64 {
+		return int64(w.wrappedVMHooks.ManagedPairingCheckBN254(g1PointsHandle, g2PointsHandle))
+	})
+	return int32(result)
+}
@@ -0,0 +1,104 @@
+package executorwrapper
+
+import (
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+var _ executor.Executor = (*TraceRecorderExecutor)(nil)
+
+// TraceRecorderExecutor records every contract function call into a TraceFrame, with the VM hook calls,
+// their typed arguments, results, gas and effects on the instance, and with the nested contract calls.
+// Complete root frames are handed to a TraceSink. The frames can be re-executed offline by a TraceReplayer.
+type TraceRecorderExecutor struct {
+	sink            TraceSink
+	wrappedExecutor executor.Executor
+
+	activeInstances []*TraceRecorderInstance
+}
+
+// SetOpcodeCosts sets the gas costs on the wrapped executor.
+func (texec *TraceRecorderExecutor) SetOpcodeCosts(opcodeCosts *executor.WASMOpcodeCost) {
+	texec.wrappedExecutor.SetOpcodeCosts(opcodeCosts)
+}
+
+// FunctionNames returns the function names of the wrapped executor.
+func (texec *TraceRecorderExecutor) FunctionNames() vmcommon.FunctionNames {
+	return texec.wrappedExecutor.FunctionNames()
+}
+
+// NewInstanceWithOptions instantiates the contract on the wrapped executor.
+func (texec *TraceRecorderExecutor) NewInstanceWithOptions(
+	contractCode []byte,
+	options executor.CompilationOptions,
+) (executor.Instance, error) {
+	wrappedInstance, err := texec.wrappedExecutor.NewInstanceWithOptions(contractCode, options)
+	if err != nil {
+		return nil, err
+	}
+	return texec.newTraceRecorderInstance(wrappedInstance, options), nil
+}
+
+// NewInstanceFromCompiledCodeWithOptions restores an instance on the wrapped executor.
+func (texec *TraceRecorderExecutor) NewInstanceFromCompiledCodeWithOptions(
+	compiledCode []byte,
+	options executor.CompilationOptions,
+) (executor.Instance, error) {
+	wrappedInstance, err := texec.wrappedExecutor.NewInstanceFromCompiledCodeWithOptions(compiledCode, options)
+	if err != nil {
+		return nil, err
+	}
+	return texec.newTraceRecorderInstance(wrappedInstance, options), nil
+}
+
+func (texec *TraceRecorderExecutor) newTraceRecorderInstance(
+	wrappedInstance executor.Instance,
+	options executor.CompilationOptions,
+) *TraceRecorderInstance {
+	return &TraceRecorderInstance{
+		executor:        texec,
+		wrappedInstance: wrappedInstance,
+		options:         options,
+		gasLimit:        options.GasLimit,
+	}
+}
+
+func (texec *TraceRecorderExecutor) pushInstance(instance *TraceRecorderInstance) {
+	texec.activeInstances = append(texec.activeInstances, instance)
+}
+
+func (texec *TraceRecorderExecutor) popInstance() {
+	texec.activeInstances = texec.activeInstances[:len(texec.activeInstances)-1]
+}
+
+func (texec *TraceRecorderExecutor) currentInstance() *TraceRecorderInstance {
+	if len(texec.activeInstances) == 0 {
+		return nil
+	}
+	return texec.activeInstances[len(texec.activeInstances)-1]
+}
+
+// CompiledCodeVersion returns the compiled code version of the wrapped executor,
+// since the recorder does not change the compiled code.
+func (texec *TraceRecorderExecutor) CompiledCodeVersion() string {
+	return executor.CompiledCodeVersion(texec.wrappedExecutor)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (texec *TraceRecorderExecutor) IsInterfaceNil() bool {
+	return texec == nil
+}
+
+// traceRecorderInterceptor routes the VM hook calls to the instance currently executing.
+type traceRecorderInterceptor struct {
+	executor *TraceRecorderExecutor
+}
+
+// InterceptVMHookCall records the VM hook call, its result and its side effects on the instance.
+func (interceptor *traceRecorderInterceptor) InterceptVMHookCall(call *VMHookCall, invoke func() int64) int64 {
+	instance := interceptor.executor.currentInstance()
+	if instance == nil {
+		return invoke()
+	}
+	return instance.recordHookCall(call, invoke)
+}
@@ -0,0 +1,54 @@
+package executorwrapper
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+var _ executor.ExecutorAbstractFactory = (*TraceRecorderExecutorFactory)(nil)
+
+// TraceRecorderExecutorFactory is the factory for the TraceRecorderExecutor.
+type TraceRecorderExecutorFactory struct {
+	sink           TraceSink
+	wrappedFactory executor.ExecutorAbstractFactory
+
+	// LastCreatedExecutor gives access to the created Executor
+	LastCreatedExecutor *TraceRecorderExecutor
+}
+
+// NewTraceRecorderExecutorFactory yields a new TraceRecorderExecutor factory,
+// which records the executions of the executors created by the wrapped factory.
+func NewTraceRecorderExecutorFactory(
+	sink TraceSink,
+	wrappedFactory executor.ExecutorAbstractFactory,
+) *TraceRecorderExecutorFactory {
+	return &TraceRecorderExecutorFactory{
+		sink:           sink,
+		wrappedFactory: wrappedFactory,
+	}
+}
+
+// CreateExecutor creates a new Executor instance.
+func (factory *TraceRecorderExecutorFactory) CreateExecutor(args executor.ExecutorFactoryArgs) (executor.Executor, error) {
+	recorderExecutor := &TraceRecorderExecutor{
+		sink: factory.sink,
+	}
+
+	wrappedExecutor, err := factory.wrappedFactory.CreateExecutor(executor.ExecutorFactoryArgs{
+		VMHooks:                  NewInterceptorVMHooks(&traceRecorderInterceptor{executor: recorderExecutor}, args.VMHooks),
+		OpcodeCosts:              args.OpcodeCosts,
+		RkyvSerializationEnabled: args.RkyvSerializationEnabled,
+		WasmerSIGSEGVPassthrough: args.WasmerSIGSEGVPassthrough,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recorderExecutor.wrappedExecutor = wrappedExecutor
+	factory.LastCreatedExecutor = recorderExecutor
+	return recorderExecutor, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (factory *TraceRecorderExecutorFactory) IsInterfaceNil() bool {
+	return factory == nil
+}
@@ -0,0 +1,213 @@
+package executorwrapper
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+var _ executor.Instance = (*TraceRecorderInstance)(nil)
+
+// TraceRecorderInstance wraps an instance and records its function calls.
+type TraceRecorderInstance struct {
+	executor        *TraceRecorderExecutor
+	wrappedInstance executor.Instance
+	options         executor.CompilationOptions
+	gasLimit        uint64
+
+	recording *traceRecording
+}
+
+// traceRecording holds the frame of the function call in progress on the instance.
+type traceRecording struct {
+	frame       *TraceFrame
+	currentCall *TraceHookCall
+}
+
+// GetPointsUsed returns the points used by the wrapped instance.
+func (inst *TraceRecorderInstance) GetPointsUsed() uint64 {
+	return inst.wrappedInstance.GetPointsUsed()
+}
+
+// SetPointsUsed sets the points used and records it, if called from a VM hook.
+func (inst *TraceRecorderInstance) SetPointsUsed(points uint64) {
+	inst.wrappedInstance.SetPointsUsed(points)
+	inst.recordEffect(&TraceEffect{Kind: TraceEffectSetPointsUsed, Value: points})
+}
+
+// SetGasLimit sets the gas limit of the wrapped instance.
+func (inst *TraceRecorderInstance) SetGasLimit(gasLimit uint64) {
+	inst.gasLimit = gasLimit
+	inst.wrappedInstance.SetGasLimit(gasLimit)
+}
+
+// SetBreakpointValue sets the breakpoint value and records it, if called from a VM hook.
+func (inst *TraceRecorderInstance) SetBreakpointValue(value uint64) {
+	inst.wrappedInstance.SetBreakpointValue(value)
+	inst.recordEffect(&TraceEffect{Kind: TraceEffectSetBreakpointValue, Value: value})
+}
+
+// GetBreakpointValue returns the breakpoint value of the wrapped instance.
+func (inst *TraceRecorderInstance) GetBreakpointValue() uint64 {
+	return inst.wrappedInstance.GetBreakpointValue()
+}
+
+// Cache returns the compiled code of the wrapped instance.
+func (inst *TraceRecorderInstance) Cache() ([]byte, error) {
+	return inst.wrappedInstance.Cache()
+}
+
+// Clean cleans the wrapped instance.
+func (inst *TraceRecorderInstance) Clean() bool {
+	return inst.wrappedInstance.Clean()
+}
+
+// IsAlreadyCleaned returns the state of the wrapped instance.
+func (inst *TraceRecorderInstance) IsAlreadyCleaned() bool {
+	return inst.wrappedInstance.IsAlreadyCleaned()
+}
+
+// CallFunction executes the function and records it in a new frame. The frame becomes a nested frame
+// if the call is made from a VM hook of another contract, otherwise it is sent to the TraceSink when complete.
+func (inst *TraceRecorderInstance) CallFunction(functionName string) error {
+	frame := &TraceFrame{
+		FunctionName:          functionName,
+		InstanceID:            inst.wrappedInstance.ID(),
+		Options:               inst.options,
+		GasLimit:              inst.gasLimit,
+		PointsUsedBefore:      inst.wrappedInstance.GetPointsUsed(),
+		BreakpointValueBefore: inst.wrappedInstance.GetBreakpointValue(),
+		HookCalls:             make([]*TraceHookCall, 0),
+	}
+
+	var parentCall *TraceHookCall
+	parentInstance := inst.executor.currentInstance()
+	if parentInstance != nil && parentInstance.recording != nil {
+		parentCall = parentInstance.recording.currentCall
+	}
+
+	previousRecording := inst.recording
+	inst.recording = &traceRecording{frame: frame}
+	inst.executor.pushInstance(inst)
+	err := inst.wrappedInstance.CallFunction(functionName)
+	inst.executor.popInstance()
+	inst.recording = previousRecording
+
+	frame.PointsUsedAfter = inst.wrappedInstance.GetPointsUsed()
+	frame.BreakpointValueAfter = inst.wrappedInstance.GetBreakpointValue()
+	if err != nil {
+		frame.Error = err.Error()
+	}
+
+	if parentCall != nil {
+		parentCall.NestedFrames = append(parentCall.NestedFrames, frame)
+	} else if inst.executor.sink != nil {
+		inst.executor.sink.RecordTraceFrame(frame)
+	}
+
+	return err
+}
+
+// recordHookCall calls the VM hook and records the call in the current frame.
+func (inst *TraceRecorderInstance) recordHookCall(call *VMHookCall, invoke func() int64) int64 {
+	recording := inst.recording
+	hookCall := newTraceHookCall(call, inst.wrappedInstance.GetPointsUsed())
+	recording.frame.HookCalls = append(recording.frame.HookCalls, hookCall)
+
+	previousCall := recording.currentCall
+	recording.currentCall = hookCall
+	hookCall.Result = invoke()
+	recording.currentCall = previousCall
+
+	hookCall.PointsUsedAfter = inst.wrappedInstance.GetPointsUsed()
+	return hookCall.Result
+}
+
+// recordEffect adds an operation to the VM hook call currently in progress on the instance, if any.
+func (inst *TraceRecorderInstance) recordEffect(effect *TraceEffect) {
+	if inst.recording == nil || inst.recording.currentCall == nil {
+		return
+	}
+	inst.recording.currentCall.Effects = append(inst.recording.currentCall.Effects, effect)
+}
+
+// HasFunction checks the wrapped instance.
+func (inst *TraceRecorderInstance) HasFunction(functionName string) bool {
+	return inst.wrappedInstance.HasFunction(functionName)
+}
+
+// GetFunctionNames returns the function names of the wrapped instance.
+func (inst *TraceRecorderInstance) GetFunctionNames() []string {
+	return inst.wrappedInstance.GetFunctionNames()
+}
+
+// ValidateFunctionArities validates the wrapped instance.
+func (inst *TraceRecorderInstance) ValidateFunctionArities() error {
+	return inst.wrappedInstance.ValidateFunctionArities()
+}
+
+// HasMemory checks the wrapped instance.
+func (inst *TraceRecorderInstance) HasMemory() bool {
+	return inst.wrappedInstance.HasMemory()
+}
+
+// MemLoad returns the contents from the given offset of the WASM memory and records them, if called from a VM hook.
+func (inst *TraceRecorderInstance) MemLoad(memPtr executor.MemPtr, length executor.MemLength) ([]byte, error) {
+	data, err := inst.wrappedInstance.MemLoad(memPtr, length)
+	if err == nil {
+		inst.recordEffect(&TraceEffect{Kind: TraceEffectMemLoad, Offset: int32(memPtr), Data: encodeTraceData(data)})
+	}
+	return data, err
+}
+
+// MemStore stores the given data in the WASM memory and records it, if called from a VM hook.
+func (inst *TraceRecorderInstance) MemStore(memPtr executor.MemPtr, data []byte) error {
+	err := inst.wrappedInstance.MemStore(memPtr, data)
+	if err == nil {
+		inst.recordEffect(&TraceEffect{Kind: TraceEffectMemStore, Offset: int32(memPtr), Data: encodeTraceData(data)})
+	}
+	return err
+}
+
+// MemLength returns the memory length of the wrapped instance.
+func (inst *TraceRecorderInstance) MemLength() uint32 {
+	return inst.wrappedInstance.MemLength()
+}
+
+// MemGrow grows the memory of the wrapped instance.
+func (inst *TraceRecorderInstance) MemGrow(pages uint32) error {
+	return inst.wrappedInstance.MemGrow(pages)
+}
+
+// MemDump yields the memory of the wrapped instance.
+func (inst *TraceRecorderInstance) MemDump() []byte {
+	return inst.wrappedInstance.MemDump()
+}
+
+// IsFunctionImported checks the wrapped instance.
+func (inst *TraceRecorderInstance) IsFunctionImported(name string) bool {
+	return inst.wrappedInstance.IsFunctionImported(name)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface.
+func (inst *TraceRecorderInstance) IsInterfaceNil() bool {
+	return inst == nil
+}
+
+// Reset resets the wrapped instance.
+func (inst *TraceRecorderInstance) Reset() bool {
+	return inst.wrappedInstance.Reset()
+}
+
+// SetVMHooksPtr sets the VM hooks pointer on the wrapped instance.
+func (inst *TraceRecorderInstance) SetVMHooksPtr(vmHooksPtr uintptr) {
+	inst.wrappedInstance.SetVMHooksPtr(vmHooksPtr)
+}
+
+// GetVMHooksPtr returns the VM hooks pointer of the wrapped instance.
+func (inst *TraceRecorderInstance) GetVMHooksPtr() uintptr {
+	return inst.wrappedInstance.GetVMHooksPtr()
+}
+
+// ID returns the ID of the wrapped instance.
+func (inst *TraceRecorderInstance) ID() string {
+	return inst.wrappedInstance.ID()
+}
@@ -0,0 +1,188 @@
+package executorwrapper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/interpreter"
+	"github.com/stretchr/testify/require"
+)
+
+// nestingVMHooks calls a function of another instance from the first Int64finish, like ExecuteOnDestContext.
+type nestingVMHooks struct {
+	*counterVMHooks
+	nestedInstance executor.Instance
+	nestedFunction string
+}
+
+func (hooks *nestingVMHooks) Int64finish(value int64) {
+	hooks.counterVMHooks.Int64finish(value)
+	if hooks.nestedInstance == nil {
+		return
+	}
+	nestedInstance := hooks.nestedInstance
+	hooks.nestedInstance = nil
+	_ = nestedInstance.CallFunction(hooks.nestedFunction)
+}
+
+func readCounterCode(t *testing.T) []byte {
+	code, err := ioutil.ReadFile("../../test/contracts/counter/output/counter.wasm")
+	require.Nil(t, err)
+	return code
+}
+
+func newTraceRecorderInstance(t *testing.T, hooks executor.VMHooks, sink TraceSink) (*TraceRecorderExecutor, executor.Instance) {
+	factory := NewTraceRecorderExecutorFactory(sink, interpreter.ExecutorFactory())
+	recorderExecutor, err := factory.CreateExecutor(executor.ExecutorFactoryArgs{
+		VMHooks:     hooks,
+		OpcodeCosts: testOpcodeCosts(),
+	})
+	require.Nil(t, err)
+
+	instance, err := recorderExecutor.NewInstanceWithOptions(readCounterCode(t), executor.CompilationOptions{
+		GasLimit:           1000000,
+		Metering:           true,
+		RuntimeBreakpoints: true,
+	})
+	require.Nil(t, err)
+	return factory.LastCreatedExecutor, instance
+}
+
+func recordCounterTrace(t *testing.T) []*TraceFrame {
+	buffer := &bytes.Buffer{}
+	writer := NewJSONTraceWriter(buffer)
+	hooks := &counterVMHooks{storage: make(map[string]int64)}
+	_, instance := newTraceRecorderInstance(t, hooks, writer)
+	hooks.instance = instance
+
+	require.Nil(t, instance.CallFunction("init"))
+	require.Nil(t, instance.CallFunction("increment"))
+	require.Nil(t, writer.Err())
+	require.Equal(t, []int64{2}, hooks.finished)
+
+	frames, err := ReadTraceFrames(buffer)
+	require.Nil(t, err)
+	return frames
+}
+
+func TestTraceRecorder_RecordsTypedHookCalls(t *testing.T) {
+	frames := recordCounterTrace(t)
+	require.Len(t, frames, 2)
+
+	increment := frames[1]
+	require.Equal(t, "increment", increment.FunctionName)
+	require.Equal(t, uint64(1000000), increment.GasLimit)
+	require.Empty(t, increment.Error)
+	require.Len(t, increment.HookCalls, 3)
+
+	load := increment.HookCalls[0]
+	require.Equal(t, "int64storageLoad", load.Name)
+	require.Equal(t, "smallIntOps", load.Family)
+	require.Equal(t, "int64", load.ResultType)
+	require.Equal(t, int64(1), load.Result)
+	require.Equal(t, []*TraceArgument{
+		{Name: "keyOffset", Type: "MemPtr", Value: 1024},
+		{Name: "keyLength", Type: "MemLength", Value: 7},
+	}, load.Args)
+	require.Equal(t, []*TraceEffect{
+		{Kind: TraceEffectMemLoad, Offset: 1024, Data: encodeTraceData([]byte("COUNTER"))},
+	}, load.Effects)
+	require.True(t, load.PointsUsedBefore > increment.PointsUsedBefore)
+	require.True(t, increment.PointsUsedAfter >= load.PointsUsedAfter)
+}
+
+func TestTraceRecorder_NestedFrames(t *testing.T) {
+	sink := &traceFrameCollector{}
+	hooks := &nestingVMHooks{
+		counterVMHooks: &counterVMHooks{storage: make(map[string]int64)},
+		nestedFunction: "get",
+	}
+	recorderExecutor, instance := newTraceRecorderInstance(t, hooks, sink)
+	hooks.instance = instance
+	nestedInstance, err := recorderExecutor.NewInstanceWithOptions(readCounterCode(t), executor.CompilationOptions{
+		GasLimit: 1000000,
+		Metering: true,
+	})
+	require.Nil(t, err)
+	hooks.nestedInstance = nestedInstance
+
+	require.Nil(t, instance.CallFunction("init"))
+	require.Nil(t, instance.CallFunction("increment"))
+	require.Len(t, sink.frames, 2)
+
+	finish := sink.frames[1].HookCalls[2]
+	require.Equal(t, "int64finish", finish.Name)
+	require.Len(t, finish.NestedFrames, 1)
+	require.Equal(t, "get", finish.NestedFrames[0].FunctionName)
+	require.Equal(t, nestedInstance.ID(), finish.NestedFrames[0].InstanceID)
+	require.Len(t, finish.NestedFrames[0].HookCalls, 2)
+	require.Len(t, FlattenTraceFrames(sink.frames), 3)
+}
+
+func TestTraceReplayer_MatchingReplay(t *testing.T) {
+	frames := recordCounterTrace(t)
+	replayer := NewTraceReplayer(interpreter.ExecutorFactory(), testOpcodeCosts())
+
+	for _, frame := range frames {
+		mismatch, err := replayer.ReplayFrame(readCounterCode(t), frame)
+		require.Nil(t, err)
+		require.Nil(t, mismatch)
+	}
+}
+
+func TestTraceReplayer_Mismatches(t *testing.T) {
+	code := readCounterCode(t)
+
+	t.Run("gas", func(t *testing.T) {
+		frames := recordCounterTrace(t)
+		opcodeCosts := testOpcodeCosts()
+		opcodeCosts.I64Add = 5
+		mismatch, err := NewTraceReplayer(interpreter.ExecutorFactory(), opcodeCosts).ReplayFrame(code, frames[1])
+		require.Nil(t, err)
+		require.NotNil(t, mismatch)
+		require.Equal(t, DivergenceGas, mismatch.Kind)
+		require.Equal(t, 1, mismatch.HookCallIndex)
+	})
+
+	t.Run("hook call", func(t *testing.T) {
+		frames := recordCounterTrace(t)
+		frames[1].HookCalls[0].Args[1].Value = 8
+		mismatch, err := NewTraceReplayer(interpreter.ExecutorFactory(), testOpcodeCosts()).ReplayFrame(code, frames[1])
+		require.Nil(t, err)
+		require.NotNil(t, mismatch)
+		require.Equal(t, DivergenceHookCall, mismatch.Kind)
+		require.Equal(t, "int64storageLoad(1024, 8)", mismatch.Recorded)
+		require.Equal(t, "int64storageLoad(1024, 7)", mismatch.Replayed)
+	})
+
+	t.Run("memory", func(t *testing.T) {
+		frames := recordCounterTrace(t)
+		frames[1].HookCalls[0].Effects[0].Data = encodeTraceData([]byte("COUNTEX"))
+		mismatch, err := NewTraceReplayer(interpreter.ExecutorFactory(), testOpcodeCosts()).ReplayFrame(code, frames[1])
+		require.Nil(t, err)
+		require.NotNil(t, mismatch)
+		require.Equal(t, DivergenceMemory, mismatch.Kind)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		frames := recordCounterTrace(t)
+		frames[1].HookCalls[0].Effects[0].Data = "zz"
+		_, err := NewTraceReplayer(interpreter.ExecutorFactory(), testOpcodeCosts()).ReplayFrame(code, frames[1])
+		require.ErrorIs(t, err, ErrInvalidTrace)
+	})
+}
+
+func TestReadTraceFrames_InvalidLine(t *testing.T) {
+	_, err := ReadTraceFrames(bytes.NewBufferString("{\"function\":\"init\"}\nnot json\n"))
+	require.ErrorIs(t, err, ErrInvalidTrace)
+}
+
+type traceFrameCollector struct {
+	frames []*TraceFrame
+}
+
+func (collector *traceFrameCollector) RecordTraceFrame(frame *TraceFrame) {
+	collector.frames = append(collector.frames, frame)
+}
@@ -0,0 +1,235 @@
+package executorwrapper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// TraceMismatch describes the first difference between a recorded frame and its replay.
+// The kinds are those of the DifferentialExecutor divergences.
+type TraceMismatch struct {
+	Kind         DivergenceKind
+	FunctionName string
+
+	// HookCallIndex is the index of the VM hook call at which the mismatch was observed,
+	// or the total number of hook calls if it was only observed at the end of the call.
+	HookCallIndex int
+	Recorded      string
+	Replayed      string
+}
+
+// Error makes a TraceMismatch usable as an error.
+func (mismatch *TraceMismatch) Error() string {
+	return mismatch.String()
+}
+
+// String yields a multi-line report of the mismatch.
+func (mismatch *TraceMismatch) String() string {
+	return fmt.Sprintf("trace mismatch (%s) in %s, at VM hook call #%d\n\trecorded: %s\n\treplayed: %s\n",
+		mismatch.Kind, mismatch.FunctionName, mismatch.HookCallIndex, mismatch.Recorded, mismatch.Replayed)
+}
+
+// TraceReplayer re-executes recorded frames offline, without a blockchain hook and without VM hooks:
+// each VM hook call made by the contract is checked against the recording, then the recorded effects
+// are applied to the instance and the recorded result is returned to the contract.
+// Nested frames are not re-executed, only their effects on the calling contract are replayed,
+// but they can be replayed separately, with the code of the contract they belong to.
+type TraceReplayer struct {
+	executorFactory executor.ExecutorAbstractFactory
+	opcodeCosts     *executor.WASMOpcodeCost
+}
+
+// NewTraceReplayer creates a TraceReplayer that executes the contracts on the executors of the given factory.
+// The opcode costs must be those of the recording, otherwise the gas will not match.
+func NewTraceReplayer(
+	executorFactory executor.ExecutorAbstractFactory,
+	opcodeCosts *executor.WASMOpcodeCost,
+) *TraceReplayer {
+	return &TraceReplayer{
+		executorFactory: executorFactory,
+		opcodeCosts:     opcodeCosts,
+	}
+}
+
+// ReplayFrame instantiates the contract code and re-executes the recorded frame. The replay starts
+// from a freshly instantiated contract, so the state of the WASM memory left by earlier calls is lost.
+// It returns the first mismatch found, or nil if the replay matches the recording.
+// The error is only set if the replay could not be performed at all.
+func (replayer *TraceReplayer) ReplayFrame(contractCode []byte, frame *TraceFrame) (*TraceMismatch, error) {
+	replay := &traceReplay{frame: frame}
+	replayExecutor, err := replayer.executorFactory.CreateExecutor(executor.ExecutorFactoryArgs{
+		VMHooks:     NewInterceptorVMHooks(replay, nil),
+		OpcodeCosts: replayer.opcodeCosts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	options := frame.Options
+	options.GasLimit = frame.GasLimit
+	instance, err := replayExecutor.NewInstanceWithOptions(contractCode, options)
+	if err != nil {
+		return nil, err
+	}
+	defer instance.Clean()
+
+	replay.instance = instance
+	instance.SetPointsUsed(frame.PointsUsedBefore)
+	instance.SetBreakpointValue(frame.BreakpointValueBefore)
+	callErr := instance.CallFunction(frame.FunctionName)
+	if replay.err != nil {
+		return nil, replay.err
+	}
+	if replay.mismatch == nil {
+		replay.compareOutcome(callErr)
+	}
+	return replay.mismatch, nil
+}
+
+// traceReplay replays the VM hook calls of one frame.
+type traceReplay struct {
+	frame         *TraceFrame
+	instance      executor.Instance
+	hookCallIndex int
+	mismatch      *TraceMismatch
+	err           error
+}
+
+// InterceptVMHookCall checks the VM hook call against the recording and replays its result.
+func (replay *traceReplay) InterceptVMHookCall(call *VMHookCall, _ func() int64) int64 {
+	if replay.mismatch != nil || replay.err != nil {
+		replay.abort()
+		return 0
+	}
+
+	if replay.hookCallIndex >= len(replay.frame.HookCalls) {
+		replay.setMismatch(DivergenceHookCall, "no more VM hook calls", call.String())
+		replay.abort()
+		return 0
+	}
+
+	hookCall := replay.frame.HookCalls[replay.hookCallIndex]
+	recordedCall := hookCall.Call()
+	if recordedCall.String() != call.String() {
+		replay.setMismatch(DivergenceHookCall, recordedCall.String(), call.String())
+		replay.abort()
+		return 0
+	}
+
+	pointsUsed := replay.instance.GetPointsUsed()
+	if pointsUsed != hookCall.PointsUsedBefore {
+		replay.setMismatch(DivergenceGas,
+			fmt.Sprintf("%d points used before %s", hookCall.PointsUsedBefore, call.Name),
+			fmt.Sprintf("%d points used before %s", pointsUsed, call.Name))
+		replay.abort()
+		return 0
+	}
+
+	for _, effect := range hookCall.Effects {
+		if !replay.replayEffect(effect) {
+			replay.abort()
+			return 0
+		}
+	}
+
+	replay.hookCallIndex++
+	return hookCall.Result
+}
+
+func (replay *traceReplay) replayEffect(effect *TraceEffect) bool {
+	switch effect.Kind {
+	case TraceEffectMemLoad, TraceEffectMemStore:
+		data, err := decodeTraceData(effect.Data)
+		if err != nil {
+			replay.err = err
+			return false
+		}
+		return replay.replayMemoryEffect(effect, data)
+	case TraceEffectSetPointsUsed:
+		replay.instance.SetPointsUsed(effect.Value)
+	case TraceEffectSetBreakpointValue:
+		replay.instance.SetBreakpointValue(effect.Value)
+	default:
+		replay.err = fmt.Errorf("%w: unknown effect kind %s", ErrInvalidTrace, effect.Kind)
+		return false
+	}
+	return true
+}
+
+func (replay *traceReplay) replayMemoryEffect(effect *TraceEffect, data []byte) bool {
+	memPtr := executor.MemPtr(effect.Offset)
+	if effect.Kind == TraceEffectMemStore {
+		err := replay.instance.MemStore(memPtr, data)
+		if err != nil {
+			replay.setMismatch(DivergenceMemory, effect.String(), errorDescription(err))
+			return false
+		}
+		return true
+	}
+
+	replayedData, err := replay.instance.MemLoad(memPtr, executor.MemLength(len(data)))
+	if err != nil || !bytes.Equal(replayedData, data) {
+		replay.setMismatch(DivergenceMemory,
+			effect.String(),
+			fmt.Sprintf("loaded %s from offset %d", encodeTraceData(replayedData), effect.Offset))
+		return false
+	}
+	return true
+}
+
+func (replay *traceReplay) compareOutcome(callErr error) {
+	frame := replay.frame
+	if replay.hookCallIndex < len(frame.HookCalls) {
+		replay.setMismatch(DivergenceHookCall,
+			frame.HookCalls[replay.hookCallIndex].Call().String(),
+			"no more VM hook calls")
+		return
+	}
+
+	if (len(frame.Error) > 0) != (callErr != nil) {
+		recordedErr := frame.Error
+		if len(recordedErr) == 0 {
+			recordedErr = errorDescription(nil)
+		}
+		replay.setMismatch(DivergenceError, recordedErr, errorDescription(callErr))
+		return
+	}
+
+	breakpointValue := replay.instance.GetBreakpointValue()
+	if breakpointValue != frame.BreakpointValueAfter {
+		replay.setMismatch(DivergenceBreakpoint,
+			fmt.Sprintf("%d", frame.BreakpointValueAfter),
+			fmt.Sprintf("%d", breakpointValue))
+		return
+	}
+
+	// after running out of gas, the points used are not relevant
+	if breakpointValue != breakpointOutOfGas {
+		pointsUsed := replay.instance.GetPointsUsed()
+		if pointsUsed != frame.PointsUsedAfter {
+			replay.setMismatch(DivergenceGas,
+				fmt.Sprintf("%d points used", frame.PointsUsedAfter),
+				fmt.Sprintf("%d points used", pointsUsed))
+		}
+	}
+}
+
+// abort stops the replayed execution at the next breakpoint check.
+func (replay *traceReplay) abort() {
+	replay.instance.SetBreakpointValue(breakpointExecutionFailed)
+}
+
+func (replay *traceReplay) setMismatch(kind DivergenceKind, recorded string, replayed string) {
+	if replay.mismatch != nil {
+		return
+	}
+	replay.mismatch = &TraceMismatch{
+		Kind:          kind,
+		FunctionName:  replay.frame.FunctionName,
+		HookCallIndex: replay.hookCallIndex,
+		Recorded:      recorded,
+		Replayed:      replayed,
+	}
+}
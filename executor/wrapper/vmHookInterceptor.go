@@ -0,0 +1,63 @@
+package executorwrapper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// VMHookCall describes a call to a VM hook. All arguments are converted to int64.
+type VMHookCall struct {
+	Name string
+	Args []int64
+}
+
+// String yields a human-readable representation of the call, e.g. "bigIntAdd(1, 2, 3)".
+func (call *VMHookCall) String() string {
+	args := make([]string, len(call.Args))
+	for i, arg := range call.Args {
+		args[i] = fmt.Sprintf("%d", arg)
+	}
+	return fmt.Sprintf("%s(%s)", call.Name, strings.Join(args, ", "))
+}
+
+// VMHookInterceptor is notified of every call going through an InterceptorVMHooks.
+// The invoke function calls the wrapped VM hook and returns its result converted to int64,
+// or 0 for VM hooks without a result. The interceptor returns the result seen by the executor.
+type VMHookInterceptor interface {
+	InterceptVMHookCall(call *VMHookCall, invoke func() int64) int64
+}
+
+// VMHookMetadata describes a VM hook. The argument and result types are those of the VMHooks interface:
+// "int32", "int64", "MemPtr" or "MemLength". The result type is empty for VM hooks without a result.
+type VMHookMetadata struct {
+	Family     string
+	ArgNames   []string
+	ArgTypes   []string
+	ResultType string
+}
+
+// GetVMHookMetadata yields the description of the VM hook with the given name, or nil if there is none.
+func GetVMHookMetadata(hookName string) *VMHookMetadata {
+	return vmHookMetadata[hookName]
+}
+
+// VMHookNames yields the names of all the VM hooks, sorted.
+func VMHookNames() []string {
+	names := make([]string, 0, len(vmHookMetadata))
+	for name := range vmHookMetadata {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// VMHookFamily yields the family of a VM hook, which is the name of the vmhooks source file
+// that implements it, e.g. "bigIntOps", "manBufOps" or "cryptoei". It is empty for unknown names.
+func VMHookFamily(hookName string) string {
+	metadata, found := vmHookMetadata[hookName]
+	if !found {
+		return ""
+	}
+	return metadata.Family
+}
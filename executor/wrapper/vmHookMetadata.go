@@ -0,0 +1,1642 @@
+package executorwrapper
+
+// Code generated by vmhooks generator. DO NOT EDIT.
+
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// !!!!!!!!!!!!!!!!!!!!!! AUTO-GENERATED FILE !!!!!!!!!!!!!!!!!!!!!!
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+var vmHookMetadata = map[string]*VMHookMetadata{
+	"getGasLeft": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int64",
+	},
+	"getSCAddress": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "",
+	},
+	"getOwnerAddress": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "",
+	},
+	"getShardOfAddress": {
+		Family:     "baseOps",
+		ArgNames:   []string{"addressOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "int32",
+	},
+	"isSmartContract": {
+		Family:     "baseOps",
+		ArgNames:   []string{"addressOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "int32",
+	},
+	"signalError": {
+		Family:     "baseOps",
+		ArgNames:   []string{"messageOffset", "messageLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "",
+	},
+	"getExternalBalance": {
+		Family:     "baseOps",
+		ArgNames:   []string{"addressOffset", "resultOffset"},
+		ArgTypes:   []string{"MemPtr", "MemPtr"},
+		ResultType: "",
+	},
+	"getBlockHash": {
+		Family:     "baseOps",
+		ArgNames:   []string{"nonce", "resultOffset"},
+		ArgTypes:   []string{"int64", "MemPtr"},
+		ResultType: "int32",
+	},
+	"getESDTBalance": {
+		Family:     "baseOps",
+		ArgNames:   []string{"addressOffset", "tokenIDOffset", "tokenIDLen", "nonce", "resultOffset"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "int64", "MemPtr"},
+		ResultType: "int32",
+	},
+	"getESDTNFTNameLength": {
+		Family:     "baseOps",
+		ArgNames:   []string{"addressOffset", "tokenIDOffset", "tokenIDLen", "nonce"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "int64"},
+		ResultType: "int32",
+	},
+	"getESDTNFTAttributeLength": {
+		Family:     "baseOps",
+		ArgNames:   []string{"addressOffset", "tokenIDOffset", "tokenIDLen", "nonce"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "int64"},
+		ResultType: "int32",
+	},
+	"getESDTNFTURILength": {
+		Family:     "baseOps",
+		ArgNames:   []string{"addressOffset", "tokenIDOffset", "tokenIDLen", "nonce"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "int64"},
+		ResultType: "int32",
+	},
+	"getESDTTokenData": {
+		Family:     "baseOps",
+		ArgNames:   []string{"addressOffset", "tokenIDOffset", "tokenIDLen", "nonce", "valueHandle", "propertiesOffset", "hashOffset", "nameOffset", "attributesOffset", "creatorOffset", "royaltiesHandle", "urisOffset"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "int64", "int32", "MemPtr", "MemPtr", "MemPtr", "MemPtr", "MemPtr", "int32", "MemPtr"},
+		ResultType: "int32",
+	},
+	"getESDTLocalRoles": {
+		Family:     "baseOps",
+		ArgNames:   []string{"tokenIdHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int64",
+	},
+	"validateTokenIdentifier": {
+		Family:     "baseOps",
+		ArgNames:   []string{"tokenIdHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"transferValue": {
+		Family:     "baseOps",
+		ArgNames:   []string{"destOffset", "valueOffset", "dataOffset", "length"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"transferValueExecute": {
+		Family:     "baseOps",
+		ArgNames:   []string{"destOffset", "valueOffset", "gasLimit", "functionOffset", "functionLength", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "int64", "MemPtr", "MemLength", "int32", "MemPtr", "MemPtr"},
+		ResultType: "int32",
+	},
+	"transferESDTExecute": {
+		Family:     "baseOps",
+		ArgNames:   []string{"destOffset", "tokenIDOffset", "tokenIDLen", "valueOffset", "gasLimit", "functionOffset", "functionLength", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "MemPtr", "int64", "MemPtr", "MemLength", "int32", "MemPtr", "MemPtr"},
+		ResultType: "int32",
+	},
+	"transferESDTNFTExecute": {
+		Family:     "baseOps",
+		ArgNames:   []string{"destOffset", "tokenIDOffset", "tokenIDLen", "valueOffset", "nonce", "gasLimit", "functionOffset", "functionLength", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "MemPtr", "int64", "int64", "MemPtr", "MemLength", "int32", "MemPtr", "MemPtr"},
+		ResultType: "int32",
+	},
+	"multiTransferESDTNFTExecute": {
+		Family:     "baseOps",
+		ArgNames:   []string{"destOffset", "numTokenTransfers", "tokenTransfersArgsLengthOffset", "tokenTransferDataOffset", "gasLimit", "functionOffset", "functionLength", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"MemPtr", "int32", "MemPtr", "MemPtr", "int64", "MemPtr", "MemLength", "int32", "MemPtr", "MemPtr"},
+		ResultType: "int32",
+	},
+	"createAsyncCall": {
+		Family:     "baseOps",
+		ArgNames:   []string{"destOffset", "valueOffset", "dataOffset", "dataLength", "successOffset", "successLength", "errorOffset", "errorLength", "gas", "extraGasForCallback"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemPtr", "MemLength", "MemPtr", "MemLength", "MemPtr", "MemLength", "int64", "int64"},
+		ResultType: "int32",
+	},
+	"setAsyncContextCallback": {
+		Family:     "baseOps",
+		ArgNames:   []string{"callback", "callbackLength", "data", "dataLength", "gas"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "MemPtr", "MemLength", "int64"},
+		ResultType: "int32",
+	},
+	"upgradeContract": {
+		Family:     "baseOps",
+		ArgNames:   []string{"destOffset", "gasLimit", "valueOffset", "codeOffset", "codeMetadataOffset", "length", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"MemPtr", "int64", "MemPtr", "MemPtr", "MemPtr", "MemLength", "int32", "MemPtr", "MemPtr"},
+		ResultType: "",
+	},
+	"upgradeFromSourceContract": {
+		Family:     "baseOps",
+		ArgNames:   []string{"destOffset", "gasLimit", "valueOffset", "sourceContractAddressOffset", "codeMetadataOffset", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"MemPtr", "int64", "MemPtr", "MemPtr", "MemPtr", "int32", "MemPtr", "MemPtr"},
+		ResultType: "",
+	},
+	"deleteContract": {
+		Family:     "baseOps",
+		ArgNames:   []string{"destOffset", "gasLimit", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"MemPtr", "int64", "int32", "MemPtr", "MemPtr"},
+		ResultType: "",
+	},
+	"asyncCall": {
+		Family:     "baseOps",
+		ArgNames:   []string{"destOffset", "valueOffset", "dataOffset", "length"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemPtr", "MemLength"},
+		ResultType: "",
+	},
+	"getArgumentLength": {
+		Family:     "baseOps",
+		ArgNames:   []string{"id"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"getArgument": {
+		Family:     "baseOps",
+		ArgNames:   []string{"id", "argOffset"},
+		ArgTypes:   []string{"int32", "MemPtr"},
+		ResultType: "int32",
+	},
+	"getFunction": {
+		Family:     "baseOps",
+		ArgNames:   []string{"functionOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "int32",
+	},
+	"getNumArguments": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int32",
+	},
+	"storageStore": {
+		Family:     "baseOps",
+		ArgNames:   []string{"keyOffset", "keyLength", "dataOffset", "dataLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"storageLoadLength": {
+		Family:     "baseOps",
+		ArgNames:   []string{"keyOffset", "keyLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"storageLoadFromAddress": {
+		Family:     "baseOps",
+		ArgNames:   []string{"addressOffset", "keyOffset", "keyLength", "dataOffset"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "MemPtr"},
+		ResultType: "int32",
+	},
+	"storageLoad": {
+		Family:     "baseOps",
+		ArgNames:   []string{"keyOffset", "keyLength", "dataOffset"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "MemPtr"},
+		ResultType: "int32",
+	},
+	"setStorageLock": {
+		Family:     "baseOps",
+		ArgNames:   []string{"keyOffset", "keyLength", "lockTimestamp"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "int64"},
+		ResultType: "int32",
+	},
+	"getStorageLock": {
+		Family:     "baseOps",
+		ArgNames:   []string{"keyOffset", "keyLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "int64",
+	},
+	"isStorageLocked": {
+		Family:     "baseOps",
+		ArgNames:   []string{"keyOffset", "keyLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"clearStorageLock": {
+		Family:     "baseOps",
+		ArgNames:   []string{"keyOffset", "keyLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"getCaller": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "",
+	},
+	"checkNoPayment": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "",
+	},
+	"getCallValue": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "int32",
+	},
+	"getESDTValue": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "int32",
+	},
+	"getESDTValueByIndex": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultOffset", "index"},
+		ArgTypes:   []string{"MemPtr", "int32"},
+		ResultType: "int32",
+	},
+	"getESDTTokenName": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "int32",
+	},
+	"getESDTTokenNameByIndex": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultOffset", "index"},
+		ArgTypes:   []string{"MemPtr", "int32"},
+		ResultType: "int32",
+	},
+	"getESDTTokenNonce": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int64",
+	},
+	"getESDTTokenNonceByIndex": {
+		Family:     "baseOps",
+		ArgNames:   []string{"index"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int64",
+	},
+	"getCurrentESDTNFTNonce": {
+		Family:     "baseOps",
+		ArgNames:   []string{"addressOffset", "tokenIDOffset", "tokenIDLen"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength"},
+		ResultType: "int64",
+	},
+	"getESDTTokenType": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int32",
+	},
+	"getESDTTokenTypeByIndex": {
+		Family:     "baseOps",
+		ArgNames:   []string{"index"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"getNumESDTTransfers": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int32",
+	},
+	"getCallValueTokenName": {
+		Family:     "baseOps",
+		ArgNames:   []string{"callValueOffset", "tokenNameOffset"},
+		ArgTypes:   []string{"MemPtr", "MemPtr"},
+		ResultType: "int32",
+	},
+	"getCallValueTokenNameByIndex": {
+		Family:     "baseOps",
+		ArgNames:   []string{"callValueOffset", "tokenNameOffset", "index"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "int32"},
+		ResultType: "int32",
+	},
+	"writeLog": {
+		Family:     "baseOps",
+		ArgNames:   []string{"dataPointer", "dataLength", "topicPtr", "numTopics"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "MemPtr", "int32"},
+		ResultType: "",
+	},
+	"writeEventLog": {
+		Family:     "baseOps",
+		ArgNames:   []string{"numTopics", "topicLengthsOffset", "topicOffset", "dataOffset", "dataLength"},
+		ArgTypes:   []string{"int32", "MemPtr", "MemPtr", "MemPtr", "MemLength"},
+		ResultType: "",
+	},
+	"getBlockTimestamp": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int64",
+	},
+	"getBlockNonce": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int64",
+	},
+	"getBlockRound": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int64",
+	},
+	"getBlockEpoch": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int64",
+	},
+	"getBlockRandomSeed": {
+		Family:     "baseOps",
+		ArgNames:   []string{"pointer"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "",
+	},
+	"getStateRootHash": {
+		Family:     "baseOps",
+		ArgNames:   []string{"pointer"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "",
+	},
+	"getPrevBlockTimestamp": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int64",
+	},
+	"getPrevBlockNonce": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int64",
+	},
+	"getPrevBlockRound": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int64",
+	},
+	"getPrevBlockEpoch": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int64",
+	},
+	"getPrevBlockRandomSeed": {
+		Family:     "baseOps",
+		ArgNames:   []string{"pointer"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "",
+	},
+	"finish": {
+		Family:     "baseOps",
+		ArgNames:   []string{"pointer", "length"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "",
+	},
+	"executeOnSameContext": {
+		Family:     "baseOps",
+		ArgNames:   []string{"gasLimit", "addressOffset", "valueOffset", "functionOffset", "functionLength", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"int64", "MemPtr", "MemPtr", "MemPtr", "MemLength", "int32", "MemPtr", "MemPtr"},
+		ResultType: "int32",
+	},
+	"executeOnDestContext": {
+		Family:     "baseOps",
+		ArgNames:   []string{"gasLimit", "addressOffset", "valueOffset", "functionOffset", "functionLength", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"int64", "MemPtr", "MemPtr", "MemPtr", "MemLength", "int32", "MemPtr", "MemPtr"},
+		ResultType: "int32",
+	},
+	"executeReadOnly": {
+		Family:     "baseOps",
+		ArgNames:   []string{"gasLimit", "addressOffset", "functionOffset", "functionLength", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"int64", "MemPtr", "MemPtr", "MemLength", "int32", "MemPtr", "MemPtr"},
+		ResultType: "int32",
+	},
+	"createContract": {
+		Family:     "baseOps",
+		ArgNames:   []string{"gasLimit", "valueOffset", "codeOffset", "codeMetadataOffset", "length", "resultOffset", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"int64", "MemPtr", "MemPtr", "MemPtr", "MemLength", "MemPtr", "int32", "MemPtr", "MemPtr"},
+		ResultType: "int32",
+	},
+	"deployFromSourceContract": {
+		Family:     "baseOps",
+		ArgNames:   []string{"gasLimit", "valueOffset", "sourceContractAddressOffset", "codeMetadataOffset", "resultAddressOffset", "numArguments", "argumentsLengthOffset", "dataOffset"},
+		ArgTypes:   []string{"int64", "MemPtr", "MemPtr", "MemPtr", "MemPtr", "int32", "MemPtr", "MemPtr"},
+		ResultType: "int32",
+	},
+	"getNumReturnData": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int32",
+	},
+	"getReturnDataSize": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultID"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"getReturnData": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultID", "dataOffset"},
+		ArgTypes:   []string{"int32", "MemPtr"},
+		ResultType: "int32",
+	},
+	"cleanReturnData": {
+		Family:     "baseOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "",
+	},
+	"deleteFromReturnData": {
+		Family:     "baseOps",
+		ArgNames:   []string{"resultID"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"getOriginalTxHash": {
+		Family:     "baseOps",
+		ArgNames:   []string{"dataOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "",
+	},
+	"getCurrentTxHash": {
+		Family:     "baseOps",
+		ArgNames:   []string{"dataOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "",
+	},
+	"getPrevTxHash": {
+		Family:     "baseOps",
+		ArgNames:   []string{"dataOffset"},
+		ArgTypes:   []string{"MemPtr"},
+		ResultType: "",
+	},
+	"managedSCAddress": {
+		Family:     "managedei",
+		ArgNames:   []string{"destinationHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"managedOwnerAddress": {
+		Family:     "managedei",
+		ArgNames:   []string{"destinationHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"managedCaller": {
+		Family:     "managedei",
+		ArgNames:   []string{"destinationHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"managedSignalError": {
+		Family:     "managedei",
+		ArgNames:   []string{"errHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"managedWriteLog": {
+		Family:     "managedei",
+		ArgNames:   []string{"topicsHandle", "dataHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"managedGetOriginalTxHash": {
+		Family:     "managedei",
+		ArgNames:   []string{"resultHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"managedGetStateRootHash": {
+		Family:     "managedei",
+		ArgNames:   []string{"resultHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"managedGetBlockRandomSeed": {
+		Family:     "managedei",
+		ArgNames:   []string{"resultHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"managedGetPrevBlockRandomSeed": {
+		Family:     "managedei",
+		ArgNames:   []string{"resultHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"managedGetReturnData": {
+		Family:     "managedei",
+		ArgNames:   []string{"resultID", "resultHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"managedGetMultiESDTCallValue": {
+		Family:     "managedei",
+		ArgNames:   []string{"multiCallValueHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"managedGetESDTBalance": {
+		Family:     "managedei",
+		ArgNames:   []string{"addressHandle", "tokenIDHandle", "nonce", "valueHandle"},
+		ArgTypes:   []string{"int32", "int32", "int64", "int32"},
+		ResultType: "",
+	},
+	"managedGetESDTTokenData": {
+		Family:     "managedei",
+		ArgNames:   []string{"addressHandle", "tokenIDHandle", "nonce", "valueHandle", "propertiesHandle", "hashHandle", "nameHandle", "attributesHandle", "creatorHandle", "royaltiesHandle", "urisHandle"},
+		ArgTypes:   []string{"int32", "int32", "int64", "int32", "int32", "int32", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"managedAsyncCall": {
+		Family:     "managedei",
+		ArgNames:   []string{"destHandle", "valueHandle", "functionHandle", "argumentsHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"managedCreateAsyncCall": {
+		Family:     "managedei",
+		ArgNames:   []string{"destHandle", "valueHandle", "functionHandle", "argumentsHandle", "successOffset", "successLength", "errorOffset", "errorLength", "gas", "extraGasForCallback", "callbackClosureHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32", "MemPtr", "MemLength", "MemPtr", "MemLength", "int64", "int64", "int32"},
+		ResultType: "int32",
+	},
+	"managedCreateAsyncCallWithDeadline": {
+		Family:     "managedei",
+		ArgNames:   []string{"destHandle", "valueHandle", "functionHandle", "argumentsHandle", "successOffset", "successLength", "errorOffset", "errorLength", "gas", "extraGasForCallback", "callbackClosureHandle", "groupHandle", "deadlineRound"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32", "MemPtr", "MemLength", "MemPtr", "MemLength", "int64", "int64", "int32", "int32", "int64"},
+		ResultType: "int32",
+	},
+	"managedCancelAsyncCallGroup": {
+		Family:     "managedei",
+		ArgNames:   []string{"groupHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"managedIsCallbackTimedOut": {
+		Family:     "managedei",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int32",
+	},
+	"managedGetCallbackClosure": {
+		Family:     "managedei",
+		ArgNames:   []string{"callbackClosureHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"managedUpgradeFromSourceContract": {
+		Family:     "managedei",
+		ArgNames:   []string{"destHandle", "gas", "valueHandle", "addressHandle", "codeMetadataHandle", "argumentsHandle", "resultHandle"},
+		ArgTypes:   []string{"int32", "int64", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"managedUpgradeContract": {
+		Family:     "managedei",
+		ArgNames:   []string{"destHandle", "gas", "valueHandle", "codeHandle", "codeMetadataHandle", "argumentsHandle", "resultHandle"},
+		ArgTypes:   []string{"int32", "int64", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"managedDeleteContract": {
+		Family:     "managedei",
+		ArgNames:   []string{"destHandle", "gasLimit", "argumentsHandle"},
+		ArgTypes:   []string{"int32", "int64", "int32"},
+		ResultType: "",
+	},
+	"managedDeployFromSourceContract": {
+		Family:     "managedei",
+		ArgNames:   []string{"gas", "valueHandle", "addressHandle", "codeMetadataHandle", "argumentsHandle", "resultAddressHandle", "resultHandle"},
+		ArgTypes:   []string{"int64", "int32", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedCreateContract": {
+		Family:     "managedei",
+		ArgNames:   []string{"gas", "valueHandle", "codeHandle", "codeMetadataHandle", "argumentsHandle", "resultAddressHandle", "resultHandle"},
+		ArgTypes:   []string{"int64", "int32", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedExecuteReadOnly": {
+		Family:     "managedei",
+		ArgNames:   []string{"gas", "addressHandle", "functionHandle", "argumentsHandle", "resultHandle"},
+		ArgTypes:   []string{"int64", "int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedExecuteOnSameContext": {
+		Family:     "managedei",
+		ArgNames:   []string{"gas", "addressHandle", "valueHandle", "functionHandle", "argumentsHandle", "resultHandle"},
+		ArgTypes:   []string{"int64", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedExecuteOnDestContext": {
+		Family:     "managedei",
+		ArgNames:   []string{"gas", "addressHandle", "valueHandle", "functionHandle", "argumentsHandle", "resultHandle"},
+		ArgTypes:   []string{"int64", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedMultiTransferESDTNFTExecute": {
+		Family:     "managedei",
+		ArgNames:   []string{"dstHandle", "tokenTransfersHandle", "gasLimit", "functionHandle", "argumentsHandle"},
+		ArgTypes:   []string{"int32", "int32", "int64", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedTransferValueExecute": {
+		Family:     "managedei",
+		ArgNames:   []string{"dstHandle", "valueHandle", "gasLimit", "functionHandle", "argumentsHandle"},
+		ArgTypes:   []string{"int32", "int32", "int64", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedIsESDTFrozen": {
+		Family:     "managedei",
+		ArgNames:   []string{"addressHandle", "tokenIDHandle", "nonce"},
+		ArgTypes:   []string{"int32", "int32", "int64"},
+		ResultType: "int32",
+	},
+	"managedIsESDTLimitedTransfer": {
+		Family:     "managedei",
+		ArgNames:   []string{"tokenIDHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"managedIsESDTPaused": {
+		Family:     "managedei",
+		ArgNames:   []string{"tokenIDHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"managedBufferToHex": {
+		Family:     "managedei",
+		ArgNames:   []string{"sourceHandle", "destHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatNewFromParts": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"integralPart", "fractionalPart", "exponent"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"bigFloatNewFromFrac": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"numerator", "denominator"},
+		ArgTypes:   []string{"int64", "int64"},
+		ResultType: "int32",
+	},
+	"bigFloatNewFromSci": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"significand", "exponent"},
+		ArgTypes:   []string{"int64", "int64"},
+		ResultType: "int32",
+	},
+	"bigFloatAdd": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatSub": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatMul": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatDiv": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatNeg": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatClone": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatCmp": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"bigFloatAbs": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatSign": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"opHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"bigFloatSqrt": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatPow": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "opHandle", "exponent"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatFloor": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destBigIntHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatCeil": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destBigIntHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatTruncate": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destBigIntHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatSetInt64": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "value"},
+		ArgTypes:   []string{"int32", "int64"},
+		ResultType: "",
+	},
+	"bigFloatIsInt": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"opHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"bigFloatSetBigInt": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle", "bigIntHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigFloatGetConstPi": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"bigFloatGetConstE": {
+		Family:     "bigFloatOps",
+		ArgNames:   []string{"destinationHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"bigIntGetUnsignedArgument": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"id", "destinationHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntGetSignedArgument": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"id", "destinationHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntStorageStoreUnsigned": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"keyOffset", "keyLength", "sourceHandle"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "int32"},
+		ResultType: "int32",
+	},
+	"bigIntStorageLoadUnsigned": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"keyOffset", "keyLength", "destinationHandle"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "int32"},
+		ResultType: "int32",
+	},
+	"bigIntGetCallValue": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"bigIntGetESDTCallValue": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destination"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"bigIntGetESDTCallValueByIndex": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "index"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntGetExternalBalance": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"addressOffset", "result"},
+		ArgTypes:   []string{"MemPtr", "int32"},
+		ResultType: "",
+	},
+	"bigIntGetESDTExternalBalance": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"addressOffset", "tokenIDOffset", "tokenIDLen", "nonce", "resultHandle"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "int64", "int32"},
+		ResultType: "",
+	},
+	"bigIntNew": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"smallValue"},
+		ArgTypes:   []string{"int64"},
+		ResultType: "int32",
+	},
+	"bigIntUnsignedByteLength": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"referenceHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"bigIntSignedByteLength": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"referenceHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"bigIntGetUnsignedBytes": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"referenceHandle", "byteOffset"},
+		ArgTypes:   []string{"int32", "MemPtr"},
+		ResultType: "int32",
+	},
+	"bigIntGetSignedBytes": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"referenceHandle", "byteOffset"},
+		ArgTypes:   []string{"int32", "MemPtr"},
+		ResultType: "int32",
+	},
+	"bigIntSetUnsignedBytes": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "byteOffset", "byteLength"},
+		ArgTypes:   []string{"int32", "MemPtr", "MemLength"},
+		ResultType: "",
+	},
+	"bigIntSetSignedBytes": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "byteOffset", "byteLength"},
+		ArgTypes:   []string{"int32", "MemPtr", "MemLength"},
+		ResultType: "",
+	},
+	"bigIntIsInt64": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"bigIntGetInt64": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int64",
+	},
+	"bigIntSetInt64": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "value"},
+		ArgTypes:   []string{"int32", "int64"},
+		ResultType: "",
+	},
+	"bigIntAdd": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntSub": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntMul": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntTDiv": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntTMod": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntEDiv": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntEMod": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntSqrt": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntPow": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntLog2": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"op1Handle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"bigIntAbs": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntNeg": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntSign": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"opHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"bigIntCmp": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"bigIntNot": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "opHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntAnd": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntOr": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntXor": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "op1Handle", "op2Handle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntShr": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "opHandle", "bits"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntShl": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"destinationHandle", "opHandle", "bits"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"bigIntFinishUnsigned": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"referenceHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"bigIntFinishSigned": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"referenceHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "",
+	},
+	"bigIntToString": {
+		Family:     "bigIntOps",
+		ArgNames:   []string{"bigIntHandle", "destinationHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "",
+	},
+	"mBufferNew": {
+		Family:     "manBufOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int32",
+	},
+	"mBufferNewFromBytes": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"dataOffset", "dataLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"mBufferGetLength": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"mBufferGetBytes": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle", "resultOffset"},
+		ArgTypes:   []string{"int32", "MemPtr"},
+		ResultType: "int32",
+	},
+	"mBufferGetByteSlice": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"sourceHandle", "startingPosition", "sliceLength", "resultOffset"},
+		ArgTypes:   []string{"int32", "int32", "int32", "MemPtr"},
+		ResultType: "int32",
+	},
+	"mBufferCopyByteSlice": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"sourceHandle", "startingPosition", "sliceLength", "destinationHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferEq": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle1", "mBufferHandle2"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferSetBytes": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle", "dataOffset", "dataLength"},
+		ArgTypes:   []string{"int32", "MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"mBufferSetByteSlice": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle", "startingPosition", "dataLength", "dataOffset"},
+		ArgTypes:   []string{"int32", "int32", "MemLength", "MemPtr"},
+		ResultType: "int32",
+	},
+	"mBufferAppend": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"accumulatorHandle", "dataHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferAppendBytes": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"accumulatorHandle", "dataOffset", "dataLength"},
+		ArgTypes:   []string{"int32", "MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"mBufferToBigIntUnsigned": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle", "bigIntHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferToBigIntSigned": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle", "bigIntHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferFromBigIntUnsigned": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle", "bigIntHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferFromBigIntSigned": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle", "bigIntHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferToBigFloat": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle", "bigFloatHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferFromBigFloat": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"mBufferHandle", "bigFloatHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferStorageStore": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"keyHandle", "sourceHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferStorageLoad": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"keyHandle", "destinationHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferStorageLoadFromAddress": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"addressHandle", "keyHandle", "destinationHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"mBufferGetArgument": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"id", "destinationHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"mBufferFinish": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"sourceHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"mBufferSetRandom": {
+		Family:     "manBufOps",
+		ArgNames:   []string{"destinationHandle", "length"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedMapNew": {
+		Family:     "manMapOps",
+		ArgNames:   []string{},
+		ArgTypes:   []string{},
+		ResultType: "int32",
+	},
+	"managedMapPut": {
+		Family:     "manMapOps",
+		ArgNames:   []string{"mMapHandle", "keyHandle", "valueHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedMapGet": {
+		Family:     "manMapOps",
+		ArgNames:   []string{"mMapHandle", "keyHandle", "outValueHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedMapRemove": {
+		Family:     "manMapOps",
+		ArgNames:   []string{"mMapHandle", "keyHandle", "outValueHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedMapContains": {
+		Family:     "manMapOps",
+		ArgNames:   []string{"mMapHandle", "keyHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"smallIntGetUnsignedArgument": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"id"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int64",
+	},
+	"smallIntGetSignedArgument": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"id"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int64",
+	},
+	"smallIntFinishUnsigned": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"value"},
+		ArgTypes:   []string{"int64"},
+		ResultType: "",
+	},
+	"smallIntFinishSigned": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"value"},
+		ArgTypes:   []string{"int64"},
+		ResultType: "",
+	},
+	"smallIntStorageStoreUnsigned": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"keyOffset", "keyLength", "value"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "int64"},
+		ResultType: "int32",
+	},
+	"smallIntStorageStoreSigned": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"keyOffset", "keyLength", "value"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "int64"},
+		ResultType: "int32",
+	},
+	"smallIntStorageLoadUnsigned": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"keyOffset", "keyLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "int64",
+	},
+	"smallIntStorageLoadSigned": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"keyOffset", "keyLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "int64",
+	},
+	"int64getArgument": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"id"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int64",
+	},
+	"int64finish": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"value"},
+		ArgTypes:   []string{"int64"},
+		ResultType: "",
+	},
+	"int64storageStore": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"keyOffset", "keyLength", "value"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "int64"},
+		ResultType: "int32",
+	},
+	"int64storageLoad": {
+		Family:     "smallIntOps",
+		ArgNames:   []string{"keyOffset", "keyLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "int64",
+	},
+	"sha256": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"dataOffset", "length", "resultOffset"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "MemPtr"},
+		ResultType: "int32",
+	},
+	"managedSha256": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"inputHandle", "outputHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"keccak256": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"dataOffset", "length", "resultOffset"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "MemPtr"},
+		ResultType: "int32",
+	},
+	"managedKeccak256": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"inputHandle", "outputHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"ripemd160": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"dataOffset", "length", "resultOffset"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "MemPtr"},
+		ResultType: "int32",
+	},
+	"managedRipemd160": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"inputHandle", "outputHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedSha512": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"inputHandle", "outputHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedSha3256": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"inputHandle", "outputHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedSha3512": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"inputHandle", "outputHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedBlake2b256": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"inputHandle", "outputHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedBlake2b512": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"inputHandle", "outputHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedPoseidon": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"inputHandle", "outputHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"verifyBLS": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keyOffset", "messageOffset", "messageLength", "sigOffset"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "MemPtr"},
+		ResultType: "int32",
+	},
+	"managedVerifyBLS": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keyHandle", "messageHandle", "sigHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedVerifyBLSAggregated": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keysHandle", "messageHandle", "sigHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedAggregateBLSPublicKeys": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keysHandle", "resultHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+	"verifyEd25519": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keyOffset", "messageOffset", "messageLength", "sigOffset"},
+		ArgTypes:   []string{"MemPtr", "MemPtr", "MemLength", "MemPtr"},
+		ResultType: "int32",
+	},
+	"managedVerifyEd25519": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keyHandle", "messageHandle", "sigHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedVerifyEd25519Batch": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keysHandle", "messagesHandle", "sigsHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedVerifyEd25519BatchBitmap": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keysHandle", "messagesHandle", "sigsHandle", "resultHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"verifyCustomSecp256k1": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keyOffset", "keyLength", "messageOffset", "messageLength", "sigOffset", "hashType"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "MemPtr", "MemLength", "MemPtr", "int32"},
+		ResultType: "int32",
+	},
+	"managedVerifyCustomSecp256k1": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keyHandle", "messageHandle", "sigHandle", "hashType"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"verifySecp256k1": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keyOffset", "keyLength", "messageOffset", "messageLength", "sigOffset"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "MemPtr", "MemLength", "MemPtr"},
+		ResultType: "int32",
+	},
+	"managedVerifySecp256k1": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keyHandle", "messageHandle", "sigHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedVerifySecp256r1": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keyHandle", "messageHandle", "sigHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedVerifySecp256r1Batch": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keysHandle", "messagesHandle", "sigsHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedVerifySecp256r1BatchBitmap": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"keysHandle", "messagesHandle", "sigsHandle", "resultHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"encodeSecp256k1DerSignature": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"rOffset", "rLength", "sOffset", "sLength", "sigOffset"},
+		ArgTypes:   []string{"MemPtr", "MemLength", "MemPtr", "MemLength", "MemPtr"},
+		ResultType: "int32",
+	},
+	"managedEncodeSecp256k1DerSignature": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"rHandle", "sHandle", "sigHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"addEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xResultHandle", "yResultHandle", "ecHandle", "fstPointXHandle", "fstPointYHandle", "sndPointXHandle", "sndPointYHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"doubleEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xResultHandle", "yResultHandle", "ecHandle", "pointXHandle", "pointYHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32", "int32"},
+		ResultType: "",
+	},
+	"isOnCurveEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"ecHandle", "pointXHandle", "pointYHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"scalarBaseMultEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xResultHandle", "yResultHandle", "ecHandle", "dataOffset", "length"},
+		ArgTypes:   []string{"int32", "int32", "int32", "MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"managedScalarBaseMultEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xResultHandle", "yResultHandle", "ecHandle", "dataHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"scalarMultEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xResultHandle", "yResultHandle", "ecHandle", "pointXHandle", "pointYHandle", "dataOffset", "length"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32", "int32", "MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"managedScalarMultEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xResultHandle", "yResultHandle", "ecHandle", "pointXHandle", "pointYHandle", "dataHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"marshalEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xPairHandle", "yPairHandle", "ecHandle", "resultOffset"},
+		ArgTypes:   []string{"int32", "int32", "int32", "MemPtr"},
+		ResultType: "int32",
+	},
+	"managedMarshalEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xPairHandle", "yPairHandle", "ecHandle", "resultHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"marshalCompressedEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xPairHandle", "yPairHandle", "ecHandle", "resultOffset"},
+		ArgTypes:   []string{"int32", "int32", "int32", "MemPtr"},
+		ResultType: "int32",
+	},
+	"managedMarshalCompressedEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xPairHandle", "yPairHandle", "ecHandle", "resultHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"unmarshalEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xResultHandle", "yResultHandle", "ecHandle", "dataOffset", "length"},
+		ArgTypes:   []string{"int32", "int32", "int32", "MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"managedUnmarshalEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xResultHandle", "yResultHandle", "ecHandle", "dataHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"unmarshalCompressedEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xResultHandle", "yResultHandle", "ecHandle", "dataOffset", "length"},
+		ArgTypes:   []string{"int32", "int32", "int32", "MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"managedUnmarshalCompressedEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xResultHandle", "yResultHandle", "ecHandle", "dataHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"generateKeyEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xPubKeyHandle", "yPubKeyHandle", "ecHandle", "resultOffset"},
+		ArgTypes:   []string{"int32", "int32", "int32", "MemPtr"},
+		ResultType: "int32",
+	},
+	"managedGenerateKeyEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"xPubKeyHandle", "yPubKeyHandle", "ecHandle", "resultHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"createEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"dataOffset", "dataLength"},
+		ArgTypes:   []string{"MemPtr", "MemLength"},
+		ResultType: "int32",
+	},
+	"managedCreateEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"dataHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"managedCreateCustomEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"fieldOrderHandle", "basePointOrderHandle", "eqCoefficientHandle", "eqConstantHandle", "xBasePointHandle", "yBasePointHandle", "sizeOfField"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"getCurveLengthEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"ecHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"getPrivKeyByteLengthEC": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"ecHandle"},
+		ArgTypes:   []string{"int32"},
+		ResultType: "int32",
+	},
+	"ellipticCurveGetValues": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"ecHandle", "fieldOrderHandle", "basePointOrderHandle", "eqConstantHandle", "xBasePointHandle", "yBasePointHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32", "int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedAddBN254G1": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"point1Handle", "point2Handle", "resultHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedScalarMulBN254G1": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"pointHandle", "scalarHandle", "resultHandle"},
+		ArgTypes:   []string{"int32", "int32", "int32"},
+		ResultType: "int32",
+	},
+	"managedPairingCheckBN254": {
+		Family:     "cryptoei",
+		ArgNames:   []string{"g1PointsHandle", "g2PointsHandle"},
+		ArgTypes:   []string{"int32", "int32"},
+		ResultType: "int32",
+	},
+}
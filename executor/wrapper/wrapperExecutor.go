@@ -74,6 +74,11 @@ func (wexec *WrapperExecutor) GetContractInstances(code []byte) []executor.Insta
 	return wexec.WrappedInstances[string(code)]
 }
 
+// CompiledCodeVersion wraps the call to the underlying executor.
+func (wexec *WrapperExecutor) CompiledCodeVersion() string {
+	return executor.CompiledCodeVersion(wexec.wrappedExecutor)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (wexec *WrapperExecutor) IsInterfaceNil() bool {
 	return wexec == nil
@@ -838,6 +838,33 @@ func (w *WrapperVMHooks) ManagedCreateAsyncCall(destHandle int32, valueHandle in
 	return result
 }
 
+// ManagedCreateAsyncCallWithDeadline VM hook wrapper
+func (w *WrapperVMHooks) ManagedCreateAsyncCallWithDeadline(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset executor.MemPtr, successLength executor.MemLength, errorOffset executor.MemPtr, errorLength executor.MemLength, gas int64, extraGasForCallback int64, callbackClosureHandle int32, groupHandle int32, deadlineRound int64) int32 {
+	callInfo := fmt.Sprintf("ManagedCreateAsyncCallWithDeadline(%d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d)", destHandle, valueHandle, functionHandle, argumentsHandle, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback, callbackClosureHandle, groupHandle, deadlineRound)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedCreateAsyncCallWithDeadline(destHandle, valueHandle, functionHandle, argumentsHandle, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback, callbackClosureHandle, groupHandle, deadlineRound)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedCancelAsyncCallGroup VM hook wrapper
+func (w *WrapperVMHooks) ManagedCancelAsyncCallGroup(groupHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedCancelAsyncCallGroup(%d)", groupHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedCancelAsyncCallGroup(groupHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedIsCallbackTimedOut VM hook wrapper
+func (w *WrapperVMHooks) ManagedIsCallbackTimedOut() int32 {
+	callInfo := "ManagedIsCallbackTimedOut()"
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedIsCallbackTimedOut()
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
 // ManagedGetCallbackClosure VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetCallbackClosure(callbackClosureHandle int32) {
 	callInfo := fmt.Sprintf("ManagedGetCallbackClosure(%d)", callbackClosureHandle)
@@ -1908,6 +1935,60 @@ func (w *WrapperVMHooks) ManagedRipemd160(inputHandle int32, outputHandle int32)
 	return result
 }
 
+// ManagedSha512 VM hook wrapper
+func (w *WrapperVMHooks) ManagedSha512(inputHandle int32, outputHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedSha512(%d, %d)", inputHandle, outputHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedSha512(inputHandle, outputHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedSha3256 VM hook wrapper
+func (w *WrapperVMHooks) ManagedSha3256(inputHandle int32, outputHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedSha3256(%d, %d)", inputHandle, outputHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedSha3256(inputHandle, outputHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedSha3512 VM hook wrapper
+func (w *WrapperVMHooks) ManagedSha3512(inputHandle int32, outputHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedSha3512(%d, %d)", inputHandle, outputHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedSha3512(inputHandle, outputHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedBlake2b256 VM hook wrapper
+func (w *WrapperVMHooks) ManagedBlake2b256(inputHandle int32, outputHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedBlake2b256(%d, %d)", inputHandle, outputHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedBlake2b256(inputHandle, outputHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedBlake2b512 VM hook wrapper
+func (w *WrapperVMHooks) ManagedBlake2b512(inputHandle int32, outputHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedBlake2b512(%d, %d)", inputHandle, outputHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedBlake2b512(inputHandle, outputHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedPoseidon VM hook wrapper
+func (w *WrapperVMHooks) ManagedPoseidon(inputHandle int32, outputHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedPoseidon(%d, %d)", inputHandle, outputHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedPoseidon(inputHandle, outputHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
 // VerifyBLS VM hook wrapper
 func (w *WrapperVMHooks) VerifyBLS(keyOffset executor.MemPtr, messageOffset executor.MemPtr, messageLength executor.MemLength, sigOffset executor.MemPtr) int32 {
 	callInfo := fmt.Sprintf("VerifyBLS(%d, %d, %d, %d)", keyOffset, messageOffset, messageLength, sigOffset)
@@ -1926,6 +2007,24 @@ func (w *WrapperVMHooks) ManagedVerifyBLS(keyHandle int32, messageHandle int32,
 	return result
 }
 
+// ManagedVerifyBLSAggregated VM hook wrapper
+func (w *WrapperVMHooks) ManagedVerifyBLSAggregated(keysHandle int32, messageHandle int32, sigHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedVerifyBLSAggregated(%d, %d, %d)", keysHandle, messageHandle, sigHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedVerifyBLSAggregated(keysHandle, messageHandle, sigHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedAggregateBLSPublicKeys VM hook wrapper
+func (w *WrapperVMHooks) ManagedAggregateBLSPublicKeys(keysHandle int32, resultHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedAggregateBLSPublicKeys(%d, %d)", keysHandle, resultHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedAggregateBLSPublicKeys(keysHandle, resultHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
 // VerifyEd25519 VM hook wrapper
 func (w *WrapperVMHooks) VerifyEd25519(keyOffset executor.MemPtr, messageOffset executor.MemPtr, messageLength executor.MemLength, sigOffset executor.MemPtr) int32 {
 	callInfo := fmt.Sprintf("VerifyEd25519(%d, %d, %d, %d)", keyOffset, messageOffset, messageLength, sigOffset)
@@ -1944,6 +2043,24 @@ func (w *WrapperVMHooks) ManagedVerifyEd25519(keyHandle int32, messageHandle int
 	return result
 }
 
+// ManagedVerifyEd25519Batch VM hook wrapper
+func (w *WrapperVMHooks) ManagedVerifyEd25519Batch(keysHandle int32, messagesHandle int32, sigsHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedVerifyEd25519Batch(%d, %d, %d)", keysHandle, messagesHandle, sigsHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedVerifyEd25519Batch(keysHandle, messagesHandle, sigsHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedVerifyEd25519BatchBitmap VM hook wrapper
+func (w *WrapperVMHooks) ManagedVerifyEd25519BatchBitmap(keysHandle int32, messagesHandle int32, sigsHandle int32, resultHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedVerifyEd25519BatchBitmap(%d, %d, %d, %d)", keysHandle, messagesHandle, sigsHandle, resultHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedVerifyEd25519BatchBitmap(keysHandle, messagesHandle, sigsHandle, resultHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
 // VerifyCustomSecp256k1 VM hook wrapper
 func (w *WrapperVMHooks) VerifyCustomSecp256k1(keyOffset executor.MemPtr, keyLength executor.MemLength, messageOffset executor.MemPtr, messageLength executor.MemLength, sigOffset executor.MemPtr, hashType int32) int32 {
 	callInfo := fmt.Sprintf("VerifyCustomSecp256k1(%d, %d, %d, %d, %d, %d)", keyOffset, keyLength, messageOffset, messageLength, sigOffset, hashType)
@@ -1980,6 +2097,33 @@ func (w *WrapperVMHooks) ManagedVerifySecp256k1(keyHandle int32, messageHandle i
 	return result
 }
 
+// ManagedVerifySecp256r1 VM hook wrapper
+func (w *WrapperVMHooks) ManagedVerifySecp256r1(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedVerifySecp256r1(%d, %d, %d)", keyHandle, messageHandle, sigHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedVerifySecp256r1(keyHandle, messageHandle, sigHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedVerifySecp256r1Batch VM hook wrapper
+func (w *WrapperVMHooks) ManagedVerifySecp256r1Batch(keysHandle int32, messagesHandle int32, sigsHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedVerifySecp256r1Batch(%d, %d, %d)", keysHandle, messagesHandle, sigsHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedVerifySecp256r1Batch(keysHandle, messagesHandle, sigsHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedVerifySecp256r1BatchBitmap VM hook wrapper
+func (w *WrapperVMHooks) ManagedVerifySecp256r1BatchBitmap(keysHandle int32, messagesHandle int32, sigsHandle int32, resultHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedVerifySecp256r1BatchBitmap(%d, %d, %d, %d)", keysHandle, messagesHandle, sigsHandle, resultHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedVerifySecp256r1BatchBitmap(keysHandle, messagesHandle, sigsHandle, resultHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
 // EncodeSecp256k1DerSignature VM hook wrapper
 func (w *WrapperVMHooks) EncodeSecp256k1DerSignature(rOffset executor.MemPtr, rLength executor.MemLength, sOffset executor.MemPtr, sLength executor.MemLength, sigOffset executor.MemPtr) int32 {
 	callInfo := fmt.Sprintf("EncodeSecp256k1DerSignature(%d, %d, %d, %d, %d)", rOffset, rLength, sOffset, sLength, sigOffset)
@@ -2167,6 +2311,15 @@ func (w *WrapperVMHooks) ManagedCreateEC(dataHandle int32) int32 {
 	return result
 }
 
+// ManagedCreateCustomEC VM hook wrapper
+func (w *WrapperVMHooks) ManagedCreateCustomEC(fieldOrderHandle int32, basePointOrderHandle int32, eqCoefficientHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32, sizeOfField int32) int32 {
+	callInfo := fmt.Sprintf("ManagedCreateCustomEC(%d, %d, %d, %d, %d, %d, %d)", fieldOrderHandle, basePointOrderHandle, eqCoefficientHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle, sizeOfField)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedCreateCustomEC(fieldOrderHandle, basePointOrderHandle, eqCoefficientHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle, sizeOfField)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
 // GetCurveLengthEC VM hook wrapper
 func (w *WrapperVMHooks) GetCurveLengthEC(ecHandle int32) int32 {
 	callInfo := fmt.Sprintf("GetCurveLengthEC(%d)", ecHandle)
@@ -2193,3 +2346,30 @@ func (w *WrapperVMHooks) EllipticCurveGetValues(ecHandle int32, fieldOrderHandle
 	w.logger.LogVMHookCallAfter(callInfo)
 	return result
 }
+
+// ManagedAddBN254G1 VM hook wrapper
+func (w *WrapperVMHooks) ManagedAddBN254G1(point1Handle int32, point2Handle int32, resultHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedAddBN254G1(%d, %d, %d)", point1Handle, point2Handle, resultHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedAddBN254G1(point1Handle, point2Handle, resultHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedScalarMulBN254G1 VM hook wrapper
+func (w *WrapperVMHooks) ManagedScalarMulBN254G1(pointHandle int32, scalarHandle int32, resultHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedScalarMulBN254G1(%d, %d, %d)", pointHandle, scalarHandle, resultHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedScalarMulBN254G1(pointHandle, scalarHandle, resultHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedPairingCheckBN254 VM hook wrapper
+func (w *WrapperVMHooks) ManagedPairingCheckBN254(g1PointsHandle int32, g2PointsHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedPairingCheckBN254(%d, %d)", g1PointsHandle, g2PointsHandle)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedPairingCheckBN254(g1PointsHandle, g2PointsHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
@@ -4,15 +4,15 @@ package delegation
 import (
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"math/big"
 	"strings"
+	"testing"
 
 	fr "github.com/multiversx/mx-chain-scenario-go/fileresolver"
 	mjparse "github.com/multiversx/mx-chain-scenario-go/json/parse"
-	mjwrite "github.com/multiversx/mx-chain-scenario-go/json/write"
 	mj "github.com/multiversx/mx-chain-scenario-go/model"
 	vmi "github.com/multiversx/mx-chain-vm-common-go"
+	fuzzutil "github.com/multiversx/mx-chain-vm-go/fuzz/util"
 	worldhook "github.com/multiversx/mx-chain-vm-go/mock/world"
 	am "github.com/multiversx/mx-chain-vm-go/scenarioexec"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
@@ -40,7 +40,7 @@ type fuzzDelegationExecutor struct {
 	totalStakeAdded             *big.Int
 	totalStakeWithdrawn         *big.Int
 	totalRewards                *big.Int
-	generatedScenario           *mj.Scenario
+	recorder                    *fuzzutil.ScenarioRecorder
 }
 
 func newFuzzDelegationExecutor(fileResolver fr.FileResolver) (*fuzzDelegationExecutor, error) {
@@ -59,9 +59,7 @@ func newFuzzDelegationExecutor(fileResolver fr.FileResolver) (*fuzzDelegationExe
 		totalStakeAdded:     big.NewInt(0),
 		totalStakeWithdrawn: big.NewInt(0),
 		totalRewards:        big.NewInt(0),
-		generatedScenario: &mj.Scenario{
-			Name: "fuzz generated",
-		},
+		recorder:            fuzzutil.NewScenarioRecorder("fuzz generated", mj.GasScheduleDefault),
 	}, nil
 }
 
@@ -79,18 +77,23 @@ type fuzzDelegationExecutorInitArgs struct {
 }
 
 func (pfe *fuzzDelegationExecutor) addStep(step mj.Step) {
-	pfe.generatedScenario.Steps = append(pfe.generatedScenario.Steps, step)
+	pfe.recorder.RecordStep(step)
 }
 
-func (pfe *fuzzDelegationExecutor) saveGeneratedScenario() {
+// saveGeneratedScenario writes the smallest scenario which reproduces the failure, if the fuzz test failed
+func (pfe *fuzzDelegationExecutor) saveGeneratedScenario(t *testing.T) {
 	vmHost := pfe.vm.(vmhost.VMHost)
 	vmHost.Reset()
-	serialized := mjwrite.ScenarioToJSONString(pfe.generatedScenario)
+	if !t.Failed() {
+		return
+	}
 
-	err := ioutil.WriteFile("fuzz_gen.scen.json", []byte(serialized), 0644)
+	err := pfe.recorder.WriteMinimalScenario("fuzz_failure.scen.json")
 	if err != nil {
 		fmt.Println(err)
+		return
 	}
+	pfe.log("failing scenario written to fuzz_failure.scen.json")
 }
 
 func (pfe *fuzzDelegationExecutor) nextTxIndex() int {
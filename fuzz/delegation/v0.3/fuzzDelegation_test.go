@@ -50,7 +50,7 @@ func TestFuzzDelegation(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	pfe := newExecutorWithPaths()
-	defer pfe.saveGeneratedScenario()
+	defer pfe.saveGeneratedScenario(t)
 
 	err := pfe.init(&fuzzDelegationExecutorInitArgs{
 		serviceFee:                  r.Intn(10000),
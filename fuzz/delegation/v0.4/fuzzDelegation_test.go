@@ -49,7 +49,7 @@ func TestFuzzDelegation_v0_4_0_genesis(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	pfe := newExecutorWithPaths()
-	defer pfe.saveGeneratedScenario()
+	defer pfe.saveGeneratedScenario(t)
 
 	err := pfe.init(&fuzzDelegationExecutorInitArgs{
 		serviceFee:                  r.Intn(10000),
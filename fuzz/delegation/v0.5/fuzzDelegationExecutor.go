@@ -5,7 +5,6 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"math/big"
 	"math/rand"
 	"strings"
@@ -13,9 +12,9 @@ import (
 
 	fr "github.com/multiversx/mx-chain-scenario-go/fileresolver"
 	mjparse "github.com/multiversx/mx-chain-scenario-go/json/parse"
-	mjwrite "github.com/multiversx/mx-chain-scenario-go/json/write"
 	mj "github.com/multiversx/mx-chain-scenario-go/model"
 	vmi "github.com/multiversx/mx-chain-vm-common-go"
+	fuzzutil "github.com/multiversx/mx-chain-vm-go/fuzz/util"
 	worldhook "github.com/multiversx/mx-chain-vm-go/mock/world"
 	am "github.com/multiversx/mx-chain-vm-go/scenarioexec"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
@@ -65,7 +64,7 @@ type fuzzDelegationExecutor struct {
 	totalStakeAdded             *big.Int
 	totalStakeWithdrawn         *big.Int
 	totalRewards                *big.Int
-	generatedScenario           *mj.Scenario
+	recorder                    *fuzzutil.ScenarioRecorder
 }
 
 func newFuzzDelegationExecutor(fileResolver fr.FileResolver) (*fuzzDelegationExecutor, error) {
@@ -92,10 +91,7 @@ func newFuzzDelegationExecutor(fileResolver fr.FileResolver) (*fuzzDelegationExe
 		totalStakeAdded:     big.NewInt(0),
 		totalStakeWithdrawn: big.NewInt(0),
 		totalRewards:        big.NewInt(0),
-		generatedScenario: &mj.Scenario{
-			Name:        "fuzz generated",
-			GasSchedule: scenGasSchedule,
-		},
+		recorder:            fuzzutil.NewScenarioRecorder("fuzz generated", scenGasSchedule),
 	}, nil
 }
 
@@ -110,18 +106,23 @@ func (pfe *fuzzDelegationExecutor) executeStep(stepSnippet string) error {
 }
 
 func (pfe *fuzzDelegationExecutor) addStep(step mj.Step) {
-	pfe.generatedScenario.Steps = append(pfe.generatedScenario.Steps, step)
+	pfe.recorder.RecordStep(step)
 }
 
-func (pfe *fuzzDelegationExecutor) saveGeneratedScenario() {
+// saveGeneratedScenario writes the smallest scenario which reproduces the failure, if the fuzz test failed
+func (pfe *fuzzDelegationExecutor) saveGeneratedScenario(t *testing.T) {
 	vmHost := pfe.vm.(vmhost.VMHost)
 	vmHost.Reset()
-	serialized := mjwrite.ScenarioToJSONString(pfe.generatedScenario)
+	if !t.Failed() {
+		return
+	}
 
-	err := ioutil.WriteFile("fuzz_gen.scen.json", []byte(serialized), 0644)
+	err := pfe.recorder.WriteMinimalScenario("fuzz_failure.scen.json")
 	if err != nil {
 		fmt.Println(err)
+		return
 	}
+	pfe.log("failing scenario written to fuzz_failure.scen.json")
 }
 
 func (pfe *fuzzDelegationExecutor) executeTxStep(stepSnippet string) (*vmi.VMOutput, error) {
@@ -52,7 +52,7 @@ func TestFuzzDelegation_v0_5(t *testing.T) {
 	}
 
 	pfe := newExecutorWithPaths()
-	defer pfe.saveGeneratedScenario()
+	defer pfe.saveGeneratedScenario(t)
 
 	var seed int64
 	if *seedFlag == 0 {
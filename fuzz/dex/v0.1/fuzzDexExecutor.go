@@ -4,13 +4,13 @@ package dex
 import (
 	"errors"
 	"fmt"
-	"io/ioutil"
+	"testing"
 
 	fr "github.com/multiversx/mx-chain-scenario-go/fileresolver"
 	mjparse "github.com/multiversx/mx-chain-scenario-go/json/parse"
-	mjwrite "github.com/multiversx/mx-chain-scenario-go/json/write"
 	mj "github.com/multiversx/mx-chain-scenario-go/model"
 	vmi "github.com/multiversx/mx-chain-vm-common-go"
+	fuzzutil "github.com/multiversx/mx-chain-vm-go/fuzz/util"
 	worldhook "github.com/multiversx/mx-chain-vm-go/mock/world"
 	am "github.com/multiversx/mx-chain-vm-go/scenarioexec"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
@@ -115,7 +115,7 @@ type fuzzDexExecutor struct {
 	tokensCheckFrequency    int
 	currentFarmTokenNonce   map[string]int
 	farmers                 map[int]FarmerInfo
-	generatedScenario       *mj.Scenario
+	recorder                *fuzzutil.ScenarioRecorder
 	farms                   [3]Farm
 	swaps                   [2]SwapPair
 }
@@ -173,23 +173,24 @@ func newFuzzDexExecutor(fileResolver fr.FileResolver) (*fuzzDexExecutor, error)
 		vm:             vmTestExecutor.GetVM(),
 		parser:         parser,
 		txIndex:        0,
-		generatedScenario: &mj.Scenario{
-			Name:        "fuzz generated",
-			GasSchedule: scenGasSchedule,
-		},
+		recorder:       fuzzutil.NewScenarioRecorder("fuzz generated", scenGasSchedule),
 	}, nil
 }
 
-func (pfe *fuzzDexExecutor) saveGeneratedScenario() {
+// saveGeneratedScenario writes the smallest scenario which reproduces the failure, if the fuzz test failed
+func (pfe *fuzzDexExecutor) saveGeneratedScenario(t *testing.T) {
 	vmHost := pfe.vm.(vmhost.VMHost)
 	vmHost.Reset()
+	if !t.Failed() {
+		return
+	}
 
-	serialized := mjwrite.ScenarioToJSONString(pfe.generatedScenario)
-
-	err := ioutil.WriteFile("fuzz_gen.scen.json", []byte(serialized), 0644)
+	err := pfe.recorder.WriteMinimalScenario("fuzz_failure.scen.json")
 	if err != nil {
 		fmt.Println(err)
+		return
 	}
+	pfe.log("failing scenario written to fuzz_failure.scen.json")
 }
 
 func (pfe *fuzzDexExecutor) executeStep(stepSnippet string) error {
@@ -203,7 +204,7 @@ func (pfe *fuzzDexExecutor) executeStep(stepSnippet string) error {
 }
 
 func (pfe *fuzzDexExecutor) addStep(step mj.Step) {
-	pfe.generatedScenario.Steps = append(pfe.generatedScenario.Steps, step)
+	pfe.recorder.RecordStep(step)
 }
 
 func (pfe *fuzzDexExecutor) executeTxStep(stepSnippet string) (*vmi.VMOutput, error) {
@@ -49,7 +49,7 @@ func TestFuzzDex_v0_1(t *testing.T) {
 	}
 
 	pfe := newExecutorWithPaths()
-	defer pfe.saveGeneratedScenario()
+	defer pfe.saveGeneratedScenario(t)
 
 	var seed int64
 	if *seedFlag == 0 {
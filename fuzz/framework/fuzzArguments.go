@@ -0,0 +1,51 @@
+package fuzzframework
+
+import (
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+	"strconv"
+)
+
+// ConstantArgument always generates the given expression.
+func ConstantArgument(expression string) ArgumentGenerator {
+	return func(_ *rand.Rand) string {
+		return expression
+	}
+}
+
+// UintArgument generates a number between 0 and max, both included.
+func UintArgument(max uint64) ArgumentGenerator {
+	return BigUintArgument(big.NewInt(0).SetUint64(max))
+}
+
+// BigUintArgument generates a number between 0 and max, both included.
+func BigUintArgument(max *big.Int) ArgumentGenerator {
+	limit := big.NewInt(0).Add(max, big.NewInt(1))
+	return func(r *rand.Rand) string {
+		return big.NewInt(0).Rand(r, limit).String()
+	}
+}
+
+// ChoiceArgument generates one of the given expressions, e.g. one of several addresses.
+func ChoiceArgument(expressions ...string) ArgumentGenerator {
+	return func(r *rand.Rand) string {
+		return expressions[r.Intn(len(expressions))]
+	}
+}
+
+// BytesArgument generates random bytes, of a length between 0 and maxLength, both included.
+func BytesArgument(maxLength int) ArgumentGenerator {
+	return func(r *rand.Rand) string {
+		data := make([]byte, r.Intn(maxLength+1))
+		_, _ = r.Read(data)
+		return "0x" + hex.EncodeToString(data)
+	}
+}
+
+// BoolArgument generates true or false.
+func BoolArgument() ArgumentGenerator {
+	return func(r *rand.Rand) string {
+		return strconv.FormatBool(r.Intn(2) == 1)
+	}
+}
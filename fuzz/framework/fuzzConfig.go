@@ -0,0 +1,130 @@
+// Package fuzzframework runs random sequences of transactions against smart contracts described by a Config,
+// checks invariants after every step, shrinks the sequences which break them and writes them as scenarios.
+package fuzzframework
+
+import (
+	"errors"
+	"math/rand"
+
+	fr "github.com/multiversx/mx-chain-scenario-go/fileresolver"
+	mj "github.com/multiversx/mx-chain-scenario-go/model"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// ErrNilFileResolver signals that the config has no file resolver
+var ErrNilFileResolver = errors.New("nil file resolver")
+
+// ErrNoAccounts signals that the config has no accounts to send transactions from
+var ErrNoAccounts = errors.New("no accounts")
+
+// ErrNoActions signals that the config has no endpoints and no actions with a positive weight
+var ErrNoActions = errors.New("no actions to choose from")
+
+// ErrNegativeWeight signals an endpoint or an action with a negative weight
+var ErrNegativeWeight = errors.New("negative weight")
+
+// ErrUnknownOwner signals a contract whose owner is not among the accounts of the config
+var ErrUnknownOwner = errors.New("contract owner is not among the accounts")
+
+// Config describes what is fuzzed: the accounts and contracts set up before every run,
+// the endpoints and the actions chosen at random, and the invariants checked after every step.
+type Config struct {
+	Name         string
+	FileResolver fr.FileResolver
+	GasSchedule  mj.GasSchedule
+	// OverrideVMExecutor replaces the default executor of the VM, if set
+	OverrideVMExecutor executor.ExecutorAbstractFactory
+
+	Accounts   []*Account
+	Contracts  []*Contract
+	Actions    []*Action
+	Invariants []*Invariant
+
+	// NumSteps is the number of random steps of a run
+	NumSteps int
+}
+
+// Account is a user account created before every run. Fields are scenario expressions.
+type Account struct {
+	Address string
+	Balance string
+}
+
+// Contract is a contract deployed by its owner before every run. Fields are scenario expressions.
+type Contract struct {
+	Address         string
+	Owner           string
+	Code            string
+	DeployArguments []string
+	DeployGasLimit  uint64
+	Endpoints       []*Endpoint
+}
+
+// Endpoint describes how the fuzzer calls an endpoint of a contract.
+type Endpoint struct {
+	Name   string
+	Weight int
+	// Callers are the addresses the endpoint is called from, all the accounts if empty
+	Callers []string
+	// Value generates the EGLD value of the call, which is 0 if not set
+	Value     ArgumentGenerator
+	Arguments []ArgumentGenerator
+	GasLimit  uint64
+	// ExpectSuccess makes a call that does not end with status 0 break the run
+	ExpectSuccess bool
+}
+
+// ArgumentGenerator returns a random argument, as a scenario expression.
+type ArgumentGenerator func(r *rand.Rand) string
+
+// Action is a weighted random step other than an endpoint call, e.g. advancing the block nonce.
+type Action struct {
+	Name   string
+	Weight int
+	// Generate returns the step, as a JSON scenario step
+	Generate func(r *rand.Rand, state *State) (string, error)
+}
+
+// Invariant is a property checked after every step of a run.
+type Invariant struct {
+	Name  string
+	Check func(state *State) error
+}
+
+func (config *Config) validate() error {
+	if config.FileResolver == nil {
+		return ErrNilFileResolver
+	}
+	if len(config.Accounts) == 0 {
+		return ErrNoAccounts
+	}
+
+	accounts := make(map[string]bool)
+	for _, account := range config.Accounts {
+		accounts[account.Address] = true
+	}
+
+	totalWeight := 0
+	for _, contract := range config.Contracts {
+		if !accounts[contract.Owner] {
+			return ErrUnknownOwner
+		}
+		for _, endpoint := range contract.Endpoints {
+			if endpoint.Weight < 0 {
+				return ErrNegativeWeight
+			}
+			totalWeight += endpoint.Weight
+		}
+	}
+	for _, action := range config.Actions {
+		if action.Weight < 0 {
+			return ErrNegativeWeight
+		}
+		totalWeight += action.Weight
+	}
+	if totalWeight == 0 {
+		return ErrNoActions
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package fuzzframework
+
+import (
+	mj "github.com/multiversx/mx-chain-scenario-go/model"
+)
+
+// ShrinkSteps removes steps from a failing sequence for as long as it still fails, and returns the smallest
+// failing sequence found. Chunks of steps are removed first, from half of the sequence down to single steps.
+func ShrinkSteps(steps []mj.Step, stillFails func([]mj.Step) bool) []mj.Step {
+	current := steps
+	for chunkSize := len(current) / 2; chunkSize >= 1; {
+		shrunk := false
+		for start := 0; start+chunkSize <= len(current); start++ {
+			candidate := make([]mj.Step, 0, len(current)-chunkSize)
+			candidate = append(candidate, current[:start]...)
+			candidate = append(candidate, current[start+chunkSize:]...)
+			if stillFails(candidate) {
+				current = candidate
+				shrunk = true
+				break
+			}
+		}
+		if !shrunk {
+			chunkSize /= 2
+		} else if chunkSize > len(current)/2 {
+			chunkSize = len(current) / 2
+		}
+	}
+	return current
+}
@@ -0,0 +1,32 @@
+package fuzzframework
+
+import (
+	"testing"
+
+	mj "github.com/multiversx/mx-chain-scenario-go/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShrinkSteps(t *testing.T) {
+	steps := make([]mj.Step, 0)
+	for i := 0; i < 20; i++ {
+		steps = append(steps, &mj.DumpStateStep{Comment: string(rune('a' + i))})
+	}
+
+	// fails whenever both the 4th and the 17th steps are present
+	containsBoth := func(candidate []mj.Step) bool {
+		found := 0
+		for _, step := range candidate {
+			if step == steps[3] || step == steps[16] {
+				found++
+			}
+		}
+		return found == 2
+	}
+	shrunk := ShrinkSteps(steps, containsBoth)
+	require.Equal(t, []mj.Step{steps[3], steps[16]}, shrunk)
+	require.Len(t, steps, 20)
+
+	shrunk = ShrinkSteps(steps, func(_ []mj.Step) bool { return false })
+	require.Equal(t, steps, shrunk)
+}
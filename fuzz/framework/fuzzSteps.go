@@ -0,0 +1,167 @@
+package fuzzframework
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strconv"
+)
+
+type accountStepJSON struct {
+	Nonce   string `json:"nonce"`
+	Balance string `json:"balance"`
+}
+
+type newAddressStepJSON struct {
+	CreatorAddress string `json:"creatorAddress"`
+	CreatorNonce   string `json:"creatorNonce"`
+	NewAddress     string `json:"newAddress"`
+}
+
+type setStateStepJSON struct {
+	Step         string                      `json:"step"`
+	Comment      string                      `json:"comment,omitempty"`
+	Accounts     map[string]*accountStepJSON `json:"accounts"`
+	NewAddresses []*newAddressStepJSON       `json:"newAddresses,omitempty"`
+}
+
+type txJSON struct {
+	From         string   `json:"from,omitempty"`
+	To           string   `json:"to,omitempty"`
+	Value        string   `json:"value,omitempty"`
+	Function     string   `json:"function,omitempty"`
+	ContractCode string   `json:"contractCode,omitempty"`
+	Arguments    []string `json:"arguments"`
+	GasLimit     string   `json:"gasLimit,omitempty"`
+	GasPrice     string   `json:"gasPrice,omitempty"`
+}
+
+type expectJSON struct {
+	Out     string `json:"out"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Logs    string `json:"logs"`
+	Gas     string `json:"gas"`
+	Refund  string `json:"refund"`
+}
+
+type txStepJSON struct {
+	Step   string      `json:"step"`
+	TxID   string      `json:"txId"`
+	Tx     *txJSON     `json:"tx"`
+	Expect *expectJSON `json:"expect,omitempty"`
+}
+
+// expectSuccess accepts any outcome of a transaction, as long as it ends with status 0
+var expectSuccess = &expectJSON{
+	Out:     "*",
+	Status:  "0",
+	Message: "*",
+	Logs:    "*",
+	Gas:     "*",
+	Refund:  "*",
+}
+
+// setupSteps creates the accounts and deploys the contracts of the config.
+func (config *Config) setupSteps() ([]string, error) {
+	setState := &setStateStepJSON{
+		Step:     "setState",
+		Comment:  "fuzz setup",
+		Accounts: make(map[string]*accountStepJSON),
+	}
+	for _, account := range config.Accounts {
+		setState.Accounts[account.Address] = &accountStepJSON{
+			Nonce:   "0",
+			Balance: account.Balance,
+		}
+	}
+
+	deploySteps := make([]string, 0, len(config.Contracts))
+	ownerNonces := make(map[string]int)
+	for index, contract := range config.Contracts {
+		setState.NewAddresses = append(setState.NewAddresses, &newAddressStepJSON{
+			CreatorAddress: contract.Owner,
+			CreatorNonce:   strconv.Itoa(ownerNonces[contract.Owner]),
+			NewAddress:     contract.Address,
+		})
+		ownerNonces[contract.Owner]++
+
+		deployStep, err := marshalStep(&txStepJSON{
+			Step: "scDeploy",
+			TxID: "deploy-" + strconv.Itoa(index+1),
+			Tx: &txJSON{
+				From:         contract.Owner,
+				Value:        "0",
+				ContractCode: contract.Code,
+				Arguments:    nonNilArguments(contract.DeployArguments),
+				GasLimit:     strconv.FormatUint(contract.DeployGasLimit, 10),
+				GasPrice:     "0",
+			},
+			Expect: expectSuccess,
+		})
+		if err != nil {
+			return nil, err
+		}
+		deploySteps = append(deploySteps, deployStep)
+	}
+
+	setStateStep, err := marshalStep(setState)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{setStateStep}, deploySteps...), nil
+}
+
+// endpointCallStep generates a random call to an endpoint.
+func (config *Config) endpointCallStep(r *rand.Rand, contract *Contract, endpoint *Endpoint, txID int) (string, error) {
+	callers := endpoint.Callers
+	if len(callers) == 0 {
+		callers = make([]string, 0, len(config.Accounts))
+		for _, account := range config.Accounts {
+			callers = append(callers, account.Address)
+		}
+	}
+
+	value := "0"
+	if endpoint.Value != nil {
+		value = endpoint.Value(r)
+	}
+
+	arguments := make([]string, 0, len(endpoint.Arguments))
+	for _, generateArgument := range endpoint.Arguments {
+		arguments = append(arguments, generateArgument(r))
+	}
+
+	step := &txStepJSON{
+		Step: "scCall",
+		TxID: strconv.Itoa(txID),
+		Tx: &txJSON{
+			From:      callers[r.Intn(len(callers))],
+			To:        contract.Address,
+			Value:     value,
+			Function:  endpoint.Name,
+			Arguments: arguments,
+			GasLimit:  strconv.FormatUint(endpoint.GasLimit, 10),
+			GasPrice:  "0",
+		},
+	}
+	if endpoint.ExpectSuccess {
+		step.Expect = expectSuccess
+	}
+	return marshalStep(step)
+}
+
+func marshalStep(step interface{}) (string, error) {
+	serialized, err := json.Marshal(step)
+	if err != nil {
+		return "", fmt.Errorf("cannot serialize step: %w", err)
+	}
+	return string(serialized), nil
+}
+
+func nonNilArguments(arguments []string) []string {
+	if arguments == nil {
+		return make([]string, 0)
+	}
+	return arguments
+}
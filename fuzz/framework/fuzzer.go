@@ -0,0 +1,283 @@
+package fuzzframework
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"strconv"
+
+	mjparse "github.com/multiversx/mx-chain-scenario-go/json/parse"
+	mjwrite "github.com/multiversx/mx-chain-scenario-go/json/write"
+	mj "github.com/multiversx/mx-chain-scenario-go/model"
+	"github.com/multiversx/mx-chain-vm-go/fuzz/weightedroulette"
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
+	"github.com/multiversx/mx-chain-vm-go/scenarioexec"
+)
+
+// State gives actions and invariants access to the world of the run.
+type State struct {
+	World *worldmock.MockWorld
+
+	executor   *scenarioexec.VMTestExecutor
+	parser     mjparse.Parser
+	numQueries int
+}
+
+// Query calls a view function of a contract and returns its results. Queries are not part of the generated
+// sequence of steps, so they must not change the state.
+func (state *State) Query(to string, function string, arguments ...string) ([][]byte, error) {
+	state.numQueries++
+	stepSnippet, err := marshalStep(&txStepJSON{
+		Step: "scQuery",
+		TxID: "query-" + strconv.Itoa(state.numQueries),
+		Tx: &txJSON{
+			To:        to,
+			Function:  function,
+			Arguments: nonNilArguments(arguments),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	step, err := state.parser.ParseScenarioStep(stepSnippet)
+	if err != nil {
+		return nil, err
+	}
+	txStep, isTx := step.(*mj.TxStep)
+	if !isTx {
+		return nil, fmt.Errorf("query of %s is not a tx step", function)
+	}
+
+	output, err := state.executor.ExecuteTxStep(txStep)
+	if err != nil {
+		return nil, err
+	}
+	if output.ReturnCode != 0 {
+		return nil, fmt.Errorf("query of %s failed: %s", function, output.ReturnMessage)
+	}
+	return output.ReturnData, nil
+}
+
+// Interpret evaluates a scenario expression, e.g. an address.
+func (state *State) Interpret(expression string) ([]byte, error) {
+	return state.parser.ExprInterpreter.InterpretString(expression)
+}
+
+// Failure is a sequence of steps which breaks an invariant or fails a step expected to succeed.
+type Failure struct {
+	Seed int64
+	Err  error
+	// Steps is the shrunk sequence of steps, without the setup steps
+	Steps                   []mj.Step
+	NumStepsBeforeShrinking int
+	// Scenario replays the failure, setup included
+	Scenario *mj.Scenario
+}
+
+// WriteScenario writes the scenario which replays the failure.
+func (failure *Failure) WriteScenario(path string) error {
+	serialized := mjwrite.ScenarioToJSONString(failure.Scenario)
+	return ioutil.WriteFile(path, []byte(serialized), 0644)
+}
+
+// Fuzzer runs random sequences of steps, as described by its config.
+type Fuzzer struct {
+	config     *Config
+	parser     mjparse.Parser
+	setupSteps []mj.Step
+}
+
+// NewFuzzer validates the config and creates a Fuzzer.
+func NewFuzzer(config *Config) (*Fuzzer, error) {
+	err := config.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	fuzzer := &Fuzzer{
+		config: config,
+		parser: mjparse.NewParser(config.FileResolver),
+	}
+	setupSnippets, err := config.setupSteps()
+	if err != nil {
+		return nil, err
+	}
+	for _, stepSnippet := range setupSnippets {
+		step, err := fuzzer.parser.ParseScenarioStep(stepSnippet)
+		if err != nil {
+			return nil, err
+		}
+		fuzzer.setupSteps = append(fuzzer.setupSteps, step)
+	}
+
+	return fuzzer, nil
+}
+
+// Run runs a random sequence of steps generated from the given seed. The same seed always generates the same
+// sequence. If the sequence fails, it is shrunk and the returned Failure holds the smallest failing sequence
+// found. The error is only returned when the run cannot be carried out, e.g. when the setup fails.
+func (fuzzer *Fuzzer) Run(seed int64) (*Failure, error) {
+	state, err := fuzzer.newState()
+	if err != nil {
+		return nil, err
+	}
+	defer state.executor.Close()
+
+	err = fuzzer.executeSetup(state)
+	if err != nil {
+		return nil, err
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	steps := make([]mj.Step, 0, fuzzer.config.NumSteps)
+	var stepErr error
+	for txID := 1; txID <= fuzzer.config.NumSteps; txID++ {
+		stepSnippet, err := fuzzer.generateStep(r, state, txID)
+		if err != nil {
+			return nil, err
+		}
+		step, err := fuzzer.parser.ParseScenarioStep(stepSnippet)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+
+		stepErr = fuzzer.executeStep(state, step, len(steps))
+		if stepErr != nil {
+			break
+		}
+	}
+	if stepErr == nil {
+		return nil, nil
+	}
+
+	shrunkSteps := ShrinkSteps(steps, func(candidate []mj.Step) bool {
+		return fuzzer.Replay(candidate) != nil
+	})
+	failure := &Failure{
+		Seed:                    seed,
+		Err:                     fuzzer.Replay(shrunkSteps),
+		Steps:                   shrunkSteps,
+		NumStepsBeforeShrinking: len(steps),
+	}
+	if failure.Err == nil {
+		// the failure does not reproduce on a fresh world, so the whole sequence is kept
+		failure.Err = stepErr
+		failure.Steps = steps
+	}
+	failure.Scenario = fuzzer.failureScenario(failure)
+
+	return failure, nil
+}
+
+// Replay runs the setup and the given steps on a fresh world, checking the invariants after each step,
+// and returns the first failure.
+func (fuzzer *Fuzzer) Replay(steps []mj.Step) error {
+	state, err := fuzzer.newState()
+	if err != nil {
+		return err
+	}
+	defer state.executor.Close()
+
+	err = fuzzer.executeSetup(state)
+	if err != nil {
+		return err
+	}
+	for index, step := range steps {
+		err = fuzzer.executeStep(state, step, index+1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fuzzer *Fuzzer) newState() (*State, error) {
+	executor, err := scenarioexec.NewVMTestExecutor()
+	if err != nil {
+		return nil, err
+	}
+	executor.OverrideVMExecutor = fuzzer.config.OverrideVMExecutor
+	err = executor.InitVM(fuzzer.config.GasSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &State{
+		World:    executor.World,
+		executor: executor,
+		parser:   fuzzer.parser,
+	}, nil
+}
+
+func (fuzzer *Fuzzer) executeSetup(state *State) error {
+	for _, step := range fuzzer.setupSteps {
+		err := state.executor.ExecuteStep(step)
+		if err != nil {
+			return fmt.Errorf("fuzz setup failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (fuzzer *Fuzzer) executeStep(state *State, step mj.Step, stepIndex int) error {
+	err := state.executor.ExecuteStep(step)
+	if err != nil {
+		return fmt.Errorf("step %d failed: %w", stepIndex, err)
+	}
+
+	for _, invariant := range fuzzer.config.Invariants {
+		err = invariant.Check(state)
+		if err != nil {
+			return fmt.Errorf("invariant '%s' broken after step %d: %w", invariant.Name, stepIndex, err)
+		}
+	}
+	return nil
+}
+
+func (fuzzer *Fuzzer) generateStep(r *rand.Rand, state *State, txID int) (string, error) {
+	var stepSnippet string
+	var err error
+
+	outcomes := make([]weightedroulette.Outcome, 0)
+	for _, contract := range fuzzer.config.Contracts {
+		for _, endpoint := range contract.Endpoints {
+			contract, endpoint := contract, endpoint
+			outcomes = append(outcomes, weightedroulette.Outcome{
+				Weight: endpoint.Weight,
+				Event: func() {
+					stepSnippet, err = fuzzer.config.endpointCallStep(r, contract, endpoint, txID)
+				},
+			})
+		}
+	}
+	for _, action := range fuzzer.config.Actions {
+		action := action
+		outcomes = append(outcomes, weightedroulette.Outcome{
+			Weight: action.Weight,
+			Event: func() {
+				stepSnippet, err = action.Generate(r, state)
+				if err != nil {
+					err = fmt.Errorf("action '%s' failed: %w", action.Name, err)
+				}
+			},
+		})
+	}
+	weightedroulette.RandomChoice(r, outcomes...)
+
+	return stepSnippet, err
+}
+
+func (fuzzer *Fuzzer) failureScenario(failure *Failure) *mj.Scenario {
+	steps := make([]mj.Step, 0, len(fuzzer.setupSteps)+len(failure.Steps))
+	steps = append(steps, fuzzer.setupSteps...)
+	steps = append(steps, failure.Steps...)
+
+	return &mj.Scenario{
+		Name:        fuzzer.config.Name,
+		Comment:     fmt.Sprintf("fuzz seed %d, shrunk from %d steps: %s", failure.Seed, failure.NumStepsBeforeShrinking, failure.Err),
+		CheckGas:    true,
+		GasSchedule: fuzzer.config.GasSchedule,
+		Steps:       steps,
+	}
+}
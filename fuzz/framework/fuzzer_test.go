@@ -0,0 +1,217 @@
+package fuzzframework
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	fr "github.com/multiversx/mx-chain-scenario-go/fileresolver"
+	mjparse "github.com/multiversx/mx-chain-scenario-go/json/parse"
+	mj "github.com/multiversx/mx-chain-scenario-go/model"
+	"github.com/multiversx/mx-chain-vm-go/interpreter"
+	"github.com/multiversx/mx-chain-vm-go/scenarioexec"
+	"github.com/stretchr/testify/require"
+)
+
+const adderAddress = "sc:adder"
+
+func newAdderFileResolver(t *testing.T) fr.FileResolver {
+	adderPath, err := filepath.Abs("../../test/adder/output/adder.wasm")
+	require.Nil(t, err)
+	return fr.NewDefaultFileResolver().ReplacePath("adder.wasm", adderPath)
+}
+
+func newAdderConfig(t *testing.T, invariants ...*Invariant) *Config {
+	return &Config{
+		Name:               "adder fuzz",
+		FileResolver:       newAdderFileResolver(t),
+		GasSchedule:        mj.GasScheduleV4,
+		OverrideVMExecutor: interpreter.ExecutorFactory(),
+		Accounts: []*Account{
+			{Address: "address:owner", Balance: "0"},
+			{Address: "address:user", Balance: "0"},
+		},
+		Contracts: []*Contract{
+			{
+				Address:         adderAddress,
+				Owner:           "address:owner",
+				Code:            "file:adder.wasm",
+				DeployArguments: []string{"0"},
+				DeployGasLimit:  5000000,
+				Endpoints: []*Endpoint{
+					{
+						Name:          "add",
+						Weight:        3,
+						Arguments:     []ArgumentGenerator{UintArgument(100)},
+						GasLimit:      5000000,
+						ExpectSuccess: true,
+					},
+				},
+			},
+		},
+		Actions: []*Action{
+			{
+				Name:   "advance block nonce",
+				Weight: 1,
+				Generate: func(r *rand.Rand, state *State) (string, error) {
+					return fmt.Sprintf(`{"step": "setState", "currentBlockInfo": {"blockNonce": "%d"}}`, r.Intn(1000)), nil
+				},
+			},
+		},
+		Invariants: invariants,
+		NumSteps:   50,
+	}
+}
+
+func adderSumInvariant(max int64) *Invariant {
+	return &Invariant{
+		Name: fmt.Sprintf("sum is at most %d", max),
+		Check: func(state *State) error {
+			results, err := state.Query(adderAddress, "getSum")
+			if err != nil {
+				return err
+			}
+			sum := big.NewInt(0).SetBytes(results[0])
+			if sum.Cmp(big.NewInt(max)) > 0 {
+				return fmt.Errorf("sum is %d", sum)
+			}
+			return nil
+		},
+	}
+}
+
+func TestFuzzer_InvalidConfig(t *testing.T) {
+	config := newAdderConfig(t)
+	config.FileResolver = nil
+	_, err := NewFuzzer(config)
+	require.Equal(t, ErrNilFileResolver, err)
+
+	config = newAdderConfig(t)
+	config.Accounts = nil
+	_, err = NewFuzzer(config)
+	require.Equal(t, ErrNoAccounts, err)
+
+	config = newAdderConfig(t)
+	config.Contracts[0].Owner = "address:nobody"
+	_, err = NewFuzzer(config)
+	require.Equal(t, ErrUnknownOwner, err)
+
+	config = newAdderConfig(t)
+	config.Actions[0].Weight = -1
+	_, err = NewFuzzer(config)
+	require.Equal(t, ErrNegativeWeight, err)
+
+	config = newAdderConfig(t)
+	config.Contracts[0].Endpoints[0].Weight = 0
+	config.Actions = nil
+	_, err = NewFuzzer(config)
+	require.Equal(t, ErrNoActions, err)
+}
+
+func TestFuzzer_InvariantHolds(t *testing.T) {
+	fuzzer, err := NewFuzzer(newAdderConfig(t, adderSumInvariant(100*50)))
+	require.Nil(t, err)
+
+	for seed := int64(1); seed <= 3; seed++ {
+		failure, err := fuzzer.Run(seed)
+		require.Nil(t, err)
+		require.Nil(t, failure)
+	}
+}
+
+func TestFuzzer_InvariantBroken(t *testing.T) {
+	fuzzer, err := NewFuzzer(newAdderConfig(t, adderSumInvariant(500)))
+	require.Nil(t, err)
+
+	failure, err := fuzzer.Run(1)
+	require.Nil(t, err)
+	require.NotNil(t, failure)
+	require.Contains(t, failure.Err.Error(), "invariant 'sum is at most 500' broken")
+	require.Less(t, len(failure.Steps), failure.NumStepsBeforeShrinking)
+
+	// the shrunk sequence only holds calls to add, and removing any of them fixes it
+	for index, step := range failure.Steps {
+		txStep, isTx := step.(*mj.TxStep)
+		require.True(t, isTx)
+		require.Equal(t, "add", txStep.Tx.Function)
+
+		candidate := append(append([]mj.Step{}, failure.Steps[:index]...), failure.Steps[index+1:]...)
+		require.Nil(t, fuzzer.Replay(candidate))
+	}
+	require.NotNil(t, fuzzer.Replay(failure.Steps))
+
+	// the scenario replays the failure
+	scenarioPath := filepath.Join(t.TempDir(), "adder-fuzz.scen.json")
+	require.Nil(t, failure.WriteScenario(scenarioPath))
+	checkScenarioBreaksAdderSum(t, scenarioPath, 500)
+}
+
+func TestFuzzer_ExpectedSuccess(t *testing.T) {
+	config := newAdderConfig(t)
+	config.Contracts[0].Endpoints[0].Name = "missingEndpoint"
+	fuzzer, err := NewFuzzer(config)
+	require.Nil(t, err)
+
+	failure, err := fuzzer.Run(1)
+	require.Nil(t, err)
+	require.NotNil(t, failure)
+	require.Contains(t, failure.Err.Error(), "step 1 failed")
+	require.Len(t, failure.Steps, 1)
+}
+
+func TestFuzzer_ActionError(t *testing.T) {
+	expectedErr := errors.New("expected error")
+	config := newAdderConfig(t)
+	config.Contracts[0].Endpoints[0].Weight = 0
+	config.Actions[0].Generate = func(_ *rand.Rand, _ *State) (string, error) {
+		return "", expectedErr
+	}
+	fuzzer, err := NewFuzzer(config)
+	require.Nil(t, err)
+
+	failure, err := fuzzer.Run(1)
+	require.Nil(t, failure)
+	require.True(t, errors.Is(err, expectedErr))
+}
+
+func TestArgumentGenerators(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	require.Equal(t, "5", ConstantArgument("5")(r))
+	require.Contains(t, []string{"a", "b"}, ChoiceArgument("a", "b")(r))
+	require.Contains(t, []string{"true", "false"}, BoolArgument()(r))
+
+	for i := 0; i < 100; i++ {
+		value, isNumber := big.NewInt(0).SetString(UintArgument(10)(r), 10)
+		require.True(t, isNumber)
+		require.True(t, value.Cmp(big.NewInt(10)) <= 0)
+
+		bytesArgument := BytesArgument(4)(r)
+		require.LessOrEqual(t, len(bytesArgument), len("0x")+8)
+	}
+}
+
+func checkScenarioBreaksAdderSum(t *testing.T, scenarioPath string, max int64) {
+	fileResolver := newAdderFileResolver(t)
+	scenarioJSON, err := ioutil.ReadFile(scenarioPath)
+	require.Nil(t, err)
+	parser := mjparse.NewParser(fileResolver)
+	scenario, err := parser.ParseScenarioFile(scenarioJSON)
+	require.Nil(t, err)
+
+	executor, err := scenarioexec.NewVMTestExecutor()
+	require.Nil(t, err)
+	executor.OverrideVMExecutor = interpreter.ExecutorFactory()
+	defer executor.Close()
+	require.Nil(t, executor.RunScenario(scenario, fileResolver))
+
+	state := &State{
+		World:    executor.World,
+		executor: executor,
+		parser:   parser,
+	}
+	require.NotNil(t, adderSumInvariant(max).Check(state))
+}
@@ -0,0 +1,132 @@
+package fuzzutil
+
+import (
+	"fmt"
+
+	mc "github.com/multiversx/mx-chain-scenario-go/controller"
+	mj "github.com/multiversx/mx-chain-scenario-go/model"
+	fuzzframework "github.com/multiversx/mx-chain-vm-go/fuzz/framework"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/scenarioexec"
+)
+
+// ScenarioRecorder records the steps executed by a fuzzer, so that a failing run can be written as a scenario.
+type ScenarioRecorder struct {
+	// OverrideVMExecutor replaces the default executor of the VM when replaying the steps, if set
+	OverrideVMExecutor executor.ExecutorAbstractFactory
+
+	name        string
+	gasSchedule mj.GasSchedule
+	steps       []mj.Step
+}
+
+// NewScenarioRecorder creates a ScenarioRecorder for scenarios run with the given gas schedule.
+func NewScenarioRecorder(name string, gasSchedule mj.GasSchedule) *ScenarioRecorder {
+	return &ScenarioRecorder{
+		name:        name,
+		gasSchedule: gasSchedule,
+		steps:       make([]mj.Step, 0),
+	}
+}
+
+// RecordStep records an executed step. State dumps are left out, since they do not change the state.
+func (recorder *ScenarioRecorder) RecordStep(step mj.Step) {
+	if _, isDump := step.(*mj.DumpStateStep); isDump {
+		return
+	}
+	recorder.steps = append(recorder.steps, step)
+}
+
+// Scenario returns all the recorded steps as a scenario.
+func (recorder *ScenarioRecorder) Scenario() *mj.Scenario {
+	return recorder.newScenario("", recorder.steps)
+}
+
+// MinimalScenario returns the smallest scenario which still fails like the recorded run. The recorded run
+// is expected to have failed at its last step, as is the case when the fuzzer stops at the first error.
+// If replaying the recorded steps on a fresh world fails at that step, the transactions which are not needed
+// to reproduce the same error are removed, while all the setState steps are kept. Otherwise, the failure
+// came from a check made outside the scenario, so all the recorded steps are returned.
+func (recorder *ScenarioRecorder) MinimalScenario() *mj.Scenario {
+	if len(recorder.steps) == 0 {
+		return recorder.Scenario()
+	}
+
+	failingStep := recorder.steps[len(recorder.steps)-1]
+	failure := recorder.replay(recorder.steps)
+	if failure == nil || failure.step != failingStep {
+		return recorder.newScenario("the failure does not reproduce from the recorded steps alone", recorder.steps)
+	}
+
+	txSteps := make([]mj.Step, 0, len(recorder.steps))
+	for _, step := range recorder.steps {
+		if _, isTx := step.(*mj.TxStep); isTx && step != failingStep {
+			txSteps = append(txSteps, step)
+		}
+	}
+	withTxSteps := func(candidate []mj.Step) []mj.Step {
+		kept := make(map[mj.Step]bool, len(candidate)+1)
+		for _, step := range candidate {
+			kept[step] = true
+		}
+		kept[failingStep] = true
+
+		steps := make([]mj.Step, 0, len(recorder.steps))
+		for _, step := range recorder.steps {
+			if _, isTx := step.(*mj.TxStep); !isTx || kept[step] {
+				steps = append(steps, step)
+			}
+		}
+		return steps
+	}
+
+	minimalTxSteps := fuzzframework.ShrinkSteps(txSteps, func(candidate []mj.Step) bool {
+		candidateFailure := recorder.replay(withTxSteps(candidate))
+		return candidateFailure != nil && candidateFailure.step == failingStep && candidateFailure.message == failure.message
+	})
+
+	comment := fmt.Sprintf("shrunk from %d steps, fails with: %s", len(recorder.steps), failure.message)
+	return recorder.newScenario(comment, withTxSteps(minimalTxSteps))
+}
+
+// WriteMinimalScenario writes the minimal scenario to a file, using the scenario writer.
+func (recorder *ScenarioRecorder) WriteMinimalScenario(path string) error {
+	return mc.WriteScenariosScenario(recorder.MinimalScenario(), path)
+}
+
+type replayFailure struct {
+	step    mj.Step
+	message string
+}
+
+// replay executes the steps on a fresh world and returns the first failing step, if any
+func (recorder *ScenarioRecorder) replay(steps []mj.Step) *replayFailure {
+	vmTestExecutor, err := scenarioexec.NewVMTestExecutor()
+	if err != nil {
+		return &replayFailure{message: err.Error()}
+	}
+	vmTestExecutor.OverrideVMExecutor = recorder.OverrideVMExecutor
+	err = vmTestExecutor.InitVM(recorder.gasSchedule)
+	if err != nil {
+		return &replayFailure{message: err.Error()}
+	}
+	defer vmTestExecutor.Close()
+
+	for _, step := range steps {
+		err = vmTestExecutor.ExecuteStep(step)
+		if err != nil {
+			return &replayFailure{step: step, message: err.Error()}
+		}
+	}
+	return nil
+}
+
+func (recorder *ScenarioRecorder) newScenario(comment string, steps []mj.Step) *mj.Scenario {
+	return &mj.Scenario{
+		Name:        recorder.name,
+		Comment:     comment,
+		CheckGas:    true,
+		GasSchedule: recorder.gasSchedule,
+		Steps:       steps,
+	}
+}
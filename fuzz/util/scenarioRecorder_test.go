@@ -0,0 +1,69 @@
+package fuzzutil
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	fr "github.com/multiversx/mx-chain-scenario-go/fileresolver"
+	mjparse "github.com/multiversx/mx-chain-scenario-go/json/parse"
+	mj "github.com/multiversx/mx-chain-scenario-go/model"
+	"github.com/multiversx/mx-chain-vm-go/interpreter"
+	"github.com/stretchr/testify/require"
+)
+
+func recordAdderSteps(t *testing.T, lastFunction string) *ScenarioRecorder {
+	adderPath, err := filepath.Abs("../../test/adder/output/adder.wasm")
+	require.Nil(t, err)
+	parser := mjparse.NewParser(fr.NewDefaultFileResolver().ReplacePath("adder.wasm", adderPath))
+
+	recorder := NewScenarioRecorder("recorded", mj.GasScheduleV4)
+	recorder.OverrideVMExecutor = interpreter.ExecutorFactory()
+
+	stepSnippets := []string{
+		`{"step": "setState", "accounts": {"address:owner": {"nonce": "0", "balance": "0"}},
+			"newAddresses": [{"creatorAddress": "address:owner", "creatorNonce": "0", "newAddress": "sc:adder"}]}`,
+		`{"step": "scDeploy", "txId": "deploy", "tx": {"from": "address:owner", "contractCode": "file:adder.wasm",
+			"arguments": ["0"], "gasLimit": "5,000,000", "gasPrice": "0"}}`,
+	}
+	for i := 1; i <= 5; i++ {
+		stepSnippets = append(stepSnippets, fmt.Sprintf(`{"step": "scCall", "txId": "%d", "tx": {"from": "address:owner",
+			"to": "sc:adder", "function": "add", "arguments": ["%d"], "gasLimit": "5,000,000", "gasPrice": "0"}}`, i, i))
+		stepSnippets = append(stepSnippets, `{"step": "dumpState"}`)
+	}
+	stepSnippets = append(stepSnippets, fmt.Sprintf(`{"step": "scCall", "txId": "last", "tx": {"from": "address:owner",
+		"to": "sc:adder", "function": "%s", "arguments": [], "gasLimit": "5,000,000", "gasPrice": "0"},
+		"expect": {"out": "*", "status": "0", "logs": "*", "gas": "*", "refund": "*"}}`, lastFunction))
+
+	for _, stepSnippet := range stepSnippets {
+		step, err := parser.ParseScenarioStep(stepSnippet)
+		require.Nil(t, err)
+		recorder.RecordStep(step)
+	}
+	return recorder
+}
+
+func TestScenarioRecorder_MinimalScenario(t *testing.T) {
+	recorder := recordAdderSteps(t, "missingEndpoint")
+	require.Len(t, recorder.Scenario().Steps, 8)
+
+	minimal := recorder.MinimalScenario()
+	require.Len(t, minimal.Steps, 3)
+	require.Equal(t, recorder.Scenario().Steps[0], minimal.Steps[0])
+	require.Equal(t, recorder.Scenario().Steps[1], minimal.Steps[1])
+	require.Equal(t, recorder.Scenario().Steps[7], minimal.Steps[2])
+	require.Contains(t, minimal.Comment, "shrunk from 8 steps")
+	require.Equal(t, mj.GasScheduleV4, minimal.GasSchedule)
+
+	scenarioPath := filepath.Join(t.TempDir(), "fuzz_failure.scen.json")
+	require.Nil(t, recorder.WriteMinimalScenario(scenarioPath))
+	require.FileExists(t, scenarioPath)
+}
+
+func TestScenarioRecorder_FailureNotReproduced(t *testing.T) {
+	recorder := recordAdderSteps(t, "getSum")
+
+	minimal := recorder.MinimalScenario()
+	require.Equal(t, recorder.Scenario().Steps, minimal.Steps)
+	require.Contains(t, minimal.Comment, "does not reproduce")
+}
@@ -0,0 +1,138 @@
+package vmjsonintegrationtest
+
+import (
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"testing"
+
+	mc "github.com/multiversx/mx-chain-scenario-go/controller"
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
+	am "github.com/multiversx/mx-chain-vm-go/scenarioexec"
+	"github.com/multiversx/mx-chain-vm-go/testcommon/testexecutor"
+	"github.com/stretchr/testify/require"
+)
+
+const forkFromSnapshotScenario = `{
+    "comment": "continues multi-transfer-esdt.scen.json from a snapshot of its final state",
+    "steps": [
+        {
+            "step": "setState",
+            "id": "snapshot:prepared.snapshot",
+            "accounts": {
+                "address:C": {
+                    "nonce": "0",
+                    "balance": "0"
+                }
+            }
+        },
+        {
+            "step": "transfer",
+            "txId": "transfer-after-fork",
+            "tx": {
+                "from": "address:B",
+                "to": "address:C",
+                "esdtValue": [
+                    {
+                        "tokenIdentifier": "str:TOK-123456",
+                        "value": "30"
+                    }
+                ],
+                "gasLimit": "0x100000000",
+                "gasPrice": "0"
+            }
+        },
+        {
+            "step": "checkState",
+            "accounts": {
+                "address:A": {
+                    "nonce": "1",
+                    "balance": "0xf00000000",
+                    "esdt": {
+                        "str:TOK-123456": "50",
+                        "str:OTHERTOK-123456": "100",
+                        "str:NFT-123456": {
+                            "instances": [
+                                {
+                                    "nonce": "5",
+                                    "balance": "10"
+                                }
+                            ]
+                        }
+                    },
+                    "storage": {},
+                    "code": ""
+                },
+                "address:B": {
+                    "nonce": "1",
+                    "esdt": {
+                        "str:TOK-123456": "70",
+                        "str:OTHERTOK-123456": "400",
+                        "str:NFT-123456": {
+                            "instances": [
+                                {
+                                    "nonce": "5",
+                                    "balance": "10"
+                                }
+                            ]
+                        }
+                    },
+                    "storage": {},
+                    "code": ""
+                },
+                "address:C": {
+                    "nonce": "0",
+                    "esdt": {
+                        "str:TOK-123456": "30"
+                    },
+                    "storage": {},
+                    "code": ""
+                }
+            }
+        }
+    ]
+}`
+
+func TestScenariosSnapshot_ForkFromPreparedState(t *testing.T) {
+	snapshotDir := t.TempDir()
+	snapshotPath := filepath.Join(snapshotDir, "prepared.snapshot")
+
+	preparedWorld := runScenarioForSnapshot(t, path.Join(getTestRoot(), "scenarios-self-test/multi-transfer-esdt.scen.json"))
+	require.Nil(t, preparedWorld.SaveSnapshot(snapshotPath))
+
+	// the snapshot is deterministic, and loading it restores the same state
+	encoded, err := preparedWorld.EncodeSnapshot()
+	require.Nil(t, err)
+	savedSnapshot, err := ioutil.ReadFile(snapshotPath)
+	require.Nil(t, err)
+	require.Equal(t, savedSnapshot, encoded)
+
+	loadedWorld := worldmock.NewMockWorld()
+	require.Nil(t, loadedWorld.LoadSnapshot(snapshotPath))
+	reencoded, err := loadedWorld.EncodeSnapshot()
+	require.Nil(t, err)
+	require.Equal(t, encoded, reencoded)
+
+	// a scenario continues from the snapshot, without replaying the setup
+	scenarioPath := filepath.Join(snapshotDir, "fork.scen.json")
+	require.Nil(t, ioutil.WriteFile(scenarioPath, []byte(forkFromSnapshotScenario), 0644))
+	runScenarioForSnapshot(t, scenarioPath)
+}
+
+func TestScenariosSnapshot_InvalidSnapshot(t *testing.T) {
+	world := worldmock.NewMockWorld()
+	require.ErrorIs(t, world.DecodeSnapshot([]byte("not a snapshot")), worldmock.ErrInvalidSnapshot)
+	require.ErrorIs(t, world.DecodeSnapshot([]byte("mockworld-snapshot-v1\ngarbage")), worldmock.ErrInvalidSnapshot)
+}
+
+func runScenarioForSnapshot(t *testing.T, scenarioPath string) *worldmock.MockWorld {
+	executor, err := am.NewVMTestExecutor()
+	require.Nil(t, err)
+	defer executor.Close()
+	executor.OverrideVMExecutor = testexecutor.NewDefaultTestExecutorFactory(t)
+
+	runner := mc.NewScenarioController(executor, mc.NewDefaultFileResolver())
+	err = runner.RunSingleJSONScenario(scenarioPath, mc.DefaultRunScenarioOptions())
+	require.Nil(t, err)
+	return executor.World
+}
@@ -100,6 +100,7 @@ func (mtb *ScenariosTestBuilder) Run() *ScenariosTestBuilder {
 	}
 
 	executor.OverrideVMExecutor = mtb.executorFactory
+	executor.CompiledCodeStore = testexecutor.NewDefaultTestCompiledCodeStore(mtb.t)
 	if mtb.executorLogger != nil {
 		executor.OverrideVMExecutor = executorwrapper.NewWrappedExecutorFactory(
 			mtb.executorLogger,
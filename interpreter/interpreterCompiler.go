@@ -0,0 +1,915 @@
+package interpreter
+
+import (
+	"fmt"
+)
+
+// maxFunctionLocals limits the number of locals a single function may declare.
+const maxFunctionLocals = 50000
+
+// instruction is the compiled form of a WASM operator. The meaning of the
+// fields depends on the opcode: branches use target, height and arity, while
+// constants, local and global accesses, calls and memory accesses use immediate.
+type instruction struct {
+	opcode    byte
+	immediate uint64
+	target    uint32
+	height    uint32
+	arity     uint32
+}
+
+// branchTarget describes where a branch continues and how it reshapes the stack.
+type branchTarget struct {
+	target uint32
+	height uint32
+	arity  uint32
+}
+
+// compiledFunction is a validated function body, ready to be executed.
+type compiledFunction struct {
+	signature      *functionType
+	numLocals      uint32
+	maxStackHeight uint32
+	code           []instruction
+	branchTables   [][]branchTarget
+}
+
+// compilationConfig holds the settings that influence the compiled code.
+type compilationConfig struct {
+	opcodeCosts     *opcodeCostTable
+	metering        bool
+	unmeteredLocals uint64
+}
+
+type controlFrame struct {
+	opcode      byte
+	params      []valueType
+	results     []valueType
+	height      int
+	unreachable bool
+	loopStart   uint32
+	elseFixup   int
+	endFixups   []branchFixup
+}
+
+func (frame *controlFrame) labelTypes() []valueType {
+	if frame.opcode == opLoop {
+		return frame.params
+	}
+	return frame.results
+}
+
+// branchFixup points to a branch target which is only known once the end of the block is reached.
+type branchFixup struct {
+	instructionIndex int
+	tableIndex       int
+	entryIndex       int
+}
+
+// functionCompiler validates a function body and translates it to instructions.
+type functionCompiler struct {
+	module        *wasmModule
+	config        *compilationConfig
+	functionIndex uint32
+	reader        *moduleDecoder
+
+	localTypes []valueType
+	operands   []valueType
+	controls   []*controlFrame
+	maxHeight  int
+
+	code            []instruction
+	branchTables    [][]branchTarget
+	accumulatedCost uint64
+}
+
+// compileModule validates and compiles all function bodies of the module.
+func compileModule(module *wasmModule, config *compilationConfig) ([]*compiledFunction, error) {
+	functions := make([]*compiledFunction, len(module.bodies))
+	for i, body := range module.bodies {
+		functionIndex := module.numImportedFunctions() + uint32(i)
+		compiler := &functionCompiler{
+			module:        module,
+			config:        config,
+			functionIndex: functionIndex,
+			reader:        &moduleDecoder{data: body.code},
+		}
+		compiled, err := compiler.compile(module.functionTypeAt(functionIndex), body.locals)
+		if err != nil {
+			return nil, err
+		}
+		functions[i] = compiled
+	}
+	return functions, nil
+}
+
+func (compiler *functionCompiler) compile(signature *functionType, locals []valueType) (*compiledFunction, error) {
+	compiler.localTypes = make([]valueType, 0, len(signature.params)+len(locals))
+	compiler.localTypes = append(compiler.localTypes, signature.params...)
+	compiler.localTypes = append(compiler.localTypes, locals...)
+	if len(compiler.localTypes) > maxFunctionLocals {
+		return nil, compiler.errorf("too many locals")
+	}
+
+	if compiler.config.metering && uint64(len(locals)) > compiler.config.unmeteredLocals {
+		meteredLocals := uint64(len(locals)) - compiler.config.unmeteredLocals
+		compiler.accumulatedCost += meteredLocals * compiler.config.opcodeCosts.localAllocate
+	}
+
+	compiler.controls = []*controlFrame{{
+		opcode:    opBlock,
+		params:    nil,
+		results:   signature.results,
+		height:    0,
+		elseFixup: -1,
+	}}
+
+	for len(compiler.controls) > 0 {
+		opcode, err := compiler.reader.readByte()
+		if err != nil {
+			return nil, compiler.errorf("unexpected end of function body")
+		}
+		err = compiler.compileOperator(opcode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !compiler.reader.atEnd() {
+		return nil, compiler.errorf("operators after the end of the function")
+	}
+
+	return &compiledFunction{
+		signature:      signature,
+		numLocals:      uint32(len(compiler.localTypes)),
+		maxStackHeight: uint32(compiler.maxHeight),
+		code:           compiler.code,
+		branchTables:   compiler.branchTables,
+	}, nil
+}
+
+func (compiler *functionCompiler) compileOperator(opcode byte) error {
+	if compiler.config.metering {
+		compiler.accumulatedCost += compiler.config.opcodeCosts.costs[opcode]
+		if isBasicBlockBoundary(opcode) {
+			compiler.flushAccumulatedCost()
+		}
+	}
+
+	switch opcode {
+	case opUnreachable:
+		compiler.emit(instruction{opcode: opUnreachable})
+		compiler.setUnreachable()
+		return nil
+	case opNop:
+		return nil
+	case opBlock, opLoop, opIf:
+		return compiler.compileBlockStart(opcode)
+	case opElse:
+		return compiler.compileElse()
+	case opEnd:
+		return compiler.compileEnd()
+	case opBr:
+		return compiler.compileBr()
+	case opBrIf:
+		return compiler.compileBrIf()
+	case opBrTable:
+		return compiler.compileBrTable()
+	case opReturn:
+		err := compiler.popExpectedTypes(compiler.controls[0].results)
+		if err != nil {
+			return err
+		}
+		compiler.emit(instruction{opcode: opReturn, arity: uint32(len(compiler.controls[0].results))})
+		compiler.setUnreachable()
+		return nil
+	case opCall:
+		return compiler.compileCall()
+	case opCallIndirect:
+		return compiler.compileCallIndirect()
+	case opDrop:
+		_, err := compiler.popOperand()
+		if err != nil {
+			return err
+		}
+		compiler.emit(instruction{opcode: opDrop})
+		return nil
+	case opSelect:
+		return compiler.compileSelect(false)
+	case opTypedSelect:
+		return compiler.compileSelect(true)
+	case opLocalGet, opLocalSet, opLocalTee:
+		return compiler.compileLocalAccess(opcode)
+	case opGlobalGet, opGlobalSet:
+		return compiler.compileGlobalAccess(opcode)
+	case opI32Load, opI32Load8S, opI32Load8U, opI32Load16S, opI32Load16U:
+		return compiler.compileLoad(opcode, valueTypeI32)
+	case opI64Load, opI64Load8S, opI64Load8U, opI64Load16S, opI64Load16U, opI64Load32S, opI64Load32U:
+		return compiler.compileLoad(opcode, valueTypeI64)
+	case opI32Store, opI32Store8, opI32Store16:
+		return compiler.compileStore(opcode, valueTypeI32)
+	case opI64Store, opI64Store8, opI64Store16, opI64Store32:
+		return compiler.compileStore(opcode, valueTypeI64)
+	case opMemorySize, opMemoryGrow:
+		return compiler.compileMemoryOperator(opcode)
+	case opI32Const:
+		value, err := compiler.readSigned(32)
+		if err != nil {
+			return err
+		}
+		compiler.pushOperand(valueTypeI32)
+		compiler.emit(instruction{opcode: opI32Const, immediate: uint64(uint32(int32(value)))})
+		return nil
+	case opI64Const:
+		value, err := compiler.readSigned(64)
+		if err != nil {
+			return err
+		}
+		compiler.pushOperand(valueTypeI64)
+		compiler.emit(instruction{opcode: opI64Const, immediate: uint64(value)})
+		return nil
+	}
+
+	signature, ok := numericSignatures[opcode]
+	if !ok {
+		return fmt.Errorf("%w: 0x%x in function %d", ErrUnsupportedOpcode, opcode, compiler.functionIndex)
+	}
+	return compiler.compileNumeric(opcode, signature)
+}
+
+// numericSignature gives the operand and result types of a numeric operator.
+type numericSignature struct {
+	params []valueType
+	result valueType
+}
+
+var (
+	i32UnarySignature   = numericSignature{params: []valueType{valueTypeI32}, result: valueTypeI32}
+	i32BinarySignature  = numericSignature{params: []valueType{valueTypeI32, valueTypeI32}, result: valueTypeI32}
+	i64UnarySignature   = numericSignature{params: []valueType{valueTypeI64}, result: valueTypeI64}
+	i64BinarySignature  = numericSignature{params: []valueType{valueTypeI64, valueTypeI64}, result: valueTypeI64}
+	i64TestSignature    = numericSignature{params: []valueType{valueTypeI64}, result: valueTypeI32}
+	i64CompareSignature = numericSignature{params: []valueType{valueTypeI64, valueTypeI64}, result: valueTypeI32}
+	i64ToI32Signature   = numericSignature{params: []valueType{valueTypeI64}, result: valueTypeI32}
+	i32ToI64Signature   = numericSignature{params: []valueType{valueTypeI32}, result: valueTypeI64}
+)
+
+var numericSignatures = map[byte]numericSignature{
+	opI32Eqz: i32UnarySignature,
+	opI32Eq:  i32BinarySignature, opI32Ne: i32BinarySignature,
+	opI32LtS: i32BinarySignature, opI32LtU: i32BinarySignature,
+	opI32GtS: i32BinarySignature, opI32GtU: i32BinarySignature,
+	opI32LeS: i32BinarySignature, opI32LeU: i32BinarySignature,
+	opI32GeS: i32BinarySignature, opI32GeU: i32BinarySignature,
+
+	opI64Eqz: i64TestSignature,
+	opI64Eq:  i64CompareSignature, opI64Ne: i64CompareSignature,
+	opI64LtS: i64CompareSignature, opI64LtU: i64CompareSignature,
+	opI64GtS: i64CompareSignature, opI64GtU: i64CompareSignature,
+	opI64LeS: i64CompareSignature, opI64LeU: i64CompareSignature,
+	opI64GeS: i64CompareSignature, opI64GeU: i64CompareSignature,
+
+	opI32Clz: i32UnarySignature, opI32Ctz: i32UnarySignature, opI32Popcnt: i32UnarySignature,
+	opI32Add: i32BinarySignature, opI32Sub: i32BinarySignature, opI32Mul: i32BinarySignature,
+	opI32DivS: i32BinarySignature, opI32DivU: i32BinarySignature,
+	opI32RemS: i32BinarySignature, opI32RemU: i32BinarySignature,
+	opI32And: i32BinarySignature, opI32Or: i32BinarySignature, opI32Xor: i32BinarySignature,
+	opI32Shl: i32BinarySignature, opI32ShrS: i32BinarySignature, opI32ShrU: i32BinarySignature,
+	opI32Rotl: i32BinarySignature, opI32Rotr: i32BinarySignature,
+
+	opI64Clz: i64UnarySignature, opI64Ctz: i64UnarySignature, opI64Popcnt: i64UnarySignature,
+	opI64Add: i64BinarySignature, opI64Sub: i64BinarySignature, opI64Mul: i64BinarySignature,
+	opI64DivS: i64BinarySignature, opI64DivU: i64BinarySignature,
+	opI64RemS: i64BinarySignature, opI64RemU: i64BinarySignature,
+	opI64And: i64BinarySignature, opI64Or: i64BinarySignature, opI64Xor: i64BinarySignature,
+	opI64Shl: i64BinarySignature, opI64ShrS: i64BinarySignature, opI64ShrU: i64BinarySignature,
+	opI64Rotl: i64BinarySignature, opI64Rotr: i64BinarySignature,
+
+	opI32WrapI64:    i64ToI32Signature,
+	opI64ExtendI32S: i32ToI64Signature,
+	opI64ExtendI32U: i32ToI64Signature,
+
+	opI32Extend8S:  i32UnarySignature,
+	opI32Extend16S: i32UnarySignature,
+	opI64Extend8S:  i64UnarySignature,
+	opI64Extend16S: i64UnarySignature,
+	opI64Extend32S: i64UnarySignature,
+}
+
+func (compiler *functionCompiler) compileNumeric(opcode byte, signature numericSignature) error {
+	for i := len(signature.params) - 1; i >= 0; i-- {
+		err := compiler.popExpected(signature.params[i])
+		if err != nil {
+			return err
+		}
+	}
+	compiler.pushOperand(signature.result)
+	compiler.emit(instruction{opcode: opcode})
+	return nil
+}
+
+func (compiler *functionCompiler) compileBlockStart(opcode byte) error {
+	params, results, err := compiler.readBlockType()
+	if err != nil {
+		return err
+	}
+
+	elseFixup := -1
+	if opcode == opIf {
+		err = compiler.popExpected(valueTypeI32)
+		if err != nil {
+			return err
+		}
+		elseFixup = len(compiler.code)
+		compiler.emit(instruction{opcode: opJumpIfZero})
+	}
+
+	err = compiler.popExpectedTypes(params)
+	if err != nil {
+		return err
+	}
+
+	frame := &controlFrame{
+		opcode:    opcode,
+		params:    params,
+		results:   results,
+		height:    len(compiler.operands),
+		loopStart: uint32(len(compiler.code)),
+		elseFixup: elseFixup,
+	}
+	compiler.controls = append(compiler.controls, frame)
+	compiler.pushOperands(params)
+	return nil
+}
+
+func (compiler *functionCompiler) compileElse() error {
+	frame := compiler.topFrame()
+	if frame.opcode != opIf {
+		return compiler.errorf("else without if")
+	}
+	err := compiler.checkFrameEnd(frame)
+	if err != nil {
+		return err
+	}
+
+	// the then-branch jumps over the else-branch
+	frame.endFixups = append(frame.endFixups, branchFixup{instructionIndex: len(compiler.code), tableIndex: -1})
+	compiler.emit(instruction{opcode: opJump})
+
+	compiler.code[frame.elseFixup].target = uint32(len(compiler.code))
+	frame.elseFixup = -1
+	frame.opcode = opElse
+	frame.unreachable = false
+	compiler.operands = compiler.operands[:frame.height]
+	compiler.pushOperands(frame.params)
+	return nil
+}
+
+func (compiler *functionCompiler) compileEnd() error {
+	frame := compiler.topFrame()
+	err := compiler.checkFrameEnd(frame)
+	if err != nil {
+		return err
+	}
+
+	if frame.opcode == opIf {
+		// an if without else must leave the stack unchanged when the condition is false
+		if !sameTypes(frame.params, frame.results) {
+			return compiler.errorf("if without else must have matching params and results")
+		}
+		compiler.code[frame.elseFixup].target = uint32(len(compiler.code))
+	}
+
+	compiler.controls = compiler.controls[:len(compiler.controls)-1]
+	if len(compiler.controls) == 0 {
+		// the end of the function body
+		compiler.resolveFixups(frame, uint32(len(compiler.code)))
+		compiler.emit(instruction{opcode: opReturn, arity: uint32(len(frame.results))})
+		return nil
+	}
+
+	compiler.resolveFixups(frame, uint32(len(compiler.code)))
+	compiler.operands = compiler.operands[:frame.height]
+	compiler.pushOperands(frame.results)
+	return nil
+}
+
+func (compiler *functionCompiler) checkFrameEnd(frame *controlFrame) error {
+	err := compiler.popExpectedTypes(frame.results)
+	if err != nil {
+		return err
+	}
+	if len(compiler.operands) != frame.height {
+		return compiler.errorf("values remaining on the stack at the end of a block")
+	}
+	return nil
+}
+
+func (compiler *functionCompiler) resolveFixups(frame *controlFrame, target uint32) {
+	for _, fixup := range frame.endFixups {
+		if fixup.tableIndex >= 0 {
+			compiler.branchTables[fixup.tableIndex][fixup.entryIndex].target = target
+			continue
+		}
+		compiler.code[fixup.instructionIndex].target = target
+	}
+}
+
+func (compiler *functionCompiler) labelFrame(depth uint32) (*controlFrame, error) {
+	if depth >= uint32(len(compiler.controls)) {
+		return nil, compiler.errorf("branch depth out of range")
+	}
+	return compiler.controls[len(compiler.controls)-1-int(depth)], nil
+}
+
+// branchTo builds the branch target for a label, registering a fixup if the target is not yet known.
+func (compiler *functionCompiler) branchTo(frame *controlFrame, fixup branchFixup) branchTarget {
+	target := branchTarget{
+		height: uint32(len(compiler.localTypes) + frame.height),
+		arity:  uint32(len(frame.labelTypes())),
+	}
+	if frame.opcode == opLoop {
+		target.target = frame.loopStart
+	} else {
+		frame.endFixups = append(frame.endFixups, fixup)
+	}
+	return target
+}
+
+func (compiler *functionCompiler) compileBr() error {
+	depth, err := compiler.reader.readU32()
+	if err != nil {
+		return err
+	}
+	frame, err := compiler.labelFrame(depth)
+	if err != nil {
+		return err
+	}
+	err = compiler.popExpectedTypes(frame.labelTypes())
+	if err != nil {
+		return err
+	}
+
+	target := compiler.branchTo(frame, branchFixup{instructionIndex: len(compiler.code), tableIndex: -1})
+	compiler.emitBranch(opBr, target)
+	compiler.setUnreachable()
+	return nil
+}
+
+func (compiler *functionCompiler) compileBrIf() error {
+	depth, err := compiler.reader.readU32()
+	if err != nil {
+		return err
+	}
+	frame, err := compiler.labelFrame(depth)
+	if err != nil {
+		return err
+	}
+	err = compiler.popExpected(valueTypeI32)
+	if err != nil {
+		return err
+	}
+	labelTypes := frame.labelTypes()
+	err = compiler.popExpectedTypes(labelTypes)
+	if err != nil {
+		return err
+	}
+	compiler.pushOperands(labelTypes)
+
+	target := compiler.branchTo(frame, branchFixup{instructionIndex: len(compiler.code), tableIndex: -1})
+	compiler.emitBranch(opBrIf, target)
+	return nil
+}
+
+func (compiler *functionCompiler) compileBrTable() error {
+	numTargets, err := compiler.reader.readU32()
+	if err != nil {
+		return err
+	}
+	if numTargets > uint32(len(compiler.reader.data)) {
+		return compiler.errorf("branch table too large")
+	}
+	depths := make([]uint32, numTargets+1)
+	for i := range depths {
+		depths[i], err = compiler.reader.readU32()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = compiler.popExpected(valueTypeI32)
+	if err != nil {
+		return err
+	}
+
+	defaultFrame, err := compiler.labelFrame(depths[numTargets])
+	if err != nil {
+		return err
+	}
+	arity := len(defaultFrame.labelTypes())
+
+	tableIndex := len(compiler.branchTables)
+	table := make([]branchTarget, len(depths))
+	compiler.branchTables = append(compiler.branchTables, table)
+	for i, depth := range depths {
+		frame, err := compiler.labelFrame(depth)
+		if err != nil {
+			return err
+		}
+		labelTypes := frame.labelTypes()
+		if len(labelTypes) != arity {
+			return compiler.errorf("branch table targets have different arities")
+		}
+		err = compiler.checkTopTypes(labelTypes)
+		if err != nil {
+			return err
+		}
+		table[i] = compiler.branchTo(frame, branchFixup{tableIndex: tableIndex, entryIndex: i})
+	}
+
+	err = compiler.popExpectedTypes(defaultFrame.labelTypes())
+	if err != nil {
+		return err
+	}
+	compiler.emit(instruction{opcode: opBrTable, immediate: uint64(tableIndex)})
+	compiler.setUnreachable()
+	return nil
+}
+
+func (compiler *functionCompiler) emitBranch(opcode byte, target branchTarget) {
+	compiler.emit(instruction{
+		opcode: opcode,
+		target: target.target,
+		height: target.height,
+		arity:  target.arity,
+	})
+}
+
+func (compiler *functionCompiler) compileCall() error {
+	functionIndex, err := compiler.reader.readU32()
+	if err != nil {
+		return err
+	}
+	if functionIndex >= compiler.module.numFunctions() {
+		return compiler.errorf("call to undefined function %d", functionIndex)
+	}
+
+	signature := compiler.module.functionTypeAt(functionIndex)
+	err = compiler.popExpectedTypes(signature.params)
+	if err != nil {
+		return err
+	}
+	compiler.pushOperands(signature.results)
+
+	callOpcode := byte(opCall)
+	if functionIndex < compiler.module.numImportedFunctions() {
+		callOpcode = opCallImport
+	}
+	compiler.emit(instruction{opcode: callOpcode, immediate: uint64(functionIndex)})
+	return nil
+}
+
+func (compiler *functionCompiler) compileCallIndirect() error {
+	typeIndex, err := compiler.reader.readU32()
+	if err != nil {
+		return err
+	}
+	tableIndex, err := compiler.reader.readByte()
+	if err != nil {
+		return err
+	}
+	if tableIndex != 0 || compiler.module.table == nil {
+		return compiler.errorf("call_indirect without table")
+	}
+	if typeIndex >= uint32(len(compiler.module.types)) {
+		return compiler.errorf("call_indirect type index out of range")
+	}
+
+	err = compiler.popExpected(valueTypeI32)
+	if err != nil {
+		return err
+	}
+	signature := compiler.module.types[typeIndex]
+	err = compiler.popExpectedTypes(signature.params)
+	if err != nil {
+		return err
+	}
+	compiler.pushOperands(signature.results)
+	compiler.emit(instruction{opcode: opCallIndirect, immediate: uint64(typeIndex)})
+	return nil
+}
+
+func (compiler *functionCompiler) compileSelect(typed bool) error {
+	var selectType valueType
+	if typed {
+		numTypes, err := compiler.reader.readU32()
+		if err != nil {
+			return err
+		}
+		if numTypes != 1 {
+			return compiler.errorf("typed select must have exactly one type")
+		}
+		selectType, err = compiler.reader.readValueType()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := compiler.popExpected(valueTypeI32)
+	if err != nil {
+		return err
+	}
+	first, err := compiler.popOperand()
+	if err != nil {
+		return err
+	}
+	second, err := compiler.popOperand()
+	if err != nil {
+		return err
+	}
+
+	resultType := first
+	if resultType == valueTypeUnknown {
+		resultType = second
+	}
+	if first != second && first != valueTypeUnknown && second != valueTypeUnknown {
+		return compiler.errorf("select operands have different types")
+	}
+	if typed {
+		if resultType != valueTypeUnknown && resultType != selectType {
+			return compiler.errorf("select operands do not match the declared type")
+		}
+		resultType = selectType
+	}
+
+	compiler.pushOperand(resultType)
+	compiler.emit(instruction{opcode: opSelect})
+	return nil
+}
+
+func (compiler *functionCompiler) compileLocalAccess(opcode byte) error {
+	localIndex, err := compiler.reader.readU32()
+	if err != nil {
+		return err
+	}
+	if localIndex >= uint32(len(compiler.localTypes)) {
+		return compiler.errorf("local index %d out of range", localIndex)
+	}
+	localType := compiler.localTypes[localIndex]
+
+	switch opcode {
+	case opLocalGet:
+		compiler.pushOperand(localType)
+	case opLocalSet:
+		err = compiler.popExpected(localType)
+	case opLocalTee:
+		err = compiler.popExpected(localType)
+		compiler.pushOperand(localType)
+	}
+	if err != nil {
+		return err
+	}
+
+	compiler.emit(instruction{opcode: opcode, immediate: uint64(localIndex)})
+	return nil
+}
+
+func (compiler *functionCompiler) compileGlobalAccess(opcode byte) error {
+	globalIndex, err := compiler.reader.readU32()
+	if err != nil {
+		return err
+	}
+	if globalIndex >= uint32(len(compiler.module.globals)) {
+		return compiler.errorf("global index %d out of range", globalIndex)
+	}
+	global := compiler.module.globals[globalIndex]
+
+	if opcode == opGlobalGet {
+		compiler.pushOperand(global.valueType)
+	} else {
+		if !global.mutable {
+			return compiler.errorf("global %d is immutable", globalIndex)
+		}
+		err = compiler.popExpected(global.valueType)
+		if err != nil {
+			return err
+		}
+	}
+
+	compiler.emit(instruction{opcode: opcode, immediate: uint64(globalIndex)})
+	return nil
+}
+
+func memoryAccessSize(opcode byte) uint32 {
+	switch opcode {
+	case opI32Load8S, opI32Load8U, opI64Load8S, opI64Load8U, opI32Store8, opI64Store8:
+		return 1
+	case opI32Load16S, opI32Load16U, opI64Load16S, opI64Load16U, opI32Store16, opI64Store16:
+		return 2
+	case opI32Load, opI64Load32S, opI64Load32U, opI32Store, opI64Store32:
+		return 4
+	default:
+		return 8
+	}
+}
+
+func (compiler *functionCompiler) readMemoryArgument(opcode byte) (uint64, error) {
+	if compiler.module.memory == nil {
+		return 0, compiler.errorf("memory access without memory")
+	}
+	alignment, err := compiler.reader.readU32()
+	if err != nil {
+		return 0, err
+	}
+	if alignment >= 32 || uint32(1)<<alignment > memoryAccessSize(opcode) {
+		return 0, compiler.errorf("memory access alignment too large")
+	}
+	offset, err := compiler.reader.readU32()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(offset), nil
+}
+
+func (compiler *functionCompiler) compileLoad(opcode byte, resultType valueType) error {
+	offset, err := compiler.readMemoryArgument(opcode)
+	if err != nil {
+		return err
+	}
+	err = compiler.popExpected(valueTypeI32)
+	if err != nil {
+		return err
+	}
+	compiler.pushOperand(resultType)
+	compiler.emit(instruction{opcode: opcode, immediate: offset})
+	return nil
+}
+
+func (compiler *functionCompiler) compileStore(opcode byte, operandType valueType) error {
+	offset, err := compiler.readMemoryArgument(opcode)
+	if err != nil {
+		return err
+	}
+	err = compiler.popExpected(operandType)
+	if err != nil {
+		return err
+	}
+	err = compiler.popExpected(valueTypeI32)
+	if err != nil {
+		return err
+	}
+	compiler.emit(instruction{opcode: opcode, immediate: offset})
+	return nil
+}
+
+func (compiler *functionCompiler) compileMemoryOperator(opcode byte) error {
+	if compiler.module.memory == nil {
+		return compiler.errorf("memory operator without memory")
+	}
+	reserved, err := compiler.reader.readByte()
+	if err != nil {
+		return err
+	}
+	if reserved != 0 {
+		return compiler.errorf("invalid memory index")
+	}
+	if opcode == opMemoryGrow {
+		err = compiler.popExpected(valueTypeI32)
+		if err != nil {
+			return err
+		}
+	}
+	compiler.pushOperand(valueTypeI32)
+	compiler.emit(instruction{opcode: opcode})
+	return nil
+}
+
+func (compiler *functionCompiler) readBlockType() ([]valueType, []valueType, error) {
+	if compiler.reader.atEnd() {
+		return nil, nil, compiler.errorf("unexpected end of function body")
+	}
+	next := compiler.reader.data[compiler.reader.pos]
+	switch valueType(next) {
+	case blockTypeEmpty:
+		compiler.reader.pos++
+		return nil, nil, nil
+	case valueTypeI32, valueTypeI64:
+		compiler.reader.pos++
+		return nil, []valueType{valueType(next)}, nil
+	}
+
+	typeIndex, err := compiler.readSigned(33)
+	if err != nil {
+		return nil, nil, err
+	}
+	if typeIndex < 0 {
+		return nil, nil, fmt.Errorf("%w: block type 0x%x in function %d", ErrUnsupportedValueType, next, compiler.functionIndex)
+	}
+	if typeIndex >= int64(len(compiler.module.types)) {
+		return nil, nil, compiler.errorf("block type index out of range")
+	}
+	blockType := compiler.module.types[typeIndex]
+	return blockType.params, blockType.results, nil
+}
+
+func (compiler *functionCompiler) readSigned(bitSize uint) (int64, error) {
+	value, length, err := readSLEB128(compiler.reader.data[compiler.reader.pos:], bitSize)
+	if err != nil {
+		return 0, err
+	}
+	compiler.reader.pos += length
+	return value, nil
+}
+
+func (compiler *functionCompiler) flushAccumulatedCost() {
+	if compiler.accumulatedCost == 0 {
+		return
+	}
+	compiler.emit(instruction{opcode: opChargeGas, immediate: compiler.accumulatedCost})
+	compiler.accumulatedCost = 0
+}
+
+func (compiler *functionCompiler) emit(instr instruction) {
+	compiler.code = append(compiler.code, instr)
+}
+
+func (compiler *functionCompiler) topFrame() *controlFrame {
+	return compiler.controls[len(compiler.controls)-1]
+}
+
+func (compiler *functionCompiler) setUnreachable() {
+	frame := compiler.topFrame()
+	compiler.operands = compiler.operands[:frame.height]
+	frame.unreachable = true
+}
+
+func (compiler *functionCompiler) pushOperand(operandType valueType) {
+	compiler.operands = append(compiler.operands, operandType)
+	if len(compiler.operands) > compiler.maxHeight {
+		compiler.maxHeight = len(compiler.operands)
+	}
+}
+
+func (compiler *functionCompiler) pushOperands(operandTypes []valueType) {
+	for _, operandType := range operandTypes {
+		compiler.pushOperand(operandType)
+	}
+}
+
+func (compiler *functionCompiler) popOperand() (valueType, error) {
+	frame := compiler.topFrame()
+	if len(compiler.operands) == frame.height {
+		if frame.unreachable {
+			return valueTypeUnknown, nil
+		}
+		return valueTypeUnknown, compiler.errorf("operand stack underflow")
+	}
+	operand := compiler.operands[len(compiler.operands)-1]
+	compiler.operands = compiler.operands[:len(compiler.operands)-1]
+	return operand, nil
+}
+
+func (compiler *functionCompiler) popExpected(expected valueType) error {
+	actual, err := compiler.popOperand()
+	if err != nil {
+		return err
+	}
+	if actual != expected && actual != valueTypeUnknown && expected != valueTypeUnknown {
+		return compiler.errorf("type mismatch: expected %s, got %s", expected, actual)
+	}
+	return nil
+}
+
+func (compiler *functionCompiler) popExpectedTypes(expected []valueType) error {
+	for i := len(expected) - 1; i >= 0; i-- {
+		err := compiler.popExpected(expected[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkTopTypes verifies the types on top of the stack, without popping them.
+func (compiler *functionCompiler) checkTopTypes(expected []valueType) error {
+	savedOperands := append([]valueType(nil), compiler.operands...)
+	err := compiler.popExpectedTypes(expected)
+	compiler.operands = savedOperands
+	return err
+}
+
+func (compiler *functionCompiler) errorf(format string, args ...interface{}) error {
+	return newValidationError(compiler.functionIndex, fmt.Sprintf(format, args...))
+}
+
+func sameTypes(first []valueType, second []valueType) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidBytecode signals that the contract bytecode is empty or malformed
+var ErrInvalidBytecode = errors.New("invalid bytecode")
+
+// ErrFailedInstantiation signals that the interpreter could not create an instance
+var ErrFailedInstantiation = errors.New("could not create interpreter instance")
+
+// ErrUnsupportedSection signals that the module contains a section which is not supported
+var ErrUnsupportedSection = fmt.Errorf("%w (unsupported section)", ErrInvalidBytecode)
+
+// ErrUnsupportedOpcode signals that the module contains an opcode which is not allowed in smart contracts
+var ErrUnsupportedOpcode = fmt.Errorf("%w (unsupported opcode)", ErrInvalidBytecode)
+
+// ErrUnsupportedValueType signals that the module uses a value type which is not allowed in smart contracts
+var ErrUnsupportedValueType = fmt.Errorf("%w (unsupported value type)", ErrInvalidBytecode)
+
+// ErrUnsupportedImport signals that the module imports something other than a VM hook
+var ErrUnsupportedImport = fmt.Errorf("%w (unsupported import)", ErrInvalidBytecode)
+
+// ErrImportNotFound signals that the module imports a function which is not a known VM hook
+var ErrImportNotFound = fmt.Errorf("%w (import not found)", ErrFailedInstantiation)
+
+// ErrImportSignatureMismatch signals that a VM hook is imported with the wrong signature
+var ErrImportSignatureMismatch = fmt.Errorf("%w (import signature mismatch)", ErrFailedInstantiation)
+
+// ErrInvalidMemory signals that the memory declaration of the module is not acceptable
+var ErrInvalidMemory = fmt.Errorf("%w (invalid memory)", ErrInvalidBytecode)
+
+// ErrValidationFailed signals that a function body does not pass validation
+var ErrValidationFailed = fmt.Errorf("%w (validation failed)", ErrInvalidBytecode)
+
+// ErrCachingFailed signals that the instance could not be cached
+var ErrCachingFailed = errors.New("instance caching failed")
+
+// ErrInstanceAlreadyCleaned signals that the instance was already cleaned and can no longer be used
+var ErrInstanceAlreadyCleaned = errors.New("instance already cleaned")
+
+// ErrExecutionTrapped signals that the execution of a contract function stopped with a trap
+var ErrExecutionTrapped = errors.New("execution trapped")
+
+// ErrMemoryGrowFailed signals that the memory could not be grown
+var ErrMemoryGrowFailed = errors.New("memory grow error")
+
+func newValidationError(functionIndex uint32, message string) error {
+	return fmt.Errorf("%w: function %d: %s", ErrValidationFailed, functionIndex, message)
+}
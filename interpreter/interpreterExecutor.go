@@ -0,0 +1,64 @@
+package interpreter
+
+import (
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+var logInterpreter = logger.GetOrCreate("vm/interpreter")
+
+var _ executor.Executor = (*InterpreterExecutor)(nil)
+
+// InterpreterExecutor creates instances which run contracts with a pure Go WASM interpreter.
+// It needs no native libraries, which makes it portable, and easy to inspect and debug.
+type InterpreterExecutor struct {
+	vmHooks     executor.VMHooks
+	opcodeCosts *opcodeCostTable
+}
+
+// CreateExecutor creates a new interpreter executor.
+func CreateExecutor(vmHooks executor.VMHooks) (*InterpreterExecutor, error) {
+	return &InterpreterExecutor{
+		vmHooks:     vmHooks,
+		opcodeCosts: newOpcodeCostTable(nil),
+	}, nil
+}
+
+// SetOpcodeCosts sets gas costs globally inside the interpreter executor.
+func (interpreterExecutor *InterpreterExecutor) SetOpcodeCosts(opcodeCosts *executor.WASMOpcodeCost) {
+	interpreterExecutor.opcodeCosts = newOpcodeCostTable(opcodeCosts)
+}
+
+// FunctionNames returns the names of the VM hooks available to contracts.
+func (interpreterExecutor *InterpreterExecutor) FunctionNames() vmcommon.FunctionNames {
+	return functionNames
+}
+
+// NewInstanceWithOptions creates a new interpreter instance from WASM bytecode,
+// respecting the provided options
+func (interpreterExecutor *InterpreterExecutor) NewInstanceWithOptions(
+	contractCode []byte,
+	options executor.CompilationOptions,
+) (executor.Instance, error) {
+	return newInstance(contractCode, interpreterExecutor.vmHooks, interpreterExecutor.opcodeCosts, options)
+}
+
+// NewInstanceFromCompiledCodeWithOptions restores an interpreter instance from
+// the output of Cache(), respecting the provided options
+func (interpreterExecutor *InterpreterExecutor) NewInstanceFromCompiledCodeWithOptions(
+	compiledCode []byte,
+	options executor.CompilationOptions,
+) (executor.Instance, error) {
+	return newInstance(compiledCode, interpreterExecutor.vmHooks, interpreterExecutor.opcodeCosts, options)
+}
+
+// CompiledCodeVersion identifies the format of the interpreter compiled code, which is the WASM bytecode itself.
+func (interpreterExecutor *InterpreterExecutor) CompiledCodeVersion() string {
+	return "interpreter"
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (interpreterExecutor *InterpreterExecutor) IsInterfaceNil() bool {
+	return interpreterExecutor == nil
+}
@@ -0,0 +1,34 @@
+package interpreter
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+var _ = (executor.ExecutorAbstractFactory)((*InterpreterExecutorFactory)(nil))
+
+// InterpreterExecutorFactory builds interpreter Executors.
+type InterpreterExecutorFactory struct{}
+
+// ExecutorFactory returns the interpreter executor factory.
+func ExecutorFactory() *InterpreterExecutorFactory {
+	return &InterpreterExecutorFactory{}
+}
+
+// CreateExecutor creates a new Executor instance.
+func (ief *InterpreterExecutorFactory) CreateExecutor(args executor.ExecutorFactoryArgs) (executor.Executor, error) {
+	interpreterExecutor, err := CreateExecutor(args.VMHooks)
+	if err != nil {
+		return nil, err
+	}
+	if args.OpcodeCosts != nil {
+		// opcode costs are sometimes not initialized at this point in certain tests
+		interpreterExecutor.SetOpcodeCosts(args.OpcodeCosts)
+	}
+
+	return interpreterExecutor, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ief *InterpreterExecutorFactory) IsInterfaceNil() bool {
+	return ief == nil
+}
@@ -0,0 +1,2740 @@
+package interpreter
+
+// Code generated by vmhooks generator. DO NOT EDIT.
+
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// !!!!!!!!!!!!!!!!!!!!!! AUTO-GENERATED FILE !!!!!!!!!!!!!!!!!!!!!!
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// vmHookImport describes a VM hook, as seen from WASM.
+type vmHookImport struct {
+	signature *functionType
+	invoke    func(vmHooks executor.VMHooks, args []uint64) uint64
+}
+
+var vmHookImports = map[string]*vmHookImport{
+	"getGasLeft": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetGasLeft()
+			return uint64(result)
+		},
+	},
+	"getSCAddress": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.GetSCAddress(executor.MemPtr(int32(args[0])))
+			return 0
+		},
+	},
+	"getOwnerAddress": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.GetOwnerAddress(executor.MemPtr(int32(args[0])))
+			return 0
+		},
+	},
+	"getShardOfAddress": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetShardOfAddress(executor.MemPtr(int32(args[0])))
+			return uint64(uint32(result))
+		},
+	},
+	"isSmartContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.IsSmartContract(executor.MemPtr(int32(args[0])))
+			return uint64(uint32(result))
+		},
+	},
+	"signalError": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.SignalError(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return 0
+		},
+	},
+	"getExternalBalance": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.GetExternalBalance(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])))
+			return 0
+		},
+	},
+	"getBlockHash": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetBlockHash(int64(args[0]), executor.MemPtr(int32(args[1])))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTBalance": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTBalance(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), int64(args[3]), executor.MemPtr(int32(args[4])))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTNFTNameLength": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTNFTNameLength(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), int64(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTNFTAttributeLength": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTNFTAttributeLength(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), int64(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTNFTURILength": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTNFTURILength(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), int64(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTTokenData": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTTokenData(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), int64(args[3]), int32(args[4]), executor.MemPtr(int32(args[5])), executor.MemPtr(int32(args[6])), executor.MemPtr(int32(args[7])), executor.MemPtr(int32(args[8])), executor.MemPtr(int32(args[9])), int32(args[10]), executor.MemPtr(int32(args[11])))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTLocalRoles": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTLocalRoles(int32(args[0]))
+			return uint64(result)
+		},
+	},
+	"validateTokenIdentifier": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ValidateTokenIdentifier(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"transferValue": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.TransferValue(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), executor.MemPtr(int32(args[2])), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"transferValueExecute": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.TransferValueExecute(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int64(args[2]), executor.MemPtr(int32(args[3])), int32(args[4]), int32(args[5]), executor.MemPtr(int32(args[6])), executor.MemPtr(int32(args[7])))
+			return uint64(uint32(result))
+		},
+	},
+	"transferESDTExecute": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.TransferESDTExecute(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), executor.MemPtr(int32(args[3])), int64(args[4]), executor.MemPtr(int32(args[5])), int32(args[6]), int32(args[7]), executor.MemPtr(int32(args[8])), executor.MemPtr(int32(args[9])))
+			return uint64(uint32(result))
+		},
+	},
+	"transferESDTNFTExecute": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.TransferESDTNFTExecute(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), executor.MemPtr(int32(args[3])), int64(args[4]), int64(args[5]), executor.MemPtr(int32(args[6])), int32(args[7]), int32(args[8]), executor.MemPtr(int32(args[9])), executor.MemPtr(int32(args[10])))
+			return uint64(uint32(result))
+		},
+	},
+	"multiTransferESDTNFTExecute": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MultiTransferESDTNFTExecute(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])), executor.MemPtr(int32(args[3])), int64(args[4]), executor.MemPtr(int32(args[5])), int32(args[6]), int32(args[7]), executor.MemPtr(int32(args[8])), executor.MemPtr(int32(args[9])))
+			return uint64(uint32(result))
+		},
+	},
+	"createAsyncCall": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.CreateAsyncCall(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), executor.MemPtr(int32(args[2])), int32(args[3]), executor.MemPtr(int32(args[4])), int32(args[5]), executor.MemPtr(int32(args[6])), int32(args[7]), int64(args[8]), int64(args[9]))
+			return uint64(uint32(result))
+		},
+	},
+	"setAsyncContextCallback": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.SetAsyncContextCallback(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])), int32(args[3]), int64(args[4]))
+			return uint64(uint32(result))
+		},
+	},
+	"upgradeContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.UpgradeContract(executor.MemPtr(int32(args[0])), int64(args[1]), executor.MemPtr(int32(args[2])), executor.MemPtr(int32(args[3])), executor.MemPtr(int32(args[4])), int32(args[5]), int32(args[6]), executor.MemPtr(int32(args[7])), executor.MemPtr(int32(args[8])))
+			return 0
+		},
+	},
+	"upgradeFromSourceContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.UpgradeFromSourceContract(executor.MemPtr(int32(args[0])), int64(args[1]), executor.MemPtr(int32(args[2])), executor.MemPtr(int32(args[3])), executor.MemPtr(int32(args[4])), int32(args[5]), executor.MemPtr(int32(args[6])), executor.MemPtr(int32(args[7])))
+			return 0
+		},
+	},
+	"deleteContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.DeleteContract(executor.MemPtr(int32(args[0])), int64(args[1]), int32(args[2]), executor.MemPtr(int32(args[3])), executor.MemPtr(int32(args[4])))
+			return 0
+		},
+	},
+	"asyncCall": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.AsyncCall(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), executor.MemPtr(int32(args[2])), int32(args[3]))
+			return 0
+		},
+	},
+	"getArgumentLength": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetArgumentLength(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"getArgument": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetArgument(int32(args[0]), executor.MemPtr(int32(args[1])))
+			return uint64(uint32(result))
+		},
+	},
+	"getFunction": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetFunction(executor.MemPtr(int32(args[0])))
+			return uint64(uint32(result))
+		},
+	},
+	"getNumArguments": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetNumArguments()
+			return uint64(uint32(result))
+		},
+	},
+	"storageStore": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.StorageStore(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"storageLoadLength": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.StorageLoadLength(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"storageLoadFromAddress": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.StorageLoadFromAddress(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), executor.MemPtr(int32(args[3])))
+			return uint64(uint32(result))
+		},
+	},
+	"storageLoad": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.StorageLoad(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])))
+			return uint64(uint32(result))
+		},
+	},
+	"setStorageLock": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.SetStorageLock(executor.MemPtr(int32(args[0])), int32(args[1]), int64(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"getStorageLock": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetStorageLock(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(result)
+		},
+	},
+	"isStorageLocked": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.IsStorageLocked(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"clearStorageLock": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ClearStorageLock(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"getCaller": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.GetCaller(executor.MemPtr(int32(args[0])))
+			return 0
+		},
+	},
+	"checkNoPayment": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			vmHooks.CheckNoPayment()
+			return 0
+		},
+	},
+	"getCallValue": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetCallValue(executor.MemPtr(int32(args[0])))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTValue": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTValue(executor.MemPtr(int32(args[0])))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTValueByIndex": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTValueByIndex(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTTokenName": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTTokenName(executor.MemPtr(int32(args[0])))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTTokenNameByIndex": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTTokenNameByIndex(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTTokenNonce": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetESDTTokenNonce()
+			return uint64(result)
+		},
+	},
+	"getESDTTokenNonceByIndex": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTTokenNonceByIndex(int32(args[0]))
+			return uint64(result)
+		},
+	},
+	"getCurrentESDTNFTNonce": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetCurrentESDTNFTNonce(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]))
+			return uint64(result)
+		},
+	},
+	"getESDTTokenType": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetESDTTokenType()
+			return uint64(uint32(result))
+		},
+	},
+	"getESDTTokenTypeByIndex": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetESDTTokenTypeByIndex(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"getNumESDTTransfers": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetNumESDTTransfers()
+			return uint64(uint32(result))
+		},
+	},
+	"getCallValueTokenName": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetCallValueTokenName(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])))
+			return uint64(uint32(result))
+		},
+	},
+	"getCallValueTokenNameByIndex": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetCallValueTokenNameByIndex(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"writeLog": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.WriteLog(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])), int32(args[3]))
+			return 0
+		},
+	},
+	"writeEventLog": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.WriteEventLog(int32(args[0]), executor.MemPtr(int32(args[1])), executor.MemPtr(int32(args[2])), executor.MemPtr(int32(args[3])), int32(args[4]))
+			return 0
+		},
+	},
+	"getBlockTimestamp": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetBlockTimestamp()
+			return uint64(result)
+		},
+	},
+	"getBlockNonce": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetBlockNonce()
+			return uint64(result)
+		},
+	},
+	"getBlockRound": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetBlockRound()
+			return uint64(result)
+		},
+	},
+	"getBlockEpoch": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetBlockEpoch()
+			return uint64(result)
+		},
+	},
+	"getBlockRandomSeed": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.GetBlockRandomSeed(executor.MemPtr(int32(args[0])))
+			return 0
+		},
+	},
+	"getStateRootHash": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.GetStateRootHash(executor.MemPtr(int32(args[0])))
+			return 0
+		},
+	},
+	"getPrevBlockTimestamp": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetPrevBlockTimestamp()
+			return uint64(result)
+		},
+	},
+	"getPrevBlockNonce": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetPrevBlockNonce()
+			return uint64(result)
+		},
+	},
+	"getPrevBlockRound": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetPrevBlockRound()
+			return uint64(result)
+		},
+	},
+	"getPrevBlockEpoch": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetPrevBlockEpoch()
+			return uint64(result)
+		},
+	},
+	"getPrevBlockRandomSeed": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.GetPrevBlockRandomSeed(executor.MemPtr(int32(args[0])))
+			return 0
+		},
+	},
+	"finish": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.Finish(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return 0
+		},
+	},
+	"executeOnSameContext": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ExecuteOnSameContext(int64(args[0]), executor.MemPtr(int32(args[1])), executor.MemPtr(int32(args[2])), executor.MemPtr(int32(args[3])), int32(args[4]), int32(args[5]), executor.MemPtr(int32(args[6])), executor.MemPtr(int32(args[7])))
+			return uint64(uint32(result))
+		},
+	},
+	"executeOnDestContext": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ExecuteOnDestContext(int64(args[0]), executor.MemPtr(int32(args[1])), executor.MemPtr(int32(args[2])), executor.MemPtr(int32(args[3])), int32(args[4]), int32(args[5]), executor.MemPtr(int32(args[6])), executor.MemPtr(int32(args[7])))
+			return uint64(uint32(result))
+		},
+	},
+	"executeReadOnly": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ExecuteReadOnly(int64(args[0]), executor.MemPtr(int32(args[1])), executor.MemPtr(int32(args[2])), int32(args[3]), int32(args[4]), executor.MemPtr(int32(args[5])), executor.MemPtr(int32(args[6])))
+			return uint64(uint32(result))
+		},
+	},
+	"createContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.CreateContract(int64(args[0]), executor.MemPtr(int32(args[1])), executor.MemPtr(int32(args[2])), executor.MemPtr(int32(args[3])), int32(args[4]), executor.MemPtr(int32(args[5])), int32(args[6]), executor.MemPtr(int32(args[7])), executor.MemPtr(int32(args[8])))
+			return uint64(uint32(result))
+		},
+	},
+	"deployFromSourceContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.DeployFromSourceContract(int64(args[0]), executor.MemPtr(int32(args[1])), executor.MemPtr(int32(args[2])), executor.MemPtr(int32(args[3])), executor.MemPtr(int32(args[4])), int32(args[5]), executor.MemPtr(int32(args[6])), executor.MemPtr(int32(args[7])))
+			return uint64(uint32(result))
+		},
+	},
+	"getNumReturnData": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.GetNumReturnData()
+			return uint64(uint32(result))
+		},
+	},
+	"getReturnDataSize": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetReturnDataSize(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"getReturnData": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetReturnData(int32(args[0]), executor.MemPtr(int32(args[1])))
+			return uint64(uint32(result))
+		},
+	},
+	"cleanReturnData": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			vmHooks.CleanReturnData()
+			return 0
+		},
+	},
+	"deleteFromReturnData": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.DeleteFromReturnData(int32(args[0]))
+			return 0
+		},
+	},
+	"getOriginalTxHash": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.GetOriginalTxHash(executor.MemPtr(int32(args[0])))
+			return 0
+		},
+	},
+	"getCurrentTxHash": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.GetCurrentTxHash(executor.MemPtr(int32(args[0])))
+			return 0
+		},
+	},
+	"getPrevTxHash": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.GetPrevTxHash(executor.MemPtr(int32(args[0])))
+			return 0
+		},
+	},
+	"managedSCAddress": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedSCAddress(int32(args[0]))
+			return 0
+		},
+	},
+	"managedOwnerAddress": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedOwnerAddress(int32(args[0]))
+			return 0
+		},
+	},
+	"managedCaller": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedCaller(int32(args[0]))
+			return 0
+		},
+	},
+	"managedSignalError": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedSignalError(int32(args[0]))
+			return 0
+		},
+	},
+	"managedWriteLog": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedWriteLog(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"managedGetOriginalTxHash": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedGetOriginalTxHash(int32(args[0]))
+			return 0
+		},
+	},
+	"managedGetStateRootHash": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedGetStateRootHash(int32(args[0]))
+			return 0
+		},
+	},
+	"managedGetBlockRandomSeed": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedGetBlockRandomSeed(int32(args[0]))
+			return 0
+		},
+	},
+	"managedGetPrevBlockRandomSeed": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedGetPrevBlockRandomSeed(int32(args[0]))
+			return 0
+		},
+	},
+	"managedGetReturnData": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedGetReturnData(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"managedGetMultiESDTCallValue": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedGetMultiESDTCallValue(int32(args[0]))
+			return 0
+		},
+	},
+	"managedGetESDTBalance": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedGetESDTBalance(int32(args[0]), int32(args[1]), int64(args[2]), int32(args[3]))
+			return 0
+		},
+	},
+	"managedGetESDTTokenData": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedGetESDTTokenData(int32(args[0]), int32(args[1]), int64(args[2]), int32(args[3]), int32(args[4]), int32(args[5]), int32(args[6]), int32(args[7]), int32(args[8]), int32(args[9]), int32(args[10]))
+			return 0
+		},
+	},
+	"managedAsyncCall": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedAsyncCall(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return 0
+		},
+	},
+	"managedCreateAsyncCall": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI64, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedCreateAsyncCall(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), executor.MemPtr(int32(args[4])), int32(args[5]), executor.MemPtr(int32(args[6])), int32(args[7]), int64(args[8]), int64(args[9]), int32(args[10]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedCreateAsyncCallWithDeadline": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedCreateAsyncCallWithDeadline(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), executor.MemPtr(int32(args[4])), int32(args[5]), executor.MemPtr(int32(args[6])), int32(args[7]), int64(args[8]), int64(args[9]), int32(args[10]), int32(args[11]), int64(args[12]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedCancelAsyncCallGroup": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedCancelAsyncCallGroup(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedIsCallbackTimedOut": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.ManagedIsCallbackTimedOut()
+			return uint64(uint32(result))
+		},
+	},
+	"managedGetCallbackClosure": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedGetCallbackClosure(int32(args[0]))
+			return 0
+		},
+	},
+	"managedUpgradeFromSourceContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedUpgradeFromSourceContract(int32(args[0]), int64(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), int32(args[5]), int32(args[6]))
+			return 0
+		},
+	},
+	"managedUpgradeContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedUpgradeContract(int32(args[0]), int64(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), int32(args[5]), int32(args[6]))
+			return 0
+		},
+	},
+	"managedDeleteContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI64, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedDeleteContract(int32(args[0]), int64(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"managedDeployFromSourceContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedDeployFromSourceContract(int64(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), int32(args[5]), int32(args[6]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedCreateContract": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedCreateContract(int64(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), int32(args[5]), int32(args[6]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedExecuteReadOnly": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedExecuteReadOnly(int64(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedExecuteOnSameContext": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedExecuteOnSameContext(int64(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), int32(args[5]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedExecuteOnDestContext": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedExecuteOnDestContext(int64(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), int32(args[5]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedMultiTransferESDTNFTExecute": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedMultiTransferESDTNFTExecute(int32(args[0]), int32(args[1]), int64(args[2]), int32(args[3]), int32(args[4]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedTransferValueExecute": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedTransferValueExecute(int32(args[0]), int32(args[1]), int64(args[2]), int32(args[3]), int32(args[4]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedIsESDTFrozen": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedIsESDTFrozen(int32(args[0]), int32(args[1]), int64(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedIsESDTLimitedTransfer": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedIsESDTLimitedTransfer(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedIsESDTPaused": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedIsESDTPaused(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedBufferToHex": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.ManagedBufferToHex(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigFloatNewFromParts": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigFloatNewFromParts(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigFloatNewFromFrac": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigFloatNewFromFrac(int64(args[0]), int64(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigFloatNewFromSci": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigFloatNewFromSci(int64(args[0]), int64(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigFloatAdd": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatAdd(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigFloatSub": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatSub(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigFloatMul": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatMul(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigFloatDiv": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatDiv(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigFloatNeg": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatNeg(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigFloatClone": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatClone(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigFloatCmp": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigFloatCmp(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigFloatAbs": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatAbs(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigFloatSign": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigFloatSign(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigFloatSqrt": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatSqrt(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigFloatPow": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatPow(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigFloatFloor": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatFloor(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigFloatCeil": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatCeil(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigFloatTruncate": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatTruncate(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigFloatSetInt64": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI64},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatSetInt64(int32(args[0]), int64(args[1]))
+			return 0
+		},
+	},
+	"bigFloatIsInt": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigFloatIsInt(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigFloatSetBigInt": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatSetBigInt(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigFloatGetConstPi": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatGetConstPi(int32(args[0]))
+			return 0
+		},
+	},
+	"bigFloatGetConstE": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigFloatGetConstE(int32(args[0]))
+			return 0
+		},
+	},
+	"bigIntGetUnsignedArgument": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntGetUnsignedArgument(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigIntGetSignedArgument": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntGetSignedArgument(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigIntStorageStoreUnsigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntStorageStoreUnsigned(executor.MemPtr(int32(args[0])), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntStorageLoadUnsigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntStorageLoadUnsigned(executor.MemPtr(int32(args[0])), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntGetCallValue": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntGetCallValue(int32(args[0]))
+			return 0
+		},
+	},
+	"bigIntGetESDTCallValue": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntGetESDTCallValue(int32(args[0]))
+			return 0
+		},
+	},
+	"bigIntGetESDTCallValueByIndex": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntGetESDTCallValueByIndex(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigIntGetExternalBalance": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntGetExternalBalance(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return 0
+		},
+	},
+	"bigIntGetESDTExternalBalance": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI64, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntGetESDTExternalBalance(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), int64(args[3]), int32(args[4]))
+			return 0
+		},
+	},
+	"bigIntNew": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntNew(int64(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntUnsignedByteLength": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntUnsignedByteLength(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntSignedByteLength": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntSignedByteLength(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntGetUnsignedBytes": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntGetUnsignedBytes(int32(args[0]), executor.MemPtr(int32(args[1])))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntGetSignedBytes": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntGetSignedBytes(int32(args[0]), executor.MemPtr(int32(args[1])))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntSetUnsignedBytes": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntSetUnsignedBytes(int32(args[0]), executor.MemPtr(int32(args[1])), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntSetSignedBytes": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntSetSignedBytes(int32(args[0]), executor.MemPtr(int32(args[1])), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntIsInt64": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntIsInt64(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntGetInt64": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntGetInt64(int32(args[0]))
+			return uint64(result)
+		},
+	},
+	"bigIntSetInt64": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI64},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntSetInt64(int32(args[0]), int64(args[1]))
+			return 0
+		},
+	},
+	"bigIntAdd": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntAdd(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntSub": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntSub(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntMul": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntMul(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntTDiv": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntTDiv(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntTMod": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntTMod(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntEDiv": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntEDiv(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntEMod": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntEMod(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntSqrt": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntSqrt(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigIntPow": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntPow(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntLog2": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntLog2(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntAbs": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntAbs(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigIntNeg": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntNeg(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigIntSign": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntSign(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntCmp": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.BigIntCmp(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"bigIntNot": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntNot(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"bigIntAnd": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntAnd(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntOr": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntOr(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntXor": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntXor(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntShr": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntShr(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntShl": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntShl(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"bigIntFinishUnsigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntFinishUnsigned(int32(args[0]))
+			return 0
+		},
+	},
+	"bigIntFinishSigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntFinishSigned(int32(args[0]))
+			return 0
+		},
+	},
+	"bigIntToString": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.BigIntToString(int32(args[0]), int32(args[1]))
+			return 0
+		},
+	},
+	"mBufferNew": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.MBufferNew()
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferNewFromBytes": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferNewFromBytes(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferGetLength": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferGetLength(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferGetBytes": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferGetBytes(int32(args[0]), executor.MemPtr(int32(args[1])))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferGetByteSlice": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferGetByteSlice(int32(args[0]), int32(args[1]), int32(args[2]), executor.MemPtr(int32(args[3])))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferCopyByteSlice": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferCopyByteSlice(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferEq": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferEq(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferSetBytes": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferSetBytes(int32(args[0]), executor.MemPtr(int32(args[1])), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferSetByteSlice": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferSetByteSlice(int32(args[0]), int32(args[1]), int32(args[2]), executor.MemPtr(int32(args[3])))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferAppend": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferAppend(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferAppendBytes": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferAppendBytes(int32(args[0]), executor.MemPtr(int32(args[1])), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferToBigIntUnsigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferToBigIntUnsigned(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferToBigIntSigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferToBigIntSigned(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferFromBigIntUnsigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferFromBigIntUnsigned(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferFromBigIntSigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferFromBigIntSigned(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferToBigFloat": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferToBigFloat(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferFromBigFloat": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferFromBigFloat(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferStorageStore": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferStorageStore(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferStorageLoad": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferStorageLoad(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferStorageLoadFromAddress": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.MBufferStorageLoadFromAddress(int32(args[0]), int32(args[1]), int32(args[2]))
+			return 0
+		},
+	},
+	"mBufferGetArgument": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferGetArgument(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferFinish": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferFinish(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"mBufferSetRandom": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MBufferSetRandom(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedMapNew": {
+		signature: &functionType{
+			params:  []valueType{},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, _ []uint64) uint64 {
+			result := vmHooks.ManagedMapNew()
+			return uint64(uint32(result))
+		},
+	},
+	"managedMapPut": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedMapPut(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedMapGet": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedMapGet(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedMapRemove": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedMapRemove(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedMapContains": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedMapContains(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"smallIntGetUnsignedArgument": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.SmallIntGetUnsignedArgument(int32(args[0]))
+			return uint64(result)
+		},
+	},
+	"smallIntGetSignedArgument": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.SmallIntGetSignedArgument(int32(args[0]))
+			return uint64(result)
+		},
+	},
+	"smallIntFinishUnsigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.SmallIntFinishUnsigned(int64(args[0]))
+			return 0
+		},
+	},
+	"smallIntFinishSigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.SmallIntFinishSigned(int64(args[0]))
+			return 0
+		},
+	},
+	"smallIntStorageStoreUnsigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.SmallIntStorageStoreUnsigned(executor.MemPtr(int32(args[0])), int32(args[1]), int64(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"smallIntStorageStoreSigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.SmallIntStorageStoreSigned(executor.MemPtr(int32(args[0])), int32(args[1]), int64(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"smallIntStorageLoadUnsigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.SmallIntStorageLoadUnsigned(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(result)
+		},
+	},
+	"smallIntStorageLoadSigned": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.SmallIntStorageLoadSigned(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(result)
+		},
+	},
+	"int64getArgument": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.Int64getArgument(int32(args[0]))
+			return uint64(result)
+		},
+	},
+	"int64finish": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI64},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.Int64finish(int64(args[0]))
+			return 0
+		},
+	},
+	"int64storageStore": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI64},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.Int64storageStore(executor.MemPtr(int32(args[0])), int32(args[1]), int64(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"int64storageLoad": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI64},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.Int64storageLoad(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(result)
+		},
+	},
+	"sha256": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.Sha256(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])))
+			return uint64(uint32(result))
+		},
+	},
+	"managedSha256": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedSha256(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"keccak256": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.Keccak256(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])))
+			return uint64(uint32(result))
+		},
+	},
+	"managedKeccak256": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedKeccak256(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"ripemd160": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.Ripemd160(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])))
+			return uint64(uint32(result))
+		},
+	},
+	"managedRipemd160": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedRipemd160(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedSha512": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedSha512(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedSha3256": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedSha3256(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedSha3512": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedSha3512(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedBlake2b256": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedBlake2b256(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedBlake2b512": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedBlake2b512(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedPoseidon": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedPoseidon(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"verifyBLS": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.VerifyBLS(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), executor.MemPtr(int32(args[3])))
+			return uint64(uint32(result))
+		},
+	},
+	"managedVerifyBLS": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedVerifyBLS(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedVerifyBLSAggregated": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedVerifyBLSAggregated(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedAggregateBLSPublicKeys": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedAggregateBLSPublicKeys(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"verifyEd25519": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.VerifyEd25519(executor.MemPtr(int32(args[0])), executor.MemPtr(int32(args[1])), int32(args[2]), executor.MemPtr(int32(args[3])))
+			return uint64(uint32(result))
+		},
+	},
+	"managedVerifyEd25519": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedVerifyEd25519(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedVerifyEd25519Batch": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedVerifyEd25519Batch(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedVerifyEd25519BatchBitmap": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedVerifyEd25519BatchBitmap(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"verifyCustomSecp256k1": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.VerifyCustomSecp256k1(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])), int32(args[3]), executor.MemPtr(int32(args[4])), int32(args[5]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedVerifyCustomSecp256k1": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedVerifyCustomSecp256k1(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"verifySecp256k1": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.VerifySecp256k1(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])), int32(args[3]), executor.MemPtr(int32(args[4])))
+			return uint64(uint32(result))
+		},
+	},
+	"managedVerifySecp256k1": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedVerifySecp256k1(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedVerifySecp256r1": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedVerifySecp256r1(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedVerifySecp256r1Batch": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedVerifySecp256r1Batch(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedVerifySecp256r1BatchBitmap": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedVerifySecp256r1BatchBitmap(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"encodeSecp256k1DerSignature": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.EncodeSecp256k1DerSignature(executor.MemPtr(int32(args[0])), int32(args[1]), executor.MemPtr(int32(args[2])), int32(args[3]), executor.MemPtr(int32(args[4])))
+			return uint64(uint32(result))
+		},
+	},
+	"managedEncodeSecp256k1DerSignature": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedEncodeSecp256k1DerSignature(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"addEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.AddEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), int32(args[5]), int32(args[6]))
+			return 0
+		},
+	},
+	"doubleEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			vmHooks.DoubleEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]))
+			return 0
+		},
+	},
+	"isOnCurveEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.IsOnCurveEC(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"scalarBaseMultEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ScalarBaseMultEC(int32(args[0]), int32(args[1]), int32(args[2]), executor.MemPtr(int32(args[3])), int32(args[4]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedScalarBaseMultEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedScalarBaseMultEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"scalarMultEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ScalarMultEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), executor.MemPtr(int32(args[5])), int32(args[6]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedScalarMultEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedScalarMultEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), int32(args[5]))
+			return uint64(uint32(result))
+		},
+	},
+	"marshalEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MarshalEC(int32(args[0]), int32(args[1]), int32(args[2]), executor.MemPtr(int32(args[3])))
+			return uint64(uint32(result))
+		},
+	},
+	"managedMarshalEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedMarshalEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"marshalCompressedEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.MarshalCompressedEC(int32(args[0]), int32(args[1]), int32(args[2]), executor.MemPtr(int32(args[3])))
+			return uint64(uint32(result))
+		},
+	},
+	"managedMarshalCompressedEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedMarshalCompressedEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"unmarshalEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.UnmarshalEC(int32(args[0]), int32(args[1]), int32(args[2]), executor.MemPtr(int32(args[3])), int32(args[4]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedUnmarshalEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedUnmarshalEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"unmarshalCompressedEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.UnmarshalCompressedEC(int32(args[0]), int32(args[1]), int32(args[2]), executor.MemPtr(int32(args[3])), int32(args[4]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedUnmarshalCompressedEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedUnmarshalCompressedEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"generateKeyEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GenerateKeyEC(int32(args[0]), int32(args[1]), int32(args[2]), executor.MemPtr(int32(args[3])))
+			return uint64(uint32(result))
+		},
+	},
+	"managedGenerateKeyEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedGenerateKeyEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]))
+			return uint64(uint32(result))
+		},
+	},
+	"createEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.CreateEC(executor.MemPtr(int32(args[0])), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedCreateEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedCreateEC(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedCreateCustomEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedCreateCustomEC(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), int32(args[5]), int32(args[6]))
+			return uint64(uint32(result))
+		},
+	},
+	"getCurveLengthEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetCurveLengthEC(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"getPrivKeyByteLengthEC": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.GetPrivKeyByteLengthEC(int32(args[0]))
+			return uint64(uint32(result))
+		},
+	},
+	"ellipticCurveGetValues": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.EllipticCurveGetValues(int32(args[0]), int32(args[1]), int32(args[2]), int32(args[3]), int32(args[4]), int32(args[5]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedAddBN254G1": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedAddBN254G1(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedScalarMulBN254G1": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedScalarMulBN254G1(int32(args[0]), int32(args[1]), int32(args[2]))
+			return uint64(uint32(result))
+		},
+	},
+	"managedPairingCheckBN254": {
+		signature: &functionType{
+			params:  []valueType{valueTypeI32, valueTypeI32},
+			results: []valueType{valueTypeI32},
+		},
+		invoke: func(vmHooks executor.VMHooks, args []uint64) uint64 {
+			result := vmHooks.ManagedPairingCheckBN254(int32(args[0]), int32(args[1]))
+			return uint64(uint32(result))
+		},
+	},
+}
@@ -0,0 +1,340 @@
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+var _ executor.Instance = (*InterpreterInstance)(nil)
+
+// importModuleName is the only module from which contracts are allowed to import functions.
+const importModuleName = "env"
+
+// InterpreterInstance is a WebAssembly instance executed by the pure Go interpreter.
+type InterpreterInstance struct {
+	bytecode  []byte
+	module    *wasmModule
+	functions []*compiledFunction
+	imports   []*vmHookImport
+	vmHooks   executor.VMHooks
+	options   executor.CompilationOptions
+
+	memory  *InterpreterMemory
+	globals []uint64
+	table   []int64
+
+	pointsUsed      uint64
+	gasLimit        uint64
+	breakpointValue uint64
+	memoryGrowCount uint64
+
+	vmHooksPtr   uintptr
+	alreadyClean bool
+}
+
+func newInstance(
+	bytecode []byte,
+	vmHooks executor.VMHooks,
+	opcodeCosts *opcodeCostTable,
+	options executor.CompilationOptions,
+) (*InterpreterInstance, error) {
+	if len(bytecode) == 0 {
+		return nil, ErrInvalidBytecode
+	}
+
+	module, err := decodeModule(bytecode)
+	if err != nil {
+		return nil, err
+	}
+
+	imports, err := resolveImports(module)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &compilationConfig{
+		opcodeCosts:     opcodeCosts,
+		metering:        options.Metering,
+		unmeteredLocals: options.UnmeteredLocals,
+	}
+	functions, err := compileModule(module, config)
+	if err != nil {
+		return nil, err
+	}
+
+	instance := &InterpreterInstance{
+		bytecode:  bytecode,
+		module:    module,
+		functions: functions,
+		imports:   imports,
+		vmHooks:   vmHooks,
+		options:   options,
+		gasLimit:  options.GasLimit,
+	}
+
+	err = instance.initializeState()
+	if err != nil {
+		return nil, err
+	}
+
+	if module.startFunction != nil {
+		err = instance.callExport(*module.startFunction)
+		if err != nil {
+			return nil, fmt.Errorf("%w: start function: %s", ErrFailedInstantiation, err.Error())
+		}
+	}
+
+	logInterpreter.Trace("new instance", "id", instance.ID())
+	return instance, nil
+}
+
+func resolveImports(module *wasmModule) ([]*vmHookImport, error) {
+	imports := make([]*vmHookImport, len(module.imports))
+	for i, functionImport := range module.imports {
+		if functionImport.module != importModuleName {
+			return nil, fmt.Errorf("%w: %s.%s", ErrUnsupportedImport, functionImport.module, functionImport.name)
+		}
+		hook, ok := vmHookImports[functionImport.name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrImportNotFound, functionImport.name)
+		}
+		if !hook.signature.equals(module.types[functionImport.typeIndex]) {
+			return nil, fmt.Errorf("%w: %s", ErrImportSignatureMismatch, functionImport.name)
+		}
+		imports[i] = hook
+	}
+	return imports, nil
+}
+
+// initializeState sets up the memory, globals and table of the instance, as defined by the module.
+func (instance *InterpreterInstance) initializeState() error {
+	module := instance.module
+
+	instance.globals = make([]uint64, len(module.globals))
+	for i, global := range module.globals {
+		instance.globals[i] = global.initValue
+	}
+
+	if module.memory != nil {
+		instance.memory = newInterpreterMemory(module.memory)
+	} else {
+		instance.memory = newInterpreterMemory(&limits{})
+	}
+	for _, segment := range module.data {
+		end := uint64(segment.offset) + uint64(len(segment.data))
+		if end > uint64(instance.memory.Length()) {
+			return fmt.Errorf("%w: data segment out of bounds", ErrFailedInstantiation)
+		}
+		copy(instance.memory.data[segment.offset:], segment.data)
+	}
+
+	instance.table = nil
+	if module.table != nil {
+		instance.table = make([]int64, module.table.min)
+		for i := range instance.table {
+			instance.table[i] = -1
+		}
+	}
+	for _, segment := range module.elements {
+		end := uint64(segment.offset) + uint64(len(segment.functionIndexes))
+		if end > uint64(len(instance.table)) {
+			return fmt.Errorf("%w: element segment out of bounds", ErrFailedInstantiation)
+		}
+		for i, functionIndex := range segment.functionIndexes {
+			instance.table[int(segment.offset)+i] = int64(functionIndex)
+		}
+	}
+
+	instance.memoryGrowCount = 0
+	return nil
+}
+
+// Clean cleans instance
+func (instance *InterpreterInstance) Clean() bool {
+	logInterpreter.Trace("cleaning instance", "id", instance.ID())
+	if instance.alreadyClean {
+		logInterpreter.Trace("clean: already cleaned instance", "id", instance.ID())
+		return false
+	}
+
+	instance.memory.Destroy()
+	instance.functions = nil
+	instance.globals = nil
+	instance.table = nil
+	instance.alreadyClean = true
+	logInterpreter.Trace("cleaned instance", "id", instance.ID())
+
+	return true
+}
+
+// IsAlreadyCleaned returns true if the instance was cleaned
+func (instance *InterpreterInstance) IsAlreadyCleaned() bool {
+	return instance.alreadyClean
+}
+
+// SetGasLimit sets the gas limit for the instance
+func (instance *InterpreterInstance) SetGasLimit(gasLimit uint64) {
+	instance.gasLimit = gasLimit
+}
+
+// SetPointsUsed sets the internal instance gas counter
+func (instance *InterpreterInstance) SetPointsUsed(points uint64) {
+	instance.pointsUsed = points
+}
+
+// GetPointsUsed returns the internal instance gas counter
+func (instance *InterpreterInstance) GetPointsUsed() uint64 {
+	return instance.pointsUsed
+}
+
+// SetBreakpointValue sets the breakpoint value for the instance
+func (instance *InterpreterInstance) SetBreakpointValue(value uint64) {
+	instance.breakpointValue = value
+}
+
+// GetBreakpointValue returns the breakpoint value
+func (instance *InterpreterInstance) GetBreakpointValue() uint64 {
+	return instance.breakpointValue
+}
+
+// Cache returns the bytes from which the instance can be restored. The
+// interpreter has no machine code, so these are simply the original bytecode.
+func (instance *InterpreterInstance) Cache() ([]byte, error) {
+	if instance.alreadyClean {
+		return nil, ErrCachingFailed
+	}
+	cached := make([]byte, len(instance.bytecode))
+	copy(cached, instance.bytecode)
+	return cached, nil
+}
+
+// IsFunctionImported returns true if the instance imports the specified function
+func (instance *InterpreterInstance) IsFunctionImported(name string) bool {
+	for _, functionImport := range instance.module.imports {
+		if functionImport.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CallFunction executes given function from loaded contract.
+func (instance *InterpreterInstance) CallFunction(functionName string) error {
+	if instance.alreadyClean {
+		return ErrInstanceAlreadyCleaned
+	}
+
+	functionIndex, ok := instance.module.exportedFunctionIndex(functionName)
+	if !ok {
+		return fmt.Errorf("%w: %s", executor.ErrFuncNotFound, functionName)
+	}
+
+	signature := instance.module.functionTypeAt(functionIndex)
+	if len(signature.params) > 0 || len(signature.results) > 0 {
+		return fmt.Errorf("%w: %s", executor.ErrFunctionNonvoidSignature, functionName)
+	}
+
+	err := instance.callExport(functionIndex)
+	if err != nil {
+		return fmt.Errorf("failed to call the `%s` exported function: %w", functionName, err)
+	}
+
+	return nil
+}
+
+// HasFunction checks if loaded contract has a function (endpoint) with given name.
+func (instance *InterpreterInstance) HasFunction(functionName string) bool {
+	_, ok := instance.module.exportedFunctionIndex(functionName)
+	return ok
+}
+
+// GetFunctionNames returns a list of the function names exported by the contract.
+func (instance *InterpreterInstance) GetFunctionNames() []string {
+	functionNames := make([]string, 0, len(instance.module.exports))
+	for _, export := range instance.module.exports {
+		if export.kind == externalKindFunction {
+			functionNames = append(functionNames, export.name)
+		}
+	}
+	return functionNames
+}
+
+// ValidateFunctionArities checks that no function (endpoint) of the given contract has any parameters or returns any result.
+// All arguments and results should be transferred via the import functions.
+func (instance *InterpreterInstance) ValidateFunctionArities() error {
+	for _, export := range instance.module.exports {
+		if export.kind != externalKindFunction {
+			continue
+		}
+		signature := instance.module.functionTypeAt(export.index)
+		if len(signature.params) > 0 || len(signature.results) > 0 {
+			return fmt.Errorf("%w: %s", executor.ErrFunctionNonvoidSignature, export.name)
+		}
+	}
+	return nil
+}
+
+// HasMemory checks whether the instance declares a memory.
+func (instance *InterpreterInstance) HasMemory() bool {
+	return instance.module.memory != nil
+}
+
+// MemLoad returns the contents from the given offset of the WASM memory.
+func (instance *InterpreterInstance) MemLoad(memPtr executor.MemPtr, length executor.MemLength) ([]byte, error) {
+	return executor.MemLoadFromMemory(instance.memory, memPtr, length)
+}
+
+// MemStore stores the given data in the WASM memory at the given offset.
+func (instance *InterpreterInstance) MemStore(memPtr executor.MemPtr, data []byte) error {
+	return executor.MemStoreToMemory(instance.memory, memPtr, data)
+}
+
+// MemLength returns the length of the allocated memory. Only called directly in tests.
+func (instance *InterpreterInstance) MemLength() uint32 {
+	return instance.memory.Length()
+}
+
+// MemGrow allocates more pages to the current memory. Only called directly in tests.
+func (instance *InterpreterInstance) MemGrow(pages uint32) error {
+	return instance.memory.Grow(pages)
+}
+
+// MemDump yields the entire contents of the memory. Only used in tests.
+func (instance *InterpreterInstance) MemDump() []byte {
+	return instance.memory.Data()
+}
+
+// ID returns an identifier for the instance, unique at runtime
+func (instance *InterpreterInstance) ID() string {
+	return fmt.Sprintf("%p", instance)
+}
+
+// Reset resets the instance memories and globals
+func (instance *InterpreterInstance) Reset() bool {
+	if instance.alreadyClean {
+		logInterpreter.Trace("reset: already cleaned instance", "id", instance.ID())
+		return false
+	}
+
+	err := instance.initializeState()
+	ok := err == nil
+
+	logInterpreter.Trace("reset: warm instance", "id", instance.ID(), "ok", ok)
+	return ok
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (instance *InterpreterInstance) IsInterfaceNil() bool {
+	return instance == nil
+}
+
+// SetVMHooksPtr sets the VM hooks pointer
+func (instance *InterpreterInstance) SetVMHooksPtr(vmHooksPtr uintptr) {
+	instance.vmHooksPtr = vmHooksPtr
+}
+
+// GetVMHooksPtr returns the VM hooks pointer
+func (instance *InterpreterInstance) GetVMHooksPtr() uintptr {
+	return instance.vmHooksPtr
+}
@@ -0,0 +1,284 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/stretchr/testify/require"
+)
+
+var noValues []valueType
+
+func newTestInstance(t *testing.T, code []byte, hooks executor.VMHooks, options executor.CompilationOptions) *InterpreterInstance {
+	interpreterExecutor, err := ExecutorFactory().CreateExecutor(executor.ExecutorFactoryArgs{
+		VMHooks:     hooks,
+		OpcodeCosts: allOpcodesCostOne(),
+	})
+	require.Nil(t, err)
+
+	instance, err := interpreterExecutor.NewInstanceWithOptions(code, options)
+	require.Nil(t, err)
+	return instance.(*InterpreterInstance)
+}
+
+func allOpcodesCostOne() *executor.WASMOpcodeCost {
+	return &executor.WASMOpcodeCost{
+		Block: 1, Loop: 1, If: 1, Else: 1, End: 1, Br: 1, BrIf: 1, BrTable: 1,
+		Return: 1, Call: 1, Drop: 1, LocalGet: 1, LocalSet: 1, LocalTee: 1,
+		I32Const: 1, I64Const: 1, I64Add: 1, I64Sub: 1, I64Mul: 1,
+	}
+}
+
+func defaultTestOptions() executor.CompilationOptions {
+	return executor.CompilationOptions{
+		GasLimit:           1000000,
+		MaxMemoryGrow:      10,
+		MaxMemoryGrowDelta: 10,
+		Metering:           true,
+		RuntimeBreakpoints: true,
+	}
+}
+
+func newModuleWithFinish() (*testModuleBuilder, uint32, uint32) {
+	builder := newTestModuleBuilder()
+	voidType := builder.addType(noValues, noValues)
+	finishType := builder.addType([]valueType{valueTypeI64}, noValues)
+	finish := builder.addImport("int64finish", finishType)
+	return builder, voidType, finish
+}
+
+func TestInterpreterInstance_RecursiveCall(t *testing.T) {
+	builder, voidType, finish := newModuleWithFinish()
+	factType := builder.addType([]valueType{valueTypeI64}, []valueType{valueTypeI64})
+	fact := builder.numFuncs
+	builder.addFunction(factType, nil,
+		opLocalGet, 0, opI64Eqz,
+		opIf, byte(valueTypeI64),
+		opI64Const, 1,
+		opElse,
+		opLocalGet, 0,
+		opLocalGet, 0, opI64Const, 1, opI64Sub,
+		opCall, byte(fact),
+		opI64Mul,
+		opEnd,
+	)
+	main := builder.addFunction(voidType, nil, opI64Const, 10, opCall, byte(fact), opCall, byte(finish))
+	builder.addExport("main", main)
+
+	hooks := &testVMHooks{}
+	instance := newTestInstance(t, builder.build(), hooks, defaultTestOptions())
+	require.Nil(t, instance.CallFunction("main"))
+	require.Equal(t, []int64{3628800}, hooks.finished)
+	require.True(t, instance.GetPointsUsed() > 0)
+}
+
+func TestInterpreterInstance_LoopAndBranchTable(t *testing.T) {
+	builder, voidType, finish := newModuleWithFinish()
+
+	sumBody := []byte{
+		opI32Const, 10, opLocalSet, 1,
+		opLoop, blockTypeEmpty,
+		opLocalGet, 0, opLocalGet, 1, opI64ExtendI32U, opI64Add, opLocalSet, 0,
+		opLocalGet, 1, opI32Const, 1, opI32Sub, opLocalTee, 1,
+		opBrIf, 0,
+		opEnd,
+		opLocalGet, 0, opCall, byte(finish),
+	}
+	sum := builder.addFunction(voidType, []valueType{valueTypeI64, valueTypeI32}, sumBody...)
+	builder.addExport("sum", sum)
+
+	selectType := builder.addType([]valueType{valueTypeI32}, []valueType{valueTypeI64})
+	selectFunction := builder.numFuncs
+	builder.addFunction(selectType, nil,
+		opBlock, blockTypeEmpty,
+		opBlock, blockTypeEmpty,
+		opBlock, blockTypeEmpty,
+		opLocalGet, 0,
+		opBrTable, 2, 0, 1, 2,
+		opEnd,
+		opI64Const, 10, opReturn,
+		opEnd,
+		opI64Const, 20, opReturn,
+		opEnd,
+		opI64Const, 30,
+	)
+	selectMain := builder.addFunction(voidType, nil,
+		opI32Const, 0, opCall, byte(selectFunction), opCall, byte(finish),
+		opI32Const, 1, opCall, byte(selectFunction), opCall, byte(finish),
+		opI32Const, 5, opCall, byte(selectFunction), opCall, byte(finish),
+	)
+	builder.addExport("select", selectMain)
+
+	hooks := &testVMHooks{}
+	instance := newTestInstance(t, builder.build(), hooks, defaultTestOptions())
+	require.Nil(t, instance.CallFunction("sum"))
+	require.Nil(t, instance.CallFunction("select"))
+	require.Equal(t, []int64{55, 10, 20, 30}, hooks.finished)
+}
+
+func TestInterpreterInstance_OutOfGas(t *testing.T) {
+	builder, voidType, _ := newModuleWithFinish()
+	main := builder.addFunction(voidType, nil, opLoop, blockTypeEmpty, opBr, 0, opEnd)
+	builder.addExport("main", main)
+
+	options := defaultTestOptions()
+	options.GasLimit = 100
+	instance := newTestInstance(t, builder.build(), &testVMHooks{}, options)
+	err := instance.CallFunction("main")
+	require.ErrorIs(t, err, ErrExecutionTrapped)
+	require.Equal(t, uint64(breakpointOutOfGas), instance.GetBreakpointValue())
+	require.True(t, instance.GetPointsUsed() <= 100)
+}
+
+func TestInterpreterInstance_Traps(t *testing.T) {
+	builder, voidType, _ := newModuleWithFinish()
+	unreachable := builder.addFunction(voidType, nil, opUnreachable)
+	builder.addExport("unreachable", unreachable)
+	divByZero := builder.addFunction(voidType, nil, opI32Const, 1, opI32Const, 0, opI32DivS, opDrop)
+	builder.addExport("divByZero", divByZero)
+	outOfBounds := builder.addFunction(voidType, nil, opI32Const, 0x7f, opI32Load, 2, 0, opDrop)
+	builder.addExport("outOfBounds", outOfBounds)
+	builder.setMemory(1, 2)
+
+	instance := newTestInstance(t, builder.build(), &testVMHooks{}, defaultTestOptions())
+	require.ErrorIs(t, instance.CallFunction("unreachable"), ErrExecutionTrapped)
+	require.ErrorIs(t, instance.CallFunction("divByZero"), ErrExecutionTrapped)
+	// i32.const 0x7f is -1, which is far beyond the memory
+	require.ErrorIs(t, instance.CallFunction("outOfBounds"), ErrExecutionTrapped)
+	require.ErrorIs(t, instance.CallFunction("missing"), executor.ErrFuncNotFound)
+	require.Equal(t, uint64(0), instance.GetBreakpointValue())
+}
+
+func TestInterpreterInstance_MemoryGrow(t *testing.T) {
+	builder, voidType, finish := newModuleWithFinish()
+	grow := builder.addFunction(voidType, nil,
+		opI32Const, 1, opMemoryGrow, 0, opI64ExtendI32S, opCall, byte(finish))
+	builder.addExport("grow", grow)
+	growTooMuch := builder.addFunction(voidType, nil,
+		opI32Const, 20, opMemoryGrow, 0, opI64ExtendI32S, opCall, byte(finish))
+	builder.addExport("growTooMuch", growTooMuch)
+	builder.setMemory(1, 3)
+	code := builder.build()
+
+	hooks := &testVMHooks{}
+	options := defaultTestOptions()
+	options.MaxMemoryGrow = 1
+	instance := newTestInstance(t, code, hooks, options)
+	require.Nil(t, instance.CallFunction("grow"))
+	require.Equal(t, []int64{1}, hooks.finished)
+	require.Equal(t, uint32(2*wasmPageSize), instance.MemLength())
+	require.ErrorIs(t, instance.CallFunction("grow"), ErrExecutionTrapped)
+	require.Equal(t, uint64(breakpointMemoryLimit), instance.GetBreakpointValue())
+
+	hooks = &testVMHooks{}
+	options.RuntimeBreakpoints = false
+	instance = newTestInstance(t, code, hooks, options)
+	require.Nil(t, instance.CallFunction("growTooMuch"))
+	require.Equal(t, []int64{-1}, hooks.finished)
+}
+
+func TestInterpreterInstance_BreakpointSetByHook(t *testing.T) {
+	builder := newTestModuleBuilder()
+	voidType := builder.addType(noValues, noValues)
+	gasLeftType := builder.addType(noValues, []valueType{valueTypeI64})
+	finishType := builder.addType([]valueType{valueTypeI64}, noValues)
+	getGasLeft := builder.addImport("getGasLeft", gasLeftType)
+	finish := builder.addImport("int64finish", finishType)
+	main := builder.addFunction(voidType, nil, opCall, byte(getGasLeft), opCall, byte(finish))
+	builder.addExport("main", main)
+
+	hooks := &testVMHooks{}
+	instance := newTestInstance(t, builder.build(), hooks, defaultTestOptions())
+	hooks.onGetGasLeft = func() int64 {
+		instance.SetBreakpointValue(3)
+		return 0
+	}
+
+	require.ErrorIs(t, instance.CallFunction("main"), ErrExecutionTrapped)
+	require.Empty(t, hooks.finished)
+	require.True(t, instance.IsFunctionImported("getGasLeft"))
+	require.False(t, instance.IsFunctionImported("bigIntAdd"))
+}
+
+func TestInterpreterInstance_ResetAndCache(t *testing.T) {
+	builder, voidType, finish := newModuleWithFinish()
+	builder.addMutableGlobalI64(5)
+	main := builder.addFunction(voidType, nil,
+		opGlobalGet, 0, opI64Const, 1, opI64Add, opGlobalSet, 0,
+		opGlobalGet, 0, opCall, byte(finish))
+	builder.addExport("main", main)
+
+	hooks := &testVMHooks{}
+	instance := newTestInstance(t, builder.build(), hooks, defaultTestOptions())
+	require.Nil(t, instance.CallFunction("main"))
+	require.Nil(t, instance.CallFunction("main"))
+	require.True(t, instance.Reset())
+	require.Nil(t, instance.CallFunction("main"))
+	require.Equal(t, []int64{6, 7, 6}, hooks.finished)
+
+	cached, err := instance.Cache()
+	require.Nil(t, err)
+	interpreterExecutor, _ := CreateExecutor(hooks)
+	restored, err := interpreterExecutor.NewInstanceFromCompiledCodeWithOptions(cached, defaultTestOptions())
+	require.Nil(t, err)
+	require.Equal(t, []string{"main"}, restored.GetFunctionNames())
+
+	require.True(t, instance.Clean())
+	require.False(t, instance.Clean())
+	require.False(t, instance.Reset())
+	require.ErrorIs(t, instance.CallFunction("main"), ErrInstanceAlreadyCleaned)
+}
+
+func TestInterpreterInstance_ValidateFunctionArities(t *testing.T) {
+	builder := newTestModuleBuilder()
+	voidType := builder.addType(noValues, noValues)
+	resultType := builder.addType(noValues, []valueType{valueTypeI32})
+	good := builder.addFunction(voidType, nil)
+	builder.addExport("good", good)
+	bad := builder.addFunction(resultType, nil, opI32Const, 1)
+	builder.addExport("bad", bad)
+
+	instance := newTestInstance(t, builder.build(), &testVMHooks{}, defaultTestOptions())
+	require.True(t, instance.HasFunction("bad"))
+	require.False(t, instance.HasMemory())
+	require.ErrorIs(t, instance.ValidateFunctionArities(), executor.ErrFunctionNonvoidSignature)
+	require.ErrorIs(t, instance.CallFunction("bad"), executor.ErrFunctionNonvoidSignature)
+}
+
+func TestInterpreterExecutor_InvalidModules(t *testing.T) {
+	interpreterExecutor, _ := CreateExecutor(&testVMHooks{})
+	options := defaultTestOptions()
+
+	_, err := interpreterExecutor.NewInstanceWithOptions(nil, options)
+	require.ErrorIs(t, err, ErrInvalidBytecode)
+
+	builder := newTestModuleBuilder()
+	voidType := builder.addType(noValues, noValues)
+	builder.addImport("notAVMHook", voidType)
+	_, err = interpreterExecutor.NewInstanceWithOptions(builder.build(), options)
+	require.ErrorIs(t, err, ErrImportNotFound)
+
+	builder = newTestModuleBuilder()
+	voidType = builder.addType(noValues, noValues)
+	builder.addImport("int64finish", voidType)
+	_, err = interpreterExecutor.NewInstanceWithOptions(builder.build(), options)
+	require.ErrorIs(t, err, ErrImportSignatureMismatch)
+
+	builder = newTestModuleBuilder()
+	voidType = builder.addType(noValues, noValues)
+	builder.addFunction(voidType, nil, opI32Const, 1)
+	_, err = interpreterExecutor.NewInstanceWithOptions(builder.build(), options)
+	require.ErrorIs(t, err, ErrValidationFailed)
+
+	builder = newTestModuleBuilder()
+	voidType = builder.addType(noValues, noValues)
+	builder.addFunction(voidType, nil, opI64Const, 1, opI32Const, 1, opI32Add, opDrop)
+	_, err = interpreterExecutor.NewInstanceWithOptions(builder.build(), options)
+	require.ErrorIs(t, err, ErrValidationFailed)
+
+	builder = newTestModuleBuilder()
+	voidType = builder.addType(noValues, noValues)
+	builder.setMemory(1, maxMemoryPages+1)
+	_, err = interpreterExecutor.NewInstanceWithOptions(builder.build(), options)
+	require.ErrorIs(t, err, ErrInvalidMemory)
+}
@@ -0,0 +1,542 @@
+package interpreter
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// maxCallDepth limits the nesting of WASM function calls within a single execution.
+const maxCallDepth = 10000
+
+// Breakpoint values set by the interpreter itself. They mirror vmhost.BreakpointValue.
+const (
+	breakpointOutOfGas    = 4
+	breakpointMemoryLimit = 5
+)
+
+// trap is raised as a panic to abort execution, and recovered in callExport.
+type trap struct {
+	reason string
+}
+
+func raiseTrap(format string, args ...interface{}) {
+	panic(trap{reason: fmt.Sprintf(format, args...)})
+}
+
+// executionMachine holds the state of one execution of an exported function.
+// Each execution has its own value stack, so that a VM hook can safely
+// trigger another execution on the same instance.
+type executionMachine struct {
+	instance  *InterpreterInstance
+	stack     []uint64
+	sp        int
+	callDepth int
+}
+
+// callExport runs the function with the given index, converting traps into errors.
+func (instance *InterpreterInstance) callExport(functionIndex uint32) (err error) {
+	machine := &executionMachine{
+		instance: instance,
+		stack:    make([]uint64, 256),
+	}
+
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		trapped, ok := recovered.(trap)
+		if !ok {
+			panic(recovered)
+		}
+		err = fmt.Errorf("%w: %s", ErrExecutionTrapped, trapped.reason)
+	}()
+
+	machine.call(functionIndex)
+	return nil
+}
+
+func (machine *executionMachine) ensureStack(size int) {
+	if size <= len(machine.stack) {
+		return
+	}
+	newLength := 2 * len(machine.stack)
+	if newLength < size {
+		newLength = size
+	}
+	grown := make([]uint64, newLength)
+	copy(grown, machine.stack[:machine.sp])
+	machine.stack = grown
+}
+
+func (machine *executionMachine) call(functionIndex uint32) {
+	instance := machine.instance
+	numImported := instance.module.numImportedFunctions()
+	if functionIndex < numImported {
+		machine.callImport(functionIndex)
+		return
+	}
+
+	machine.callDepth++
+	if machine.callDepth > maxCallDepth {
+		raiseTrap("call stack exhausted")
+	}
+	machine.execute(instance.functions[functionIndex-numImported])
+	machine.callDepth--
+}
+
+func (machine *executionMachine) callImport(functionIndex uint32) {
+	instance := machine.instance
+	hook := instance.imports[functionIndex]
+	numParams := len(hook.signature.params)
+
+	args := make([]uint64, numParams)
+	copy(args, machine.stack[machine.sp-numParams:machine.sp])
+	machine.sp -= numParams
+
+	result := hook.invoke(instance.vmHooks, args)
+	if len(hook.signature.results) > 0 {
+		machine.ensureStack(machine.sp + 1)
+		machine.stack[machine.sp] = result
+		machine.sp++
+	}
+
+	if instance.options.RuntimeBreakpoints && instance.breakpointValue != 0 {
+		raiseTrap("breakpoint %d set by %s", instance.breakpointValue, instance.module.imports[functionIndex].name)
+	}
+}
+
+func (machine *executionMachine) chargeGas(cost uint64) {
+	instance := machine.instance
+	newPointsUsed := instance.pointsUsed + cost
+	if newPointsUsed < instance.pointsUsed || newPointsUsed > instance.gasLimit {
+		instance.breakpointValue = breakpointOutOfGas
+		raiseTrap("out of gas")
+	}
+	instance.pointsUsed = newPointsUsed
+}
+
+// effectiveAddress computes the address of a memory access and checks its bounds.
+func (machine *executionMachine) effectiveAddress(base uint64, offset uint64, size uint64) uint64 {
+	address := uint64(uint32(base)) + offset
+	if address+size > uint64(len(machine.instance.memory.data)) {
+		raiseTrap("out of bounds memory access")
+	}
+	return address
+}
+
+func (machine *executionMachine) memoryGrow(pages uint32) uint32 {
+	instance := machine.instance
+	if instance.options.RuntimeBreakpoints {
+		if instance.memoryGrowCount >= instance.options.MaxMemoryGrow ||
+			uint64(pages) > instance.options.MaxMemoryGrowDelta {
+			instance.breakpointValue = breakpointMemoryLimit
+			raiseTrap("memory limit reached")
+		}
+		instance.memoryGrowCount++
+	}
+
+	previousPages := instance.memory.Pages()
+	err := instance.memory.Grow(pages)
+	if err != nil {
+		return 0xffffffff
+	}
+	return previousPages
+}
+
+// execute runs a compiled function. Its arguments are on top of the stack and are
+// replaced by its results when it returns.
+func (machine *executionMachine) execute(function *compiledFunction) {
+	instance := machine.instance
+	numParams := len(function.signature.params)
+	fp := machine.sp - numParams
+	machine.ensureStack(fp + int(function.numLocals) + int(function.maxStackHeight))
+
+	stack := machine.stack
+	for i := machine.sp; i < fp+int(function.numLocals); i++ {
+		stack[i] = 0
+	}
+	sp := fp + int(function.numLocals)
+
+	code := function.code
+	pc := 0
+	for {
+		instr := &code[pc]
+		pc++
+
+		switch instr.opcode {
+		case opUnreachable:
+			raiseTrap("unreachable")
+		case opChargeGas:
+			machine.chargeGas(instr.immediate)
+		case opJump:
+			pc = int(instr.target)
+		case opJumpIfZero:
+			sp--
+			if uint32(stack[sp]) == 0 {
+				pc = int(instr.target)
+			}
+		case opBr:
+			sp = branch(stack, fp, sp, instr.height, instr.arity)
+			pc = int(instr.target)
+		case opBrIf:
+			sp--
+			if uint32(stack[sp]) != 0 {
+				sp = branch(stack, fp, sp, instr.height, instr.arity)
+				pc = int(instr.target)
+			}
+		case opBrTable:
+			sp--
+			table := function.branchTables[instr.immediate]
+			index := uint32(stack[sp])
+			if index >= uint32(len(table)-1) {
+				index = uint32(len(table) - 1)
+			}
+			target := table[index]
+			sp = branch(stack, fp, sp, target.height, target.arity)
+			pc = int(target.target)
+		case opReturn:
+			arity := int(instr.arity)
+			copy(stack[fp:fp+arity], stack[sp-arity:sp])
+			machine.sp = fp + arity
+			return
+
+		case opCall, opCallImport:
+			machine.sp = sp
+			machine.call(uint32(instr.immediate))
+			stack = machine.stack
+			sp = machine.sp
+		case opCallIndirect:
+			sp--
+			tableIndex := uint32(stack[sp])
+			if tableIndex >= uint32(len(instance.table)) {
+				raiseTrap("undefined table element")
+			}
+			functionIndex := instance.table[tableIndex]
+			if functionIndex < 0 {
+				raiseTrap("uninitialized table element")
+			}
+			expectedType := instance.module.types[instr.immediate]
+			if !instance.module.functionTypeAt(uint32(functionIndex)).equals(expectedType) {
+				raiseTrap("indirect call type mismatch")
+			}
+			machine.sp = sp
+			machine.call(uint32(functionIndex))
+			stack = machine.stack
+			sp = machine.sp
+
+		case opDrop:
+			sp--
+		case opSelect:
+			sp -= 2
+			if uint32(stack[sp+1]) == 0 {
+				stack[sp-1] = stack[sp]
+			}
+
+		case opLocalGet:
+			stack[sp] = stack[fp+int(instr.immediate)]
+			sp++
+		case opLocalSet:
+			sp--
+			stack[fp+int(instr.immediate)] = stack[sp]
+		case opLocalTee:
+			stack[fp+int(instr.immediate)] = stack[sp-1]
+		case opGlobalGet:
+			stack[sp] = instance.globals[instr.immediate]
+			sp++
+		case opGlobalSet:
+			sp--
+			instance.globals[instr.immediate] = stack[sp]
+
+		case opI32Load:
+			address := machine.effectiveAddress(stack[sp-1], instr.immediate, 4)
+			stack[sp-1] = uint64(binary.LittleEndian.Uint32(instance.memory.data[address:]))
+		case opI64Load:
+			address := machine.effectiveAddress(stack[sp-1], instr.immediate, 8)
+			stack[sp-1] = binary.LittleEndian.Uint64(instance.memory.data[address:])
+		case opI32Load8S:
+			address := machine.effectiveAddress(stack[sp-1], instr.immediate, 1)
+			stack[sp-1] = uint64(uint32(int32(int8(instance.memory.data[address]))))
+		case opI32Load8U, opI64Load8U:
+			address := machine.effectiveAddress(stack[sp-1], instr.immediate, 1)
+			stack[sp-1] = uint64(instance.memory.data[address])
+		case opI32Load16S:
+			address := machine.effectiveAddress(stack[sp-1], instr.immediate, 2)
+			stack[sp-1] = uint64(uint32(int32(int16(binary.LittleEndian.Uint16(instance.memory.data[address:])))))
+		case opI32Load16U, opI64Load16U:
+			address := machine.effectiveAddress(stack[sp-1], instr.immediate, 2)
+			stack[sp-1] = uint64(binary.LittleEndian.Uint16(instance.memory.data[address:]))
+		case opI64Load8S:
+			address := machine.effectiveAddress(stack[sp-1], instr.immediate, 1)
+			stack[sp-1] = uint64(int64(int8(instance.memory.data[address])))
+		case opI64Load16S:
+			address := machine.effectiveAddress(stack[sp-1], instr.immediate, 2)
+			stack[sp-1] = uint64(int64(int16(binary.LittleEndian.Uint16(instance.memory.data[address:]))))
+		case opI64Load32S:
+			address := machine.effectiveAddress(stack[sp-1], instr.immediate, 4)
+			stack[sp-1] = uint64(int64(int32(binary.LittleEndian.Uint32(instance.memory.data[address:]))))
+		case opI64Load32U:
+			address := machine.effectiveAddress(stack[sp-1], instr.immediate, 4)
+			stack[sp-1] = uint64(binary.LittleEndian.Uint32(instance.memory.data[address:]))
+
+		case opI32Store, opI64Store32:
+			sp -= 2
+			address := machine.effectiveAddress(stack[sp], instr.immediate, 4)
+			binary.LittleEndian.PutUint32(instance.memory.data[address:], uint32(stack[sp+1]))
+		case opI64Store:
+			sp -= 2
+			address := machine.effectiveAddress(stack[sp], instr.immediate, 8)
+			binary.LittleEndian.PutUint64(instance.memory.data[address:], stack[sp+1])
+		case opI32Store8, opI64Store8:
+			sp -= 2
+			address := machine.effectiveAddress(stack[sp], instr.immediate, 1)
+			instance.memory.data[address] = byte(stack[sp+1])
+		case opI32Store16, opI64Store16:
+			sp -= 2
+			address := machine.effectiveAddress(stack[sp], instr.immediate, 2)
+			binary.LittleEndian.PutUint16(instance.memory.data[address:], uint16(stack[sp+1]))
+
+		case opMemorySize:
+			stack[sp] = uint64(instance.memory.Pages())
+			sp++
+		case opMemoryGrow:
+			stack[sp-1] = uint64(machine.memoryGrow(uint32(stack[sp-1])))
+
+		case opI32Const, opI64Const:
+			stack[sp] = instr.immediate
+			sp++
+
+		default:
+			sp = executeNumeric(instr.opcode, stack, sp)
+		}
+	}
+}
+
+// branch moves the label values to the label height and returns the new stack pointer.
+func branch(stack []uint64, fp int, sp int, height uint32, arity uint32) int {
+	destination := fp + int(height)
+	copy(stack[destination:destination+int(arity)], stack[sp-int(arity):sp])
+	return destination + int(arity)
+}
+
+func boolToValue(condition bool) uint64 {
+	if condition {
+		return 1
+	}
+	return 0
+}
+
+// executeNumeric runs the comparison, arithmetic and conversion operators.
+// i32 values are kept zero-extended in the 64 bit stack slots.
+func executeNumeric(opcode byte, stack []uint64, sp int) int {
+	switch opcode {
+	case opI32Eqz:
+		stack[sp-1] = boolToValue(uint32(stack[sp-1]) == 0)
+		return sp
+	case opI64Eqz:
+		stack[sp-1] = boolToValue(stack[sp-1] == 0)
+		return sp
+	case opI32Clz:
+		stack[sp-1] = uint64(bits.LeadingZeros32(uint32(stack[sp-1])))
+		return sp
+	case opI32Ctz:
+		stack[sp-1] = uint64(bits.TrailingZeros32(uint32(stack[sp-1])))
+		return sp
+	case opI32Popcnt:
+		stack[sp-1] = uint64(bits.OnesCount32(uint32(stack[sp-1])))
+		return sp
+	case opI64Clz:
+		stack[sp-1] = uint64(bits.LeadingZeros64(stack[sp-1]))
+		return sp
+	case opI64Ctz:
+		stack[sp-1] = uint64(bits.TrailingZeros64(stack[sp-1]))
+		return sp
+	case opI64Popcnt:
+		stack[sp-1] = uint64(bits.OnesCount64(stack[sp-1]))
+		return sp
+	case opI32WrapI64:
+		stack[sp-1] = uint64(uint32(stack[sp-1]))
+		return sp
+	case opI64ExtendI32S:
+		stack[sp-1] = uint64(int64(int32(stack[sp-1])))
+		return sp
+	case opI64ExtendI32U:
+		stack[sp-1] = uint64(uint32(stack[sp-1]))
+		return sp
+	case opI32Extend8S:
+		stack[sp-1] = uint64(uint32(int32(int8(stack[sp-1]))))
+		return sp
+	case opI32Extend16S:
+		stack[sp-1] = uint64(uint32(int32(int16(stack[sp-1]))))
+		return sp
+	case opI64Extend8S:
+		stack[sp-1] = uint64(int64(int8(stack[sp-1])))
+		return sp
+	case opI64Extend16S:
+		stack[sp-1] = uint64(int64(int16(stack[sp-1])))
+		return sp
+	case opI64Extend32S:
+		stack[sp-1] = uint64(int64(int32(stack[sp-1])))
+		return sp
+	}
+
+	sp--
+	first, second := stack[sp-1], stack[sp]
+	if opcode <= opI32GeU || (opcode >= opI32Clz && opcode <= opI32Rotr) {
+		stack[sp-1] = uint64(executeI32Binary(opcode, uint32(first), uint32(second)))
+	} else {
+		stack[sp-1] = executeI64Binary(opcode, first, second)
+	}
+	return sp
+}
+
+func executeI32Binary(opcode byte, first uint32, second uint32) uint32 {
+	switch opcode {
+	case opI32Eq:
+		return uint32(boolToValue(first == second))
+	case opI32Ne:
+		return uint32(boolToValue(first != second))
+	case opI32LtS:
+		return uint32(boolToValue(int32(first) < int32(second)))
+	case opI32LtU:
+		return uint32(boolToValue(first < second))
+	case opI32GtS:
+		return uint32(boolToValue(int32(first) > int32(second)))
+	case opI32GtU:
+		return uint32(boolToValue(first > second))
+	case opI32LeS:
+		return uint32(boolToValue(int32(first) <= int32(second)))
+	case opI32LeU:
+		return uint32(boolToValue(first <= second))
+	case opI32GeS:
+		return uint32(boolToValue(int32(first) >= int32(second)))
+	case opI32GeU:
+		return uint32(boolToValue(first >= second))
+	case opI32Add:
+		return first + second
+	case opI32Sub:
+		return first - second
+	case opI32Mul:
+		return first * second
+	case opI32DivS:
+		if second == 0 {
+			raiseTrap("integer divide by zero")
+		}
+		if int32(first) == -1<<31 && int32(second) == -1 {
+			raiseTrap("integer overflow")
+		}
+		return uint32(int32(first) / int32(second))
+	case opI32DivU:
+		if second == 0 {
+			raiseTrap("integer divide by zero")
+		}
+		return first / second
+	case opI32RemS:
+		if second == 0 {
+			raiseTrap("integer divide by zero")
+		}
+		if int32(second) == -1 {
+			return 0
+		}
+		return uint32(int32(first) % int32(second))
+	case opI32RemU:
+		if second == 0 {
+			raiseTrap("integer divide by zero")
+		}
+		return first % second
+	case opI32And:
+		return first & second
+	case opI32Or:
+		return first | second
+	case opI32Xor:
+		return first ^ second
+	case opI32Shl:
+		return first << (second & 31)
+	case opI32ShrS:
+		return uint32(int32(first) >> (second & 31))
+	case opI32ShrU:
+		return first >> (second & 31)
+	case opI32Rotl:
+		return bits.RotateLeft32(first, int(second&31))
+	case opI32Rotr:
+		return bits.RotateLeft32(first, -int(second&31))
+	}
+	raiseTrap("unknown opcode 0x%x", opcode)
+	return 0
+}
+
+func executeI64Binary(opcode byte, first uint64, second uint64) uint64 {
+	switch opcode {
+	case opI64Eq:
+		return boolToValue(first == second)
+	case opI64Ne:
+		return boolToValue(first != second)
+	case opI64LtS:
+		return boolToValue(int64(first) < int64(second))
+	case opI64LtU:
+		return boolToValue(first < second)
+	case opI64GtS:
+		return boolToValue(int64(first) > int64(second))
+	case opI64GtU:
+		return boolToValue(first > second)
+	case opI64LeS:
+		return boolToValue(int64(first) <= int64(second))
+	case opI64LeU:
+		return boolToValue(first <= second)
+	case opI64GeS:
+		return boolToValue(int64(first) >= int64(second))
+	case opI64GeU:
+		return boolToValue(first >= second)
+	case opI64Add:
+		return first + second
+	case opI64Sub:
+		return first - second
+	case opI64Mul:
+		return first * second
+	case opI64DivS:
+		if second == 0 {
+			raiseTrap("integer divide by zero")
+		}
+		if int64(first) == -1<<63 && int64(second) == -1 {
+			raiseTrap("integer overflow")
+		}
+		return uint64(int64(first) / int64(second))
+	case opI64DivU:
+		if second == 0 {
+			raiseTrap("integer divide by zero")
+		}
+		return first / second
+	case opI64RemS:
+		if second == 0 {
+			raiseTrap("integer divide by zero")
+		}
+		if int64(second) == -1 {
+			return 0
+		}
+		return uint64(int64(first) % int64(second))
+	case opI64RemU:
+		if second == 0 {
+			raiseTrap("integer divide by zero")
+		}
+		return first % second
+	case opI64And:
+		return first & second
+	case opI64Or:
+		return first | second
+	case opI64Xor:
+		return first ^ second
+	case opI64Shl:
+		return first << (second & 63)
+	case opI64ShrS:
+		return uint64(int64(first) >> (second & 63))
+	case opI64ShrU:
+		return first >> (second & 63)
+	case opI64Rotl:
+		return bits.RotateLeft64(first, int(second&63))
+	case opI64Rotr:
+		return bits.RotateLeft64(first, -int(second&63))
+	}
+	raiseTrap("unknown opcode 0x%x", opcode)
+	return 0
+}
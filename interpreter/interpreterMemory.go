@@ -0,0 +1,68 @@
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+var _ = (executor.Memory)((*InterpreterMemory)(nil))
+
+// wasmPageSize is the size of a WASM memory page, in bytes.
+const wasmPageSize = 65536
+
+// InterpreterMemory is the linear memory of an interpreter instance.
+type InterpreterMemory struct {
+	data     []byte
+	maxPages uint32
+}
+
+func newInterpreterMemory(memoryLimits *limits) *InterpreterMemory {
+	maxPages := uint32(maxWASMPages)
+	if memoryLimits.hasMax {
+		maxPages = memoryLimits.max
+	}
+	return &InterpreterMemory{
+		data:     make([]byte, uint64(memoryLimits.min)*wasmPageSize),
+		maxPages: maxPages,
+	}
+}
+
+// Length calculates the memory length (in bytes).
+func (memory *InterpreterMemory) Length() uint32 {
+	return uint32(len(memory.data))
+}
+
+// Data returns a slice of bytes over the WebAssembly memory.
+func (memory *InterpreterMemory) Data() []byte {
+	return memory.data
+}
+
+// Pages returns the current size of the memory, in pages.
+func (memory *InterpreterMemory) Pages() uint32 {
+	return uint32(len(memory.data) / wasmPageSize)
+}
+
+// Grow the memory by a number of pages (65kb each).
+func (memory *InterpreterMemory) Grow(numberOfPages uint32) error {
+	newPages := uint64(memory.Pages()) + uint64(numberOfPages)
+	if newPages > uint64(memory.maxPages) {
+		return fmt.Errorf("%w: cannot grow from %d pages by %d pages, maximum is %d",
+			ErrMemoryGrowFailed, memory.Pages(), numberOfPages, memory.maxPages)
+	}
+
+	grown := make([]byte, newPages*wasmPageSize)
+	copy(grown, memory.data)
+	memory.data = grown
+	return nil
+}
+
+// Destroy releases the memory contents.
+func (memory *InterpreterMemory) Destroy() {
+	memory.data = nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (memory *InterpreterMemory) IsInterfaceNil() bool {
+	return memory == nil
+}
@@ -0,0 +1,293 @@
+package interpreter
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// WASM opcodes accepted by the interpreter. Floating point, SIMD, bulk memory,
+// reference types and threads are rejected, just like in the Wasmer executors.
+const (
+	opUnreachable  = 0x00
+	opNop          = 0x01
+	opBlock        = 0x02
+	opLoop         = 0x03
+	opIf           = 0x04
+	opElse         = 0x05
+	opEnd          = 0x0b
+	opBr           = 0x0c
+	opBrIf         = 0x0d
+	opBrTable      = 0x0e
+	opReturn       = 0x0f
+	opCall         = 0x10
+	opCallIndirect = 0x11
+	opDrop         = 0x1a
+	opSelect       = 0x1b
+	opTypedSelect  = 0x1c
+	opLocalGet     = 0x20
+	opLocalSet     = 0x21
+	opLocalTee     = 0x22
+	opGlobalGet    = 0x23
+	opGlobalSet    = 0x24
+
+	opI32Load    = 0x28
+	opI64Load    = 0x29
+	opI32Load8S  = 0x2c
+	opI32Load8U  = 0x2d
+	opI32Load16S = 0x2e
+	opI32Load16U = 0x2f
+	opI64Load8S  = 0x30
+	opI64Load8U  = 0x31
+	opI64Load16S = 0x32
+	opI64Load16U = 0x33
+	opI64Load32S = 0x34
+	opI64Load32U = 0x35
+	opI32Store   = 0x36
+	opI64Store   = 0x37
+	opI32Store8  = 0x3a
+	opI32Store16 = 0x3b
+	opI64Store8  = 0x3c
+	opI64Store16 = 0x3d
+	opI64Store32 = 0x3e
+	opMemorySize = 0x3f
+	opMemoryGrow = 0x40
+
+	opI32Const = 0x41
+	opI64Const = 0x42
+
+	opI32Eqz = 0x45
+	opI32Eq  = 0x46
+	opI32Ne  = 0x47
+	opI32LtS = 0x48
+	opI32LtU = 0x49
+	opI32GtS = 0x4a
+	opI32GtU = 0x4b
+	opI32LeS = 0x4c
+	opI32LeU = 0x4d
+	opI32GeS = 0x4e
+	opI32GeU = 0x4f
+
+	opI64Eqz = 0x50
+	opI64Eq  = 0x51
+	opI64Ne  = 0x52
+	opI64LtS = 0x53
+	opI64LtU = 0x54
+	opI64GtS = 0x55
+	opI64GtU = 0x56
+	opI64LeS = 0x57
+	opI64LeU = 0x58
+	opI64GeS = 0x59
+	opI64GeU = 0x5a
+
+	opI32Clz    = 0x67
+	opI32Ctz    = 0x68
+	opI32Popcnt = 0x69
+	opI32Add    = 0x6a
+	opI32Sub    = 0x6b
+	opI32Mul    = 0x6c
+	opI32DivS   = 0x6d
+	opI32DivU   = 0x6e
+	opI32RemS   = 0x6f
+	opI32RemU   = 0x70
+	opI32And    = 0x71
+	opI32Or     = 0x72
+	opI32Xor    = 0x73
+	opI32Shl    = 0x74
+	opI32ShrS   = 0x75
+	opI32ShrU   = 0x76
+	opI32Rotl   = 0x77
+	opI32Rotr   = 0x78
+
+	opI64Clz    = 0x79
+	opI64Ctz    = 0x7a
+	opI64Popcnt = 0x7b
+	opI64Add    = 0x7c
+	opI64Sub    = 0x7d
+	opI64Mul    = 0x7e
+	opI64DivS   = 0x7f
+	opI64DivU   = 0x80
+	opI64RemS   = 0x81
+	opI64RemU   = 0x82
+	opI64And    = 0x83
+	opI64Or     = 0x84
+	opI64Xor    = 0x85
+	opI64Shl    = 0x86
+	opI64ShrS   = 0x87
+	opI64ShrU   = 0x88
+	opI64Rotl   = 0x89
+	opI64Rotr   = 0x8a
+
+	opI32WrapI64    = 0xa7
+	opI64ExtendI32S = 0xac
+	opI64ExtendI32U = 0xad
+
+	opI32Extend8S  = 0xc0
+	opI32Extend16S = 0xc1
+	opI64Extend8S  = 0xc2
+	opI64Extend16S = 0xc3
+	opI64Extend32S = 0xc4
+)
+
+// Internal opcodes, only produced by the compiler. They occupy a range that is unused by WASM.
+const (
+	// opChargeGas adds the accumulated cost of a basic block to the points used
+	opChargeGas = 0xe0
+
+	// opJump continues execution at the target, without touching the stack
+	opJump = 0xe1
+
+	// opJumpIfZero pops a condition and continues at the target if it is zero
+	opJumpIfZero = 0xe2
+
+	// opCallImport calls a VM hook
+	opCallImport = 0xe3
+)
+
+const blockTypeEmpty = 0x40
+
+// isBasicBlockBoundary returns true for the operators before which the
+// accumulated opcode cost is charged. The set mirrors the Wasmer metering middleware.
+func isBasicBlockBoundary(opcode byte) bool {
+	switch opcode {
+	case opLoop, opIf, opEnd, opElse, opBr, opBrIf, opBrTable, opCall, opCallIndirect, opReturn, opUnreachable:
+		return true
+	default:
+		return false
+	}
+}
+
+// opcodeCostTable holds the gas cost of every accepted opcode, indexed by opcode.
+type opcodeCostTable struct {
+	costs         [256]uint64
+	localAllocate uint64
+}
+
+func newOpcodeCostTable(opcodeCosts *executor.WASMOpcodeCost) *opcodeCostTable {
+	table := &opcodeCostTable{}
+	if opcodeCosts == nil {
+		return table
+	}
+
+	table.localAllocate = uint64(opcodeCosts.LocalAllocate)
+
+	costs := &table.costs
+	costs[opUnreachable] = uint64(opcodeCosts.Unreachable)
+	costs[opNop] = uint64(opcodeCosts.Nop)
+	costs[opBlock] = uint64(opcodeCosts.Block)
+	costs[opLoop] = uint64(opcodeCosts.Loop)
+	costs[opIf] = uint64(opcodeCosts.If)
+	costs[opElse] = uint64(opcodeCosts.Else)
+	costs[opEnd] = uint64(opcodeCosts.End)
+	costs[opBr] = uint64(opcodeCosts.Br)
+	costs[opBrIf] = uint64(opcodeCosts.BrIf)
+	costs[opBrTable] = uint64(opcodeCosts.BrTable)
+	costs[opReturn] = uint64(opcodeCosts.Return)
+	costs[opCall] = uint64(opcodeCosts.Call)
+	costs[opCallIndirect] = uint64(opcodeCosts.CallIndirect)
+	costs[opDrop] = uint64(opcodeCosts.Drop)
+	costs[opSelect] = uint64(opcodeCosts.Select)
+	costs[opTypedSelect] = uint64(opcodeCosts.TypedSelect)
+	costs[opLocalGet] = uint64(opcodeCosts.LocalGet)
+	costs[opLocalSet] = uint64(opcodeCosts.LocalSet)
+	costs[opLocalTee] = uint64(opcodeCosts.LocalTee)
+	costs[opGlobalGet] = uint64(opcodeCosts.GlobalGet)
+	costs[opGlobalSet] = uint64(opcodeCosts.GlobalSet)
+
+	costs[opI32Load] = uint64(opcodeCosts.I32Load)
+	costs[opI64Load] = uint64(opcodeCosts.I64Load)
+	costs[opI32Load8S] = uint64(opcodeCosts.I32Load8S)
+	costs[opI32Load8U] = uint64(opcodeCosts.I32Load8U)
+	costs[opI32Load16S] = uint64(opcodeCosts.I32Load16S)
+	costs[opI32Load16U] = uint64(opcodeCosts.I32Load16U)
+	costs[opI64Load8S] = uint64(opcodeCosts.I64Load8S)
+	costs[opI64Load8U] = uint64(opcodeCosts.I64Load8U)
+	costs[opI64Load16S] = uint64(opcodeCosts.I64Load16S)
+	costs[opI64Load16U] = uint64(opcodeCosts.I64Load16U)
+	costs[opI64Load32S] = uint64(opcodeCosts.I64Load32S)
+	costs[opI64Load32U] = uint64(opcodeCosts.I64Load32U)
+	costs[opI32Store] = uint64(opcodeCosts.I32Store)
+	costs[opI64Store] = uint64(opcodeCosts.I64Store)
+	costs[opI32Store8] = uint64(opcodeCosts.I32Store8)
+	costs[opI32Store16] = uint64(opcodeCosts.I32Store16)
+	costs[opI64Store8] = uint64(opcodeCosts.I64Store8)
+	costs[opI64Store16] = uint64(opcodeCosts.I64Store16)
+	costs[opI64Store32] = uint64(opcodeCosts.I64Store32)
+	costs[opMemorySize] = uint64(opcodeCosts.MemorySize)
+	costs[opMemoryGrow] = uint64(opcodeCosts.MemoryGrow)
+
+	costs[opI32Const] = uint64(opcodeCosts.I32Const)
+	costs[opI64Const] = uint64(opcodeCosts.I64Const)
+
+	costs[opI32Eqz] = uint64(opcodeCosts.I32Eqz)
+	costs[opI32Eq] = uint64(opcodeCosts.I32Eq)
+	costs[opI32Ne] = uint64(opcodeCosts.I32Ne)
+	costs[opI32LtS] = uint64(opcodeCosts.I32LtS)
+	costs[opI32LtU] = uint64(opcodeCosts.I32LtU)
+	costs[opI32GtS] = uint64(opcodeCosts.I32GtS)
+	costs[opI32GtU] = uint64(opcodeCosts.I32GtU)
+	costs[opI32LeS] = uint64(opcodeCosts.I32LeS)
+	costs[opI32LeU] = uint64(opcodeCosts.I32LeU)
+	costs[opI32GeS] = uint64(opcodeCosts.I32GeS)
+	costs[opI32GeU] = uint64(opcodeCosts.I32GeU)
+
+	costs[opI64Eqz] = uint64(opcodeCosts.I64Eqz)
+	costs[opI64Eq] = uint64(opcodeCosts.I64Eq)
+	costs[opI64Ne] = uint64(opcodeCosts.I64Ne)
+	costs[opI64LtS] = uint64(opcodeCosts.I64LtS)
+	costs[opI64LtU] = uint64(opcodeCosts.I64LtU)
+	costs[opI64GtS] = uint64(opcodeCosts.I64GtS)
+	costs[opI64GtU] = uint64(opcodeCosts.I64GtU)
+	costs[opI64LeS] = uint64(opcodeCosts.I64LeS)
+	costs[opI64LeU] = uint64(opcodeCosts.I64LeU)
+	costs[opI64GeS] = uint64(opcodeCosts.I64GeS)
+	costs[opI64GeU] = uint64(opcodeCosts.I64GeU)
+
+	costs[opI32Clz] = uint64(opcodeCosts.I32Clz)
+	costs[opI32Ctz] = uint64(opcodeCosts.I32Ctz)
+	costs[opI32Popcnt] = uint64(opcodeCosts.I32Popcnt)
+	costs[opI32Add] = uint64(opcodeCosts.I32Add)
+	costs[opI32Sub] = uint64(opcodeCosts.I32Sub)
+	costs[opI32Mul] = uint64(opcodeCosts.I32Mul)
+	costs[opI32DivS] = uint64(opcodeCosts.I32DivS)
+	costs[opI32DivU] = uint64(opcodeCosts.I32DivU)
+	costs[opI32RemS] = uint64(opcodeCosts.I32RemS)
+	costs[opI32RemU] = uint64(opcodeCosts.I32RemU)
+	costs[opI32And] = uint64(opcodeCosts.I32And)
+	costs[opI32Or] = uint64(opcodeCosts.I32Or)
+	costs[opI32Xor] = uint64(opcodeCosts.I32Xor)
+	costs[opI32Shl] = uint64(opcodeCosts.I32Shl)
+	costs[opI32ShrS] = uint64(opcodeCosts.I32ShrS)
+	costs[opI32ShrU] = uint64(opcodeCosts.I32ShrU)
+	costs[opI32Rotl] = uint64(opcodeCosts.I32Rotl)
+	costs[opI32Rotr] = uint64(opcodeCosts.I32Rotr)
+
+	costs[opI64Clz] = uint64(opcodeCosts.I64Clz)
+	costs[opI64Ctz] = uint64(opcodeCosts.I64Ctz)
+	costs[opI64Popcnt] = uint64(opcodeCosts.I64Popcnt)
+	costs[opI64Add] = uint64(opcodeCosts.I64Add)
+	costs[opI64Sub] = uint64(opcodeCosts.I64Sub)
+	costs[opI64Mul] = uint64(opcodeCosts.I64Mul)
+	costs[opI64DivS] = uint64(opcodeCosts.I64DivS)
+	costs[opI64DivU] = uint64(opcodeCosts.I64DivU)
+	costs[opI64RemS] = uint64(opcodeCosts.I64RemS)
+	costs[opI64RemU] = uint64(opcodeCosts.I64RemU)
+	costs[opI64And] = uint64(opcodeCosts.I64And)
+	costs[opI64Or] = uint64(opcodeCosts.I64Or)
+	costs[opI64Xor] = uint64(opcodeCosts.I64Xor)
+	costs[opI64Shl] = uint64(opcodeCosts.I64Shl)
+	costs[opI64ShrS] = uint64(opcodeCosts.I64ShrS)
+	costs[opI64ShrU] = uint64(opcodeCosts.I64ShrU)
+	costs[opI64Rotl] = uint64(opcodeCosts.I64Rotl)
+	costs[opI64Rotr] = uint64(opcodeCosts.I64Rotr)
+
+	costs[opI32WrapI64] = uint64(opcodeCosts.I32WrapI64)
+	costs[opI64ExtendI32S] = uint64(opcodeCosts.I64ExtendI32S)
+	costs[opI64ExtendI32U] = uint64(opcodeCosts.I64ExtendI32U)
+
+	costs[opI32Extend8S] = uint64(opcodeCosts.I32Extend8S)
+	costs[opI32Extend16S] = uint64(opcodeCosts.I32Extend16S)
+	costs[opI64Extend8S] = uint64(opcodeCosts.I64Extend8S)
+	costs[opI64Extend16S] = uint64(opcodeCosts.I64Extend16S)
+	costs[opI64Extend32S] = uint64(opcodeCosts.I64Extend32S)
+
+	return table
+}
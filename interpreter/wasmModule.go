@@ -0,0 +1,165 @@
+package interpreter
+
+import (
+	"fmt"
+)
+
+// valueType is the binary encoding of a WASM value type.
+type valueType byte
+
+const (
+	valueTypeI32 valueType = 0x7f
+	valueTypeI64 valueType = 0x7e
+
+	// valueTypeUnknown is only used during validation, for the polymorphic stack of unreachable code
+	valueTypeUnknown valueType = 0x00
+)
+
+// String returns the WASM text format name of the value type.
+func (vt valueType) String() string {
+	switch vt {
+	case valueTypeI32:
+		return "i32"
+	case valueTypeI64:
+		return "i64"
+	case valueTypeUnknown:
+		return "unknown"
+	default:
+		return fmt.Sprintf("0x%x", byte(vt))
+	}
+}
+
+const (
+	externalKindFunction = 0x00
+	externalKindTable    = 0x01
+	externalKindMemory   = 0x02
+	externalKindGlobal   = 0x03
+)
+
+const (
+	sectionCustom   = 0
+	sectionType     = 1
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionTable    = 4
+	sectionMemory   = 5
+	sectionGlobal   = 6
+	sectionExport   = 7
+	sectionStart    = 8
+	sectionElement  = 9
+	sectionCode     = 10
+	sectionData     = 11
+)
+
+const wasmMagic = "\x00asm"
+const wasmVersion = 1
+
+// functionType is the signature of a WASM function.
+type functionType struct {
+	params  []valueType
+	results []valueType
+}
+
+func (ft *functionType) equals(other *functionType) bool {
+	if len(ft.params) != len(other.params) || len(ft.results) != len(other.results) {
+		return false
+	}
+	for i := range ft.params {
+		if ft.params[i] != other.params[i] {
+			return false
+		}
+	}
+	for i := range ft.results {
+		if ft.results[i] != other.results[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a human-readable form of the signature.
+func (ft *functionType) String() string {
+	return fmt.Sprintf("%v -> %v", ft.params, ft.results)
+}
+
+type functionImport struct {
+	module    string
+	name      string
+	typeIndex uint32
+}
+
+type limits struct {
+	min    uint32
+	max    uint32
+	hasMax bool
+}
+
+type globalDefinition struct {
+	valueType valueType
+	mutable   bool
+	initValue uint64
+}
+
+type exportEntry struct {
+	name  string
+	kind  byte
+	index uint32
+}
+
+type elementSegment struct {
+	offset          uint32
+	functionIndexes []uint32
+}
+
+type dataSegment struct {
+	offset uint32
+	data   []byte
+}
+
+type functionBody struct {
+	locals []valueType
+	code   []byte
+}
+
+// wasmModule is the decoded form of a WASM binary.
+type wasmModule struct {
+	types         []*functionType
+	imports       []*functionImport
+	functionTypes []uint32
+	table         *limits
+	memory        *limits
+	globals       []*globalDefinition
+	exports       []*exportEntry
+	exportsByName map[string]*exportEntry
+	startFunction *uint32
+	elements      []*elementSegment
+	bodies        []*functionBody
+	data          []*dataSegment
+}
+
+func (module *wasmModule) numImportedFunctions() uint32 {
+	return uint32(len(module.imports))
+}
+
+func (module *wasmModule) numFunctions() uint32 {
+	return uint32(len(module.imports) + len(module.functionTypes))
+}
+
+// functionTypeAt returns the signature of a function from the function index space,
+// which contains the imported functions first.
+func (module *wasmModule) functionTypeAt(functionIndex uint32) *functionType {
+	numImported := module.numImportedFunctions()
+	if functionIndex < numImported {
+		return module.types[module.imports[functionIndex].typeIndex]
+	}
+	return module.types[module.functionTypes[functionIndex-numImported]]
+}
+
+// exportedFunctionIndex returns the index of an exported function, if it exists.
+func (module *wasmModule) exportedFunctionIndex(name string) (uint32, bool) {
+	export, ok := module.exportsByName[name]
+	if !ok || export.kind != externalKindFunction {
+		return 0, false
+	}
+	return export.index, true
+}
@@ -0,0 +1,148 @@
+package interpreter
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// testModuleBuilder assembles small WASM modules for tests.
+type testModuleBuilder struct {
+	types     [][]byte
+	imports   [][]byte
+	functions []uint32
+	bodies    [][]byte
+	exports   [][]byte
+	globals   [][]byte
+	memory    []byte
+	numFuncs  uint32
+}
+
+func newTestModuleBuilder() *testModuleBuilder {
+	return &testModuleBuilder{}
+}
+
+func (builder *testModuleBuilder) addType(params []valueType, results []valueType) uint32 {
+	encoded := []byte{0x60}
+	encoded = append(encoded, encodeValueTypes(params)...)
+	encoded = append(encoded, encodeValueTypes(results)...)
+	builder.types = append(builder.types, encoded)
+	return uint32(len(builder.types) - 1)
+}
+
+func (builder *testModuleBuilder) addImport(name string, typeIndex uint32) uint32 {
+	encoded := encodeName(importModuleName)
+	encoded = append(encoded, encodeName(name)...)
+	encoded = append(encoded, externalKindFunction)
+	encoded = append(encoded, encodeU32(typeIndex)...)
+	builder.imports = append(builder.imports, encoded)
+	builder.numFuncs++
+	return builder.numFuncs - 1
+}
+
+func (builder *testModuleBuilder) addFunction(typeIndex uint32, locals []valueType, code ...byte) uint32 {
+	body := encodeU32(uint32(len(locals)))
+	for _, local := range locals {
+		body = append(body, 1, byte(local))
+	}
+	body = append(body, code...)
+	body = append(body, opEnd)
+
+	builder.functions = append(builder.functions, typeIndex)
+	builder.bodies = append(builder.bodies, body)
+	builder.numFuncs++
+	return builder.numFuncs - 1
+}
+
+func (builder *testModuleBuilder) addExport(name string, functionIndex uint32) {
+	encoded := encodeName(name)
+	encoded = append(encoded, externalKindFunction)
+	encoded = append(encoded, encodeU32(functionIndex)...)
+	builder.exports = append(builder.exports, encoded)
+}
+
+func (builder *testModuleBuilder) addMutableGlobalI64(initValue byte) {
+	builder.globals = append(builder.globals, []byte{byte(valueTypeI64), 1, opI64Const, initValue, opEnd})
+}
+
+func (builder *testModuleBuilder) setMemory(minPages uint32, maxPages uint32) {
+	builder.memory = []byte{1}
+	builder.memory = append(builder.memory, encodeU32(minPages)...)
+	builder.memory = append(builder.memory, encodeU32(maxPages)...)
+}
+
+func (builder *testModuleBuilder) build() []byte {
+	module := []byte(wasmMagic)
+	module = append(module, wasmVersion, 0, 0, 0)
+	module = appendSection(module, sectionType, builder.types)
+	module = appendSection(module, sectionImport, builder.imports)
+
+	functionEntries := make([][]byte, len(builder.functions))
+	for i, typeIndex := range builder.functions {
+		functionEntries[i] = encodeU32(typeIndex)
+	}
+	module = appendSection(module, sectionFunction, functionEntries)
+	if builder.memory != nil {
+		module = appendSection(module, sectionMemory, [][]byte{builder.memory})
+	}
+	module = appendSection(module, sectionGlobal, builder.globals)
+	module = appendSection(module, sectionExport, builder.exports)
+
+	bodyEntries := make([][]byte, len(builder.bodies))
+	for i, body := range builder.bodies {
+		bodyEntries[i] = append(encodeU32(uint32(len(body))), body...)
+	}
+	module = appendSection(module, sectionCode, bodyEntries)
+	return module
+}
+
+func appendSection(module []byte, sectionID byte, entries [][]byte) []byte {
+	if len(entries) == 0 {
+		return module
+	}
+	content := encodeU32(uint32(len(entries)))
+	for _, entry := range entries {
+		content = append(content, entry...)
+	}
+	module = append(module, sectionID)
+	module = append(module, encodeU32(uint32(len(content)))...)
+	return append(module, content...)
+}
+
+func encodeU32(value uint32) []byte {
+	var encoded []byte
+	for {
+		b := byte(value & 0x7f)
+		value >>= 7
+		if value != 0 {
+			encoded = append(encoded, b|0x80)
+			continue
+		}
+		return append(encoded, b)
+	}
+}
+
+func encodeName(name string) []byte {
+	return append(encodeU32(uint32(len(name))), name...)
+}
+
+func encodeValueTypes(types []valueType) []byte {
+	encoded := encodeU32(uint32(len(types)))
+	for _, vt := range types {
+		encoded = append(encoded, byte(vt))
+	}
+	return encoded
+}
+
+// testVMHooks implements only the VM hooks needed by the tests; calling any other hook panics.
+type testVMHooks struct {
+	executor.VMHooks
+	finished     []int64
+	onGetGasLeft func() int64
+}
+
+func (hooks *testVMHooks) Int64finish(value int64) {
+	hooks.finished = append(hooks.finished, value)
+}
+
+func (hooks *testVMHooks) GetGasLeft() int64 {
+	return hooks.onGetGasLeft()
+}
@@ -0,0 +1,696 @@
+package interpreter
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// maxMemoryPages is the maximum number of pages a contract may declare for its memory,
+// both as initial size and as maximum size.
+const maxMemoryPages = 20
+
+// maxWASMPages is the maximum number of pages of a 32-bit WASM memory.
+const maxWASMPages = 65536
+
+const funcRefType = 0x70
+
+// moduleDecoder reads the binary format of a WASM module.
+type moduleDecoder struct {
+	data []byte
+	pos  int
+}
+
+// decodeModule parses a WASM binary into a wasmModule, also checking its structural validity.
+// Function bodies are not validated here, that happens when they are compiled.
+func decodeModule(bytecode []byte) (*wasmModule, error) {
+	decoder := &moduleDecoder{data: bytecode}
+	module := &wasmModule{
+		exportsByName: make(map[string]*exportEntry),
+	}
+
+	err := decoder.decodeHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	lastSectionID := byte(0)
+	for !decoder.atEnd() {
+		sectionID, err := decoder.readByte()
+		if err != nil {
+			return nil, err
+		}
+		sectionSize, err := decoder.readU32()
+		if err != nil {
+			return nil, err
+		}
+		sectionData, err := decoder.readBytes(sectionSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if sectionID == sectionCustom {
+			continue
+		}
+		if sectionID <= lastSectionID {
+			return nil, fmt.Errorf("%w: section %d out of order", ErrInvalidBytecode, sectionID)
+		}
+		lastSectionID = sectionID
+
+		sectionDecoder := &moduleDecoder{data: sectionData}
+		err = sectionDecoder.decodeSection(sectionID, module)
+		if err != nil {
+			return nil, err
+		}
+		if !sectionDecoder.atEnd() {
+			return nil, fmt.Errorf("%w: section %d size mismatch", ErrInvalidBytecode, sectionID)
+		}
+	}
+
+	err = checkModule(module)
+	if err != nil {
+		return nil, err
+	}
+
+	return module, nil
+}
+
+func (decoder *moduleDecoder) decodeHeader() error {
+	magic, err := decoder.readBytes(4)
+	if err != nil || string(magic) != wasmMagic {
+		return fmt.Errorf("%w: bad magic number", ErrInvalidBytecode)
+	}
+	version, err := decoder.readBytes(4)
+	if err != nil {
+		return err
+	}
+	if version[0] != wasmVersion || version[1] != 0 || version[2] != 0 || version[3] != 0 {
+		return fmt.Errorf("%w: unsupported version", ErrInvalidBytecode)
+	}
+	return nil
+}
+
+func (decoder *moduleDecoder) decodeSection(sectionID byte, module *wasmModule) error {
+	switch sectionID {
+	case sectionType:
+		return decoder.decodeTypeSection(module)
+	case sectionImport:
+		return decoder.decodeImportSection(module)
+	case sectionFunction:
+		return decoder.decodeFunctionSection(module)
+	case sectionTable:
+		return decoder.decodeTableSection(module)
+	case sectionMemory:
+		return decoder.decodeMemorySection(module)
+	case sectionGlobal:
+		return decoder.decodeGlobalSection(module)
+	case sectionExport:
+		return decoder.decodeExportSection(module)
+	case sectionStart:
+		return decoder.decodeStartSection(module)
+	case sectionElement:
+		return decoder.decodeElementSection(module)
+	case sectionCode:
+		return decoder.decodeCodeSection(module)
+	case sectionData:
+		return decoder.decodeDataSection(module)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnsupportedSection, sectionID)
+	}
+}
+
+func (decoder *moduleDecoder) decodeTypeSection(module *wasmModule) error {
+	count, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		form, err := decoder.readByte()
+		if err != nil {
+			return err
+		}
+		if form != 0x60 {
+			return fmt.Errorf("%w: invalid function type form 0x%x", ErrInvalidBytecode, form)
+		}
+		params, err := decoder.readValueTypes()
+		if err != nil {
+			return err
+		}
+		results, err := decoder.readValueTypes()
+		if err != nil {
+			return err
+		}
+		module.types = append(module.types, &functionType{params: params, results: results})
+	}
+	return nil
+}
+
+func (decoder *moduleDecoder) decodeImportSection(module *wasmModule) error {
+	count, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		moduleName, err := decoder.readName()
+		if err != nil {
+			return err
+		}
+		name, err := decoder.readName()
+		if err != nil {
+			return err
+		}
+		kind, err := decoder.readByte()
+		if err != nil {
+			return err
+		}
+		if kind != externalKindFunction {
+			return fmt.Errorf("%w: %s.%s", ErrUnsupportedImport, moduleName, name)
+		}
+		typeIndex, err := decoder.readU32()
+		if err != nil {
+			return err
+		}
+		if typeIndex >= uint32(len(module.types)) {
+			return fmt.Errorf("%w: import type index out of range", ErrInvalidBytecode)
+		}
+		module.imports = append(module.imports, &functionImport{
+			module:    moduleName,
+			name:      name,
+			typeIndex: typeIndex,
+		})
+	}
+	return nil
+}
+
+func (decoder *moduleDecoder) decodeFunctionSection(module *wasmModule) error {
+	count, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		typeIndex, err := decoder.readU32()
+		if err != nil {
+			return err
+		}
+		if typeIndex >= uint32(len(module.types)) {
+			return fmt.Errorf("%w: function type index out of range", ErrInvalidBytecode)
+		}
+		module.functionTypes = append(module.functionTypes, typeIndex)
+	}
+	return nil
+}
+
+func (decoder *moduleDecoder) decodeTableSection(module *wasmModule) error {
+	count, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	if count > 1 {
+		return fmt.Errorf("%w: multiple tables", ErrInvalidBytecode)
+	}
+	if count == 0 {
+		return nil
+	}
+	elementType, err := decoder.readByte()
+	if err != nil {
+		return err
+	}
+	if elementType != funcRefType {
+		return fmt.Errorf("%w: invalid table element type", ErrInvalidBytecode)
+	}
+	tableLimits, err := decoder.readLimits()
+	if err != nil {
+		return err
+	}
+	module.table = tableLimits
+	return nil
+}
+
+func (decoder *moduleDecoder) decodeMemorySection(module *wasmModule) error {
+	count, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	if count > 1 {
+		return fmt.Errorf("%w: multiple memories", ErrInvalidMemory)
+	}
+	if count == 0 {
+		return nil
+	}
+	memoryLimits, err := decoder.readLimits()
+	if err != nil {
+		return err
+	}
+	if memoryLimits.min > maxMemoryPages {
+		return fmt.Errorf("%w: too many initial pages", ErrInvalidMemory)
+	}
+	if memoryLimits.hasMax && memoryLimits.max > maxMemoryPages {
+		return fmt.Errorf("%w: too many maximum pages", ErrInvalidMemory)
+	}
+	module.memory = memoryLimits
+	return nil
+}
+
+func (decoder *moduleDecoder) decodeGlobalSection(module *wasmModule) error {
+	count, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		globalType, err := decoder.readValueType()
+		if err != nil {
+			return err
+		}
+		mutability, err := decoder.readByte()
+		if err != nil {
+			return err
+		}
+		if mutability > 1 {
+			return fmt.Errorf("%w: invalid global mutability", ErrInvalidBytecode)
+		}
+		initValue, err := decoder.readConstExpr(globalType)
+		if err != nil {
+			return err
+		}
+		module.globals = append(module.globals, &globalDefinition{
+			valueType: globalType,
+			mutable:   mutability == 1,
+			initValue: initValue,
+		})
+	}
+	return nil
+}
+
+func (decoder *moduleDecoder) decodeExportSection(module *wasmModule) error {
+	count, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		name, err := decoder.readName()
+		if err != nil {
+			return err
+		}
+		kind, err := decoder.readByte()
+		if err != nil {
+			return err
+		}
+		if kind > externalKindGlobal {
+			return fmt.Errorf("%w: invalid export kind", ErrInvalidBytecode)
+		}
+		index, err := decoder.readU32()
+		if err != nil {
+			return err
+		}
+		_, duplicate := module.exportsByName[name]
+		if duplicate {
+			return fmt.Errorf("%w: duplicate export %s", ErrInvalidBytecode, name)
+		}
+		export := &exportEntry{name: name, kind: kind, index: index}
+		module.exports = append(module.exports, export)
+		module.exportsByName[name] = export
+	}
+	return nil
+}
+
+func (decoder *moduleDecoder) decodeStartSection(module *wasmModule) error {
+	startFunction, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	module.startFunction = &startFunction
+	return nil
+}
+
+func (decoder *moduleDecoder) decodeElementSection(module *wasmModule) error {
+	count, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		flags, err := decoder.readU32()
+		if err != nil {
+			return err
+		}
+		if flags != 0 {
+			return fmt.Errorf("%w: element segment kind %d", ErrUnsupportedSection, flags)
+		}
+		offset, err := decoder.readConstExpr(valueTypeI32)
+		if err != nil {
+			return err
+		}
+		numIndexes, err := decoder.readU32()
+		if err != nil {
+			return err
+		}
+		segment := &elementSegment{offset: uint32(offset)}
+		for j := uint32(0); j < numIndexes; j++ {
+			functionIndex, err := decoder.readU32()
+			if err != nil {
+				return err
+			}
+			segment.functionIndexes = append(segment.functionIndexes, functionIndex)
+		}
+		module.elements = append(module.elements, segment)
+	}
+	return nil
+}
+
+func (decoder *moduleDecoder) decodeCodeSection(module *wasmModule) error {
+	count, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	if count != uint32(len(module.functionTypes)) {
+		return fmt.Errorf("%w: function and code section counts differ", ErrInvalidBytecode)
+	}
+	for i := uint32(0); i < count; i++ {
+		bodySize, err := decoder.readU32()
+		if err != nil {
+			return err
+		}
+		bodyData, err := decoder.readBytes(bodySize)
+		if err != nil {
+			return err
+		}
+		body, err := decodeFunctionBody(bodyData)
+		if err != nil {
+			return err
+		}
+		module.bodies = append(module.bodies, body)
+	}
+	return nil
+}
+
+func decodeFunctionBody(bodyData []byte) (*functionBody, error) {
+	decoder := &moduleDecoder{data: bodyData}
+	numLocalGroups, err := decoder.readU32()
+	if err != nil {
+		return nil, err
+	}
+
+	body := &functionBody{}
+	totalLocals := uint64(0)
+	for i := uint32(0); i < numLocalGroups; i++ {
+		numLocals, err := decoder.readU32()
+		if err != nil {
+			return nil, err
+		}
+		localType, err := decoder.readValueType()
+		if err != nil {
+			return nil, err
+		}
+		totalLocals += uint64(numLocals)
+		if totalLocals > maxFunctionLocals {
+			return nil, fmt.Errorf("%w: too many locals", ErrInvalidBytecode)
+		}
+		for j := uint32(0); j < numLocals; j++ {
+			body.locals = append(body.locals, localType)
+		}
+	}
+	body.code = decoder.data[decoder.pos:]
+	return body, nil
+}
+
+func (decoder *moduleDecoder) decodeDataSection(module *wasmModule) error {
+	count, err := decoder.readU32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		flags, err := decoder.readU32()
+		if err != nil {
+			return err
+		}
+		if flags == 2 {
+			memoryIndex, err := decoder.readU32()
+			if err != nil {
+				return err
+			}
+			if memoryIndex != 0 {
+				return fmt.Errorf("%w: data segment memory index", ErrInvalidMemory)
+			}
+		} else if flags != 0 {
+			return fmt.Errorf("%w: data segment kind %d", ErrUnsupportedSection, flags)
+		}
+		offset, err := decoder.readConstExpr(valueTypeI32)
+		if err != nil {
+			return err
+		}
+		size, err := decoder.readU32()
+		if err != nil {
+			return err
+		}
+		data, err := decoder.readBytes(size)
+		if err != nil {
+			return err
+		}
+		module.data = append(module.data, &dataSegment{offset: uint32(offset), data: data})
+	}
+	return nil
+}
+
+// checkModule verifies the cross-section references of the module.
+func checkModule(module *wasmModule) error {
+	if len(module.bodies) != len(module.functionTypes) {
+		return fmt.Errorf("%w: function and code section counts differ", ErrInvalidBytecode)
+	}
+
+	numFunctions := module.numFunctions()
+	for _, export := range module.exports {
+		switch export.kind {
+		case externalKindFunction:
+			if export.index >= numFunctions {
+				return fmt.Errorf("%w: exported function index out of range", ErrInvalidBytecode)
+			}
+		case externalKindTable:
+			if module.table == nil || export.index != 0 {
+				return fmt.Errorf("%w: exported table index out of range", ErrInvalidBytecode)
+			}
+		case externalKindMemory:
+			if module.memory == nil || export.index != 0 {
+				return fmt.Errorf("%w: exported memory index out of range", ErrInvalidMemory)
+			}
+		case externalKindGlobal:
+			if export.index >= uint32(len(module.globals)) {
+				return fmt.Errorf("%w: exported global index out of range", ErrInvalidBytecode)
+			}
+		}
+	}
+
+	if module.startFunction != nil {
+		if *module.startFunction >= numFunctions {
+			return fmt.Errorf("%w: start function index out of range", ErrInvalidBytecode)
+		}
+		startType := module.functionTypeAt(*module.startFunction)
+		if len(startType.params) != 0 || len(startType.results) != 0 {
+			return fmt.Errorf("%w: start function must be void", ErrInvalidBytecode)
+		}
+	}
+
+	if len(module.elements) > 0 && module.table == nil {
+		return fmt.Errorf("%w: element segment without table", ErrInvalidBytecode)
+	}
+	for _, segment := range module.elements {
+		for _, functionIndex := range segment.functionIndexes {
+			if functionIndex >= numFunctions {
+				return fmt.Errorf("%w: element function index out of range", ErrInvalidBytecode)
+			}
+		}
+	}
+
+	if len(module.data) > 0 && module.memory == nil {
+		return fmt.Errorf("%w: data segment without memory", ErrInvalidMemory)
+	}
+
+	return nil
+}
+
+func (decoder *moduleDecoder) atEnd() bool {
+	return decoder.pos >= len(decoder.data)
+}
+
+func (decoder *moduleDecoder) readByte() (byte, error) {
+	if decoder.pos >= len(decoder.data) {
+		return 0, fmt.Errorf("%w: unexpected end", ErrInvalidBytecode)
+	}
+	b := decoder.data[decoder.pos]
+	decoder.pos++
+	return b, nil
+}
+
+func (decoder *moduleDecoder) readBytes(length uint32) ([]byte, error) {
+	end := uint64(decoder.pos) + uint64(length)
+	if end > uint64(len(decoder.data)) {
+		return nil, fmt.Errorf("%w: unexpected end", ErrInvalidBytecode)
+	}
+	result := decoder.data[decoder.pos:end]
+	decoder.pos = int(end)
+	return result, nil
+}
+
+func (decoder *moduleDecoder) readU32() (uint32, error) {
+	value, length, err := readULEB128(decoder.data[decoder.pos:], 32)
+	if err != nil {
+		return 0, err
+	}
+	decoder.pos += length
+	return uint32(value), nil
+}
+
+func (decoder *moduleDecoder) readName() (string, error) {
+	length, err := decoder.readU32()
+	if err != nil {
+		return "", err
+	}
+	nameBytes, err := decoder.readBytes(length)
+	if err != nil {
+		return "", err
+	}
+	if !utf8.Valid(nameBytes) {
+		return "", fmt.Errorf("%w: invalid UTF-8 name", ErrInvalidBytecode)
+	}
+	return string(nameBytes), nil
+}
+
+func (decoder *moduleDecoder) readValueType() (valueType, error) {
+	b, err := decoder.readByte()
+	if err != nil {
+		return 0, err
+	}
+	vt := valueType(b)
+	if vt != valueTypeI32 && vt != valueTypeI64 {
+		return 0, fmt.Errorf("%w: %s", ErrUnsupportedValueType, vt)
+	}
+	return vt, nil
+}
+
+func (decoder *moduleDecoder) readValueTypes() ([]valueType, error) {
+	count, err := decoder.readU32()
+	if err != nil {
+		return nil, err
+	}
+	if count > uint32(len(decoder.data)) {
+		return nil, fmt.Errorf("%w: unexpected end", ErrInvalidBytecode)
+	}
+	result := make([]valueType, count)
+	for i := range result {
+		result[i], err = decoder.readValueType()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (decoder *moduleDecoder) readLimits() (*limits, error) {
+	flags, err := decoder.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if flags > 1 {
+		return nil, fmt.Errorf("%w: invalid limits flags", ErrInvalidBytecode)
+	}
+	result := &limits{hasMax: flags == 1}
+	result.min, err = decoder.readU32()
+	if err != nil {
+		return nil, err
+	}
+	if result.hasMax {
+		result.max, err = decoder.readU32()
+		if err != nil {
+			return nil, err
+		}
+		if result.min > result.max {
+			return nil, fmt.Errorf("%w: minimum greater than maximum", ErrInvalidBytecode)
+		}
+	}
+	return result, nil
+}
+
+// readConstExpr reads an initializer expression. Only constants are accepted,
+// because the interpreter does not allow imported globals.
+func (decoder *moduleDecoder) readConstExpr(expectedType valueType) (uint64, error) {
+	opcode, err := decoder.readByte()
+	if err != nil {
+		return 0, err
+	}
+
+	var value uint64
+	switch {
+	case opcode == opI32Const && expectedType == valueTypeI32:
+		signed, length, err := readSLEB128(decoder.data[decoder.pos:], 32)
+		if err != nil {
+			return 0, err
+		}
+		decoder.pos += length
+		value = uint64(uint32(int32(signed)))
+	case opcode == opI64Const && expectedType == valueTypeI64:
+		signed, length, err := readSLEB128(decoder.data[decoder.pos:], 64)
+		if err != nil {
+			return 0, err
+		}
+		decoder.pos += length
+		value = uint64(signed)
+	default:
+		return 0, fmt.Errorf("%w: unsupported initializer expression", ErrInvalidBytecode)
+	}
+
+	end, err := decoder.readByte()
+	if err != nil {
+		return 0, err
+	}
+	if end != opEnd {
+		return 0, fmt.Errorf("%w: unterminated initializer expression", ErrInvalidBytecode)
+	}
+	return value, nil
+}
+
+// readULEB128 decodes an unsigned LEB128 integer of at most the given bit size.
+func readULEB128(data []byte, bitSize uint) (uint64, int, error) {
+	var result uint64
+	var shift uint
+	for i := 0; i < len(data); i++ {
+		b := data[i]
+		if shift >= bitSize || (bitSize-shift < 7 && uint64(b&0x7f)>>(bitSize-shift) != 0) {
+			return 0, 0, fmt.Errorf("%w: integer too large", ErrInvalidBytecode)
+		}
+		result |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return result, i + 1, nil
+		}
+		shift += 7
+	}
+	return 0, 0, fmt.Errorf("%w: unexpected end", ErrInvalidBytecode)
+}
+
+// readSLEB128 decodes a signed LEB128 integer of at most the given bit size.
+func readSLEB128(data []byte, bitSize uint) (int64, int, error) {
+	var result int64
+	var shift uint
+	for i := 0; i < len(data); i++ {
+		b := data[i]
+		if shift >= bitSize {
+			return 0, 0, fmt.Errorf("%w: integer too large", ErrInvalidBytecode)
+		}
+		result |= int64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			if shift < 64 && b&0x40 != 0 {
+				result |= -1 << shift
+			}
+			if !fitsSigned(result, bitSize) {
+				return 0, 0, fmt.Errorf("%w: integer too large", ErrInvalidBytecode)
+			}
+			return result, i + 1, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("%w: unexpected end", ErrInvalidBytecode)
+}
+
+func fitsSigned(value int64, bitSize uint) bool {
+	if bitSize >= 64 {
+		return true
+	}
+	limit := int64(1) << (bitSize - 1)
+	return value >= -limit && value < limit
+}
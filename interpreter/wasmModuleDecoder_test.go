@@ -0,0 +1,60 @@
+package interpreter
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func compileTestContract(t *testing.T, path string) error {
+	code, err := ioutil.ReadFile(filepath.Clean(path))
+	require.Nil(t, err)
+
+	module, err := decodeModule(code)
+	if err != nil {
+		return err
+	}
+	_, err = resolveImports(module)
+	if err != nil {
+		return err
+	}
+	config := &compilationConfig{
+		opcodeCosts: newOpcodeCostTable(&executor.WASMOpcodeCost{}),
+		metering:    true,
+	}
+	_, err = compileModule(module, config)
+	return err
+}
+
+func TestDecodeModule_TestContracts(t *testing.T) {
+	paths, err := filepath.Glob("../test/contracts/*/output/*.wasm")
+	require.Nil(t, err)
+	require.NotEmpty(t, paths)
+
+	rejected := map[string]bool{
+		"memgrow-wrong.wasm": true,
+		"num-with-fp.wasm":   true,
+	}
+	for _, path := range paths {
+		err = compileTestContract(t, path)
+		if rejected[filepath.Base(path)] {
+			require.ErrorIs(t, err, ErrInvalidBytecode, path)
+			continue
+		}
+		require.Nil(t, err, path)
+	}
+}
+
+func TestDecodeModule_ForbiddenOpcodes(t *testing.T) {
+	paths, err := filepath.Glob("../test/contracts/forbidden-opcodes/*/output/*.wasm")
+	require.Nil(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		err = compileTestContract(t, path)
+		require.ErrorIs(t, err, ErrInvalidBytecode, path)
+	}
+}
@@ -21,16 +21,61 @@ func (c *CryptoHookMock) Ripemd160(_ []byte) ([]byte, error) {
 	return c.Result, c.Err
 }
 
+// Sha512 mocked method
+func (c *CryptoHookMock) Sha512(_ []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Sha3256 mocked method
+func (c *CryptoHookMock) Sha3256(_ []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Sha3512 mocked method
+func (c *CryptoHookMock) Sha3512(_ []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Blake2b256 mocked method
+func (c *CryptoHookMock) Blake2b256(_ []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Blake2b512 mocked method
+func (c *CryptoHookMock) Blake2b512(_ []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// Poseidon mocked method
+func (c *CryptoHookMock) Poseidon(_ []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
 // VerifyBLS mocked method
 func (c *CryptoHookMock) VerifyBLS(_ []byte, _ []byte, _ []byte) error {
 	return c.Err
 }
 
+// VerifyAggregatedBLS mocked method
+func (c *CryptoHookMock) VerifyAggregatedBLS(_ [][]byte, _ []byte, _ []byte) error {
+	return c.Err
+}
+
+// AggregateBLSPublicKeys mocked method
+func (c *CryptoHookMock) AggregateBLSPublicKeys(_ [][]byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
 // VerifyEd25519 mocked method
 func (c *CryptoHookMock) VerifyEd25519(_ []byte, _ []byte, _ []byte) error {
 	return c.Err
 }
 
+// VerifyEd25519Batch mocked method
+func (c *CryptoHookMock) VerifyEd25519Batch(keys [][]byte, _ [][]byte, _ [][]byte) ([]bool, error) {
+	return c.batchResults(len(keys)), c.Err
+}
+
 // VerifySecp256k1 mocked method
 func (c *CryptoHookMock) VerifySecp256k1(_ []byte, _ []byte, _ []byte, _ uint8) error {
 	return c.Err
@@ -41,7 +86,41 @@ func (c *CryptoHookMock) EncodeSecp256k1DERSignature(_, _ []byte) []byte {
 	return make([]byte, 0)
 }
 
+// VerifySecp256r1 mocked method
+func (c *CryptoHookMock) VerifySecp256r1(_ []byte, _ []byte, _ []byte) error {
+	return c.Err
+}
+
+// VerifySecp256r1Batch mocked method
+func (c *CryptoHookMock) VerifySecp256r1Batch(keys [][]byte, _ [][]byte, _ [][]byte) ([]bool, error) {
+	return c.batchResults(len(keys)), c.Err
+}
+
 // Ecrecover mocked method
 func (c *CryptoHookMock) Ecrecover(_ []byte, _ []byte, _ []byte, _ []byte) ([]byte, error) {
 	return c.Result, c.Err
 }
+
+// AddBN254G1 mocked method
+func (c *CryptoHookMock) AddBN254G1(_ []byte, _ []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// ScalarMulBN254G1 mocked method
+func (c *CryptoHookMock) ScalarMulBN254G1(_ []byte, _ []byte) ([]byte, error) {
+	return c.Result, c.Err
+}
+
+// PairingCheckBN254 mocked method
+func (c *CryptoHookMock) PairingCheckBN254(_ [][]byte, _ [][]byte) (bool, error) {
+	return c.Err == nil, c.Err
+}
+
+
+func (c *CryptoHookMock) batchResults(numSignatures int) []bool {
+	results := make([]bool, numSignatures)
+	for i := range results {
+		results[i] = c.Err == nil
+	}
+	return results
+}
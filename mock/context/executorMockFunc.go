@@ -104,6 +104,9 @@ var functionNames = map[string]struct{}{
 	"managedGetESDTTokenData": empty,
 	"managedAsyncCall": empty,
 	"managedCreateAsyncCall": empty,
+	"managedCreateAsyncCallWithDeadline": empty,
+	"managedCancelAsyncCallGroup": empty,
+	"managedIsCallbackTimedOut": empty,
 	"managedGetCallbackClosure": empty,
 	"managedUpgradeFromSourceContract": empty,
 	"managedUpgradeContract": empty,
@@ -229,14 +232,27 @@ var functionNames = map[string]struct{}{
 	"managedKeccak256": empty,
 	"ripemd160": empty,
 	"managedRipemd160": empty,
+	"managedSha512": empty,
+	"managedSha3256": empty,
+	"managedSha3512": empty,
+	"managedBlake2b256": empty,
+	"managedBlake2b512": empty,
+	"managedPoseidon": empty,
 	"verifyBLS": empty,
 	"managedVerifyBLS": empty,
+	"managedVerifyBLSAggregated": empty,
+	"managedAggregateBLSPublicKeys": empty,
 	"verifyEd25519": empty,
 	"managedVerifyEd25519": empty,
+	"managedVerifyEd25519Batch": empty,
+	"managedVerifyEd25519BatchBitmap": empty,
 	"verifyCustomSecp256k1": empty,
 	"managedVerifyCustomSecp256k1": empty,
 	"verifySecp256k1": empty,
 	"managedVerifySecp256k1": empty,
+	"managedVerifySecp256r1": empty,
+	"managedVerifySecp256r1Batch": empty,
+	"managedVerifySecp256r1BatchBitmap": empty,
 	"encodeSecp256k1DerSignature": empty,
 	"managedEncodeSecp256k1DerSignature": empty,
 	"addEC": empty,
@@ -258,7 +274,11 @@ var functionNames = map[string]struct{}{
 	"managedGenerateKeyEC": empty,
 	"createEC": empty,
 	"managedCreateEC": empty,
+	"managedCreateCustomEC": empty,
 	"getCurveLengthEC": empty,
 	"getPrivKeyByteLengthEC": empty,
 	"ellipticCurveGetValues": empty,
+	"managedAddBN254G1": empty,
+	"managedScalarMulBN254G1": empty,
+	"managedPairingCheckBN254": empty,
 }
@@ -40,6 +40,8 @@ type RuntimeContextMock struct {
 	GasTrace                 map[string]map[string][]uint64
 	SameContractOnStackCount uint64
 	HasFunctionResult        bool
+	InstanceTracker          vmhost.InstanceTracker
+	FailExecutionErr         error
 }
 
 // InitState mocked method
@@ -57,7 +59,7 @@ func (context *RuntimeContextMock) ReplaceVMExecutor(vmExecutor executor.Executo
 
 // GetInstanceTracker mocked method
 func (context *RuntimeContextMock) GetInstanceTracker() vmhost.InstanceTracker {
-	return nil
+	return context.InstanceTracker
 }
 
 // StartWasmerInstance mocked method
@@ -316,7 +318,8 @@ func (r *RuntimeContextMock) ManagedMapAPIErrorShouldFailExecution() bool {
 }
 
 // FailExecution mocked method
-func (r *RuntimeContextMock) FailExecution(_ error) {
+func (r *RuntimeContextMock) FailExecution(err error) {
+	r.FailExecutionErr = err
 }
 
 // AddAsyncContextCall mocked method
@@ -23,8 +23,10 @@ type VMHostMock struct {
 	OutputContext            vmhost.OutputContext
 	MeteringContext          vmhost.MeteringContext
 	StorageContext           vmhost.StorageContext
-	EnableEpochsHandlerField vmcommon.EnableEpochsHandler
+	EnableEpochsHandlerField vmhost.EnableEpochsHandler
 	ManagedTypesContext      vmhost.ManagedTypesContext
+	CompiledCodeStoreField   vmhost.CompiledCodeStore
+	AsyncCallGraphField      vmhost.AsyncCallGraphRecorder
 
 	IsBuiltinFunc bool
 
@@ -71,10 +73,20 @@ func (host *VMHostMock) Storage() vmhost.StorageContext {
 }
 
 // EnableEpochsHandler mocked method
-func (host *VMHostMock) EnableEpochsHandler() vmcommon.EnableEpochsHandler {
+func (host *VMHostMock) EnableEpochsHandler() vmhost.EnableEpochsHandler {
 	return host.EnableEpochsHandlerField
 }
 
+// CompiledCodeStore mocked method
+func (host *VMHostMock) CompiledCodeStore() vmhost.CompiledCodeStore {
+	return host.CompiledCodeStoreField
+}
+
+// AsyncCallGraph mocked method
+func (host *VMHostMock) AsyncCallGraph() vmhost.AsyncCallGraphRecorder {
+	return host.AsyncCallGraphField
+}
+
 // ManagedTypes mocked method
 func (host *VMHostMock) ManagedTypes() vmhost.ManagedTypesContext {
 	return host.ManagedTypesContext
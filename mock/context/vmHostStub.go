@@ -25,9 +25,11 @@ type VMHostStub struct {
 	MeteringCalled            func() vmhost.MeteringContext
 	AsyncCalled               func() vmhost.AsyncContext
 	StorageCalled             func() vmhost.StorageContext
-	EnableEpochsHandlerCalled func() vmcommon.EnableEpochsHandler
+	EnableEpochsHandlerCalled func() vmhost.EnableEpochsHandler
 	GetContextsCalled         func() (vmhost.ManagedTypesContext, vmhost.BlockchainContext, vmhost.MeteringContext, vmhost.OutputContext, vmhost.RuntimeContext, vmhost.AsyncContext, vmhost.StorageContext)
 	ManagedTypesCalled        func() vmhost.ManagedTypesContext
+	CompiledCodeStoreCalled   func() vmhost.CompiledCodeStore
+	AsyncCallGraphCalled      func() vmhost.AsyncCallGraphRecorder
 
 	ExecuteESDTTransferCalled   func(transfersArgs *vmhost.ESDTTransfersArgs, callType vm.CallType) (*vmcommon.VMOutput, uint64, error)
 	CreateNewContractCalled     func(input *vmcommon.ContractCreateInput) ([]byte, error)
@@ -159,13 +161,29 @@ func (vhs *VMHostStub) Storage() vmhost.StorageContext {
 }
 
 // EnableEpochsHandler mocked method
-func (vhs *VMHostStub) EnableEpochsHandler() vmcommon.EnableEpochsHandler {
+func (vhs *VMHostStub) EnableEpochsHandler() vmhost.EnableEpochsHandler {
 	if vhs.EnableEpochsHandlerCalled != nil {
 		return vhs.EnableEpochsHandlerCalled()
 	}
 	return nil
 }
 
+// CompiledCodeStore mocked method
+func (vhs *VMHostStub) CompiledCodeStore() vmhost.CompiledCodeStore {
+	if vhs.CompiledCodeStoreCalled != nil {
+		return vhs.CompiledCodeStoreCalled()
+	}
+	return nil
+}
+
+// AsyncCallGraph mocked method
+func (vhs *VMHostStub) AsyncCallGraph() vmhost.AsyncCallGraphRecorder {
+	if vhs.AsyncCallGraphCalled != nil {
+		return vhs.AsyncCallGraphCalled()
+	}
+	return nil
+}
+
 // Async mocked method
 func (vhs *VMHostStub) Async() vmhost.AsyncContext {
 	if vhs.AsyncCalled != nil {
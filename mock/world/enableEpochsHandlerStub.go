@@ -1,8 +1,8 @@
 package worldmock
 
-import vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+import "github.com/multiversx/mx-chain-vm-go/vmhost"
 
-var _ vmcommon.EnableEpochsHandler = (*EnableEpochsHandlerStub)(nil)
+var _ vmhost.EnableEpochsHandler = (*EnableEpochsHandlerStub)(nil)
 
 // EnableEpochsHandlerStub -
 type EnableEpochsHandlerStub struct {
@@ -48,6 +48,11 @@ type EnableEpochsHandlerStub struct {
 	IsWipeSingleNFTLiquidityDecreaseEnabledField         bool
 	IsAlwaysSaveTokenMetaDataEnabledField                bool
 	IsRuntimeCodeSizeFixEnabledField                     bool
+	IsAsyncCallTimeoutsFlagEnabledField                  bool
+	IsBLSMultiSigFlagEnabledField                        bool
+	IsMemoryBudgetFlagEnabledField                       bool
+	IsCustomEllipticCurvesFlagEnabledField               bool
+	IsFixVMHooksErrorHandlingFlagEnabledField            bool
 }
 
 // IsGlobalMintBurnFlagEnabled -
@@ -260,6 +265,31 @@ func (stub *EnableEpochsHandlerStub) IsRuntimeCodeSizeFixEnabled() bool {
 	return stub.IsRuntimeCodeSizeFixEnabledField
 }
 
+// IsAsyncCallTimeoutsFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsAsyncCallTimeoutsFlagEnabled() bool {
+	return stub.IsAsyncCallTimeoutsFlagEnabledField
+}
+
+// IsBLSMultiSigFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsBLSMultiSigFlagEnabled() bool {
+	return stub.IsBLSMultiSigFlagEnabledField
+}
+
+// IsMemoryBudgetFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsMemoryBudgetFlagEnabled() bool {
+	return stub.IsMemoryBudgetFlagEnabledField
+}
+
+// IsCustomEllipticCurvesFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsCustomEllipticCurvesFlagEnabled() bool {
+	return stub.IsCustomEllipticCurvesFlagEnabledField
+}
+
+// IsFixVMHooksErrorHandlingFlagEnabled -
+func (stub *EnableEpochsHandlerStub) IsFixVMHooksErrorHandlingFlagEnabled() bool {
+	return stub.IsFixVMHooksErrorHandlingFlagEnabledField
+}
+
 // IsInterfaceNil -
 func (stub *EnableEpochsHandlerStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -294,6 +324,11 @@ func EnableEpochsHandlerStubAllFlags() *EnableEpochsHandlerStub {
 		IsFixOldTokenLiquidityEnabledField:                   true,
 		IsAlwaysSaveTokenMetaDataEnabledField:                true,
 		IsRuntimeCodeSizeFixEnabledField:                     true,
+		IsAsyncCallTimeoutsFlagEnabledField:                  true,
+		IsBLSMultiSigFlagEnabledField:                        true,
+		IsMemoryBudgetFlagEnabledField:                       true,
+		IsCustomEllipticCurvesFlagEnabledField:               true,
+		IsFixVMHooksErrorHandlingFlagEnabledField:            true,
 	}
 }
 
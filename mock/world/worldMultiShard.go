@@ -0,0 +1,437 @@
+package worldmock
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// ErrInvalidShardID signals that the shard ID is not one of the shards of the MultiShardWorld
+var ErrInvalidShardID = errors.New("invalid shard ID")
+
+// ErrCrossShardMessagesPending signals that cross-shard messages were still pending after the maximum number of blocks
+var ErrCrossShardMessagesPending = errors.New("cross-shard messages still pending")
+
+// HostFactory creates the VM of a shard, on top of the MockWorld of that shard.
+type HostFactory func(shardID uint32, world *MockWorld) (vmcommon.VMExecutionHandler, error)
+
+// Shard is one shard of a MultiShardWorld, with its own MockWorld and VM.
+type Shard struct {
+	ID    uint32
+	World *MockWorld
+	Host  vmcommon.VMExecutionHandler
+
+	incoming []*CrossShardMessage
+}
+
+// CrossShardMessage is a transfer emitted by a transaction on one shard, to be executed on another shard.
+type CrossShardMessage struct {
+	SourceShardID      uint32
+	DestinationShardID uint32
+	EmittedInBlock     uint64
+	Destination        []byte
+	Transfer           *vmcommon.OutputTransfer
+}
+
+// ExecutedTransaction records a transaction executed by a MultiShardWorld, either submitted directly
+// or delivered as a cross-shard message.
+type ExecutedTransaction struct {
+	ShardID uint32
+	Block   uint64
+	Input   *vmcommon.ContractCallInput
+	Output  *vmcommon.VMOutput
+	Message *CrossShardMessage
+}
+
+// MultiShardWorld simulates several shards, each with its own MockWorld and VM. The transfers of a transaction
+// towards accounts of other shards, e.g. cross-shard async calls and their callbacks, are queued on the destination
+// shard and executed in the next simulated block.
+type MultiShardWorld struct {
+	Shards       []*Shard
+	CurrentBlock uint64
+	Executed     []*ExecutedTransaction
+
+	argsParser vmcommon.CallArgsParser
+	txNumber   uint64
+}
+
+// NewMultiShardWorld creates a MultiShardWorld with the given number of shards,
+// creating the VM of each shard with the given factory.
+func NewMultiShardWorld(numShards uint32, hostFactory HostFactory) (*MultiShardWorld, error) {
+	if numShards == 0 {
+		return nil, fmt.Errorf("%w: at least one shard is required", ErrInvalidShardID)
+	}
+	if hostFactory == nil {
+		return nil, errors.New("nil host factory")
+	}
+
+	multiShardWorld := &MultiShardWorld{
+		Shards:     make([]*Shard, numShards),
+		Executed:   make([]*ExecutedTransaction, 0),
+		argsParser: parsers.NewCallArgsParser(),
+	}
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		world := NewMockWorld()
+		world.SelfShardID = shardID
+		world.CurrentBlockInfo = &BlockInfo{}
+
+		host, err := hostFactory(shardID, world)
+		if err != nil {
+			return nil, err
+		}
+		multiShardWorld.Shards[shardID] = &Shard{
+			ID:       shardID,
+			World:    world,
+			Host:     host,
+			incoming: make([]*CrossShardMessage, 0),
+		}
+	}
+
+	return multiShardWorld, nil
+}
+
+// GetShard returns the shard with the given ID.
+func (msw *MultiShardWorld) GetShard(shardID uint32) (*Shard, error) {
+	if shardID >= uint32(len(msw.Shards)) {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidShardID, shardID)
+	}
+	return msw.Shards[shardID], nil
+}
+
+// CreateAccount creates an account in the world of the given shard. The other shards only learn its shard ID.
+func (msw *MultiShardWorld) CreateAccount(shardID uint32, address []byte) (*Account, error) {
+	shard, err := msw.GetShard(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	account := shard.World.AcctMap.CreateAccount(address, shard.World)
+	account.ShardID = shardID
+	msw.registerForeignAccount(account)
+	return account, nil
+}
+
+// CreateSmartContractAccount creates a smart contract account in the world of the given shard.
+// The other shards only learn its shard ID and that it is a smart contract.
+func (msw *MultiShardWorld) CreateSmartContractAccount(shardID uint32, owner []byte, address []byte, code []byte) (*Account, error) {
+	shard, err := msw.GetShard(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	account := shard.World.AcctMap.CreateSmartContractAccount(owner, address, code, shard.World)
+	account.ShardID = shardID
+	msw.registerForeignAccount(account)
+	return account, nil
+}
+
+// GetAccount returns the account from the world of the shard it belongs to, or nil if it does not exist.
+func (msw *MultiShardWorld) GetAccount(address []byte) *Account {
+	for _, shard := range msw.Shards {
+		account := shard.World.AcctMap.GetAccount(address)
+		if account != nil && account.ShardID == shard.ID {
+			return account
+		}
+	}
+	return nil
+}
+
+// ShardOf returns the shard of an address. Addresses unknown to all the shards are assigned by their last byte.
+func (msw *MultiShardWorld) ShardOf(address []byte) uint32 {
+	account := msw.GetAccount(address)
+	if account != nil {
+		return account.ShardID
+	}
+	if len(address) == 0 {
+		return 0
+	}
+	return uint32(address[len(address)-1]) % uint32(len(msw.Shards))
+}
+
+// registerForeignAccount makes the account known to the other shards, which need its shard ID to route calls to it.
+func (msw *MultiShardWorld) registerForeignAccount(account *Account) {
+	for _, shard := range msw.Shards {
+		if shard.ID == account.ShardID {
+			continue
+		}
+		foreignAccount := shard.World.AcctMap.CreateAccount(account.Address, shard.World)
+		foreignAccount.ShardID = account.ShardID
+		foreignAccount.IsSmartContract = account.IsSmartContract
+		foreignAccount.CodeMetadata = account.CodeMetadata
+	}
+}
+
+// HasPendingMessages returns true if any shard still has cross-shard messages to execute.
+func (msw *MultiShardWorld) HasPendingMessages() bool {
+	for _, shard := range msw.Shards {
+		if len(shard.incoming) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// RunSmartContractCall executes a call in the current block, on the shard of the recipient.
+// Its cross-shard transfers are queued for the next block.
+func (msw *MultiShardWorld) RunSmartContractCall(input *vmcommon.ContractCallInput) (*ExecutedTransaction, error) {
+	shard, err := msw.GetShard(msw.ShardOf(input.RecipientAddr))
+	if err != nil {
+		return nil, err
+	}
+	return msw.execute(shard, input, nil)
+}
+
+// ProcessBlock starts a new block and executes, on every shard, the cross-shard messages
+// emitted in the previous blocks. The messages emitted now are executed in the next block.
+func (msw *MultiShardWorld) ProcessBlock() ([]*ExecutedTransaction, error) {
+	msw.CurrentBlock++
+	for _, shard := range msw.Shards {
+		shard.World.PreviousBlockInfo = shard.World.CurrentBlockInfo
+		shard.World.CurrentBlockInfo = &BlockInfo{
+			BlockNonce: msw.CurrentBlock,
+			BlockRound: msw.CurrentBlock,
+		}
+	}
+
+	// only the messages emitted before this block are delivered now
+	messagesPerShard := make([][]*CrossShardMessage, len(msw.Shards))
+	for i, shard := range msw.Shards {
+		messagesPerShard[i] = shard.incoming
+		shard.incoming = make([]*CrossShardMessage, 0)
+	}
+
+	executed := make([]*ExecutedTransaction, 0)
+	for i, shard := range msw.Shards {
+		for _, message := range messagesPerShard[i] {
+			executedTx, err := msw.deliver(shard, message)
+			if err != nil {
+				return executed, err
+			}
+			if executedTx != nil {
+				executed = append(executed, executedTx)
+			}
+		}
+	}
+	return executed, nil
+}
+
+// ProcessBlocksUntilIdle processes blocks until no cross-shard messages are pending, but at most maxBlocks.
+func (msw *MultiShardWorld) ProcessBlocksUntilIdle(maxBlocks int) ([]*ExecutedTransaction, error) {
+	executed := make([]*ExecutedTransaction, 0)
+	for block := 0; block < maxBlocks; block++ {
+		if !msw.HasPendingMessages() {
+			return executed, nil
+		}
+		executedInBlock, err := msw.ProcessBlock()
+		executed = append(executed, executedInBlock...)
+		if err != nil {
+			return executed, err
+		}
+	}
+	if msw.HasPendingMessages() {
+		return executed, fmt.Errorf("%w after %d blocks", ErrCrossShardMessagesPending, maxBlocks)
+	}
+	return executed, nil
+}
+
+// deliver executes a cross-shard message on its destination shard. Transfers that carry
+// no call, or which are sent to user accounts, only credit the destination, without involving the VM.
+func (msw *MultiShardWorld) deliver(shard *Shard, message *CrossShardMessage) (*ExecutedTransaction, error) {
+	transfer := message.Transfer
+	isCall := transfer.GasLimit > 0 || len(transfer.Data) > 0 || transfer.CallType != vm.DirectCall
+	destination := shard.World.AcctMap.GetAccount(message.Destination)
+	isContract := destination != nil && destination.IsSmartContract
+	if !isCall || !isContract {
+		msw.creditAccount(shard, message.Destination, transfer.Value)
+		return nil, nil
+	}
+
+	input, err := msw.createInputFromMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	return msw.execute(shard, input, message)
+}
+
+func (msw *MultiShardWorld) createInputFromMessage(message *CrossShardMessage) (*vmcommon.ContractCallInput, error) {
+	transfer := message.Transfer
+	function, arguments, err := msw.argsParser.ParseData(string(transfer.Data))
+	if err != nil {
+		return nil, err
+	}
+	if transfer.CallType == vm.AsynchronousCallBack {
+		// the data of a callback starts with a placeholder instead of the function,
+		// the VM finds the callback to execute in the async context of the caller
+		function = vmhost.CallbackFunctionName
+	}
+
+	asyncArguments, err := msw.createAsyncArguments(transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	callValue := transfer.Value
+	if callValue == nil {
+		callValue = big.NewInt(0)
+	}
+
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:     transfer.SenderAddress,
+			Arguments:      arguments,
+			CallValue:      callValue,
+			CallType:       transfer.CallType,
+			GasPrice:       1,
+			GasProvided:    transfer.GasLimit,
+			GasLocked:      transfer.GasLocked,
+			OriginalTxHash: nil,
+			PrevTxHash:     msw.currentTxHash(),
+			CurrentTxHash:  msw.nextTxHash(),
+			AsyncArguments: asyncArguments,
+			ESDTTransfers:  make([]*vmcommon.ESDTTransfer, 0),
+		},
+		RecipientAddr: message.Destination,
+		Function:      function,
+	}, nil
+}
+
+// createAsyncArguments decodes the async data of a transfer, i.e. the call ID and the caller call ID,
+// followed, for callbacks, by the call ID of the async initiator and the accumulated gas.
+func (msw *MultiShardWorld) createAsyncArguments(transfer *vmcommon.OutputTransfer) (*vmcommon.AsyncArguments, error) {
+	if transfer.CallType != vm.AsynchronousCall && transfer.CallType != vm.AsynchronousCallBack {
+		return nil, nil
+	}
+
+	// the async data starts with a separator, so the first argument is always empty
+	parsedArguments, err := msw.argsParser.ParseArguments(string(transfer.AsyncData))
+	if err != nil {
+		return nil, err
+	}
+	if len(parsedArguments) < 3 {
+		return nil, fmt.Errorf("invalid async data in transfer from %x", transfer.SenderAddress)
+	}
+
+	asyncArguments := &vmcommon.AsyncArguments{
+		CallID:       parsedArguments[1],
+		CallerCallID: parsedArguments[2],
+	}
+	if transfer.CallType == vm.AsynchronousCallBack {
+		if len(parsedArguments) < 5 {
+			return nil, fmt.Errorf("invalid callback async data in transfer from %x", transfer.SenderAddress)
+		}
+		asyncArguments.CallbackAsyncInitiatorCallID = parsedArguments[3]
+		asyncArguments.GasAccumulated = big.NewInt(0).SetBytes(parsedArguments[4]).Uint64()
+	}
+	return asyncArguments, nil
+}
+
+// execute runs the call on the given shard, applies its effects on the accounts of the shard,
+// and queues its transfers towards the other shards.
+func (msw *MultiShardWorld) execute(shard *Shard, input *vmcommon.ContractCallInput, message *CrossShardMessage) (*ExecutedTransaction, error) {
+	if input.CurrentTxHash == nil {
+		input.PrevTxHash = msw.currentTxHash()
+		input.CurrentTxHash = msw.nextTxHash()
+	}
+
+	world := shard.World
+	world.CreateStateBackup()
+	output, err := shard.Host.RunSmartContractCall(input)
+	if err != nil {
+		_ = world.RollbackChanges()
+		return nil, err
+	}
+
+	if output.ReturnCode == vmcommon.Ok {
+		msw.applyLocalChanges(shard, output)
+		err = world.CommitChanges()
+	} else {
+		err = world.RollbackChanges()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	msw.queueCrossShardTransfers(shard, output)
+
+	executedTx := &ExecutedTransaction{
+		ShardID: shard.ID,
+		Block:   msw.CurrentBlock,
+		Input:   input,
+		Output:  output,
+		Message: message,
+	}
+	msw.Executed = append(msw.Executed, executedTx)
+	return executedTx, nil
+}
+
+// applyLocalChanges updates the accounts that belong to the shard. The changes of the accounts
+// of other shards only take effect when the corresponding cross-shard messages are delivered.
+func (msw *MultiShardWorld) applyLocalChanges(shard *Shard, output *vmcommon.VMOutput) {
+	for _, outputAccount := range output.OutputAccounts {
+		if msw.ShardOf(outputAccount.Address) != shard.ID {
+			continue
+		}
+
+		isNewAccount := shard.World.AcctMap.GetAccount(outputAccount.Address) == nil
+		shard.World.UpdateAccountFromOutputAccount(outputAccount)
+		if isNewAccount {
+			account := shard.World.AcctMap.GetAccount(outputAccount.Address)
+			account.ShardID = shard.ID
+			msw.registerForeignAccount(account)
+		}
+	}
+	for _, deletedAddress := range output.DeletedAccounts {
+		if msw.ShardOf(deletedAddress) == shard.ID {
+			shard.World.AcctMap.DeleteAccount(deletedAddress)
+		}
+	}
+}
+
+func (msw *MultiShardWorld) queueCrossShardTransfers(shard *Shard, output *vmcommon.VMOutput) {
+	for _, outputAccount := range output.OutputAccounts {
+		destinationShardID := msw.ShardOf(outputAccount.Address)
+		if destinationShardID == shard.ID {
+			continue
+		}
+
+		destinationShard := msw.Shards[destinationShardID]
+		for _, transfer := range outputAccount.OutputTransfers {
+			transferCopy := transfer
+			destinationShard.incoming = append(destinationShard.incoming, &CrossShardMessage{
+				SourceShardID:      shard.ID,
+				DestinationShardID: destinationShardID,
+				EmittedInBlock:     msw.CurrentBlock,
+				Destination:        outputAccount.Address,
+				Transfer:           &transferCopy,
+			})
+		}
+	}
+}
+
+func (msw *MultiShardWorld) creditAccount(shard *Shard, address []byte, value *big.Int) {
+	if value == nil {
+		return
+	}
+
+	account := shard.World.AcctMap.GetAccount(address)
+	if account == nil || account.ShardID != shard.ID {
+		account = shard.World.AcctMap.CreateAccount(address, shard.World)
+		account.ShardID = shard.ID
+		msw.registerForeignAccount(account)
+	}
+	account.Balance = big.NewInt(0).Add(account.Balance, value)
+}
+
+func (msw *MultiShardWorld) currentTxHash() []byte {
+	return big.NewInt(0).SetUint64(msw.txNumber).Bytes()
+}
+
+func (msw *MultiShardWorld) nextTxHash() []byte {
+	msw.txNumber++
+	return msw.currentTxHash()
+}
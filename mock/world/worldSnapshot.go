@@ -0,0 +1,205 @@
+package worldmock
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"sort"
+)
+
+// ErrInvalidSnapshot signals that the data given as a world snapshot is not a snapshot, or has an unsupported version.
+var ErrInvalidSnapshot = errors.New("invalid world snapshot")
+
+// snapshotHeader starts every snapshot, followed by the gob encoding of a worldSnapshot
+const snapshotHeader = "mockworld-snapshot-v1\n"
+
+// worldSnapshot holds the state of a MockWorld. It only contains slices, sorted by key,
+// so that the same world is always encoded to the same bytes.
+type worldSnapshot struct {
+	SelfShardID                uint32
+	Accounts                   []*accountSnapshot
+	PreviousBlockInfo          *BlockInfo
+	CurrentBlockInfo           *BlockInfo
+	Blockhashes                [][]byte
+	NewAddressMocks            []*NewAddressMock
+	StateRootHash              []byte
+	LastCreatedContractAddress []byte
+	CompiledCode               []*keyValueSnapshot
+	IsPausedValue              bool
+	IsLimitedTransferValue     bool
+}
+
+type accountSnapshot struct {
+	Exists          bool
+	Address         []byte
+	Nonce           uint64
+	Balance         *big.Int
+	Storage         []*keyValueSnapshot
+	RootHash        []byte
+	Code            []byte
+	CodeHash        []byte
+	CodeMetadata    []byte
+	OwnerAddress    []byte
+	AsyncCallData   string
+	Username        []byte
+	DeveloperReward *big.Int
+	ShardID         uint32
+	IsSmartContract bool
+}
+
+type keyValueSnapshot struct {
+	Key   []byte
+	Value []byte
+}
+
+// EncodeSnapshot serializes the accounts, including their storage and thus their ESDT data,
+// the block info, the new address mocks and the compiled code of the world. Changes not yet
+// committed are included as well, but the backups of the AccountsAdapter are not.
+func (b *MockWorld) EncodeSnapshot() ([]byte, error) {
+	snapshot := &worldSnapshot{
+		SelfShardID:                b.SelfShardID,
+		Accounts:                   make([]*accountSnapshot, 0, len(b.AcctMap)),
+		PreviousBlockInfo:          b.PreviousBlockInfo,
+		CurrentBlockInfo:           b.CurrentBlockInfo,
+		Blockhashes:                b.Blockhashes,
+		NewAddressMocks:            b.NewAddressMocks,
+		StateRootHash:              b.StateRootHash,
+		LastCreatedContractAddress: b.LastCreatedContractAddress,
+		CompiledCode:               sortedKeyValues(b.CompiledCode),
+		IsPausedValue:              b.IsPausedValue,
+		IsLimitedTransferValue:     b.IsLimitedTransferValue,
+	}
+
+	addresses := make([]string, 0, len(b.AcctMap))
+	for address := range b.AcctMap {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		snapshot.Accounts = append(snapshot.Accounts, newAccountSnapshot(b.AcctMap[address]))
+	}
+
+	buffer := bytes.NewBufferString(snapshotHeader)
+	err := gob.NewEncoder(buffer).Encode(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode world snapshot: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// DecodeSnapshot replaces the state of the world with the one from a snapshot created by EncodeSnapshot.
+// The builtin functions, the provided blockchain hook and the outputs of other VMs are left unchanged.
+func (b *MockWorld) DecodeSnapshot(data []byte) error {
+	if !bytes.HasPrefix(data, []byte(snapshotHeader)) {
+		return ErrInvalidSnapshot
+	}
+
+	snapshot := &worldSnapshot{}
+	err := gob.NewDecoder(bytes.NewReader(data[len(snapshotHeader):])).Decode(snapshot)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSnapshot, err)
+	}
+
+	b.Clear()
+	b.SelfShardID = snapshot.SelfShardID
+	for _, account := range snapshot.Accounts {
+		b.AcctMap.PutAccount(account.toAccount(b))
+	}
+	b.PreviousBlockInfo = snapshot.PreviousBlockInfo
+	b.CurrentBlockInfo = snapshot.CurrentBlockInfo
+	b.Blockhashes = snapshot.Blockhashes
+	b.NewAddressMocks = snapshot.NewAddressMocks
+	b.StateRootHash = snapshot.StateRootHash
+	b.LastCreatedContractAddress = snapshot.LastCreatedContractAddress
+	for _, compiledCode := range snapshot.CompiledCode {
+		b.CompiledCode[string(compiledCode.Key)] = compiledCode.Value
+	}
+	b.IsPausedValue = snapshot.IsPausedValue
+	b.IsLimitedTransferValue = snapshot.IsLimitedTransferValue
+
+	return nil
+}
+
+// SaveSnapshot writes a snapshot of the world to a file, see EncodeSnapshot.
+func (b *MockWorld) SaveSnapshot(path string) error {
+	data, err := b.EncodeSnapshot()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// LoadSnapshot replaces the state of the world with the snapshot from a file, see DecodeSnapshot.
+func (b *MockWorld) LoadSnapshot(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return b.DecodeSnapshot(data)
+}
+
+func newAccountSnapshot(account *Account) *accountSnapshot {
+	return &accountSnapshot{
+		Exists:          account.Exists,
+		Address:         account.Address,
+		Nonce:           account.Nonce,
+		Balance:         account.Balance,
+		Storage:         sortedKeyValues(account.Storage),
+		RootHash:        account.RootHash,
+		Code:            account.Code,
+		CodeHash:        account.CodeHash,
+		CodeMetadata:    account.CodeMetadata,
+		OwnerAddress:    account.OwnerAddress,
+		AsyncCallData:   account.AsyncCallData,
+		Username:        account.Username,
+		DeveloperReward: account.DeveloperReward,
+		ShardID:         account.ShardID,
+		IsSmartContract: account.IsSmartContract,
+	}
+}
+
+func (snapshot *accountSnapshot) toAccount(world *MockWorld) *Account {
+	storage := make(map[string][]byte, len(snapshot.Storage))
+	for _, entry := range snapshot.Storage {
+		storage[string(entry.Key)] = entry.Value
+	}
+
+	return &Account{
+		Exists:          snapshot.Exists,
+		Address:         snapshot.Address,
+		Nonce:           snapshot.Nonce,
+		Balance:         snapshot.Balance,
+		Storage:         storage,
+		RootHash:        snapshot.RootHash,
+		Code:            snapshot.Code,
+		CodeHash:        snapshot.CodeHash,
+		CodeMetadata:    snapshot.CodeMetadata,
+		OwnerAddress:    snapshot.OwnerAddress,
+		AsyncCallData:   snapshot.AsyncCallData,
+		Username:        snapshot.Username,
+		DeveloperReward: snapshot.DeveloperReward,
+		ShardID:         snapshot.ShardID,
+		IsSmartContract: snapshot.IsSmartContract,
+		MockWorld:       world,
+	}
+}
+
+func sortedKeyValues(values map[string][]byte) []*keyValueSnapshot {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	sorted := make([]*keyValueSnapshot, 0, len(keys))
+	for _, key := range keys {
+		sorted = append(sorted, &keyValueSnapshot{
+			Key:   []byte(key),
+			Value: values[key],
+		})
+	}
+	return sorted
+}
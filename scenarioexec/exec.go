@@ -25,16 +25,36 @@ var log = logger.GetOrCreate("vm/scenarios")
 // TestVMType is the VM type argument we use in tests.
 var TestVMType = []byte{0, 0}
 
+// TxObserver is notified of the output of every transaction step, before the expected results are checked.
+// The gasChecked flag tells whether the scenario being executed checks the remaining gas.
+type TxObserver interface {
+	ObserveTx(step *mj.TxStep, output *vmi.VMOutput, gasChecked bool)
+}
+
 // VMTestExecutor parses, interprets and executes both .test.json tests and .scen.json scenarios with VM.
 type VMTestExecutor struct {
 	World              *worldhook.MockWorld
 	vm                 vmi.VMExecutionHandler
 	OverrideVMExecutor executor.ExecutorAbstractFactory
+	Debugger           vmhost.ExecutionDebugger
+	CompiledCodeStore  vmhost.CompiledCodeStore
+	Profiler           vmhost.ExecutionProfiler
+	Coverage           vmhost.ExecutionCoverage
+	AsyncCallGraph     vmhost.AsyncCallGraphRecorder
 	vmHost             vmhost.VMHost
 	checkGas           bool
 	scenarioTraceGas   []bool
 	fileResolver       fr.FileResolver
 	exprReconstructor  er.ExprReconstructor
+
+	// OverrideGasSchedule replaces the gas schedules requested by the scenarios, if set.
+	OverrideGasSchedule config.GasScheduleMap
+
+	// TxObserver receives the output of every transaction, if set.
+	TxObserver TxObserver
+
+	// SkipGasChecks disables the checks of the remaining gas, even for scenarios that request them.
+	SkipGasChecks bool
 }
 
 var _ mc.TestExecutor = (*VMTestExecutor)(nil)
@@ -88,6 +108,11 @@ func (ae *VMTestExecutor) InitVM(scenGasSchedule mj.GasSchedule) error {
 			EnableEpochsHandler:      worldhook.EnableEpochsHandlerStubAllFlags(),
 			WasmerSIGSEGVPassthrough: false,
 			Hasher:                   worldhook.DefaultHasher,
+			Debugger:                 ae.Debugger,
+			CompiledCodeStore:        ae.CompiledCodeStore,
+			Profiler:                 ae.Profiler,
+			Coverage:                 ae.Coverage,
+			AsyncCallGraph:           ae.AsyncCallGraph,
 		})
 	if err != nil {
 		return err
@@ -108,6 +133,10 @@ func (ae *VMTestExecutor) getVMHost() vmhost.VMHost {
 }
 
 func (ae *VMTestExecutor) gasScheduleMapFromScenarios(scenGasSchedule mj.GasSchedule) (config.GasScheduleMap, error) {
+	if ae.OverrideGasSchedule != nil {
+		return ae.OverrideGasSchedule, nil
+	}
+
 	switch scenGasSchedule {
 	case mj.GasScheduleDefault:
 		return gasSchedules.LoadGasScheduleConfig(gasSchedules.GetV4())
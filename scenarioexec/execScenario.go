@@ -2,6 +2,8 @@ package scenarioexec
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	mc "github.com/multiversx/mx-chain-scenario-go/controller"
@@ -11,6 +13,9 @@ import (
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 )
 
+// setStateSnapshotPrefix marks the id of the "setState" steps which load a MockWorld snapshot
+const setStateSnapshotPrefix = "snapshot:"
+
 // Reset clears state/world.
 // Is called in RunAllJSONScenariosInDirectory, but not in RunSingleJSONScenario.
 func (ae *VMTestExecutor) Reset() {
@@ -102,11 +107,18 @@ func (ae *VMTestExecutor) ExecuteExternalStep(step *mj.ExternalStepsStep) error
 }
 
 // ExecuteSetStateStep executes a SetStateStep.
+// A step with an id of the form "snapshot:<path>" first replaces the whole world with the MockWorld snapshot
+// found at that path, relative to the scenario, and then applies its own fields on top of it.
 func (ae *VMTestExecutor) ExecuteSetStateStep(step *mj.SetStateStep) error {
 	if len(step.Comment) > 0 {
 		log.Trace("SetStateStep", "comment", step.Comment)
 	}
 
+	snapshotLoaded, err := ae.loadSnapshotFromSetStateStep(step)
+	if err != nil {
+		return err
+	}
+
 	for _, scenAccount := range step.Accounts {
 		if scenAccount.Update {
 			err := ae.UpdateAccount(scenAccount)
@@ -126,10 +138,12 @@ func (ae *VMTestExecutor) ExecuteSetStateStep(step *mj.SetStateStep) error {
 	// replace block info
 	ae.World.PreviousBlockInfo = convertBlockInfo(step.PreviousBlockInfo, ae.World.PreviousBlockInfo)
 	ae.World.CurrentBlockInfo = convertBlockInfo(step.CurrentBlockInfo, ae.World.CurrentBlockInfo)
-	ae.World.Blockhashes = step.BlockHashes.ToValues()
+	if !snapshotLoaded || len(step.BlockHashes.Values) > 0 {
+		ae.World.Blockhashes = step.BlockHashes.ToValues()
+	}
 
 	// append NewAddressMocks
-	err := validateNewAddressMocks(step.NewAddressMocks)
+	err = validateNewAddressMocks(step.NewAddressMocks)
 	if err != nil {
 		return err
 	}
@@ -139,6 +153,24 @@ func (ae *VMTestExecutor) ExecuteSetStateStep(step *mj.SetStateStep) error {
 	return nil
 }
 
+func (ae *VMTestExecutor) loadSnapshotFromSetStateStep(step *mj.SetStateStep) (bool, error) {
+	if !strings.HasPrefix(step.SetStateIdent, setStateSnapshotPrefix) {
+		return false, nil
+	}
+
+	snapshotPath := strings.TrimPrefix(step.SetStateIdent, setStateSnapshotPrefix)
+	if ae.fileResolver != nil {
+		snapshotPath = ae.fileResolver.ResolveAbsolutePath(snapshotPath)
+	}
+	log.Trace("SetStateStep", "snapshot", snapshotPath)
+
+	err := ae.World.LoadSnapshot(snapshotPath)
+	if err != nil {
+		return false, fmt.Errorf("cannot load snapshot in \"setState\" step: %w", err)
+	}
+	return true, nil
+}
+
 // ExecuteTxStep executes a TxStep.
 func (ae *VMTestExecutor) ExecuteTxStep(step *mj.TxStep) (*vmi.VMOutput, error) {
 	log.Trace("ExecuteTxStep", "id", step.TxIdent)
@@ -159,9 +191,13 @@ func (ae *VMTestExecutor) ExecuteTxStep(step *mj.TxStep) (*vmi.VMOutput, error)
 		vmhost.DisableLoggingForTests()
 	}
 
+	if ae.TxObserver != nil {
+		ae.TxObserver.ObserveTx(step, output, ae.checkGas)
+	}
+
 	// check results
 	if step.ExpectedResult != nil {
-		err = ae.checkTxResults(step.TxIdent, step.ExpectedResult, ae.checkGas, output)
+		err = ae.checkTxResults(step.TxIdent, step.ExpectedResult, ae.checkGas && !ae.SkipGasChecks, output)
 		if err != nil {
 			return nil, err
 		}
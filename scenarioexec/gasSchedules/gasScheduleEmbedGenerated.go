@@ -98,10 +98,13 @@ const (
     AsyncCallStep = 200000
     AsyncCallbackGasLock = 2000000
     CreateAsyncCall = 200000
+    CreateAsyncCallWithDeadline = 200000
     SetAsyncCallback = 100000
     SetAsyncGroupCallback = 100000
     SetAsyncContextCallback = 100000
+    CancelAsyncCallGroup = 100000
     GetCallbackClosure = 100000
+    IsCallbackTimedOut = 10000
     ExecuteReadOnly = 160000
     CreateContract = 300000
     GetReturnData = 100
@@ -214,10 +217,33 @@ const (
     SHA256 = 1000000
     Keccak256 = 1000000
     Ripemd160 = 1000000
+    SHA512 = 1000000
+    SHA512PerByte = 1000
+    SHA3256 = 1000000
+    SHA3256PerByte = 1500
+    SHA3512 = 1000000
+    SHA3512PerByte = 1500
+    Blake2b256 = 1000000
+    Blake2b256PerByte = 500
+    Blake2b512 = 1000000
+    Blake2b512PerByte = 500
+    Poseidon = 2000000
+    PoseidonPerFieldMultiplication = 300
     VerifyBLS = 5000000
+    VerifyBLSAggregated = 5000000
+    VerifyBLSAggregatedPerKey = 500000
+    AggregateBLSKeys = 100000
+    AggregateBLSKeysPerKey = 500000
     VerifyEd25519 = 2000000
+    VerifyEd25519Batch = 100000
+    VerifyEd25519BatchPerSignature = 2000000
     VerifySecp256k1 = 2000000
+    VerifySecp256r1 = 2000000
+    VerifySecp256r1Batch = 100000
+    VerifySecp256r1BatchPerSignature = 2000000
     EllipticCurveNew = 10000
+    EllipticCurveNewCustom = 100000
+    EllipticCurveNewCustomPerByte = 800000
     AddECC = 75000
     DoubleECC = 65000
     IsOnCurveECC = 10000
@@ -228,6 +254,10 @@ const (
     UnmarshalCompressedECC = 270000
     GenerateKeyECC = 7000000
     EncodeDERSig = 10000000
+    AddBN254G1 = 15000
+    ScalarMulBN254G1 = 250000
+    PairingCheckBN254 = 1000000
+    PairingCheckBN254PerPair = 1400000
 
 [ManagedBufferAPICost]
     MBufferNew = 2000
@@ -251,6 +281,9 @@ const (
     MBufferFinish = 1000
     MBufferSetRandom = 6000
 
+[MaxPerTransaction]
+    MemoryBudget = 268435456
+
 [WASMOpcodeCost]
     AtomicFence = 10
     AtomicNotify = 10
@@ -900,10 +933,13 @@ const (
     AsyncCallStep = 100000
     AsyncCallbackGasLock = 4000000
     CreateAsyncCall = 200000
+    CreateAsyncCallWithDeadline = 200000
     SetAsyncCallback = 100000
     SetAsyncGroupCallback = 100000
     SetAsyncContextCallback = 100000
+    CancelAsyncCallGroup = 100000
     GetCallbackClosure = 100000
+    IsCallbackTimedOut = 10000
     ExecuteReadOnly = 160000
     CreateContract = 300000
     GetReturnData = 100
@@ -1016,10 +1052,33 @@ const (
     SHA256 = 1000000
     Keccak256 = 1000000
     Ripemd160 = 1000000
+    SHA512 = 1000000
+    SHA512PerByte = 1000
+    SHA3256 = 1000000
+    SHA3256PerByte = 1500
+    SHA3512 = 1000000
+    SHA3512PerByte = 1500
+    Blake2b256 = 1000000
+    Blake2b256PerByte = 500
+    Blake2b512 = 1000000
+    Blake2b512PerByte = 500
+    Poseidon = 2000000
+    PoseidonPerFieldMultiplication = 300
     VerifyBLS = 5000000
+    VerifyBLSAggregated = 5000000
+    VerifyBLSAggregatedPerKey = 500000
+    AggregateBLSKeys = 100000
+    AggregateBLSKeysPerKey = 500000
     VerifyEd25519 = 2000000
+    VerifyEd25519Batch = 100000
+    VerifyEd25519BatchPerSignature = 2000000
     VerifySecp256k1 = 2000000
+    VerifySecp256r1 = 2000000
+    VerifySecp256r1Batch = 100000
+    VerifySecp256r1BatchPerSignature = 2000000
     EllipticCurveNew = 10000
+    EllipticCurveNewCustom = 100000
+    EllipticCurveNewCustomPerByte = 800000
     AddECC = 75000
     DoubleECC = 65000
     IsOnCurveECC = 10000
@@ -1030,6 +1089,10 @@ const (
     UnmarshalCompressedECC = 270000
     GenerateKeyECC = 7000000
     EncodeDERSig = 10000000
+    AddBN254G1 = 15000
+    ScalarMulBN254G1 = 250000
+    PairingCheckBN254 = 1000000
+    PairingCheckBN254PerPair = 1400000
 
 [ManagedBufferAPICost]
     MBufferNew = 2000
@@ -1053,6 +1116,9 @@ const (
     MBufferFinish = 1000
     MBufferSetRandom = 6000
 
+[MaxPerTransaction]
+    MemoryBudget = 268435456
+
 [WASMOpcodeCost]
     AtomicFence = 10
     AtomicNotify = 10
@@ -123,6 +123,12 @@ func (template *InstanceCallTestTemplate) WithWasmerSIGSEGVPassthrough(passthrou
 	return template
 }
 
+// WithTransactionMemoryBudget sets the memory budget of the transaction
+func (template *InstanceCallTestTemplate) WithTransactionMemoryBudget(transactionMemoryBudget uint64) *InstanceCallTestTemplate {
+	template.hostBuilder.WithTransactionMemoryBudget(transactionMemoryBudget)
+	return template
+}
+
 // GetVMHost returns the inner VMHost
 func (template *InstanceCallTestTemplate) GetVMHost() vmhost.VMHost {
 	return template.host
@@ -0,0 +1,225 @@
+package testcommon
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// edge types, as written in call graph files
+const (
+	callGraphFileSyncEdge            = "sync"
+	callGraphFileAsyncEdge           = "async"
+	callGraphFileAsyncCrossShardEdge = "asyncCrossShard"
+)
+
+// ErrUnknownCallGraphFileFormat signals a call graph file which is neither JSON, nor YAML
+var ErrUnknownCallGraphFileFormat = errors.New("unknown call graph file format, expected .json, .yaml or .yml")
+
+// TestCallGraphFile is the declarative description of a TestCallGraph, as read from a JSON or YAML file.
+// Calls are written as "contract.function"; the nodes are created when first referenced, in order.
+// The callback of an async edge is a function of the calling contract, so it is only named on the edge.
+//
+//	name: one async call
+//	start: {call: sc1.f1, gasLimit: 500, gasUsed: 10}
+//	edges:
+//	  - {from: sc1.f1, to: sc2.f2, type: async, callback: cb1, gasLimit: 35, gasUsed: 7, gasUsedByCallback: 6}
+//	expected:
+//	  gasUsed: 23
+//	  gasRemaining: 477
+type TestCallGraphFile struct {
+	Name        string                     `json:"name" yaml:"name"`
+	Description string                     `json:"description,omitempty" yaml:"description,omitempty"`
+	Start       *TestCallGraphFileStart    `json:"start" yaml:"start"`
+	Nodes       []string                   `json:"nodes,omitempty" yaml:"nodes,omitempty"`
+	Edges       []*TestCallGraphFileEdge   `json:"edges" yaml:"edges"`
+	Expected    *TestCallGraphFileExpected `json:"expected,omitempty" yaml:"expected,omitempty"`
+}
+
+// TestCallGraphFileStart is the call started by the transaction.
+type TestCallGraphFileStart struct {
+	Call     string `json:"call" yaml:"call"`
+	GasLimit uint64 `json:"gasLimit" yaml:"gasLimit"`
+	GasUsed  uint64 `json:"gasUsed" yaml:"gasUsed"`
+}
+
+// TestCallGraphFileEdge is a sync, async or cross-shard async call between two nodes. The gas locked is added
+// to DefaultCallGraphLockedGas, like TestCallEdge.SetGasLocked does. Group callbacks are not supported yet
+// by TestCallGraph, so an edge declaring one is rejected.
+type TestCallGraphFileEdge struct {
+	From              string `json:"from" yaml:"from"`
+	To                string `json:"to" yaml:"to"`
+	Type              string `json:"type" yaml:"type"`
+	Callback          string `json:"callback,omitempty" yaml:"callback,omitempty"`
+	Group             string `json:"group,omitempty" yaml:"group,omitempty"`
+	GroupCallback     string `json:"groupCallback,omitempty" yaml:"groupCallback,omitempty"`
+	GasLimit          uint64 `json:"gasLimit" yaml:"gasLimit"`
+	GasUsed           uint64 `json:"gasUsed" yaml:"gasUsed"`
+	GasUsedByCallback uint64 `json:"gasUsedByCallback,omitempty" yaml:"gasUsedByCallback,omitempty"`
+	GasLocked         uint64 `json:"gasLocked,omitempty" yaml:"gasLocked,omitempty"`
+	Fail              bool   `json:"fail,omitempty" yaml:"fail,omitempty"`
+	CallbackFail      bool   `json:"callbackFail,omitempty" yaml:"callbackFail,omitempty"`
+}
+
+// TestCallGraphFileExpected holds the outcomes expected from the gas graph computed for the call graph.
+// All of them are optional.
+type TestCallGraphFileExpected struct {
+	GasUsed      *uint64                          `json:"gasUsed,omitempty" yaml:"gasUsed,omitempty"`
+	GasRemaining *uint64                          `json:"gasRemaining,omitempty" yaml:"gasRemaining,omitempty"`
+	Calls        []*TestCallGraphFileExpectedCall `json:"calls,omitempty" yaml:"calls,omitempty"`
+}
+
+// TestCallGraphFileExpectedCall is a call expected to finish, in execution order,
+// with the gas it was provided and the gas it had left.
+type TestCallGraphFileExpectedCall struct {
+	Call         string `json:"call" yaml:"call"`
+	GasProvided  uint64 `json:"gasProvided" yaml:"gasProvided"`
+	GasRemaining uint64 `json:"gasRemaining" yaml:"gasRemaining"`
+	Fail         bool   `json:"fail,omitempty" yaml:"fail,omitempty"`
+}
+
+// LoadTestCallGraphFile reads a call graph file, choosing the format by its extension.
+func LoadTestCallGraphFile(path string) (*TestCallGraphFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	graphFile := &TestCallGraphFile{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(graphFile)
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(graphFile)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCallGraphFileFormat, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if len(graphFile.Name) == 0 {
+		graphFile.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return graphFile, nil
+}
+
+// BuildTestCallGraph creates the TestCallGraph described by the file.
+func (graphFile *TestCallGraphFile) BuildTestCallGraph() (*TestCallGraph, error) {
+	if graphFile.Start == nil {
+		return nil, fmt.Errorf("%s: missing start call", graphFile.Name)
+	}
+
+	callGraph := CreateTestCallGraph()
+	contractID, functionName, err := ParseTestCallGraphFileCall(graphFile.Start.Call)
+	if err != nil {
+		return nil, fmt.Errorf("%s: start: %w", graphFile.Name, err)
+	}
+	callGraph.AddStartNode(contractID, functionName, graphFile.Start.GasLimit, graphFile.Start.GasUsed)
+
+	for _, call := range graphFile.Nodes {
+		_, err = getOrAddCallGraphFileNode(callGraph, call)
+		if err != nil {
+			return nil, fmt.Errorf("%s: nodes: %w", graphFile.Name, err)
+		}
+	}
+
+	for index, fileEdge := range graphFile.Edges {
+		err = addCallGraphFileEdge(callGraph, fileEdge)
+		if err != nil {
+			return nil, fmt.Errorf("%s: edge %d (%s -> %s): %w", graphFile.Name, index, fileEdge.From, fileEdge.To, err)
+		}
+	}
+
+	return callGraph, nil
+}
+
+func addCallGraphFileEdge(callGraph *TestCallGraph, fileEdge *TestCallGraphFileEdge) error {
+	from, err := getOrAddCallGraphFileNode(callGraph, fileEdge.From)
+	if err != nil {
+		return err
+	}
+	to, err := getOrAddCallGraphFileNode(callGraph, fileEdge.To)
+	if err != nil {
+		return err
+	}
+	if len(fileEdge.GroupCallback) > 0 {
+		return errors.New("group callbacks are not supported")
+	}
+	if len(fileEdge.Callback) == 0 && (fileEdge.GasUsedByCallback > 0 || fileEdge.CallbackFail) {
+		return errors.New("the edge has no callback")
+	}
+
+	var edge *TestCallEdge
+	switch fileEdge.Type {
+	case callGraphFileSyncEdge:
+		if len(fileEdge.Callback) > 0 || fileEdge.GasLocked > 0 {
+			return errors.New("sync edges have no callback")
+		}
+		edge = callGraph.AddSyncEdge(from, to)
+	case callGraphFileAsyncEdge:
+		edge = callGraph.AddAsyncEdge(from, to, fileEdge.Callback, fileEdge.Group)
+	case callGraphFileAsyncCrossShardEdge:
+		edge = callGraph.AddAsyncCrossShardEdge(from, to, fileEdge.Callback, fileEdge.Group)
+	default:
+		return fmt.Errorf("unknown edge type %q", fileEdge.Type)
+	}
+
+	if edge.Type != Sync && len(fileEdge.Callback) > 0 {
+		_, err = getOrAddCallGraphFileNode(callGraph, from.Call.OriginalContractID+"."+fileEdge.Callback)
+		if err != nil {
+			return err
+		}
+	}
+
+	edge.SetGasLimit(fileEdge.GasLimit).
+		SetGasUsed(fileEdge.GasUsed)
+	if edge.Type != Sync {
+		edge.SetGasUsedByCallback(fileEdge.GasUsedByCallback)
+		if fileEdge.GasLocked > 0 {
+			edge.SetGasLocked(fileEdge.GasLocked)
+		}
+	}
+	if fileEdge.Fail {
+		edge.SetFail()
+	}
+	if fileEdge.CallbackFail {
+		edge.SetCallbackFail()
+	}
+	return nil
+}
+
+func getOrAddCallGraphFileNode(callGraph *TestCallGraph, call string) (*TestCallNode, error) {
+	contractID, functionName, err := ParseTestCallGraphFileCall(call)
+	if err != nil {
+		return nil, err
+	}
+
+	node := callGraph.FindNode(MakeTestSCAddress(contractID), functionName)
+	if node != nil {
+		return node, nil
+	}
+	return callGraph.AddNode(contractID, functionName), nil
+}
+
+// ParseTestCallGraphFileCall splits a "contract.function" call into the contract ID and the function name.
+func ParseTestCallGraphFileCall(call string) (string, string, error) {
+	parts := strings.Split(call, ".")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("invalid call %q, expected contract.function", call)
+	}
+	if len(SCAddressPrefix)+len(parts[0]) > AddressSize {
+		return "", "", fmt.Errorf("contract name %q is too long", parts[0])
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,271 @@
+package testcommon
+
+import (
+	"math/rand"
+	"strconv"
+)
+
+// minGeneratedCallGasLimit is the smallest gas limit given to a generated call, so that it can burn some gas
+const minGeneratedCallGasLimit = 20
+
+// TestCallGraphGeneratorConfig holds the limits and probabilities used to generate random call graphs.
+type TestCallGraphGeneratorConfig struct {
+	StartGasLimit uint64
+	// MaxDepth is the maximum number of edges between the start node and any other node
+	MaxDepth int
+	// MaxFanOut is the maximum number of calls made by a node
+	MaxFanOut int
+	// MaxAsyncCallsPerNode is the maximum number of async calls made by a node, all of them in the default group
+	MaxAsyncCallsPerNode int
+	// MaxAsyncCallerDepth is the maximum depth of the nodes making async calls, 0 meaning only the start node
+	MaxAsyncCallerDepth int
+	// MaxExtraGasLocked is the maximum gas locked for a callback, over DefaultCallGraphLockedGas
+	MaxExtraGasLocked uint64
+
+	AsyncCallProbability    float64
+	CrossShardProbability   float64
+	CallbackProbability     float64
+	SyncFailProbability     float64
+	AsyncFailProbability    float64
+	CallbackFailProbability float64
+}
+
+// DefaultTestCallGraphGeneratorConfig returns the generator config used by the generated call graph tests.
+func DefaultTestCallGraphGeneratorConfig() *TestCallGraphGeneratorConfig {
+	return &TestCallGraphGeneratorConfig{
+		StartGasLimit:           10000,
+		MaxDepth:                3,
+		MaxFanOut:               3,
+		MaxAsyncCallsPerNode:    2,
+		MaxAsyncCallerDepth:     2,
+		MaxExtraGasLocked:       100,
+		AsyncCallProbability:    0.5,
+		CrossShardProbability:   0.4,
+		CallbackProbability:     0.7,
+		SyncFailProbability:     0.1,
+		AsyncFailProbability:    0.1,
+		CallbackFailProbability: 0.1,
+	}
+}
+
+type callGraphGenerator struct {
+	random       *rand.Rand
+	config       *TestCallGraphGeneratorConfig
+	graphFile    *TestCallGraphFile
+	numContracts int
+	numCallbacks int
+}
+
+// GenerateTestCallGraphFile generates a random call graph file from the given seed. The same seed and config
+// always produce the same graph. Every call is made to a new contract, and the gas limits are split so that
+// each call can afford the gas used by it and by the calls it makes. Since the call graph tests do not support
+// multi-level async calls yet, async calls are only made by the start node and by the calls synchronously made
+// from it, up to MaxAsyncCallerDepth, while the async calls and the callbacks only make sync calls.
+func GenerateTestCallGraphFile(seed int64, config *TestCallGraphGeneratorConfig) *TestCallGraphFile {
+	generator := &callGraphGenerator{
+		random: rand.New(rand.NewSource(seed)),
+		config: config,
+		graphFile: &TestCallGraphFile{
+			Name:  "generated-" + strconv.FormatInt(seed, 10),
+			Edges: make([]*TestCallGraphFileEdge, 0),
+		},
+	}
+
+	startCall := generator.newCall()
+	startGasUsed := generator.randomGasUsed(config.StartGasLimit)
+	generator.graphFile.Start = &TestCallGraphFileStart{
+		Call:     startCall,
+		GasLimit: config.StartGasLimit,
+		GasUsed:  startGasUsed,
+	}
+	generator.addCalls(startCall, config.StartGasLimit-startGasUsed, 0, true)
+
+	return generator.graphFile
+}
+
+// GenerateTestCallGraph generates a random TestCallGraph from the given seed, see GenerateTestCallGraphFile.
+func GenerateTestCallGraph(seed int64, config *TestCallGraphGeneratorConfig) (*TestCallGraph, error) {
+	return GenerateTestCallGraphFile(seed, config).BuildTestCallGraph()
+}
+
+// addCalls adds the calls made by the given call, which has the given gas left for them.
+func (generator *callGraphGenerator) addCalls(from string, gasAvailable uint64, depth int, canCallAsync bool) {
+	config := generator.config
+	if depth >= config.MaxDepth {
+		return
+	}
+
+	numCalls := generator.random.Intn(config.MaxFanOut + 1)
+	numAsyncCalls := 0
+	for i := 0; i < numCalls; i++ {
+		edge := &TestCallGraphFileEdge{
+			From: from,
+			Type: callGraphFileSyncEdge,
+		}
+
+		callbackGas := uint64(0)
+		if canCallAsync && depth <= config.MaxAsyncCallerDepth && numAsyncCalls < config.MaxAsyncCallsPerNode && generator.chance(config.AsyncCallProbability) {
+			numAsyncCalls++
+			edge.Type = callGraphFileAsyncEdge
+			if generator.chance(config.CrossShardProbability) {
+				edge.Type = callGraphFileAsyncCrossShardEdge
+			}
+			if generator.chance(config.CallbackProbability) {
+				edge.Callback = generator.newCallback()
+				edge.GasLocked = generator.randomUint64(config.MaxExtraGasLocked + 1)
+				callbackGas = DefaultCallGraphLockedGas + edge.GasLocked
+			}
+		}
+
+		if gasAvailable < callbackGas+minGeneratedCallGasLimit {
+			return
+		}
+		maxGasLimit := (gasAvailable - callbackGas) / uint64(numCalls-i)
+		if maxGasLimit < minGeneratedCallGasLimit {
+			maxGasLimit = minGeneratedCallGasLimit
+		}
+		edge.To = generator.newCall()
+		edge.GasLimit = minGeneratedCallGasLimit + generator.randomUint64(maxGasLimit-minGeneratedCallGasLimit+1)
+		edge.GasUsed = generator.randomGasUsed(edge.GasLimit)
+		if edge.Type == callGraphFileSyncEdge {
+			edge.Fail = generator.chance(config.SyncFailProbability)
+		} else {
+			edge.Fail = generator.chance(config.AsyncFailProbability)
+		}
+		gasAvailable -= edge.GasLimit + callbackGas
+
+		generator.graphFile.Edges = append(generator.graphFile.Edges, edge)
+		isSync := edge.Type == callGraphFileSyncEdge
+		generator.addCalls(edge.To, edge.GasLimit-edge.GasUsed, depth+1, canCallAsync && isSync)
+
+		if len(edge.Callback) > 0 {
+			// the callback is guaranteed to receive at least the gas locked for it
+			edge.GasUsedByCallback = generator.randomGasUsed(callbackGas)
+			edge.CallbackFail = generator.chance(config.CallbackFailProbability)
+			callback := callGraphFileContractID(from) + "." + edge.Callback
+			generator.addCalls(callback, callbackGas-edge.GasUsedByCallback, depth+1, false)
+		}
+	}
+}
+
+func (generator *callGraphGenerator) newCall() string {
+	generator.numContracts++
+	suffix := strconv.Itoa(generator.numContracts)
+	return "sc" + suffix + ".f" + suffix
+}
+
+func (generator *callGraphGenerator) newCallback() string {
+	generator.numCallbacks++
+	return "cb" + strconv.Itoa(generator.numCallbacks)
+}
+
+// randomGasUsed returns a gas used between 1 and a quarter of the gas limit, leaving gas for the calls made
+func (generator *callGraphGenerator) randomGasUsed(gasLimit uint64) uint64 {
+	return 1 + generator.randomUint64(gasLimit/4)
+}
+
+func (generator *callGraphGenerator) randomUint64(n uint64) uint64 {
+	if n == 0 {
+		return 0
+	}
+	return uint64(generator.random.Int63n(int64(n)))
+}
+
+func (generator *callGraphGenerator) chance(probability float64) bool {
+	return generator.random.Float64() < probability
+}
+
+// ShrinkTestCallGraphFile simplifies a call graph file for as long as the simplified graph still fails, and
+// returns the smallest failing graph found. A graph is simplified by removing a call together with the calls
+// it makes, by removing a callback, by making a cross-shard async call local, or by removing a failure.
+func ShrinkTestCallGraphFile(graphFile *TestCallGraphFile, stillFails func(*TestCallGraphFile) bool) *TestCallGraphFile {
+	current := graphFile
+	for {
+		shrunk := false
+		for _, candidate := range shrinkCandidates(current) {
+			if stillFails(candidate) {
+				current = candidate
+				shrunk = true
+				break
+			}
+		}
+		if !shrunk {
+			return current
+		}
+	}
+}
+
+func shrinkCandidates(graphFile *TestCallGraphFile) []*TestCallGraphFile {
+	candidates := make([]*TestCallGraphFile, 0)
+	for i := range graphFile.Edges {
+		candidate := copyTestCallGraphFile(graphFile)
+		candidate.Edges = append(candidate.Edges[:i], candidate.Edges[i+1:]...)
+		candidates = append(candidates, candidate)
+	}
+
+	for i, edge := range graphFile.Edges {
+		if len(edge.Callback) > 0 {
+			candidate := copyTestCallGraphFile(graphFile)
+			candidateEdge := candidate.Edges[i]
+			candidateEdge.Callback = ""
+			candidateEdge.GasUsedByCallback = 0
+			candidateEdge.GasLocked = 0
+			candidateEdge.CallbackFail = false
+			candidates = append(candidates, candidate)
+		}
+		if edge.Type == callGraphFileAsyncCrossShardEdge {
+			candidate := copyTestCallGraphFile(graphFile)
+			candidate.Edges[i].Type = callGraphFileAsyncEdge
+			candidates = append(candidates, candidate)
+		}
+		if edge.Fail {
+			candidate := copyTestCallGraphFile(graphFile)
+			candidate.Edges[i].Fail = false
+			candidates = append(candidates, candidate)
+		}
+		if edge.CallbackFail {
+			candidate := copyTestCallGraphFile(graphFile)
+			candidate.Edges[i].CallbackFail = false
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	for _, candidate := range candidates {
+		removeUnreachableCallGraphFileEdges(candidate)
+	}
+	return candidates
+}
+
+// removeUnreachableCallGraphFileEdges removes the edges of the calls which are no longer made,
+// relying on the edges of a call being listed after the edge that makes it
+func removeUnreachableCallGraphFileEdges(graphFile *TestCallGraphFile) {
+	reachable := map[string]bool{graphFile.Start.Call: true}
+	edges := make([]*TestCallGraphFileEdge, 0, len(graphFile.Edges))
+	for _, edge := range graphFile.Edges {
+		if !reachable[edge.From] {
+			continue
+		}
+		reachable[edge.To] = true
+		if len(edge.Callback) > 0 {
+			reachable[callGraphFileContractID(edge.From)+"."+edge.Callback] = true
+		}
+		edges = append(edges, edge)
+	}
+	graphFile.Edges = edges
+}
+
+func copyTestCallGraphFile(graphFile *TestCallGraphFile) *TestCallGraphFile {
+	graphFileCopy := *graphFile
+	graphFileCopy.Expected = nil
+	graphFileCopy.Edges = make([]*TestCallGraphFileEdge, len(graphFile.Edges))
+	for i, edge := range graphFile.Edges {
+		edgeCopy := *edge
+		graphFileCopy.Edges[i] = &edgeCopy
+	}
+	return &graphFileCopy
+}
+
+func callGraphFileContractID(call string) string {
+	contractID, _, _ := ParseTestCallGraphFileCall(call)
+	return contractID
+}
@@ -111,6 +111,20 @@ func (thb *TestHostBuilder) WithGasSchedule(gasSchedule config.GasScheduleMap) *
 	return thb
 }
 
+// WithTransactionMemoryBudget allows tests to bound the memory held by a transaction, by setting it in the gas
+// schedule. The default is config.DefaultTransactionMemoryBudget.
+func (thb *TestHostBuilder) WithTransactionMemoryBudget(transactionMemoryBudget uint64) *TestHostBuilder {
+	thb.initializeGasCosts()
+	thb.vmHostParameters.GasSchedule["MaxPerTransaction"]["MemoryBudget"] = transactionMemoryBudget
+	return thb
+}
+
+// WithAsyncCallGraph allows tests to record the async call graph of the executions. The default is no recording.
+func (thb *TestHostBuilder) WithAsyncCallGraph(recorder vmhost.AsyncCallGraphRecorder) *TestHostBuilder {
+	thb.vmHostParameters.AsyncCallGraph = recorder
+	return thb
+}
+
 // Build initializes the VM host with all configured options.
 func (thb *TestHostBuilder) Build() vmhost.VMHost {
 	thb.initializeHost()
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/interpreter"
 	"github.com/multiversx/mx-chain-vm-go/wasmer"
 	"github.com/multiversx/mx-chain-vm-go/wasmer2"
 )
@@ -20,6 +21,9 @@ var ExecWasmer1 = "wasmer1"
 // ExecWasmer2 is the value of the EnvVMEXECUTOR variable which selects Wasmer 2
 var ExecWasmer2 = "wasmer2"
 
+// ExecInterpreter is the value of the EnvVMEXECUTOR variable which selects the pure Go interpreter
+var ExecInterpreter = "interpreter"
+
 var defaultExecutorString = ExecWasmer2
 
 // NewDefaultTestExecutorFactory instantiates an executor factory based on the $VMEXECUTOR environment variable
@@ -32,6 +36,9 @@ func NewDefaultTestExecutorFactory(tb testing.TB) executor.ExecutorAbstractFacto
 	if execStr == ExecWasmer2 {
 		return wasmer2.ExecutorFactory()
 	}
+	if execStr == ExecInterpreter {
+		return interpreter.ExecutorFactory()
+	}
 
 	if tb == (testing.TB)(nil) {
 		panic(fmt.Sprintf("executor %s not recognized", execStr))
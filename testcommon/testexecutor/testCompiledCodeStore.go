@@ -0,0 +1,32 @@
+package testexecutor
+
+import (
+	"os"
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/codestore"
+)
+
+// EnvVMCOMPILEDCODEDIR is the name of the environment variable that sets the directory
+// where the tests keep compiled contracts between runs
+var EnvVMCOMPILEDCODEDIR = "VMCOMPILEDCODEDIR"
+
+// maxTestCompiledCodeDirSize limits the size of the $VMCOMPILEDCODEDIR directory
+const maxTestCompiledCodeDirSize = 1 << 30
+
+// NewDefaultTestCompiledCodeStore creates a compiled code store in the $VMCOMPILEDCODEDIR directory.
+// It returns nil if the environment variable is not set, in which case the tests compile all contracts.
+func NewDefaultTestCompiledCodeStore(tb testing.TB) vmhost.CompiledCodeStore {
+	directory := os.Getenv(EnvVMCOMPILEDCODEDIR)
+	if len(directory) == 0 {
+		return nil
+	}
+
+	store, err := codestore.NewFileCompiledCodeStore(directory, maxTestCompiledCodeDirSize)
+	if err != nil {
+		tb.Fatalf("cannot create compiled code store in %s: %v", directory, err)
+		return nil
+	}
+	return store
+}
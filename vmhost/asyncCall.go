@@ -21,6 +21,9 @@ type AsyncCall struct {
 	ErrorCallback   string
 
 	CallbackClosure []byte
+
+	// DeadlineRound is the last round in which the result of the async call is accepted; 0 means no deadline
+	DeadlineRound uint64
 }
 
 // Clone creates a deep clone of the AsyncCall
@@ -36,6 +39,7 @@ func (ac *AsyncCall) Clone() *AsyncCall {
 		ValueBytes:      make([]byte, len(ac.ValueBytes)),
 		SuccessCallback: ac.SuccessCallback,
 		ErrorCallback:   ac.ErrorCallback,
+		DeadlineRound:   ac.DeadlineRound,
 	}
 
 	copy(clone.Destination, ac.Destination)
@@ -111,6 +115,16 @@ func (ac *AsyncCall) UpdateStatus(returnCode vmcommon.ReturnCode) {
 	}
 }
 
+// HasDeadline returns true if the result of the async call is only accepted until a certain round
+func (ac *AsyncCall) HasDeadline() bool {
+	return ac.DeadlineRound > 0
+}
+
+// IsExpired returns true if the result of the async call arrives after its deadline round
+func (ac *AsyncCall) IsExpired(currentRound uint64) bool {
+	return ac.HasDeadline() && currentRound > ac.DeadlineRound
+}
+
 // Reject sets the rejected status for this async call
 func (ac *AsyncCall) Reject() {
 	ac.Status = AsyncCallRejected
@@ -144,6 +158,7 @@ func (ac *AsyncCall) toSerializable() *SerializableAsyncCall {
 		SuccessCallback: ac.SuccessCallback,
 		ErrorCallback:   ac.ErrorCallback,
 		CallbackClosure: ac.CallbackClosure,
+		DeadlineRound:   ac.DeadlineRound,
 	}
 }
 
@@ -168,5 +183,6 @@ func (serAsyncCall *SerializableAsyncCall) fromSerializable() *AsyncCall {
 		SuccessCallback: serAsyncCall.SuccessCallback,
 		ErrorCallback:   serAsyncCall.ErrorCallback,
 		CallbackClosure: serAsyncCall.CallbackClosure,
+		DeadlineRound:   serAsyncCall.DeadlineRound,
 	}
 }
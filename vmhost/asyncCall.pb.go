@@ -93,6 +93,7 @@ type SerializableAsyncCall struct {
 	SuccessCallback string                             `protobuf:"bytes,10,opt,name=SuccessCallback,proto3" json:"SuccessCallback,omitempty"`
 	ErrorCallback   string                             `protobuf:"bytes,11,opt,name=ErrorCallback,proto3" json:"ErrorCallback,omitempty"`
 	CallbackClosure []byte                             `protobuf:"bytes,12,opt,name=CallbackClosure,proto3" json:"CallbackClosure,omitempty"`
+	DeadlineRound   uint64                             `protobuf:"varint,13,opt,name=DeadlineRound,proto3" json:"DeadlineRound,omitempty"`
 }
 
 func (m *SerializableAsyncCall) Reset()      { *m = SerializableAsyncCall{} }
@@ -200,6 +201,13 @@ func (m *SerializableAsyncCall) GetCallbackClosure() []byte {
 	return nil
 }
 
+func (m *SerializableAsyncCall) GetDeadlineRound() uint64 {
+	if m != nil {
+		return m.DeadlineRound
+	}
+	return 0
+}
+
 type SerializableAsyncCallGroup struct {
 	Callback     string                   `protobuf:"bytes,1,opt,name=Callback,proto3" json:"Callback,omitempty"`
 	GasLocked    uint64                   `protobuf:"varint,2,opt,name=GasLocked,proto3" json:"GasLocked,omitempty"`
@@ -281,44 +289,45 @@ func init() {
 func init() { proto.RegisterFile("asyncCall.proto", fileDescriptor_a0e9b586d6e1f667) }
 
 var fileDescriptor_a0e9b586d6e1f667 = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
+	// 598 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xc1, 0x4f, 0x13, 0x4f,
 	0x14, 0xde, 0x69, 0x4b, 0x7f, 0xf0, 0x80, 0x1f, 0xcd, 0x24, 0x9a, 0x11, 0x61, 0xb2, 0x56, 0x43,
-	0x9a, 0x26, 0x96, 0x04, 0x8f, 0xc6, 0x44, 0x4b, 0x91, 0x90, 0x68, 0x24, 0x5d, 0xf4, 0xe0, 0x6d,
+	0x9a, 0x26, 0x96, 0x04, 0x8f, 0xc6, 0x44, 0x4b, 0x91, 0x90, 0x68, 0x24, 0x5b, 0xf4, 0xe0, 0x6d,
 	0xba, 0x3b, 0x94, 0xb1, 0xcb, 0x0c, 0x99, 0x99, 0x45, 0xf1, 0xe4, 0xc5, 0xbb, 0x7f, 0x86, 0x7f,
-	0x0a, 0x47, 0x8e, 0x78, 0xb3, 0xcb, 0xc5, 0x23, 0x7f, 0x82, 0xd9, 0x29, 0xac, 0x5d, 0x68, 0xca,
-	0x69, 0xdf, 0xfb, 0xde, 0xf7, 0xbe, 0xf7, 0xcd, 0xdb, 0xc9, 0xc0, 0x12, 0x33, 0x27, 0x32, 0xdc,
-	0x64, 0x71, 0xdc, 0x3a, 0xd2, 0xca, 0x2a, 0x5c, 0x3d, 0x3e, 0x3c, 0x50, 0xc6, 0x2e, 0x3f, 0xed,
-	0x0b, 0x7b, 0x90, 0xf4, 0x5a, 0xa1, 0x3a, 0x5c, 0xef, 0xab, 0xbe, 0x5a, 0x77, 0xe5, 0x5e, 0xb2,
-	0xef, 0x32, 0x97, 0xb8, 0x68, 0xd4, 0x56, 0x3f, 0x2d, 0xc3, 0xbd, 0x80, 0x6b, 0xc1, 0x62, 0xf1,
-	0x95, 0xf5, 0x62, 0xfe, 0xea, 0x5a, 0x16, 0xdf, 0x87, 0x6a, 0xf6, 0xdd, 0xe9, 0x10, 0xe4, 0xa3,
-	0xc6, 0x42, 0xf7, 0x2a, 0xc3, 0xcf, 0xa1, 0x1a, 0x58, 0x66, 0x13, 0x43, 0x4a, 0x3e, 0x6a, 0xfc,
-	0xbf, 0xf1, 0xb8, 0x35, 0x9a, 0xdc, 0x9a, 0x28, 0x33, 0xa2, 0x76, 0xaf, 0x5a, 0xf0, 0x2e, 0x2c,
-	0x6e, 0x7d, 0xe1, 0x61, 0x62, 0x85, 0x92, 0x6f, 0x55, 0xc4, 0x49, 0xd9, 0x69, 0x34, 0xa7, 0x6a,
-	0x14, 0x3a, 0xba, 0x45, 0x01, 0xec, 0xc3, 0x7c, 0x87, 0x1b, 0x2b, 0x24, 0xcb, 0x20, 0x32, 0xe3,
-	0xbc, 0x8e, 0x43, 0x18, 0x43, 0xa5, 0xc3, 0x2c, 0x23, 0x55, 0x57, 0x72, 0x31, 0x5e, 0x86, 0xd9,
-	0x6d, 0x66, 0xde, 0x88, 0x43, 0x61, 0xc9, 0x7f, 0x3e, 0x6a, 0x54, 0xba, 0x79, 0x8e, 0x57, 0x60,
-	0x2e, 0x8b, 0x55, 0x38, 0xe0, 0x11, 0x99, 0x75, 0xc5, 0x7f, 0x00, 0xa6, 0x00, 0x1f, 0x58, 0x9c,
-	0xf0, 0xf6, 0x89, 0xe5, 0x86, 0xcc, 0x39, 0xcd, 0x31, 0x04, 0x37, 0x60, 0x29, 0x48, 0xc2, 0x90,
-	0x1b, 0x93, 0x59, 0xef, 0xb1, 0x70, 0x40, 0xc0, 0x47, 0x8d, 0xb9, 0xee, 0x4d, 0x18, 0x3f, 0x81,
-	0xc5, 0x2d, 0xad, 0x95, 0xce, 0x79, 0xf3, 0x8e, 0x57, 0x04, 0x33, 0xbd, 0xeb, 0x78, 0x33, 0x56,
-	0x26, 0xd1, 0x9c, 0x2c, 0xb8, 0xa1, 0x37, 0xe1, 0xfa, 0x2f, 0x04, 0xcb, 0x13, 0xf7, 0xb7, 0xad,
-	0x55, 0x72, 0x94, 0x1d, 0x39, 0x9f, 0x84, 0xdc, 0xa4, 0x3c, 0x2f, 0x1e, 0xb9, 0x74, 0xf3, 0xc8,
-	0x75, 0x58, 0xb8, 0x66, 0xba, 0x45, 0x96, 0xdd, 0xfc, 0x02, 0x96, 0xad, 0x65, 0x27, 0xe2, 0xd2,
-	0x8a, 0x7d, 0xc1, 0x35, 0xa9, 0x38, 0xfd, 0x31, 0x04, 0xbf, 0x00, 0xc8, 0xfd, 0x18, 0x32, 0xe3,
-	0x97, 0x1b, 0xf3, 0x1b, 0xab, 0x53, 0xff, 0x7a, 0x77, 0xac, 0xa1, 0xf9, 0x1d, 0xc1, 0xc3, 0x29,
-	0xf7, 0x0b, 0xfb, 0xb0, 0x32, 0xb1, 0xbc, 0xcb, 0x65, 0x24, 0x64, 0xbf, 0xe6, 0xe1, 0x47, 0xb0,
-	0x3a, 0x79, 0x0c, 0x37, 0x2a, 0x3e, 0xe6, 0x51, 0x0d, 0x4d, 0xa1, 0x7c, 0xe2, 0xa1, 0xe5, 0x51,
-	0xad, 0xd4, 0x1c, 0x22, 0xa8, 0xdf, 0x7d, 0x47, 0xf1, 0x2a, 0x3c, 0x18, 0x67, 0x05, 0x27, 0x32,
-	0xcc, 0x09, 0x35, 0x0f, 0x37, 0x61, 0xed, 0x96, 0x48, 0x3b, 0x11, 0xb1, 0x15, 0xf2, 0x75, 0x22,
-	0xc3, 0x1d, 0x69, 0x35, 0x0b, 0x0e, 0x98, 0xce, 0x4c, 0xdd, 0xc1, 0xdd, 0xd4, 0xca, 0x98, 0x11,
-	0xb7, 0x84, 0xd7, 0x8a, 0xe6, 0xb6, 0x82, 0xce, 0xde, 0x9e, 0x66, 0xd2, 0xec, 0x73, 0xfd, 0x4e,
-	0x66, 0x2e, 0xdb, 0x2c, 0x1c, 0xd4, 0xca, 0x78, 0x05, 0xc8, 0x2d, 0xcd, 0xf7, 0x72, 0x20, 0xd5,
-	0x67, 0x59, 0xab, 0xb4, 0x5f, 0x9e, 0x0d, 0xa9, 0x77, 0x3e, 0xa4, 0xde, 0xe5, 0x90, 0xa2, 0x6f,
-	0x29, 0x45, 0x3f, 0x53, 0x8a, 0x4e, 0x53, 0x8a, 0xce, 0x52, 0x8a, 0xce, 0x53, 0x8a, 0x7e, 0xa7,
-	0x14, 0xfd, 0x49, 0xa9, 0x77, 0x99, 0x52, 0xf4, 0xe3, 0x82, 0x7a, 0x67, 0x17, 0xd4, 0x3b, 0xbf,
-	0xa0, 0xde, 0xc7, 0xab, 0x37, 0xa8, 0x57, 0x75, 0x6f, 0xcb, 0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xe8, 0xcb, 0x37, 0x37, 0xa5, 0x04, 0x00, 0x00,
+	0x8a, 0x47, 0x8e, 0x78, 0xb3, 0xcb, 0xc5, 0x93, 0xe1, 0x4f, 0x30, 0x3b, 0x85, 0xb5, 0x0b, 0x4d,
+	0x39, 0xed, 0x7b, 0xdf, 0xfb, 0xde, 0x37, 0x5f, 0xbe, 0xbc, 0x2c, 0x2c, 0x31, 0x73, 0x22, 0xc3,
+	0x4d, 0x16, 0xc7, 0xad, 0x23, 0xad, 0xac, 0xc2, 0xd5, 0xe3, 0xc3, 0x03, 0x65, 0xec, 0xf2, 0xe3,
+	0xbe, 0xb0, 0x07, 0x49, 0xaf, 0x15, 0xaa, 0xc3, 0xf5, 0xbe, 0xea, 0xab, 0x75, 0x37, 0xee, 0x25,
+	0xfb, 0xae, 0x73, 0x8d, 0xab, 0x46, 0x6b, 0xf5, 0x3f, 0x65, 0xb8, 0xd3, 0xe5, 0x5a, 0xb0, 0x58,
+	0x7c, 0x66, 0xbd, 0x98, 0xbf, 0xb8, 0x92, 0xc5, 0x77, 0xa1, 0x9a, 0x7d, 0x77, 0x3a, 0x04, 0xf9,
+	0xa8, 0xb1, 0x10, 0x5c, 0x76, 0xf8, 0x29, 0x54, 0xbb, 0x96, 0xd9, 0xc4, 0x90, 0x92, 0x8f, 0x1a,
+	0xff, 0x6f, 0x3c, 0x6c, 0x8d, 0x5e, 0x6e, 0x4d, 0x94, 0x19, 0x51, 0x83, 0xcb, 0x15, 0xbc, 0x0b,
+	0x8b, 0x5b, 0x9f, 0x78, 0x98, 0x58, 0xa1, 0xe4, 0x6b, 0x15, 0x71, 0x52, 0x76, 0x1a, 0xcd, 0xa9,
+	0x1a, 0x85, 0x8d, 0xa0, 0x28, 0x80, 0x7d, 0x98, 0xef, 0x70, 0x63, 0x85, 0x64, 0x19, 0x44, 0x66,
+	0x9c, 0xd7, 0x71, 0x08, 0x63, 0xa8, 0x74, 0x98, 0x65, 0xa4, 0xea, 0x46, 0xae, 0xc6, 0xcb, 0x30,
+	0xbb, 0xcd, 0xcc, 0x2b, 0x71, 0x28, 0x2c, 0xf9, 0xcf, 0x47, 0x8d, 0x4a, 0x90, 0xf7, 0x78, 0x05,
+	0xe6, 0xb2, 0x5a, 0x85, 0x03, 0x1e, 0x91, 0x59, 0x37, 0xfc, 0x07, 0x60, 0x0a, 0xf0, 0x8e, 0xc5,
+	0x09, 0x6f, 0x9f, 0x58, 0x6e, 0xc8, 0x9c, 0xd3, 0x1c, 0x43, 0x70, 0x03, 0x96, 0xba, 0x49, 0x18,
+	0x72, 0x63, 0x32, 0xeb, 0x3d, 0x16, 0x0e, 0x08, 0xf8, 0xa8, 0x31, 0x17, 0x5c, 0x87, 0xf1, 0x23,
+	0x58, 0xdc, 0xd2, 0x5a, 0xe9, 0x9c, 0x37, 0xef, 0x78, 0x45, 0x30, 0xd3, 0xbb, 0xaa, 0x37, 0x63,
+	0x65, 0x12, 0xcd, 0xc9, 0x82, 0x7b, 0xf4, 0x3a, 0x9c, 0xe9, 0x75, 0x38, 0x8b, 0x62, 0x21, 0x79,
+	0xa0, 0x12, 0x19, 0x91, 0x45, 0xe7, 0xbd, 0x08, 0xd6, 0x7f, 0x22, 0x58, 0x9e, 0x98, 0xf2, 0xb6,
+	0x56, 0xc9, 0x51, 0x16, 0x4c, 0xee, 0x07, 0x39, 0x3f, 0x79, 0x5f, 0x0c, 0xa6, 0x74, 0x3d, 0x98,
+	0x3a, 0x2c, 0x5c, 0x31, 0x5d, 0xdc, 0x65, 0xe7, 0xb2, 0x80, 0x65, 0xe1, 0xed, 0x44, 0x5c, 0x5a,
+	0xb1, 0x2f, 0xb8, 0x26, 0x15, 0xa7, 0x3f, 0x86, 0xe0, 0x67, 0x00, 0xb9, 0x1f, 0x43, 0x66, 0xfc,
+	0x72, 0x63, 0x7e, 0x63, 0x75, 0xea, 0x6d, 0x04, 0x63, 0x0b, 0xcd, 0xaf, 0x08, 0xee, 0x4f, 0xb9,
+	0x42, 0xec, 0xc3, 0xca, 0xc4, 0xf1, 0x2e, 0x97, 0x91, 0x90, 0xfd, 0x9a, 0x87, 0x1f, 0xc0, 0xea,
+	0xe4, 0x67, 0xb8, 0x51, 0xf1, 0x31, 0x8f, 0x6a, 0x68, 0x0a, 0xe5, 0x03, 0x0f, 0x2d, 0x8f, 0x6a,
+	0xa5, 0xe6, 0x10, 0x41, 0xfd, 0xf6, 0x4b, 0xc6, 0xab, 0x70, 0x6f, 0x9c, 0xd5, 0x3d, 0x91, 0x61,
+	0x4e, 0xa8, 0x79, 0xb8, 0x09, 0x6b, 0x37, 0x44, 0xda, 0x89, 0x88, 0xad, 0x90, 0x2f, 0x13, 0x19,
+	0xee, 0x48, 0xab, 0x59, 0xf7, 0x80, 0xe9, 0xcc, 0xd4, 0x2d, 0xdc, 0x4d, 0xad, 0x8c, 0x19, 0x71,
+	0x4b, 0x78, 0xad, 0x68, 0x6e, 0xab, 0xdb, 0xd9, 0xdb, 0xd3, 0x4c, 0x9a, 0x7d, 0xae, 0xdf, 0xc8,
+	0xcc, 0x65, 0x9b, 0x85, 0x83, 0x5a, 0x19, 0xaf, 0x00, 0xb9, 0xa1, 0xf9, 0x56, 0x0e, 0xa4, 0xfa,
+	0x28, 0x6b, 0x95, 0xf6, 0xf3, 0xd3, 0x21, 0xf5, 0xce, 0x86, 0xd4, 0xbb, 0x18, 0x52, 0xf4, 0x25,
+	0xa5, 0xe8, 0x7b, 0x4a, 0xd1, 0x8f, 0x94, 0xa2, 0xd3, 0x94, 0xa2, 0xb3, 0x94, 0xa2, 0x5f, 0x29,
+	0x45, 0xbf, 0x53, 0xea, 0x5d, 0xa4, 0x14, 0x7d, 0x3b, 0xa7, 0xde, 0xe9, 0x39, 0xf5, 0xce, 0xce,
+	0xa9, 0xf7, 0xfe, 0xf2, 0x4f, 0xd5, 0xab, 0xba, 0x3f, 0xd0, 0x93, 0xbf, 0x03, 0x00, 0x4b, 0xe5,
+	0x14, 0xb9, 0xcb, 0x04, 0x00, 0x00,
 }
 
 func (x SerializableAsyncCallStatus) String() string {
@@ -387,6 +396,9 @@ func (this *SerializableAsyncCall) Equal(that interface{}) bool {
 	if !bytes.Equal(this.CallbackClosure, that1.CallbackClosure) {
 		return false
 	}
+	if this.DeadlineRound != that1.DeadlineRound {
+		return false
+	}
 	return true
 }
 func (this *SerializableAsyncCallGroup) Equal(that interface{}) bool {
@@ -434,7 +446,7 @@ func (this *SerializableAsyncCall) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 16)
 	s = append(s, "&vmhost.SerializableAsyncCall{")
 	s = append(s, "CallID: "+fmt.Sprintf("%#v", this.CallID)+",\n")
 	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
@@ -447,6 +459,7 @@ func (this *SerializableAsyncCall) GoString() string {
 	s = append(s, "SuccessCallback: "+fmt.Sprintf("%#v", this.SuccessCallback)+",\n")
 	s = append(s, "ErrorCallback: "+fmt.Sprintf("%#v", this.ErrorCallback)+",\n")
 	s = append(s, "CallbackClosure: "+fmt.Sprintf("%#v", this.CallbackClosure)+",\n")
+	s = append(s, "DeadlineRound: "+fmt.Sprintf("%#v", this.DeadlineRound)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -494,6 +507,11 @@ func (m *SerializableAsyncCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeadlineRound != 0 {
+		i = encodeVarintAsyncCall(dAtA, i, uint64(m.DeadlineRound))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.CallbackClosure) > 0 {
 		i -= len(m.CallbackClosure)
 		copy(dAtA[i:], m.CallbackClosure)
@@ -686,6 +704,9 @@ func (m *SerializableAsyncCall) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAsyncCall(uint64(l))
 	}
+	if m.DeadlineRound != 0 {
+		n += 1 + sovAsyncCall(uint64(m.DeadlineRound))
+	}
 	return n
 }
 
@@ -741,6 +762,7 @@ func (this *SerializableAsyncCall) String() string {
 		`SuccessCallback:` + fmt.Sprintf("%v", this.SuccessCallback) + `,`,
 		`ErrorCallback:` + fmt.Sprintf("%v", this.ErrorCallback) + `,`,
 		`CallbackClosure:` + fmt.Sprintf("%v", this.CallbackClosure) + `,`,
+		`DeadlineRound:` + fmt.Sprintf("%v", this.DeadlineRound) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1111,6 +1133,25 @@ func (m *SerializableAsyncCall) Unmarshal(dAtA []byte) error {
 				m.CallbackClosure = []byte{}
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineRound", wireType)
+			}
+			m.DeadlineRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsyncCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAsyncCall(dAtA[iNdEx:])
@@ -0,0 +1,277 @@
+// Package asyncgraph records the actual async call tree of transactions, as built by the async context:
+// the contexts in which contracts executed, the async call groups and calls they registered, and the
+// execution and callback of each async call.
+package asyncgraph
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var _ vmhost.AsyncCallGraphRecorder = (*Recorder)(nil)
+
+// executionModeNames names the execution modes of async calls.
+var executionModeNames = map[vmhost.AsyncCallExecutionMode]string{
+	vmhost.SyncExecution:              "SyncExecution",
+	vmhost.AsyncBuiltinFuncIntraShard: "AsyncBuiltinFuncIntraShard",
+	vmhost.AsyncBuiltinFuncCrossShard: "AsyncBuiltinFuncCrossShard",
+	vmhost.ESDTTransferOnCallBack:     "ESDTTransferOnCallBack",
+	vmhost.AsyncUnknown:               "AsyncUnknown",
+}
+
+// Graph is the async call tree recorded for one or more transactions. Each root is a contract execution that
+// was not started by another recorded one, e.g. a transaction or an incoming cross-shard async call or callback.
+type Graph struct {
+	Roots []*ContextNode `json:"roots"`
+}
+
+// ContextNode is the execution of a contract function, in its own async context.
+// Addresses and call IDs are hex-encoded.
+type ContextNode struct {
+	Address        string         `json:"address"`
+	Function       string         `json:"function"`
+	CallType       string         `json:"callType"`
+	CallID         string         `json:"callId"`
+	CallerCallID   string         `json:"callerCallId,omitempty"`
+	GasProvided    uint64         `json:"gasProvided"`
+	GasLocked      uint64         `json:"gasLocked,omitempty"`
+	GasAccumulated uint64         `json:"gasAccumulated,omitempty"`
+	Groups         []*CallGroup   `json:"groups,omitempty"`
+	SyncCalls      []*ContextNode `json:"syncCalls,omitempty"`
+}
+
+// CallGroup is an async call group, with the async calls registered in it, in order.
+type CallGroup struct {
+	ID    string      `json:"id"`
+	Calls []*CallNode `json:"calls"`
+}
+
+// CallNode is an async call, together with its execution and its callback, if they were recorded.
+// The call ID is only known once the call is started. The execution of calls sent to other shards
+// is not recorded, nor is their callback, unless it executes in the same host later.
+type CallNode struct {
+	CallID          string `json:"callId,omitempty"`
+	Destination     string `json:"destination"`
+	Function        string `json:"function"`
+	ExecutionMode   string `json:"executionMode"`
+	GasLimit        uint64 `json:"gasLimit"`
+	GasLocked       uint64 `json:"gasLocked"`
+	SuccessCallback string `json:"successCallback,omitempty"`
+	ErrorCallback   string `json:"errorCallback,omitempty"`
+
+	ReturnCode   string       `json:"returnCode,omitempty"`
+	GasRemaining uint64       `json:"gasRemaining,omitempty"`
+	Execution    *ContextNode `json:"execution,omitempty"`
+
+	CallbackReturnCode     string       `json:"callbackReturnCode,omitempty"`
+	CallbackGasRemaining   uint64       `json:"callbackGasRemaining,omitempty"`
+	CallbackGasAccumulated uint64       `json:"callbackGasAccumulated,omitempty"`
+	Callback               *ContextNode `json:"callback,omitempty"`
+
+	destination []byte
+	data        []byte
+}
+
+// Recorder builds the async call graph from the notifications of the async context.
+// It records until reset, so it is usually reset after each transaction.
+type Recorder struct {
+	mutRecorder sync.Mutex
+	roots       []*ContextNode
+	contexts    map[string]*ContextNode
+	calls       map[string]*CallNode
+}
+
+// NewRecorder creates a new, empty Recorder.
+func NewRecorder() *Recorder {
+	recorder := &Recorder{}
+	recorder.Reset()
+	return recorder
+}
+
+// Reset discards everything recorded so far.
+func (recorder *Recorder) Reset() {
+	recorder.mutRecorder.Lock()
+	defer recorder.mutRecorder.Unlock()
+
+	recorder.roots = make([]*ContextNode, 0)
+	recorder.contexts = make(map[string]*ContextNode)
+	recorder.calls = make(map[string]*CallNode)
+}
+
+// Graph returns the graph recorded since the last reset. The graph must not be used while recording continues.
+func (recorder *Recorder) Graph() *Graph {
+	recorder.mutRecorder.Lock()
+	defer recorder.mutRecorder.Unlock()
+
+	return &Graph{
+		Roots: append([]*ContextNode{}, recorder.roots...),
+	}
+}
+
+// BeginContext records the start of a contract execution, attaching it to the async call it executes,
+// to the async call whose callback it is, or to the context which called it synchronously.
+func (recorder *Recorder) BeginContext(address []byte, callID []byte, callerCallID []byte, input *vmcommon.ContractCallInput) {
+	recorder.mutRecorder.Lock()
+	defer recorder.mutRecorder.Unlock()
+
+	node := &ContextNode{
+		Address:      hex.EncodeToString(address),
+		Function:     input.Function,
+		CallType:     input.CallType.ToString(),
+		CallID:       hex.EncodeToString(callID),
+		CallerCallID: hex.EncodeToString(callerCallID),
+		GasProvided:  input.GasProvided,
+		GasLocked:    input.GasLocked,
+	}
+	if input.AsyncArguments != nil {
+		node.GasAccumulated = input.AsyncArguments.GasAccumulated
+	}
+	recorder.contexts[string(callID)] = node
+
+	if input.CallType == vm.AsynchronousCallBack {
+		call, found := recorder.calls[string(callerCallID)]
+		if found {
+			call.Callback = node
+			return
+		}
+	}
+	if input.CallType == vm.AsynchronousCall {
+		call, found := recorder.calls[string(callID)]
+		if found {
+			call.Execution = node
+			return
+		}
+	}
+	parent, found := recorder.contexts[string(callerCallID)]
+	if found && len(callerCallID) > 0 && parent != node {
+		parent.SyncCalls = append(parent.SyncCalls, node)
+		return
+	}
+
+	recorder.roots = append(recorder.roots, node)
+}
+
+// RegisterAsyncCall records an async call registered in the given group by the context with the given call ID.
+func (recorder *Recorder) RegisterAsyncCall(callerCallID []byte, groupID string, asyncCall *vmhost.AsyncCall) {
+	recorder.mutRecorder.Lock()
+	defer recorder.mutRecorder.Unlock()
+
+	context, found := recorder.contexts[string(callerCallID)]
+	if !found {
+		return
+	}
+
+	call := &CallNode{
+		Destination:     hex.EncodeToString(asyncCall.Destination),
+		Function:        functionFromData(asyncCall.Data),
+		ExecutionMode:   executionModeName(asyncCall.ExecutionMode),
+		GasLimit:        asyncCall.GasLimit,
+		GasLocked:       asyncCall.GasLocked,
+		SuccessCallback: asyncCall.SuccessCallback,
+		ErrorCallback:   asyncCall.ErrorCallback,
+		destination:     append([]byte{}, asyncCall.Destination...),
+		data:            append([]byte{}, asyncCall.Data...),
+	}
+	if len(asyncCall.CallID) > 0 {
+		recorder.setCallID(call, asyncCall.CallID)
+	}
+
+	group := context.getOrCreateGroup(groupID)
+	group.Calls = append(group.Calls, call)
+}
+
+// StartAsyncCall records the call ID assigned to an async call when it is started by the context with the given call ID.
+func (recorder *Recorder) StartAsyncCall(callerCallID []byte, asyncCall *vmhost.AsyncCall) {
+	recorder.mutRecorder.Lock()
+	defer recorder.mutRecorder.Unlock()
+
+	context, found := recorder.contexts[string(callerCallID)]
+	if !found {
+		return
+	}
+
+	// calls are started in the order in which they were registered,
+	// so the first registered call with the same destination and data is the one
+	for _, group := range context.Groups {
+		for _, call := range group.Calls {
+			isSameCall := len(call.CallID) == 0 &&
+				bytes.Equal(call.destination, asyncCall.Destination) &&
+				bytes.Equal(call.data, asyncCall.Data)
+			if isSameCall {
+				call.GasLimit = asyncCall.GasLimit
+				recorder.setCallID(call, asyncCall.CallID)
+				return
+			}
+		}
+	}
+}
+
+// FinishAsyncCall records the result of an async call executed in the same shard.
+func (recorder *Recorder) FinishAsyncCall(callID []byte, returnCode vmcommon.ReturnCode, gasRemaining uint64) {
+	recorder.mutRecorder.Lock()
+	defer recorder.mutRecorder.Unlock()
+
+	call, found := recorder.calls[string(callID)]
+	if !found {
+		return
+	}
+	call.ReturnCode = returnCode.String()
+	call.GasRemaining = gasRemaining
+}
+
+// FinishCallback records the result of the callback of an async call, with the gas accumulated until then.
+func (recorder *Recorder) FinishCallback(callID []byte, returnCode vmcommon.ReturnCode, gasRemaining uint64, gasAccumulated uint64) {
+	recorder.mutRecorder.Lock()
+	defer recorder.mutRecorder.Unlock()
+
+	call, found := recorder.calls[string(callID)]
+	if !found {
+		return
+	}
+	call.CallbackReturnCode = returnCode.String()
+	call.CallbackGasRemaining = gasRemaining
+	call.CallbackGasAccumulated = gasAccumulated
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (recorder *Recorder) IsInterfaceNil() bool {
+	return recorder == nil
+}
+
+func (recorder *Recorder) setCallID(call *CallNode, callID []byte) {
+	call.CallID = hex.EncodeToString(callID)
+	recorder.calls[string(callID)] = call
+}
+
+func (node *ContextNode) getOrCreateGroup(groupID string) *CallGroup {
+	for _, group := range node.Groups {
+		if group.ID == groupID {
+			return group
+		}
+	}
+
+	group := &CallGroup{
+		ID:    groupID,
+		Calls: make([]*CallNode, 0),
+	}
+	node.Groups = append(node.Groups, group)
+	return group
+}
+
+func functionFromData(data []byte) string {
+	return strings.SplitN(string(data), "@", 2)[0]
+}
+
+func executionModeName(executionMode vmhost.AsyncCallExecutionMode) string {
+	name, found := executionModeNames[executionMode]
+	if !found {
+		return "unknown"
+	}
+	return name
+}
@@ -0,0 +1,161 @@
+package asyncgraph
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+// recordPromises records a transaction calling the parent, which registers a local and a cross-shard
+// async call; the local call executes a sync call and has a callback.
+func recordPromises(recorder *Recorder) {
+	parent := []byte("\x00\x00parentSC")
+	child := []byte("\x00\x00childSC")
+	remote := []byte("\x00\x00remoteSC")
+
+	recorder.BeginContext(parent, []byte{0x01}, nil, &vmcommon.ContractCallInput{
+		VMInput:  vmcommon.VMInput{CallType: vm.DirectCall, GasProvided: 1000},
+		Function: "performAsyncCalls",
+	})
+
+	localCall := &vmhost.AsyncCall{
+		ExecutionMode:   vmhost.SyncExecution,
+		Destination:     child,
+		Data:            []byte("doWork@01"),
+		GasLimit:        300,
+		GasLocked:       150,
+		SuccessCallback: "success",
+		ErrorCallback:   "failure",
+	}
+	remoteCall := &vmhost.AsyncCall{
+		ExecutionMode: vmhost.AsyncUnknown,
+		Destination:   remote,
+		Data:          []byte("doRemoteWork"),
+		GasLimit:      200,
+	}
+	recorder.RegisterAsyncCall([]byte{0x01}, "group", localCall)
+	recorder.RegisterAsyncCall([]byte{0x01}, "group", remoteCall)
+
+	localCall.CallID = []byte{0x02}
+	recorder.StartAsyncCall([]byte{0x01}, localCall)
+	recorder.BeginContext(child, []byte{0x02}, []byte{0x01}, &vmcommon.ContractCallInput{
+		VMInput:  vmcommon.VMInput{CallType: vm.AsynchronousCall, GasProvided: 300, GasLocked: 150},
+		Function: "doWork",
+	})
+	recorder.BeginContext(parent, []byte{0x03}, []byte{0x02}, &vmcommon.ContractCallInput{
+		VMInput:  vmcommon.VMInput{CallType: vm.DirectCall, GasProvided: 100},
+		Function: "getValue",
+	})
+	recorder.FinishAsyncCall([]byte{0x02}, vmcommon.Ok, 50)
+	recorder.BeginContext(parent, []byte{0x04}, []byte{0x02}, &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallType:       vm.AsynchronousCallBack,
+			GasProvided:    200,
+			AsyncArguments: &vmcommon.AsyncArguments{GasAccumulated: 10},
+		},
+		Function: "success",
+	})
+	recorder.FinishCallback([]byte{0x02}, vmcommon.UserError, 20, 10)
+
+	remoteCall.CallID = []byte{0x05}
+	recorder.StartAsyncCall([]byte{0x01}, remoteCall)
+}
+
+func TestRecorder_Graph(t *testing.T) {
+	recorder := NewRecorder()
+	recordPromises(recorder)
+
+	graph := recorder.Graph()
+	require.Len(t, graph.Roots, 1)
+
+	root := graph.Roots[0]
+	require.Equal(t, "performAsyncCalls", root.Function)
+	require.Equal(t, "01", root.CallID)
+	require.Empty(t, root.SyncCalls)
+	require.Len(t, root.Groups, 1)
+	require.Equal(t, "group", root.Groups[0].ID)
+	require.Len(t, root.Groups[0].Calls, 2)
+
+	localCall := root.Groups[0].Calls[0]
+	require.Equal(t, "02", localCall.CallID)
+	require.Equal(t, "doWork", localCall.Function)
+	require.Equal(t, "SyncExecution", localCall.ExecutionMode)
+	require.Equal(t, uint64(150), localCall.GasLocked)
+	require.Equal(t, vmcommon.Ok.String(), localCall.ReturnCode)
+	require.Equal(t, uint64(50), localCall.GasRemaining)
+	require.NotNil(t, localCall.Execution)
+	require.Equal(t, "doWork", localCall.Execution.Function)
+	require.Len(t, localCall.Execution.SyncCalls, 1)
+	require.Equal(t, "getValue", localCall.Execution.SyncCalls[0].Function)
+	require.NotNil(t, localCall.Callback)
+	require.Equal(t, "success", localCall.Callback.Function)
+	require.Equal(t, uint64(10), localCall.Callback.GasAccumulated)
+	require.Equal(t, vmcommon.UserError.String(), localCall.CallbackReturnCode)
+	require.Equal(t, uint64(10), localCall.CallbackGasAccumulated)
+
+	remoteCall := root.Groups[0].Calls[1]
+	require.Equal(t, "05", remoteCall.CallID)
+	require.Equal(t, "AsyncUnknown", remoteCall.ExecutionMode)
+	require.Empty(t, remoteCall.ReturnCode)
+	require.Nil(t, remoteCall.Execution)
+	require.Nil(t, remoteCall.Callback)
+
+	recorder.Reset()
+	require.Empty(t, recorder.Graph().Roots)
+}
+
+func TestRecorder_UnknownCaller(t *testing.T) {
+	recorder := NewRecorder()
+
+	// a callback arriving from another shard starts a new root
+	recorder.BeginContext([]byte("parent"), []byte{0x07}, []byte{0x06}, &vmcommon.ContractCallInput{
+		VMInput:  vmcommon.VMInput{CallType: vm.AsynchronousCallBack},
+		Function: "callBack",
+	})
+	recorder.StartAsyncCall([]byte{0x08}, &vmhost.AsyncCall{CallID: []byte{0x09}})
+	recorder.FinishAsyncCall([]byte{0x09}, vmcommon.Ok, 0)
+
+	graph := recorder.Graph()
+	require.Len(t, graph.Roots, 1)
+	require.Equal(t, "06", graph.Roots[0].CallerCallID)
+	require.Empty(t, graph.Roots[0].Groups)
+}
+
+func TestGraph_Writers(t *testing.T) {
+	recorder := NewRecorder()
+	recordPromises(recorder)
+	graph := recorder.Graph()
+
+	jsonBuffer := &bytes.Buffer{}
+	err := graph.WriteJSON(jsonBuffer)
+	require.Nil(t, err)
+	decoded := &Graph{}
+	err = json.Unmarshal(jsonBuffer.Bytes(), decoded)
+	require.Nil(t, err)
+	require.Equal(t, "success", decoded.Roots[0].Groups[0].Calls[0].Callback.Function)
+
+	dotBuffer := &bytes.Buffer{}
+	err = graph.WriteDOT(dotBuffer)
+	require.Nil(t, err)
+	dot := dotBuffer.String()
+	require.True(t, strings.HasPrefix(dot, "digraph asyncCallGraph {"))
+	require.Equal(t, 4, strings.Count(dot, "shape=box"))
+	require.Equal(t, 2, strings.Count(dot, "shape=ellipse"))
+	require.Contains(t, dot, "doWork -> childSC")
+	require.Contains(t, dot, "[label=\"execution\"]")
+	require.Contains(t, dot, "[label=\"sync\"]")
+	require.Contains(t, dot, "style=dashed, label=\"callback\\nuser error\"")
+}
+
+func TestDisplayAddress(t *testing.T) {
+	require.Equal(t, "adder", displayAddress("0000006164646572"))
+	require.Equal(t, "0102", displayAddress("0102"))
+	require.Equal(t, "00000000", displayAddress("00000000"))
+	require.Equal(t, "zz", displayAddress("zz"))
+}
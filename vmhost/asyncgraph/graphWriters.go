@@ -0,0 +1,148 @@
+package asyncgraph
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// WriteJSON writes the graph as indented JSON.
+func (graph *Graph) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(graph)
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language. Contexts are drawn as boxes and async calls as ellipses;
+// each async call points to its execution and, with a dashed edge, to its callback.
+func (graph *Graph) WriteDOT(writer io.Writer) error {
+	dotWriter := &dotWriter{
+		writer: bufio.NewWriter(writer),
+	}
+
+	dotWriter.line("digraph asyncCallGraph {")
+	dotWriter.line("\tnode [fontname=\"monospace\"];")
+	for _, root := range graph.Roots {
+		dotWriter.writeContext(root)
+	}
+	dotWriter.line("}")
+
+	if dotWriter.err != nil {
+		return dotWriter.err
+	}
+	return dotWriter.writer.Flush()
+}
+
+// dotWriter names the nodes in the order in which they are written and keeps the first write error.
+type dotWriter struct {
+	writer    *bufio.Writer
+	nodeCount int
+	err       error
+}
+
+func (dw *dotWriter) line(format string, args ...interface{}) {
+	if dw.err != nil {
+		return
+	}
+	_, dw.err = fmt.Fprintf(dw.writer, format+"\n", args...)
+}
+
+func (dw *dotWriter) newNodeName(prefix string) string {
+	dw.nodeCount++
+	return fmt.Sprintf("%s%d", prefix, dw.nodeCount)
+}
+
+func (dw *dotWriter) writeContext(context *ContextNode) string {
+	name := dw.newNodeName("context")
+	label := []string{
+		displayAddress(context.Address),
+		fmt.Sprintf("%s (%s)", context.Function, context.CallType),
+		"callID " + shortID(context.CallID),
+		fmt.Sprintf("gas provided %d, locked %d", context.GasProvided, context.GasLocked),
+	}
+	if context.GasAccumulated > 0 {
+		label = append(label, fmt.Sprintf("gas accumulated %d", context.GasAccumulated))
+	}
+	dw.line("\t%s [shape=box, label=%s];", name, dotLabel(label))
+
+	for _, group := range context.Groups {
+		for _, call := range group.Calls {
+			callName := dw.writeCall(call)
+			dw.line("\t%s -> %s [label=%s];", name, callName, dotLabel([]string{"group " + group.ID}))
+		}
+	}
+	for _, syncCall := range context.SyncCalls {
+		syncCallName := dw.writeContext(syncCall)
+		dw.line("\t%s -> %s [label=\"sync\"];", name, syncCallName)
+	}
+	return name
+}
+
+func (dw *dotWriter) writeCall(call *CallNode) string {
+	name := dw.newNodeName("call")
+	label := []string{
+		fmt.Sprintf("%s -> %s", call.Function, displayAddress(call.Destination)),
+		"callID " + shortID(call.CallID),
+		call.ExecutionMode,
+		fmt.Sprintf("gas limit %d, locked %d", call.GasLimit, call.GasLocked),
+	}
+	if len(call.ReturnCode) > 0 {
+		label = append(label, fmt.Sprintf("%s, gas remaining %d", call.ReturnCode, call.GasRemaining))
+	}
+	dw.line("\t%s [shape=ellipse, label=%s];", name, dotLabel(label))
+
+	if call.Execution != nil {
+		executionName := dw.writeContext(call.Execution)
+		dw.line("\t%s -> %s [label=\"execution\"];", name, executionName)
+	}
+	if call.Callback != nil {
+		callbackName := dw.writeContext(call.Callback)
+		callbackLabel := []string{"callback"}
+		if len(call.CallbackReturnCode) > 0 {
+			callbackLabel = append(callbackLabel, call.CallbackReturnCode)
+		}
+		dw.line("\t%s -> %s [style=dashed, label=%s];", name, callbackName, dotLabel(callbackLabel))
+	}
+	return name
+}
+
+// dotLabel joins the lines into a quoted DOT label.
+func dotLabel(lines []string) string {
+	escaped := make([]string, len(lines))
+	for i, line := range lines {
+		line = strings.ReplaceAll(line, "\\", "\\\\")
+		escaped[i] = strings.ReplaceAll(line, "\"", "\\\"")
+	}
+	return "\"" + strings.Join(escaped, "\\n") + "\""
+}
+
+// displayAddress shows the address as text if, leading zeros aside, it is printable, as test addresses usually are.
+func displayAddress(hexAddress string) string {
+	address, err := hex.DecodeString(hexAddress)
+	if err != nil {
+		return hexAddress
+	}
+
+	text := strings.TrimLeft(string(address), "\x00")
+	if len(text) == 0 {
+		return hexAddress
+	}
+	for _, char := range text {
+		if char > unicode.MaxASCII || !unicode.IsPrint(char) {
+			return shortID(hexAddress)
+		}
+	}
+	return text
+}
+
+func shortID(hexID string) string {
+	const maxLength = 16
+	if len(hexID) <= maxLength {
+		return hexID
+	}
+	return hexID[:maxLength/2] + ".." + hexID[len(hexID)-maxLength/2:]
+}
@@ -0,0 +1,25 @@
+package asyncstorage
+
+import (
+	"bytes"
+	"sort"
+
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
+)
+
+// AccountsFromWorld returns the storage of all the accounts of the world, ordered by address.
+// The storage is not copied, so collecting garbage from these accounts modifies the world.
+func AccountsFromWorld(world *worldmock.MockWorld) []*AccountStorage {
+	accounts := make([]*AccountStorage, 0, len(world.AcctMap))
+	for _, account := range world.AcctMap {
+		accounts = append(accounts, &AccountStorage{
+			Address: account.Address,
+			Storage: account.Storage,
+		})
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Address, accounts[j].Address) < 0
+	})
+	return accounts
+}
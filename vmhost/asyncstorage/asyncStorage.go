@@ -0,0 +1,251 @@
+// Package asyncstorage decodes the async contexts persisted in the storage of contracts, validates them
+// and finds the orphaned ones, which no callback or async call result can complete anymore.
+package asyncstorage
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+)
+
+// AccountStorage is the storage of an account, as found in a MockWorld or in a scenario state.
+type AccountStorage struct {
+	Address []byte
+	Storage map[string][]byte
+}
+
+// PersistedContext is an async context found in the storage of an account,
+// together with the problems found while validating it.
+type PersistedContext struct {
+	Address    []byte
+	StorageKey []byte
+	KeyCallID  []byte
+	Context    *contexts.SerializableAsyncContext
+	Problems   []string
+	IsOrphan   bool
+}
+
+// Inspector finds the async contexts persisted in storage, under the given protected key prefix.
+type Inspector struct {
+	asyncKeyPrefix []byte
+	marshalizer    *marshal.GogoProtoMarshalizer
+}
+
+// NewInspector creates an Inspector for the protected key prefix the VM was configured with.
+func NewInspector(protectedKeyPrefix []byte) (*Inspector, error) {
+	if len(protectedKeyPrefix) == 0 {
+		return nil, vmhost.ErrEmptyProtectedKeyPrefix
+	}
+
+	asyncKeyPrefix := append([]byte{}, protectedKeyPrefix...)
+	asyncKeyPrefix = append(asyncKeyPrefix, contexts.VMStoragePrefix...)
+	asyncKeyPrefix = append(asyncKeyPrefix, vmhost.AsyncDataPrefix...)
+	return &Inspector{
+		asyncKeyPrefix: asyncKeyPrefix,
+		marshalizer:    &marshal.GogoProtoMarshalizer{},
+	}, nil
+}
+
+// Inspect decodes and validates all the async contexts persisted by the given accounts, ordered by
+// address and storage key. The parent of a context is only looked up if its account is among the given ones.
+func (inspector *Inspector) Inspect(accounts []*AccountStorage) []*PersistedContext {
+	knownAddresses := make(map[string]bool)
+	persisted := make([]*PersistedContext, 0)
+	for _, account := range accounts {
+		knownAddresses[string(account.Address)] = true
+		persisted = append(persisted, inspector.decodeAccount(account)...)
+	}
+
+	byCallID := make(map[string]*PersistedContext)
+	for _, persistedContext := range persisted {
+		byCallID[contextKey(persistedContext.Address, persistedContext.KeyCallID)] = persistedContext
+	}
+	for _, persistedContext := range persisted {
+		if persistedContext.Context != nil {
+			persistedContext.validate()
+			persistedContext.validateParent(byCallID, knownAddresses)
+		}
+	}
+
+	return persisted
+}
+
+// CollectGarbage deletes the orphaned async contexts from the storage of the given accounts and returns them.
+func (inspector *Inspector) CollectGarbage(accounts []*AccountStorage) []*PersistedContext {
+	storageByAddress := make(map[string]map[string][]byte)
+	for _, account := range accounts {
+		storageByAddress[string(account.Address)] = account.Storage
+	}
+
+	orphans := make([]*PersistedContext, 0)
+	for _, persistedContext := range inspector.Inspect(accounts) {
+		if !persistedContext.IsOrphan {
+			continue
+		}
+		delete(storageByAddress[string(persistedContext.Address)], string(persistedContext.StorageKey))
+		orphans = append(orphans, persistedContext)
+	}
+
+	return orphans
+}
+
+func (inspector *Inspector) decodeAccount(account *AccountStorage) []*PersistedContext {
+	keys := make([]string, 0)
+	for key, value := range account.Storage {
+		if len(value) > 0 && bytes.HasPrefix([]byte(key), inspector.asyncKeyPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	persisted := make([]*PersistedContext, 0, len(keys))
+	for _, key := range keys {
+		persistedContext := &PersistedContext{
+			Address:    account.Address,
+			StorageKey: []byte(key),
+			KeyCallID:  []byte(key[len(inspector.asyncKeyPrefix):]),
+			Problems:   make([]string, 0),
+		}
+
+		serializable := &contexts.SerializableAsyncContext{}
+		err := inspector.marshalizer.Unmarshal(serializable, account.Storage[key])
+		if err != nil {
+			persistedContext.addProblem("cannot be decoded: %s", err.Error())
+		} else {
+			persistedContext.Context = serializable
+		}
+		persisted = append(persisted, persistedContext)
+	}
+
+	return persisted
+}
+
+// validate checks the invariants of the context which do not depend on other contexts.
+func (pc *PersistedContext) validate() {
+	context := pc.Context
+	if !bytes.Equal(context.CallID, pc.KeyCallID) {
+		pc.addProblem("stored under call ID %s, but has call ID %s", hexOrEmpty(pc.KeyCallID), hexOrEmpty(context.CallID))
+	}
+	if !bytes.Equal(context.Address, pc.Address) {
+		pc.addProblem("stored by %s, but belongs to %s", hexOrEmpty(pc.Address), hexOrEmpty(context.Address))
+	}
+	if context.IsComplete() {
+		pc.addProblem("complete, it should have been deleted")
+		pc.IsOrphan = true
+		return
+	}
+
+	numPending := 0
+	callIDs := make(map[string]bool)
+	for _, group := range context.AsyncCallGroups {
+		if len(group.Callback) > 0 && group.GasLocked == 0 {
+			pc.addProblem("group %s has the callback %s, but no gas locked", group.Identifier, group.Callback)
+		}
+		if len(group.AsyncCalls) == 0 {
+			pc.addProblem("group %s has no async calls", group.Identifier)
+		}
+		for _, asyncCall := range group.AsyncCalls {
+			if asyncCall.Status == vmhost.SerializableAsyncCallPending {
+				numPending++
+			}
+			pc.validateAsyncCall(group.Identifier, asyncCall, callIDs)
+		}
+	}
+
+	if context.CallsCounter > 0 && numPending == 0 {
+		pc.addProblem("waits for %d async calls, but none is pending", context.CallsCounter)
+		pc.IsOrphan = true
+	} else if uint64(numPending) > context.CallsCounter {
+		pc.addProblem("has %d pending async calls, but waits for %d", numPending, context.CallsCounter)
+	}
+	if context.CallsCounter > context.TotalCallsCounter {
+		pc.addProblem("waits for %d async calls, but only started %d", context.CallsCounter, context.TotalCallsCounter)
+	}
+}
+
+func (pc *PersistedContext) validateAsyncCall(groupID string, asyncCall *vmhost.SerializableAsyncCall, callIDs map[string]bool) {
+	if len(asyncCall.CallID) == 0 {
+		if asyncCall.Status == vmhost.SerializableAsyncCallPending {
+			pc.addProblem("async call to %s in group %s is pending, but was never started", hexOrEmpty(asyncCall.Destination), groupID)
+		}
+		return
+	}
+
+	callID := hex.EncodeToString(asyncCall.CallID)
+	if callIDs[callID] {
+		pc.addProblem("async call ID %s appears more than once", callID)
+	}
+	callIDs[callID] = true
+
+	hasCallbacks := len(asyncCall.SuccessCallback) > 0 || len(asyncCall.ErrorCallback) > 0
+	if hasCallbacks && asyncCall.GasLocked == 0 {
+		pc.addProblem("async call %s has callbacks, but no gas locked", callID)
+	}
+	if !hasCallbacks && len(asyncCall.CallbackClosure) > 0 {
+		pc.addProblem("async call %s has a callback closure, but no callbacks", callID)
+	}
+}
+
+// validateParent checks that the context to which the results will be returned is still persisted,
+// and that it still waits for them.
+func (pc *PersistedContext) validateParent(byCallID map[string]*PersistedContext, knownAddresses map[string]bool) {
+	parentAddress, parentCallID := pc.parent()
+	if len(parentCallID) == 0 || !knownAddresses[string(parentAddress)] {
+		return
+	}
+
+	parent, found := byCallID[contextKey(parentAddress, parentCallID)]
+	if !found {
+		pc.addProblem("parent context %s of %s not found", hexOrEmpty(parentCallID), hexOrEmpty(parentAddress))
+		pc.IsOrphan = true
+		return
+	}
+	if parent.Context == nil || pc.Context.CallType != contexts.AsynchronousCall {
+		return
+	}
+
+	if !parent.isWaitingFor(pc.Context.CallID) {
+		pc.addProblem("parent context %s of %s does not wait for it", hexOrEmpty(parentCallID), hexOrEmpty(parentAddress))
+		pc.IsOrphan = true
+	}
+}
+
+// parent returns the address and call ID of the context from which this one is loaded,
+// like asyncContext.LoadParentContext does.
+func (pc *PersistedContext) parent() ([]byte, []byte) {
+	if pc.Context.CallType == contexts.AsynchronousCallBack {
+		return pc.Context.Address, pc.Context.CallbackAsyncInitiatorCallID
+	}
+	return pc.Context.CallerAddr, pc.Context.CallerCallID
+}
+
+func (pc *PersistedContext) isWaitingFor(callID []byte) bool {
+	for _, group := range pc.Context.AsyncCallGroups {
+		for _, asyncCall := range group.AsyncCalls {
+			if bytes.Equal(asyncCall.CallID, callID) && asyncCall.Status == vmhost.SerializableAsyncCallPending {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (pc *PersistedContext) addProblem(format string, args ...interface{}) {
+	pc.Problems = append(pc.Problems, fmt.Sprintf(format, args...))
+}
+
+func contextKey(address []byte, callID []byte) string {
+	return string(address) + "/" + string(callID)
+}
+
+func hexOrEmpty(data []byte) string {
+	if len(data) == 0 {
+		return "<empty>"
+	}
+	return hex.EncodeToString(data)
+}
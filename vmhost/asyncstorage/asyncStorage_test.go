@@ -0,0 +1,242 @@
+package asyncstorage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+	"github.com/stretchr/testify/require"
+)
+
+var protectedKeyPrefix = []byte("ELROND")
+
+var parentAddress = []byte("parentSC........................")
+var childAddress = []byte("childSC.........................")
+
+func asyncStorageKey(callID []byte) string {
+	return "ELRONDVM@ASYNC" + string(callID)
+}
+
+func putContext(t *testing.T, account *AccountStorage, context *contexts.SerializableAsyncContext) {
+	data, err := (&marshal.GogoProtoMarshalizer{}).Marshal(context)
+	require.Nil(t, err)
+	account.Storage[asyncStorageKey(context.CallID)] = data
+}
+
+// parentWaitingForChild is a parent context waiting for a cross-shard async call,
+// whose execution waits in turn for an async call of its own.
+func parentWaitingForChild(t *testing.T) (*AccountStorage, *AccountStorage) {
+	parent := &AccountStorage{Address: parentAddress, Storage: map[string][]byte{"counter": {1}}}
+	child := &AccountStorage{Address: childAddress, Storage: make(map[string][]byte)}
+
+	putContext(t, parent, &contexts.SerializableAsyncContext{
+		Address:           parentAddress,
+		CallID:            []byte{0x01},
+		CallType:          contexts.DirectCall,
+		CallsCounter:      1,
+		TotalCallsCounter: 1,
+		AsyncCallGroups: []*vmhost.SerializableAsyncCallGroup{{
+			Identifier: "group",
+			AsyncCalls: []*vmhost.SerializableAsyncCall{{
+				CallID:          []byte{0x02},
+				Status:          vmhost.SerializableAsyncCallPending,
+				Destination:     childAddress,
+				GasLimit:        1000,
+				GasLocked:       100,
+				SuccessCallback: "callBack",
+				ErrorCallback:   "callBack",
+			}},
+		}},
+	})
+	putContext(t, child, &contexts.SerializableAsyncContext{
+		Address:           childAddress,
+		CallID:            []byte{0x02},
+		CallType:          contexts.AsynchronousCall,
+		CallerAddr:        parentAddress,
+		CallerCallID:      []byte{0x01},
+		CallsCounter:      1,
+		TotalCallsCounter: 1,
+		AsyncCallGroups: []*vmhost.SerializableAsyncCallGroup{{
+			Identifier: "group",
+			AsyncCalls: []*vmhost.SerializableAsyncCall{{
+				CallID:      []byte{0x03},
+				Status:      vmhost.SerializableAsyncCallPending,
+				Destination: []byte("remoteSC........................"),
+				GasLimit:    500,
+			}},
+		}},
+	})
+	return parent, child
+}
+
+func TestNewInspector(t *testing.T) {
+	inspector, err := NewInspector(nil)
+	require.Nil(t, inspector)
+	require.Equal(t, vmhost.ErrEmptyProtectedKeyPrefix, err)
+
+	inspector, err = NewInspector(protectedKeyPrefix)
+	require.Nil(t, err)
+	require.Equal(t, []byte("ELRONDVM@ASYNC"), inspector.asyncKeyPrefix)
+}
+
+func TestInspector_ValidContexts(t *testing.T) {
+	inspector, _ := NewInspector(protectedKeyPrefix)
+	parent, child := parentWaitingForChild(t)
+
+	persisted := inspector.Inspect([]*AccountStorage{parent, child})
+	require.Len(t, persisted, 2)
+	for _, persistedContext := range persisted {
+		require.NotNil(t, persistedContext.Context)
+		require.Empty(t, persistedContext.Problems)
+		require.False(t, persistedContext.IsOrphan)
+	}
+	require.Equal(t, []byte{0x01}, persisted[0].KeyCallID)
+	require.Equal(t, parentAddress, persisted[0].Address)
+	require.Equal(t, []byte{0x02}, persisted[1].KeyCallID)
+
+	// without the parent account, the parent is not looked up
+	persisted = inspector.Inspect([]*AccountStorage{child})
+	require.Len(t, persisted, 1)
+	require.Empty(t, persisted[0].Problems)
+}
+
+func TestInspector_Problems(t *testing.T) {
+	inspector, _ := NewInspector(protectedKeyPrefix)
+	account := &AccountStorage{Address: parentAddress, Storage: make(map[string][]byte)}
+
+	putContext(t, account, &contexts.SerializableAsyncContext{
+		Address:           parentAddress,
+		CallID:            []byte{0x01},
+		CallsCounter:      1,
+		TotalCallsCounter: 2,
+		AsyncCallGroups: []*vmhost.SerializableAsyncCallGroup{{
+			Identifier: "group",
+			Callback:   "groupCallback",
+			AsyncCalls: []*vmhost.SerializableAsyncCall{
+				{CallID: []byte{0x02}, Status: vmhost.SerializableAsyncCallPending, SuccessCallback: "callBack"},
+				{CallID: []byte{0x02}, Status: vmhost.SerializableAsyncCallPending, CallbackClosure: []byte("closure")},
+				{Status: vmhost.SerializableAsyncCallPending, Destination: childAddress},
+			},
+		}},
+	})
+	account.Storage[asyncStorageKey([]byte{0x05})] = []byte("not a context")
+
+	persisted := inspector.Inspect([]*AccountStorage{account})
+	require.Len(t, persisted, 2)
+
+	require.Equal(t, []string{
+		"group group has the callback groupCallback, but no gas locked",
+		"async call 02 has callbacks, but no gas locked",
+		"async call ID 02 appears more than once",
+		"async call 02 has a callback closure, but no callbacks",
+		"async call to " + hexOrEmpty(childAddress) + " in group group is pending, but was never started",
+		"has 3 pending async calls, but waits for 1",
+	}, persisted[0].Problems)
+	require.False(t, persisted[0].IsOrphan)
+
+	require.Nil(t, persisted[1].Context)
+	require.Len(t, persisted[1].Problems, 1)
+	require.Contains(t, persisted[1].Problems[0], "cannot be decoded")
+	require.False(t, persisted[1].IsOrphan)
+}
+
+func TestInspector_Orphans(t *testing.T) {
+	inspector, _ := NewInspector(protectedKeyPrefix)
+
+	t.Run("missing parent", func(t *testing.T) {
+		parent, child := parentWaitingForChild(t)
+		delete(parent.Storage, asyncStorageKey([]byte{0x01}))
+
+		persisted := inspector.Inspect([]*AccountStorage{parent, child})
+		require.Len(t, persisted, 1)
+		require.True(t, persisted[0].IsOrphan)
+		require.Equal(t, []string{"parent context 01 of " + hexOrEmpty(parentAddress) + " not found"}, persisted[0].Problems)
+	})
+	t.Run("parent not waiting", func(t *testing.T) {
+		parent, child := parentWaitingForChild(t)
+		putContext(t, parent, &contexts.SerializableAsyncContext{
+			Address:           parentAddress,
+			CallID:            []byte{0x01},
+			CallsCounter:      1,
+			TotalCallsCounter: 1,
+			AsyncCallGroups: []*vmhost.SerializableAsyncCallGroup{{
+				Identifier: "group",
+				AsyncCalls: []*vmhost.SerializableAsyncCall{{CallID: []byte{0x04}, Destination: childAddress}},
+			}},
+		})
+
+		persisted := inspector.Inspect([]*AccountStorage{parent, child})
+		require.Len(t, persisted, 2)
+		require.False(t, persisted[0].IsOrphan)
+		require.True(t, persisted[1].IsOrphan)
+		require.Equal(t, []string{"parent context 01 of " + hexOrEmpty(parentAddress) + " does not wait for it"}, persisted[1].Problems)
+	})
+	t.Run("complete", func(t *testing.T) {
+		account := &AccountStorage{Address: parentAddress, Storage: make(map[string][]byte)}
+		putContext(t, account, &contexts.SerializableAsyncContext{Address: parentAddress, CallID: []byte{0x01}})
+
+		persisted := inspector.Inspect([]*AccountStorage{account})
+		require.True(t, persisted[0].IsOrphan)
+		require.Equal(t, []string{"complete, it should have been deleted"}, persisted[0].Problems)
+	})
+	t.Run("no pending calls", func(t *testing.T) {
+		account := &AccountStorage{Address: parentAddress, Storage: make(map[string][]byte)}
+		putContext(t, account, &contexts.SerializableAsyncContext{
+			Address:           parentAddress,
+			CallID:            []byte{0x01},
+			CallsCounter:      1,
+			TotalCallsCounter: 1,
+			AsyncCallGroups: []*vmhost.SerializableAsyncCallGroup{{
+				Identifier: "group",
+				AsyncCalls: []*vmhost.SerializableAsyncCall{{CallID: []byte{0x02}, Status: vmhost.SerializableAsyncCallResolved}},
+			}},
+		})
+
+		persisted := inspector.Inspect([]*AccountStorage{account})
+		require.True(t, persisted[0].IsOrphan)
+		require.Equal(t, []string{"waits for 1 async calls, but none is pending"}, persisted[0].Problems)
+	})
+}
+
+func TestInspector_CollectGarbage(t *testing.T) {
+	inspector, _ := NewInspector(protectedKeyPrefix)
+
+	world := worldmock.NewMockWorld()
+	parent, child := parentWaitingForChild(t)
+	world.AcctMap.PutAccount(&worldmock.Account{Address: parent.Address, Storage: parent.Storage})
+	world.AcctMap.PutAccount(&worldmock.Account{Address: child.Address, Storage: child.Storage})
+	delete(parent.Storage, asyncStorageKey([]byte{0x01}))
+
+	accounts := AccountsFromWorld(world)
+	require.Len(t, accounts, 2)
+	require.Equal(t, childAddress, accounts[0].Address)
+
+	orphans := inspector.CollectGarbage(accounts)
+	require.Len(t, orphans, 1)
+	require.Equal(t, []byte{0x02}, orphans[0].KeyCallID)
+	require.Empty(t, world.AcctMap.GetAccount(childAddress).Storage)
+	require.Equal(t, []byte{1}, world.AcctMap.GetAccount(parentAddress).Storage["counter"])
+
+	require.Empty(t, inspector.CollectGarbage(accounts))
+}
+
+func TestWriteReport(t *testing.T) {
+	inspector, _ := NewInspector(protectedKeyPrefix)
+	parent, child := parentWaitingForChild(t)
+	delete(parent.Storage, asyncStorageKey([]byte{0x01}))
+
+	buffer := &bytes.Buffer{}
+	err := WriteReport(buffer, inspector.Inspect([]*AccountStorage{parent, child}))
+	require.Nil(t, err)
+
+	report := buffer.String()
+	require.Contains(t, report, hexOrEmpty(childAddress)+" callID 02 (orphan)\n")
+	require.Contains(t, report, "  AsynchronousCall from "+hexOrEmpty(parentAddress)+", callerCallID 01\n")
+	require.Contains(t, report, "  waits for 1 of 1 async calls, gas accumulated 0\n")
+	require.Contains(t, report, "    pending 03 -> ")
+	require.Contains(t, report, "  PROBLEM: parent context 01 of "+hexOrEmpty(parentAddress)+" not found\n")
+	require.Contains(t, report, "1 async contexts, 1 problems, 1 orphans\n")
+}
@@ -0,0 +1,65 @@
+package asyncstorage
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// asyncCallStatusNames names the statuses of persisted async calls.
+var asyncCallStatusNames = map[vmhost.SerializableAsyncCallStatus]string{
+	vmhost.SerializableAsyncCallPending:  "pending",
+	vmhost.SerializableAsyncCallResolved: "resolved",
+	vmhost.SerializableAsyncCallRejected: "rejected",
+}
+
+// WriteReport describes the persisted contexts and their problems, in a human-readable form.
+func WriteReport(writer io.Writer, persisted []*PersistedContext) error {
+	bufferedWriter := bufio.NewWriter(writer)
+	numProblems := 0
+	numOrphans := 0
+	for _, persistedContext := range persisted {
+		numProblems += len(persistedContext.Problems)
+		if persistedContext.IsOrphan {
+			numOrphans++
+		}
+		writeContext(bufferedWriter, persistedContext)
+	}
+	fmt.Fprintf(bufferedWriter, "%d async contexts, %d problems, %d orphans\n", len(persisted), numProblems, numOrphans)
+
+	return bufferedWriter.Flush()
+}
+
+func writeContext(writer io.Writer, pc *PersistedContext) {
+	orphan := ""
+	if pc.IsOrphan {
+		orphan = " (orphan)"
+	}
+	fmt.Fprintf(writer, "%s callID %s%s\n", hexOrEmpty(pc.Address), hexOrEmpty(pc.KeyCallID), orphan)
+
+	context := pc.Context
+	if context != nil {
+		fmt.Fprintf(writer, "  %s from %s, callerCallID %s\n", context.CallType.String(), hexOrEmpty(context.CallerAddr), hexOrEmpty(context.CallerCallID))
+		if len(context.CallbackAsyncInitiatorCallID) > 0 {
+			fmt.Fprintf(writer, "  callback of %s\n", hexOrEmpty(context.CallbackAsyncInitiatorCallID))
+		}
+		fmt.Fprintf(writer, "  waits for %d of %d async calls, gas accumulated %d\n", context.CallsCounter, context.TotalCallsCounter, context.GasAccumulated)
+		for _, group := range context.AsyncCallGroups {
+			fmt.Fprintf(writer, "  group %s, callback %q, gas locked %d\n", group.Identifier, group.Callback, group.GasLocked)
+			for _, asyncCall := range group.AsyncCalls {
+				fmt.Fprintf(writer, "    %s %s -> %s, gas limit %d, locked %d\n",
+					asyncCallStatusNames[asyncCall.Status],
+					hexOrEmpty(asyncCall.CallID),
+					hexOrEmpty(asyncCall.Destination),
+					asyncCall.GasLimit,
+					asyncCall.GasLocked)
+			}
+		}
+	}
+
+	for _, problem := range pc.Problems {
+		fmt.Fprintf(writer, "  PROBLEM: %s\n", problem)
+	}
+}
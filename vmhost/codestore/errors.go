@@ -0,0 +1,15 @@
+package codestore
+
+import "errors"
+
+// ErrEmptyStoreDirectory signals that no directory was given for the compiled code store
+var ErrEmptyStoreDirectory = errors.New("empty compiled code store directory")
+
+// ErrZeroMaxStoreSize signals that the maximum size of the compiled code store is zero
+var ErrZeroMaxStoreSize = errors.New("zero maximum compiled code store size")
+
+// ErrCompiledCodeTooLarge signals that the compiled code does not fit in the store
+var ErrCompiledCodeTooLarge = errors.New("compiled code is larger than the store")
+
+// errCorruptedEntry signals that a stored entry failed the integrity checks
+var errCorruptedEntry = errors.New("corrupted compiled code entry")
@@ -0,0 +1,248 @@
+// Package codestore provides persistent stores of compiled contract code, shared across processes.
+package codestore
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var log = logger.GetOrCreate("vm/codestore")
+
+var _ vmhost.CompiledCodeStore = (*FileCompiledCodeStore)(nil)
+
+// entryMagic and entryFormatVersion start every entry file, followed by the key digest,
+// the digest of the compiled code, and the compiled code itself.
+const entryMagic = "MXCC"
+const entryFormatVersion = byte(1)
+const entryFileExtension = ".ccode"
+const entryHeaderLength = len(entryMagic) + 1 + 2*sha256.Size
+
+// FileCompiledCodeStore keeps compiled code in a directory, one file per entry.
+// Entries are written atomically, checked for integrity when read, and the least
+// recently used ones are evicted when the total size exceeds the configured maximum.
+// Several processes can safely share the same directory.
+type FileCompiledCodeStore struct {
+	mutStore       sync.Mutex
+	directory      string
+	maxSizeInBytes int64
+}
+
+// NewFileCompiledCodeStore creates the store in the given directory, creating the directory if needed.
+// Entries already in the directory are kept and reused.
+func NewFileCompiledCodeStore(directory string, maxSizeInBytes int64) (*FileCompiledCodeStore, error) {
+	if len(directory) == 0 {
+		return nil, ErrEmptyStoreDirectory
+	}
+	if maxSizeInBytes <= 0 {
+		return nil, ErrZeroMaxStoreSize
+	}
+
+	err := os.MkdirAll(directory, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileCompiledCodeStore{
+		directory:      directory,
+		maxSizeInBytes: maxSizeInBytes,
+	}, nil
+}
+
+// Get returns the compiled code stored under the key. Corrupted entries are removed and reported as missing.
+func (store *FileCompiledCodeStore) Get(key vmhost.CompiledCodeKey) ([]byte, bool) {
+	store.mutStore.Lock()
+	defer store.mutStore.Unlock()
+
+	keyDigest := digestKey(key)
+	entryPath := store.entryPath(keyDigest)
+	entry, err := ioutil.ReadFile(entryPath)
+	if err != nil {
+		return nil, false
+	}
+
+	compiledCode, err := decodeEntry(entry, keyDigest)
+	if err != nil {
+		log.Warn("compiled code store: removing entry", "path", entryPath, "error", err)
+		_ = os.Remove(entryPath)
+		return nil, false
+	}
+
+	// the modification time orders the entries for eviction
+	now := time.Now()
+	_ = os.Chtimes(entryPath, now, now)
+	return compiledCode, true
+}
+
+// Put stores the compiled code under the key, then evicts entries if the store became too large.
+func (store *FileCompiledCodeStore) Put(key vmhost.CompiledCodeKey, compiledCode []byte) error {
+	store.mutStore.Lock()
+	defer store.mutStore.Unlock()
+
+	keyDigest := digestKey(key)
+	entry := encodeEntry(keyDigest, compiledCode)
+	if int64(len(entry)) > store.maxSizeInBytes {
+		return ErrCompiledCodeTooLarge
+	}
+
+	err := store.writeEntry(store.entryPath(keyDigest), entry)
+	if err != nil {
+		return err
+	}
+
+	return store.evictIfNeeded()
+}
+
+// SizeInBytes returns the total size of the entries in the store.
+func (store *FileCompiledCodeStore) SizeInBytes() (int64, error) {
+	store.mutStore.Lock()
+	defer store.mutStore.Unlock()
+
+	entries, err := store.listEntries()
+	if err != nil {
+		return 0, err
+	}
+
+	totalSize := int64(0)
+	for _, entry := range entries {
+		totalSize += entry.Size()
+	}
+	return totalSize, nil
+}
+
+// writeEntry writes to a temporary file first, so that readers never see partially written entries.
+func (store *FileCompiledCodeStore) writeEntry(entryPath string, entry []byte) error {
+	tempFile, err := ioutil.TempFile(store.directory, "entry-*.tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(entry)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), entryPath)
+	}
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+	}
+	return err
+}
+
+func (store *FileCompiledCodeStore) evictIfNeeded() error {
+	entries, err := store.listEntries()
+	if err != nil {
+		return err
+	}
+
+	totalSize := int64(0)
+	for _, entry := range entries {
+		totalSize += entry.Size()
+	}
+	if totalSize <= store.maxSizeInBytes {
+		return nil
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ModTime().Before(entries[j].ModTime())
+	})
+	for _, entry := range entries {
+		if totalSize <= store.maxSizeInBytes {
+			break
+		}
+		err = os.Remove(filepath.Join(store.directory, entry.Name()))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		totalSize -= entry.Size()
+		log.Trace("compiled code store: evicted entry", "name", entry.Name(), "size", entry.Size())
+	}
+	return nil
+}
+
+func (store *FileCompiledCodeStore) listEntries() ([]os.FileInfo, error) {
+	dirEntries, err := os.ReadDir(store.directory)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]os.FileInfo, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), entryFileExtension) {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			// removed in the meantime, by another process
+			continue
+		}
+		entries = append(entries, info)
+	}
+	return entries, nil
+}
+
+func (store *FileCompiledCodeStore) entryPath(keyDigest []byte) string {
+	return filepath.Join(store.directory, hex.EncodeToString(keyDigest)+entryFileExtension)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (store *FileCompiledCodeStore) IsInterfaceNil() bool {
+	return store == nil
+}
+
+// digestKey hashes the length-prefixed key fields, so that distinct keys cannot produce the same input.
+func digestKey(key vmhost.CompiledCodeKey) []byte {
+	hasher := sha256.New()
+	for _, field := range [][]byte{key.CodeHash, []byte(key.ExecutorVersion), key.OpcodeCostFingerprint, key.CompilationOptionsFingerprint} {
+		lengthBytes := make([]byte, 4)
+		binary.BigEndian.PutUint32(lengthBytes, uint32(len(field)))
+		_, _ = hasher.Write(lengthBytes)
+		_, _ = hasher.Write(field)
+	}
+	return hasher.Sum(nil)
+}
+
+func encodeEntry(keyDigest []byte, compiledCode []byte) []byte {
+	codeDigest := sha256.Sum256(compiledCode)
+	entry := make([]byte, 0, entryHeaderLength+len(compiledCode))
+	entry = append(entry, entryMagic...)
+	entry = append(entry, entryFormatVersion)
+	entry = append(entry, keyDigest...)
+	entry = append(entry, codeDigest[:]...)
+	return append(entry, compiledCode...)
+}
+
+func decodeEntry(entry []byte, expectedKeyDigest []byte) ([]byte, error) {
+	if len(entry) < entryHeaderLength {
+		return nil, errCorruptedEntry
+	}
+	if string(entry[:len(entryMagic)]) != entryMagic || entry[len(entryMagic)] != entryFormatVersion {
+		return nil, errCorruptedEntry
+	}
+
+	keyDigestStart := len(entryMagic) + 1
+	codeDigestStart := keyDigestStart + sha256.Size
+	if !bytes.Equal(entry[keyDigestStart:codeDigestStart], expectedKeyDigest) {
+		return nil, errCorruptedEntry
+	}
+
+	compiledCode := entry[entryHeaderLength:]
+	codeDigest := sha256.Sum256(compiledCode)
+	if !bytes.Equal(entry[codeDigestStart:entryHeaderLength], codeDigest[:]) {
+		return nil, errCorruptedEntry
+	}
+	return compiledCode, nil
+}
@@ -0,0 +1,115 @@
+package codestore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(codeHash string) vmhost.CompiledCodeKey {
+	return vmhost.CompiledCodeKey{
+		CodeHash:                      []byte(codeHash),
+		ExecutorVersion:               "v1.5/wasmer2",
+		OpcodeCostFingerprint:         []byte("costs"),
+		CompilationOptionsFingerprint: []byte("options"),
+	}
+}
+
+func TestNewFileCompiledCodeStore(t *testing.T) {
+	store, err := NewFileCompiledCodeStore("", 100)
+	require.Equal(t, ErrEmptyStoreDirectory, err)
+	require.True(t, store.IsInterfaceNil())
+
+	_, err = NewFileCompiledCodeStore(t.TempDir(), 0)
+	require.Equal(t, ErrZeroMaxStoreSize, err)
+
+	store, err = NewFileCompiledCodeStore(filepath.Join(t.TempDir(), "nested", "dir"), 100)
+	require.Nil(t, err)
+	require.False(t, store.IsInterfaceNil())
+}
+
+func TestFileCompiledCodeStore_PutGet(t *testing.T) {
+	directory := t.TempDir()
+	store, _ := NewFileCompiledCodeStore(directory, 1000)
+
+	_, found := store.Get(testKey("code"))
+	require.False(t, found)
+
+	require.Nil(t, store.Put(testKey("code"), []byte("compiled")))
+	compiledCode, found := store.Get(testKey("code"))
+	require.True(t, found)
+	require.Equal(t, []byte("compiled"), compiledCode)
+
+	otherVersion := testKey("code")
+	otherVersion.ExecutorVersion = "v1.5/wasmer1"
+	_, found = store.Get(otherVersion)
+	require.False(t, found)
+
+	otherCosts := testKey("code")
+	otherCosts.OpcodeCostFingerprint = []byte("other costs")
+	_, found = store.Get(otherCosts)
+	require.False(t, found)
+
+	otherOptions := testKey("code")
+	otherOptions.CompilationOptionsFingerprint = []byte("other options")
+	_, found = store.Get(otherOptions)
+	require.False(t, found)
+
+	// entries outlive the store instance
+	reopened, _ := NewFileCompiledCodeStore(directory, 1000)
+	compiledCode, found = reopened.Get(testKey("code"))
+	require.True(t, found)
+	require.Equal(t, []byte("compiled"), compiledCode)
+}
+
+func TestFileCompiledCodeStore_CorruptedEntry(t *testing.T) {
+	directory := t.TempDir()
+	store, _ := NewFileCompiledCodeStore(directory, 1000)
+	require.Nil(t, store.Put(testKey("code"), []byte("compiled")))
+
+	entryPath := store.entryPath(digestKey(testKey("code")))
+	entry, err := ioutil.ReadFile(entryPath)
+	require.Nil(t, err)
+	entry[len(entry)-1] ^= 0xff
+	require.Nil(t, ioutil.WriteFile(entryPath, entry, 0644))
+
+	_, found := store.Get(testKey("code"))
+	require.False(t, found)
+	_, err = os.Stat(entryPath)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestFileCompiledCodeStore_Eviction(t *testing.T) {
+	compiledCode := make([]byte, 100)
+	entrySize := int64(entryHeaderLength + len(compiledCode))
+	store, _ := NewFileCompiledCodeStore(t.TempDir(), 2*entrySize)
+
+	require.Equal(t, ErrCompiledCodeTooLarge, store.Put(testKey("huge"), make([]byte, 2*entrySize)))
+
+	require.Nil(t, store.Put(testKey("first"), compiledCode))
+	require.Nil(t, store.Put(testKey("second"), compiledCode))
+	past := time.Now().Add(-time.Hour)
+	require.Nil(t, os.Chtimes(store.entryPath(digestKey(testKey("first"))), past, past))
+	require.Nil(t, os.Chtimes(store.entryPath(digestKey(testKey("second"))), past.Add(time.Minute), past.Add(time.Minute)))
+
+	// reading the first entry makes it the most recently used
+	_, found := store.Get(testKey("first"))
+	require.True(t, found)
+
+	require.Nil(t, store.Put(testKey("third"), compiledCode))
+	_, found = store.Get(testKey("second"))
+	require.False(t, found)
+	_, found = store.Get(testKey("first"))
+	require.True(t, found)
+	_, found = store.Get(testKey("third"))
+	require.True(t, found)
+
+	size, err := store.SizeInBytes()
+	require.Nil(t, err)
+	require.Equal(t, 2*entrySize, size)
+}
@@ -39,6 +39,10 @@ const (
 	// BreakpointMemoryLimit means that Wasmer must stop immediately
 	// due to over-allocation of WASM memory
 	BreakpointMemoryLimit
+
+	// BreakpointMemoryBudgetExceeded means that Wasmer must stop immediately
+	// because the transaction holds more memory than its memory budget allows
+	BreakpointMemoryBudgetExceeded
 )
 
 const (
@@ -57,6 +61,12 @@ const (
 	// BreakpointOutOfGasString is the human-readable name of BreakpointOutOfGas
 	BreakpointOutOfGasString = "BreakpointOutOfGas"
 
+	// BreakpointMemoryLimitString is the human-readable name of BreakpointMemoryLimit
+	BreakpointMemoryLimitString = "BreakpointMemoryLimit"
+
+	// BreakpointMemoryBudgetExceededString is the human-readable name of BreakpointMemoryBudgetExceeded
+	BreakpointMemoryBudgetExceededString = "BreakpointMemoryBudgetExceeded"
+
 	// UnknownBreakpointString is the human-readable label for an unknown breakpoint value
 	UnknownBreakpointString = "unknown breakpoint"
 )
@@ -74,6 +84,10 @@ func (b BreakpointValue) String() string {
 		return BreakpointSignalErrorString
 	case BreakpointOutOfGas:
 		return BreakpointOutOfGasString
+	case BreakpointMemoryLimit:
+		return BreakpointMemoryLimitString
+	case BreakpointMemoryBudgetExceeded:
+		return BreakpointMemoryBudgetExceededString
 	default:
 		return UnknownBreakpointString
 	}
@@ -173,9 +187,24 @@ type VMHostParameters struct {
 	ProtectedKeyPrefix                  []byte
 	WasmerSIGSEGVPassthrough            bool
 	EpochNotifier                       vmcommon.EpochNotifier
-	EnableEpochsHandler                 vmcommon.EnableEpochsHandler
+	EnableEpochsHandler                 EnableEpochsHandler
 	Hasher                              HashComputer
 	TimeOutForSCExecutionInMilliseconds uint32
+	Debugger                            ExecutionDebugger
+	CompiledCodeStore                   CompiledCodeStore
+	Profiler                            ExecutionProfiler
+	Coverage                            ExecutionCoverage
+	AsyncCallGraph                      AsyncCallGraphRecorder
+}
+
+// CompiledCodeKey identifies compiled code in a CompiledCodeStore. Besides the code itself, compiled code
+// depends on the executor build which produced it, on the opcode costs metered into it and on the
+// compilation options.
+type CompiledCodeKey struct {
+	CodeHash                      []byte
+	ExecutorVersion               string
+	OpcodeCostFingerprint         []byte
+	CompilationOptionsFingerprint []byte
 }
 
 // AsyncCallInfo contains the information required to handle the asynchronous call of another SmartContract
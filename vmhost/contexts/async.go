@@ -150,6 +150,11 @@ func (context *asyncContext) InitStateFromInput(input *vmcommon.ContractCallInpu
 		logAsync.Trace("", "gasAccumulated", context.gasAccumulated)
 	}
 
+	callGraph := context.host.AsyncCallGraph()
+	if !check.IfNil(callGraph) {
+		callGraph.BeginContext(context.address, context.callID, context.callerCallID, input)
+	}
+
 	return nil
 }
 
@@ -560,6 +565,31 @@ func (context *asyncContext) RegisterLegacyAsyncCall(address []byte, data []byte
 	return nil
 }
 
+// CancelCallGroup removes the specified AsyncCallGroup before any of its AsyncCalls is executed, and gives back
+// the gas they consumed when they were registered. Only the groups registered by the current execution
+// can be cancelled, since the calls of the groups persisted by previous executions have already been sent.
+func (context *asyncContext) CancelCallGroup(groupID string) error {
+	index, ok := context.findGroupByID(groupID)
+	if !ok {
+		return vmhost.ErrAsyncCallGroupNotFound
+	}
+
+	metering := context.host.Metering()
+	group := context.asyncCallGroups[index]
+	for _, call := range group.AsyncCalls {
+		if call.ExecutionMode == vmhost.ESDTTransferOnCallBack {
+			context.decrementCallsCounter()
+		}
+		metering.RestoreGas(call.GetTotalGas())
+	}
+	metering.RestoreGas(group.GasLocked)
+
+	context.deleteCallGroup(index)
+
+	logAsync.Trace("cancelled async call group", "group", groupID, "calls", len(group.AsyncCalls))
+	return nil
+}
+
 func (context *asyncContext) canRegisterLegacyAsyncCall() bool {
 	vmInput := context.host.Runtime().GetVMInput()
 	noGroups := len(context.asyncCallGroups) == 0
@@ -622,6 +652,11 @@ func (context *asyncContext) addAsyncCall(groupID string, call *vmhost.AsyncCall
 		"gas locked", call.GasLocked,
 	)
 
+	callGraph := context.host.AsyncCallGraph()
+	if !check.IfNil(callGraph) {
+		callGraph.RegisterAsyncCall(context.callID, groupID, call)
+	}
+
 	return nil
 }
 
@@ -1027,6 +1062,16 @@ func (context *asyncContext) GetCallbackClosure() ([]byte, error) {
 	return context.callbackParentCall.CallbackClosure, nil
 }
 
+// IsCallbackTimedOut returns true if the callback being executed received the result of an async call after
+// the deadline round of the call. The callback still receives the actual result of the destination call,
+// which may have succeeded, so that the contract decides how to handle a late result.
+func (context *asyncContext) IsCallbackTimedOut() bool {
+	if context.callbackParentCall == nil {
+		return false
+	}
+	return context.callbackParentCall.IsExpired(context.host.Blockchain().CurrentRound())
+}
+
 // DebugCallIDAsString - just for debug purposes
 func DebugCallIDAsString(arr []byte) string {
 	if len(arr) > 3 {
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-go/math"
@@ -74,6 +75,11 @@ func (context *asyncContext) executeAsyncLocalCall(asyncCall *vmhost.AsyncCall)
 
 	asyncCall.UpdateStatus(vmOutput.ReturnCode)
 
+	callGraph := context.host.AsyncCallGraph()
+	if !check.IfNil(callGraph) {
+		callGraph.FinishAsyncCall(asyncCall.CallID, vmOutput.ReturnCode, vmOutput.GasRemaining)
+	}
+
 	if isComplete {
 		if asyncCall.HasCallback() {
 			// Restore gas locked while still on the caller instance; otherwise, the
@@ -106,6 +112,7 @@ func (context *asyncContext) ExecuteSyncCallbackAndFinishOutput(
 	err error) (bool, *vmcommon.VMOutput) {
 	callbackVMOutput, isComplete, callbackErr := context.executeSyncCallback(asyncCall, vmOutput, gasAccumulated, err)
 	context.finishAsyncLocalCallbackExecution(callbackVMOutput, callbackErr, vmOutput.ReturnCode)
+	context.recordCallbackResult(asyncCall, callbackVMOutput, gasAccumulated)
 	return isComplete, callbackVMOutput
 }
 
@@ -144,6 +151,19 @@ func (context *asyncContext) executeSyncCallback(
 	return callbackVMOutput, isComplete, callbackErr
 }
 
+func (context *asyncContext) recordCallbackResult(asyncCall *vmhost.AsyncCall, callbackVMOutput *vmcommon.VMOutput, gasAccumulated uint64) {
+	callGraph := context.host.AsyncCallGraph()
+	if check.IfNil(callGraph) {
+		return
+	}
+
+	if callbackVMOutput == nil {
+		callGraph.FinishCallback(asyncCall.CallID, vmcommon.ExecutionFailed, 0, gasAccumulated)
+		return
+	}
+	callGraph.FinishCallback(asyncCall.CallID, callbackVMOutput.ReturnCode, callbackVMOutput.GasRemaining, gasAccumulated)
+}
+
 func (context *asyncContext) executeESDTTransferOnCallback(asyncCall *vmhost.AsyncCall) {
 	context.host.Output().PrependFinish(asyncCall.Data)
 
@@ -270,6 +290,11 @@ func (context *asyncContext) createContractCallInput(asyncCall *vmhost.AsyncCall
 	context.SetAsyncArgumentsForCall(contractCallInput)
 	asyncCall.CallID = contractCallInput.AsyncArguments.CallID
 
+	callGraph := host.AsyncCallGraph()
+	if !check.IfNil(callGraph) {
+		callGraph.StartAsyncCall(context.callID, asyncCall)
+	}
+
 	return contractCallInput, nil
 }
 
@@ -3,6 +3,7 @@ package contexts
 import (
 	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-common-go/txDataBuilder"
@@ -38,6 +39,11 @@ func (context *asyncContext) sendAsyncCallCrossShard(asyncCall *vmhost.AsyncCall
 	newCallID := context.generateNewCallID()
 	asyncCall.CallID = newCallID
 
+	callGraph := host.AsyncCallGraph()
+	if !check.IfNil(callGraph) {
+		callGraph.StartAsyncCall(context.callID, asyncCall)
+	}
+
 	asyncData := createAsyncDataForAsyncCall(newCallID, context.GetCallID())
 
 	callData := txDataBuilder.NewBuilder()
@@ -505,6 +505,104 @@ func TestAsyncContext_UpdateCurrentCallStatus(t *testing.T) {
 	require.Equal(t, vmhost.AsyncCallRejected, asyncCall.Status)
 }
 
+func TestAsyncContext_UpdateCurrentCallStatus_Expired(t *testing.T) {
+	contract := []byte("contract")
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: []byte("caller"),
+			Arguments:  [][]byte{{0}, []byte("result")},
+			CallType:   vm.AsynchronousCallBack,
+		},
+		RecipientAddr: contract,
+	}
+
+	host, world := initializeVMAndWasmerAsyncContext(t)
+	async := makeAsyncContext(t, host, contract)
+
+	storedAsync := &asyncContext{
+		asyncCallGroups: []*vmhost.AsyncCallGroup{
+			{
+				Identifier: "",
+				AsyncCalls: []*vmhost.AsyncCall{
+					{
+						Destination:   []byte("caller"),
+						DeadlineRound: 5,
+					},
+				},
+			},
+		},
+	}
+	storedAsync.host = host
+	err := storedAsync.Save()
+	require.Nil(t, err)
+
+	require.False(t, async.IsCallbackTimedOut())
+
+	// the result arrives in the deadline round, so it is not late
+	world.CurrentBlockInfo = &worldmock.BlockInfo{BlockRound: 5}
+	host.Runtime().InitStateFromContractCallInput(vmInput)
+	asyncCall, isLegacy, err := async.UpdateCurrentAsyncCallStatus(contract, []byte{}, &vmInput.VMInput)
+	require.Nil(t, err)
+	require.False(t, isLegacy)
+	require.Equal(t, vmhost.AsyncCallResolved, asyncCall.Status)
+	require.Equal(t, [][]byte{{0}, []byte("result")}, vmInput.Arguments)
+	async.SetCallbackParentCall(asyncCall)
+	require.False(t, async.IsCallbackTimedOut())
+
+	// the result arrives after the deadline round, the callback still gets the actual result
+	world.CurrentBlockInfo.BlockRound = 6
+	asyncCall, isLegacy, err = async.UpdateCurrentAsyncCallStatus(contract, []byte{}, &vmInput.VMInput)
+	require.Nil(t, err)
+	require.False(t, isLegacy)
+	require.Equal(t, vmhost.AsyncCallResolved, asyncCall.Status)
+	require.Equal(t, [][]byte{{0}, []byte("result")}, vmInput.Arguments)
+	async.SetCallbackParentCall(asyncCall)
+	require.True(t, async.IsCallbackTimedOut())
+}
+
+func TestAsyncContext_CancelCallGroup(t *testing.T) {
+	host, _ := initializeVMAndWasmerAsyncContext(t)
+	metering := host.Metering()
+
+	async := makeAsyncContext(t, host, nil)
+
+	err := async.CancelCallGroup("testGroup")
+	require.Equal(t, vmhost.ErrAsyncCallGroupNotFound, err)
+
+	err = async.RegisterAsyncCall("testGroup", &vmhost.AsyncCall{
+		Destination: []byte("somewhere"),
+		Data:        []byte("something"),
+		GasLimit:    100,
+		GasLocked:   50,
+	})
+	require.Nil(t, err)
+	err = async.RegisterAsyncCall("testGroup", &vmhost.AsyncCall{
+		Destination: []byte("elsewhere"),
+		Data:        []byte("something"),
+		GasLimit:    150,
+	})
+	require.Nil(t, err)
+	err = async.RegisterAsyncCall("otherGroup", &vmhost.AsyncCall{
+		Destination: []byte("somewhere"),
+		Data:        []byte("something"),
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(9700), metering.GasLeft())
+
+	err = async.CancelCallGroup("testGroup")
+	require.Nil(t, err)
+	require.Equal(t, uint64(10000), metering.GasLeft())
+
+	_, exists := async.GetCallGroup("testGroup")
+	require.False(t, exists)
+	_, exists = async.GetCallGroup("otherGroup")
+	require.True(t, exists)
+
+	err = async.CancelCallGroup("testGroup")
+	require.Equal(t, vmhost.ErrAsyncCallGroupNotFound, err)
+}
+
 func TestAsyncContext_SendAsyncCallCrossShard(t *testing.T) {
 	host, world := initializeVMAndWasmerAsyncContext(t)
 	world.AcctMap.PutAccount(&worldmock.Account{
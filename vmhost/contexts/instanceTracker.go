@@ -134,6 +134,25 @@ func (tracker *instanceTracker) ClearStateStack() {
 	tracker.codeSizeStack = make([]uint64, 0)
 }
 
+// MemoryUsage returns the total size of the WASM memory of the active instance and of the instances on the stack.
+// Instances that appear more than once are only counted once.
+func (tracker *instanceTracker) MemoryUsage() uint64 {
+	counted := make(map[executor.Instance]struct{}, len(tracker.instanceStack)+1)
+	usage := uint64(0)
+	for _, instance := range append([]executor.Instance{tracker.instance}, tracker.instanceStack...) {
+		if check.IfNil(instance) {
+			continue
+		}
+		_, ok := counted[instance]
+		if ok {
+			continue
+		}
+		counted[instance] = struct{}{}
+		usage += uint64(instance.MemLength())
+	}
+	return usage
+}
+
 // StackSize returns the size of the instance stack
 func (tracker *instanceTracker) StackSize() uint64 {
 	return uint64(len(tracker.instanceStack))
@@ -364,3 +364,23 @@ func checkInstances(t *testing.T, iTracker *instanceTracker) {
 	require.Len(t, iTracker.codeHashStack, 0)
 	require.Nil(t, iTracker.CheckInstances())
 }
+
+func TestInstanceTracker_MemoryUsage(t *testing.T) {
+	iTracker, err := NewInstanceTracker()
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), iTracker.MemoryUsage())
+
+	parent := mock.NewInstanceMock(nil)
+	_ = parent.MemGrow(2)
+	iTracker.SetNewInstance(parent, Bytecode)
+	require.Equal(t, uint64(parent.MemLength()), iTracker.MemoryUsage())
+
+	// the active instance is also on the stack, but it is counted only once
+	iTracker.PushState()
+	require.Equal(t, uint64(parent.MemLength()), iTracker.MemoryUsage())
+
+	child := mock.NewInstanceMock(nil)
+	_ = child.MemGrow(1)
+	iTracker.SetNewInstance(child, Bytecode)
+	require.Equal(t, uint64(parent.MemLength()+child.MemLength()), iTracker.MemoryUsage())
+}
@@ -8,9 +8,11 @@ import (
 	"io"
 	basicMath "math"
 	"math/big"
+	"sort"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-vm-go/crypto/weierstrass"
 	"github.com/multiversx/mx-chain-vm-go/math"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 )
@@ -42,13 +44,16 @@ const p256CurveUnmarshalCompressedMultiplier = 100
 const p384CurveUnmarshalCompressedMultiplier = 200
 const p521CurveUnmarshalCompressedMultiplier = 400
 
+// curveFieldSizes holds the sizes of field of the NIST curves, in the order of the curve multipliers
+var curveFieldSizes = [...]int32{224, 256, 384, 521}
+
 const minEncodedBigFloatLength = 6
 const handleLen = 4
 
 type managedBufferMap map[int32][]byte
 type bigIntMap map[int32]*big.Int
 type bigFloatMap map[int32]*big.Float
-type ellipticCurveMap map[int32]*elliptic.CurveParams
+type ellipticCurveMap map[int32]*weierstrass.Curve
 type managedMapMap map[int32]map[string][]byte
 
 type managedTypesContext struct {
@@ -56,6 +61,10 @@ type managedTypesContext struct {
 	managedTypesValues  managedTypesState
 	managedTypesStack   []managedTypesState
 	randomnessGenerator math.RandomnessGenerator
+	touchedBigInts      map[*big.Int]uint64
+
+	// bigIntTrackingEnabled is set when the transaction has a memory budget, which needs the growth of the big ints
+	bigIntTrackingEnabled bool
 }
 
 type managedTypesState struct {
@@ -64,6 +73,12 @@ type managedTypesState struct {
 	ecValues       ellipticCurveMap
 	mBufferValues  managedBufferMap
 	mMapValues     managedMapMap
+
+	// memoryUsage is the memory held by the values of the state, see MemoryUsage
+	memoryUsage uint64
+
+	// copiedMemoryUsage is the memory held only by a state pushed on the stack, i.e. by its copies of the big numbers
+	copiedMemoryUsage uint64
 }
 
 // NewManagedTypesContext creates a new managedTypesContext
@@ -83,6 +98,7 @@ func NewManagedTypesContext(host vmhost.VMHost) (*managedTypesContext, error) {
 		},
 		managedTypesStack:   make([]managedTypesState, 0),
 		randomnessGenerator: nil,
+		touchedBigInts:      make(map[*big.Int]uint64),
 	}
 
 	return context, nil
@@ -117,10 +133,13 @@ func (context *managedTypesContext) InitState() {
 		ecValues:       make(ellipticCurveMap),
 		mBufferValues:  make(managedBufferMap),
 		mMapValues:     make(managedMapMap)}
+	context.discardTouchedBigInts()
+	context.bigIntTrackingEnabled = vmhost.TransactionMemoryBudget(context.host) > 0
 }
 
 // PushState appends the values map to the state stack
 func (context *managedTypesContext) PushState() {
+	context.settleBigIntMemoryUsage()
 	newBigIntState, newBigFloatState, newEcState, newmBufferState, newmMapState := context.clone()
 	context.managedTypesStack = append(context.managedTypesStack, managedTypesState{
 		bigIntValues:   newBigIntState,
@@ -128,6 +147,9 @@ func (context *managedTypesContext) PushState() {
 		ecValues:       newEcState,
 		mBufferValues:  newmBufferState,
 		mMapValues:     newmMapState,
+
+		memoryUsage:       context.managedTypesValues.memoryUsage,
+		copiedMemoryUsage: context.copiedMemoryUsage(),
 	})
 }
 
@@ -149,7 +171,9 @@ func (context *managedTypesContext) PopSetActiveState() {
 	context.managedTypesValues.ecValues = prevEcValues
 	context.managedTypesValues.mBufferValues = prevmBufferValues
 	context.managedTypesValues.mMapValues = prevmMapValues
+	context.managedTypesValues.memoryUsage = prevState.memoryUsage
 	context.managedTypesStack = context.managedTypesStack[:managedTypesStackLen-1]
+	context.discardTouchedBigInts()
 }
 
 // PopDiscard removes the latest entry from the state stack
@@ -247,7 +271,9 @@ func (context *managedTypesContext) GetBigIntOrCreate(handle int32) *big.Int {
 	if !ok {
 		value = big.NewInt(0)
 		context.managedTypesValues.bigIntValues[handle] = value
+		context.updateMemoryUsage(0, bigIntMemorySize(value))
 	}
+	context.trackBigInt(value)
 	return value
 }
 
@@ -258,9 +284,20 @@ func (context *managedTypesContext) GetBigInt(handle int32) (*big.Int, error) {
 		logMTypes.Trace("missing big int", "handle", handle)
 		return nil, vmhost.ErrNoBigIntUnderThisHandle
 	}
+	context.trackBigInt(value)
 	return value, nil
 }
 
+// GetBigIntHandles returns the handles of all big ints, in ascending order
+func (context *managedTypesContext) GetBigIntHandles() []int32 {
+	handles := make([]int32, 0, len(context.managedTypesValues.bigIntValues))
+	for handle := range context.managedTypesValues.bigIntValues {
+		handles = append(handles, handle)
+	}
+	sortHandles(handles)
+	return handles
+}
+
 // GetTwoBigInt returns the values at the two given handles. If there is at least one missing value, it will return error
 func (context *managedTypesContext) GetTwoBigInt(handle1 int32, handle2 int32) (*big.Int, *big.Int, error) {
 	bigIntValues := context.managedTypesValues.bigIntValues
@@ -274,6 +311,8 @@ func (context *managedTypesContext) GetTwoBigInt(handle1 int32, handle2 int32) (
 		logMTypes.Trace("missing big int", "handle", handle2)
 		return nil, nil, vmhost.ErrNoBigIntUnderThisHandle
 	}
+	context.trackBigInt(value1)
+	context.trackBigInt(value2)
 	return value1, value2, nil
 }
 
@@ -286,6 +325,7 @@ func (context *managedTypesContext) newBigIntNoCopy(value *big.Int) int32 {
 		newHandle++
 	}
 	context.managedTypesValues.bigIntValues[newHandle] = value
+	context.updateMemoryUsage(0, bigIntMemorySize(value))
 	return newHandle
 }
 
@@ -320,6 +360,7 @@ func (context *managedTypesContext) GetBigFloatOrCreate(handle int32) (*big.Floa
 	if !ok {
 		value = big.NewFloat(0)
 		context.managedTypesValues.bigFloatValues[handle] = value
+		context.updateMemoryUsage(0, bigFloatMemorySize)
 	}
 	if value.IsInf() {
 		return nil, vmhost.ErrInfinityFloatOperation
@@ -394,6 +435,7 @@ func (context *managedTypesContext) PutBigFloat(value *big.Float) (int32, error)
 	}
 
 	context.managedTypesValues.bigFloatValues[newHandle] = new(big.Float).Set(value)
+	context.updateMemoryUsage(0, bigFloatMemorySize)
 	return newHandle, nil
 }
 
@@ -410,7 +452,7 @@ func (context *managedTypesContext) NewBigIntFromInt64(int64Value int64) int32 {
 // ELLIPTIC CURVES
 
 // GetEllipticCurve returns the elliptic curve under the given handle. If there is no value under that handle, it will return error
-func (context *managedTypesContext) GetEllipticCurve(handle int32) (*elliptic.CurveParams, error) {
+func (context *managedTypesContext) GetEllipticCurve(handle int32) (*weierstrass.Curve, error) {
 	curve, ok := context.managedTypesValues.ecValues[handle]
 	if !ok {
 		return nil, vmhost.ErrNoEllipticCurveUnderThisHandle
@@ -418,8 +460,13 @@ func (context *managedTypesContext) GetEllipticCurve(handle int32) (*elliptic.Cu
 	return curve, nil
 }
 
-// PutEllipticCurve adds the given elliptic curve to the current ecValues map and returns the handle
+// PutEllipticCurve adds the given elliptic curve, with a = -3, to the current ecValues map and returns the handle
 func (context *managedTypesContext) PutEllipticCurve(curve *elliptic.CurveParams) int32 {
+	return context.PutCustomEllipticCurve(weierstrass.NewCurveFromParams(curve))
+}
+
+// PutCustomEllipticCurve adds the given short Weierstrass curve to the current ecValues map and returns the handle
+func (context *managedTypesContext) PutCustomEllipticCurve(curve *weierstrass.Curve) int32 {
 	newHandle := int32(len(context.managedTypesValues.ecValues))
 	for {
 		if _, ok := context.managedTypesValues.ecValues[newHandle]; !ok {
@@ -427,7 +474,9 @@ func (context *managedTypesContext) PutEllipticCurve(curve *elliptic.CurveParams
 		}
 		newHandle++
 	}
-	context.managedTypesValues.ecValues[newHandle] = &elliptic.CurveParams{P: curve.P, N: curve.N, B: curve.B, Gx: curve.Gx, Gy: curve.Gy, BitSize: curve.BitSize, Name: curve.Name}
+	params := &elliptic.CurveParams{P: curve.P, N: curve.N, B: curve.B, Gx: curve.Gx, Gy: curve.Gy, BitSize: curve.BitSize, Name: curve.Name}
+	context.managedTypesValues.ecValues[newHandle] = weierstrass.NewCurve(params, curve.A)
+	context.updateMemoryUsage(0, ellipticCurveMemorySize(curve))
 	return newHandle
 }
 
@@ -446,17 +495,12 @@ func (context *managedTypesContext) Get100xCurveGasCostMultiplier(ecHandle int32
 	if sizeOfField < 0 {
 		return -1
 	}
-	switch sizeOfField {
-	case 224:
-		return p224CurveMultiplier
-	case 256:
-		return p256CurveMultiplier
-	case 384:
-		return p384CurveMultiplier
-	case 521:
-		return p521CurveMultiplier
-	}
-	return -1
+	return curveGasCostMultiplier(sizeOfField, [...]int32{
+		p224CurveMultiplier,
+		p256CurveMultiplier,
+		p384CurveMultiplier,
+		p521CurveMultiplier,
+	})
 }
 
 // GetScalarMult100xCurveGasCostMultiplier returns (100*multiplier) to be used with the basic gasCost within ScalarMult/ScalarBaseMult function depending on which curve is used
@@ -465,17 +509,12 @@ func (context *managedTypesContext) GetScalarMult100xCurveGasCostMultiplier(ecHa
 	if sizeOfField < 0 {
 		return -1
 	}
-	switch sizeOfField {
-	case 224:
-		return p224CurveScalarMultMultiplier
-	case 256:
-		return p256CurveScalarMultMultiplier
-	case 384:
-		return p384CurveScalarMultMultiplier
-	case 521:
-		return p521CurveScalarMultMultiplier
-	}
-	return -1
+	return curveGasCostMultiplier(sizeOfField, [...]int32{
+		p224CurveScalarMultMultiplier,
+		p256CurveScalarMultMultiplier,
+		p384CurveScalarMultMultiplier,
+		p521CurveScalarMultMultiplier,
+	})
 }
 
 // GetUCompressed100xCurveGasCostMultiplier returns (100*multiplier) to be used with the basic gasCost within UnmarshalCompressed function depending on which curve is used
@@ -484,15 +523,28 @@ func (context *managedTypesContext) GetUCompressed100xCurveGasCostMultiplier(ecH
 	if sizeOfField < 0 {
 		return -1
 	}
-	switch sizeOfField {
-	case 224:
-		return p224CurveUnmarshalCompressedMultiplier
-	case 256:
-		return p256CurveUnmarshalCompressedMultiplier
-	case 384:
-		return p384CurveUnmarshalCompressedMultiplier
-	case 521:
-		return p521CurveUnmarshalCompressedMultiplier
+
+	// the square root is much slower when the field order is 1 mod 4, as for p224
+	curve := context.managedTypesValues.ecValues[ecHandle]
+	if curve.P.Bit(1) == 0 && sizeOfField <= curveFieldSizes[len(curveFieldSizes)-1] {
+		return p224CurveUnmarshalCompressedMultiplier * sizeOfField / curveFieldSizes[0]
+	}
+
+	return curveGasCostMultiplier(sizeOfField, [...]int32{
+		p224CurveUnmarshalCompressedMultiplier,
+		p256CurveUnmarshalCompressedMultiplier,
+		p384CurveUnmarshalCompressedMultiplier,
+		p521CurveUnmarshalCompressedMultiplier,
+	})
+}
+
+// curveGasCostMultiplier returns the multiplier of the smallest NIST curve with a field at least as large as the
+// given one, or -1 if the field is larger than the ones of all the NIST curves
+func curveGasCostMultiplier(sizeOfField int32, multipliers [len(curveFieldSizes)]int32) int32 {
+	for i, nistSizeOfField := range curveFieldSizes {
+		if sizeOfField <= nistSizeOfField {
+			return multipliers[i]
+		}
 	}
 	return -1
 }
@@ -519,6 +571,7 @@ func (context *managedTypesContext) NewManagedBuffer() int32 {
 	}
 	newmBuffer := make([]byte, 0)
 	context.managedTypesValues.mBufferValues[newHandle] = newmBuffer
+	context.updateMemoryUsage(0, managedBufferMemorySize(newmBuffer))
 	return newHandle
 }
 
@@ -529,11 +582,17 @@ func (context *managedTypesContext) NewManagedBufferFromBytes(bytes []byte) int3
 	return mBufferHandle
 }
 
-// SetBytes sets the bytes given as value for the managed buffer
-func (context *managedTypesContext) SetBytes(mBufferHandle int32, bytes []byte) {
-	_, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
+// SetBytes sets the bytes given as value for the managed buffer. The bytes are not set if they would exceed
+// the memory budget, in which case the execution is failed and ErrMemoryBudgetExceeded is returned.
+func (context *managedTypesContext) SetBytes(mBufferHandle int32, bytes []byte) error {
+	oldmBuffer, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
 	if !ok {
-		context.managedTypesValues.mBufferValues[mBufferHandle] = make([]byte, 0)
+		oldmBuffer = make([]byte, 0)
+		context.managedTypesValues.mBufferValues[mBufferHandle] = oldmBuffer
+		context.updateMemoryUsage(0, managedBufferMemorySize(oldmBuffer))
+	}
+	if len(bytes) > len(oldmBuffer) && !context.reserveMemory(uint64(len(bytes)-len(oldmBuffer))) {
+		return vmhost.ErrMemoryBudgetExceeded
 	}
 
 	// always performing a copy,
@@ -542,6 +601,18 @@ func (context *managedTypesContext) SetBytes(mBufferHandle int32, bytes []byte)
 	copy(bytesCopy, bytes)
 
 	context.managedTypesValues.mBufferValues[mBufferHandle] = bytesCopy
+	context.updateMemoryUsage(managedBufferMemorySize(oldmBuffer), managedBufferMemorySize(bytesCopy))
+	return nil
+}
+
+// GetManagedBufferHandles returns the handles of all managed buffers, in ascending order
+func (context *managedTypesContext) GetManagedBufferHandles() []int32 {
+	handles := make([]int32, 0, len(context.managedTypesValues.mBufferValues))
+	for handle := range context.managedTypesValues.mBufferValues {
+		handles = append(handles, handle)
+	}
+	sortHandles(handles)
+	return handles
 }
 
 // GetBytes returns the bytes for the managed buffer. Returns nil as value and error if buffer is non-existent
@@ -553,14 +624,20 @@ func (context *managedTypesContext) GetBytes(mBufferHandle int32) ([]byte, error
 	return mBuffer, nil
 }
 
-// AppendBytes appends the given bytes to the buffer at the end
-func (context *managedTypesContext) AppendBytes(mBufferHandle int32, bytes []byte) bool {
-	_, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
+// AppendBytes appends the given bytes to the buffer at the end. Returns error if the buffer is non-existent.
+// The bytes are not appended if they would exceed the memory budget, in which case the execution is failed
+// and ErrMemoryBudgetExceeded is returned.
+func (context *managedTypesContext) AppendBytes(mBufferHandle int32, bytes []byte) error {
+	mBuffer, ok := context.managedTypesValues.mBufferValues[mBufferHandle]
 	if !ok {
-		return false
+		return vmhost.ErrNoManagedBufferUnderThisHandle
 	}
-	context.managedTypesValues.mBufferValues[mBufferHandle] = append(context.managedTypesValues.mBufferValues[mBufferHandle], bytes...)
-	return true
+	if !context.reserveMemory(uint64(len(bytes))) {
+		return vmhost.ErrMemoryBudgetExceeded
+	}
+	context.managedTypesValues.mBufferValues[mBufferHandle] = append(mBuffer, bytes...)
+	context.updateMemoryUsage(0, uint64(len(bytes)))
+	return nil
 }
 
 // GetLength returns the length of the managed buffer
@@ -593,12 +670,14 @@ func (context *managedTypesContext) DeleteSlice(mBufferHandle int32, startPositi
 	if lengthOfSlice < 0 || startPosition < 0 {
 		return nil, vmhost.ErrBadBounds
 	}
+	oldLength := len(mBuffer)
 	if int(lengthOfSlice) > len(mBuffer)-int(startPosition) {
 		mBuffer = mBuffer[:startPosition]
 	} else {
 		mBuffer = append(mBuffer[:startPosition], mBuffer[startPosition+lengthOfSlice:]...)
 	}
 	context.managedTypesValues.mBufferValues[mBufferHandle] = mBuffer
+	context.updateMemoryUsage(uint64(oldLength), uint64(len(mBuffer)))
 	return context.managedTypesValues.mBufferValues[mBufferHandle], nil
 }
 
@@ -611,8 +690,12 @@ func (context *managedTypesContext) InsertSlice(mBufferHandle int32, startPositi
 	if startPosition < 0 || startPosition > int32(len(mBuffer))-1 {
 		return nil, vmhost.ErrBadBounds
 	}
+	if !context.reserveMemory(uint64(len(slice))) {
+		return nil, vmhost.ErrMemoryBudgetExceeded
+	}
 	mBuffer = append(mBuffer[:startPosition], append(slice, mBuffer[startPosition:]...)...)
 	context.managedTypesValues.mBufferValues[mBufferHandle] = mBuffer
+	context.updateMemoryUsage(0, uint64(len(slice)))
 	return context.managedTypesValues.mBufferValues[mBufferHandle], nil
 }
 
@@ -680,6 +763,7 @@ func (context *managedTypesContext) NewManagedMap() int32 {
 	}
 	newmMap := make(map[string][]byte, 0)
 	context.managedTypesValues.mMapValues[newHandle] = newmMap
+	context.updateMemoryUsage(0, managedValueMemoryOverhead)
 	return newHandle
 }
 
@@ -699,12 +783,20 @@ func (context *managedTypesContext) ManagedMapPut(mMapHandle int32, keyHandle in
 	if err != nil {
 		return err
 	}
+	if !context.reserveMemory(managedMapEntryMemorySize(string(key), value)) {
+		return vmhost.ErrMemoryBudgetExceeded
+	}
 	valueCopy := make([]byte, len(value))
 	copy(valueCopy, value)
 
 	context.ConsumeGasForBytes(value)
 
+	oldValue, found := mMap[string(key)]
+	if found {
+		context.updateMemoryUsage(managedMapEntryMemorySize(string(key), oldValue), 0)
+	}
 	mMap[string(key)] = valueCopy
+	context.updateMemoryUsage(0, managedMapEntryMemorySize(string(key), valueCopy))
 
 	return nil
 }
@@ -724,7 +816,7 @@ func (context *managedTypesContext) ManagedMapGet(mMapHandle int32, keyHandle in
 
 // ManagedMapRemove removes the bytes stored as the key handle and returns it in an output value handle
 func (context *managedTypesContext) ManagedMapRemove(mMapHandle int32, keyHandle int32, outValueHandle int32) error {
-	mMap, key, value, _, err := context.getKeyValueFromManagedMap(mMapHandle, keyHandle)
+	mMap, key, value, foundValue, err := context.getKeyValueFromManagedMap(mMapHandle, keyHandle)
 	if err != nil {
 		return err
 	}
@@ -732,6 +824,9 @@ func (context *managedTypesContext) ManagedMapRemove(mMapHandle int32, keyHandle
 	context.SetBytes(outValueHandle, value)
 	context.ConsumeGasForBytes(value)
 
+	if foundValue {
+		context.updateMemoryUsage(managedMapEntryMemorySize(string(key), value), 0)
+	}
 	delete(mMap, string(key))
 	return nil
 }
@@ -761,3 +856,9 @@ func (context *managedTypesContext) getKeyValueFromManagedMap(mMapHandle int32,
 
 	return mMap, key, value, foundValue, nil
 }
+
+func sortHandles(handles []int32) {
+	sort.Slice(handles, func(i, j int) bool {
+		return handles[i] < handles[j]
+	})
+}
@@ -0,0 +1,119 @@
+package contexts
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/multiversx/mx-chain-vm-go/crypto/weierstrass"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// managedValueMemoryOverhead approximates the memory taken by a managed value besides its contents,
+// i.e. the handle, the map entry and the slice or pointer header
+const managedValueMemoryOverhead = 32
+
+// bigFloatMemorySize approximates the memory taken by a big float, which always has the same precision
+const bigFloatMemorySize = managedValueMemoryOverhead + 64
+
+// MemoryUsage returns an estimation, in bytes, of the memory held by the managed values of the active state,
+// together with the copies of the big numbers kept on the state stack. The big ints grown in place are only
+// accounted when the transaction has a memory budget.
+func (context *managedTypesContext) MemoryUsage() uint64 {
+	context.settleBigIntMemoryUsage()
+
+	usage := context.managedTypesValues.memoryUsage
+	for _, state := range context.managedTypesStack {
+		usage += state.copiedMemoryUsage
+	}
+	return usage
+}
+
+// reserveMemory checks, before an allocation, that the transaction can hold additionalSize more bytes
+// within its memory budget. Otherwise it fails the execution and returns false.
+func (context *managedTypesContext) reserveMemory(additionalSize uint64) bool {
+	budget := vmhost.TransactionMemoryBudget(context.host)
+	if budget == 0 {
+		return true
+	}
+
+	usage := vmhost.TransactionMemoryUsage(context.host)
+	if usage <= budget && additionalSize <= budget-usage {
+		return true
+	}
+
+	logMTypes.Trace("transaction memory budget exceeded",
+		"usage", usage,
+		"additional size", additionalSize,
+		"budget", budget)
+	context.host.Runtime().FailExecution(vmhost.ErrMemoryBudgetExceeded)
+	return false
+}
+
+// trackBigInt remembers the size of a big int handed out for modification,
+// so that its growth is accounted the next time the memory usage is settled
+func (context *managedTypesContext) trackBigInt(value *big.Int) {
+	if !context.bigIntTrackingEnabled {
+		return
+	}
+	_, ok := context.touchedBigInts[value]
+	if ok {
+		return
+	}
+	context.touchedBigInts[value] = bigIntMemorySize(value)
+}
+
+func (context *managedTypesContext) settleBigIntMemoryUsage() {
+	for value, sizeBefore := range context.touchedBigInts {
+		context.updateMemoryUsage(sizeBefore, bigIntMemorySize(value))
+	}
+	context.discardTouchedBigInts()
+}
+
+func (context *managedTypesContext) discardTouchedBigInts() {
+	if len(context.touchedBigInts) == 0 {
+		return
+	}
+	context.touchedBigInts = make(map[*big.Int]uint64)
+}
+
+func (context *managedTypesContext) updateMemoryUsage(sizeBefore uint64, sizeAfter uint64) {
+	state := &context.managedTypesValues
+	if state.memoryUsage < sizeBefore {
+		state.memoryUsage = 0
+	} else {
+		state.memoryUsage -= sizeBefore
+	}
+	state.memoryUsage += sizeAfter
+}
+
+// copiedMemoryUsage returns the memory held by the big numbers of the active state,
+// which are the only values copied when the state is pushed on the stack
+func (context *managedTypesContext) copiedMemoryUsage() uint64 {
+	usage := uint64(len(context.managedTypesValues.bigFloatValues)) * bigFloatMemorySize
+	for _, value := range context.managedTypesValues.bigIntValues {
+		usage += bigIntMemorySize(value)
+	}
+	return usage
+}
+
+func bigIntMemorySize(value *big.Int) uint64 {
+	return managedValueMemoryOverhead + uint64(cap(value.Bits()))*bits.UintSize/8
+}
+
+func managedBufferMemorySize(buffer []byte) uint64 {
+	return managedValueMemoryOverhead + uint64(len(buffer))
+}
+
+func managedMapEntryMemorySize(key string, value []byte) uint64 {
+	return managedValueMemoryOverhead + uint64(len(key)) + uint64(len(value))
+}
+
+func ellipticCurveMemorySize(curve *weierstrass.Curve) uint64 {
+	usage := uint64(managedValueMemoryOverhead + len(curve.Name))
+	for _, value := range []*big.Int{curve.P, curve.N, curve.A, curve.B, curve.Gx, curve.Gy} {
+		if value != nil {
+			usage += bigIntMemorySize(value)
+		}
+	}
+	return usage
+}
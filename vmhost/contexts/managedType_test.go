@@ -7,17 +7,27 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-vm-go/config"
 	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+func newManagedTypesTestHost() *contextmock.VMHostStub {
+	return &contextmock.VMHostStub{
+		EnableEpochsHandlerCalled: func() vmhost.EnableEpochsHandler {
+			return &worldmock.EnableEpochsHandlerStub{}
+		},
+	}
+}
+
 func TestNewManagedTypes(t *testing.T) {
 	t.Parallel()
 
-	host := &contextmock.VMHostStub{}
+	host := newManagedTypesTestHost()
 
 	managedTypesCtx, err := NewManagedTypesContext(host)
 	currentStateValues := managedTypesCtx.managedTypesValues
@@ -79,13 +89,12 @@ func TestManagedTypesContext_Randomness(t *testing.T) {
 
 func TestManagedTypesContext_ClearStateStack(t *testing.T) {
 	t.Parallel()
-	host := &contextmock.VMHostStub{
-		BlockchainCalled: func() vmhost.BlockchainContext {
-			return &mock.BlockchainContextMock{}
-		},
-		RuntimeCalled: func() vmhost.RuntimeContext {
-			return &contextmock.RuntimeContextMock{CurrentTxHash: bytes.Repeat([]byte{1}, 32)}
-		},
+	host := newManagedTypesTestHost()
+	host.BlockchainCalled = func() vmhost.BlockchainContext {
+		return &mock.BlockchainContextMock{}
+	}
+	host.RuntimeCalled = func() vmhost.RuntimeContext {
+		return &contextmock.RuntimeContextMock{CurrentTxHash: bytes.Repeat([]byte{1}, 32)}
 	}
 	intValue1, intValue2 := int64(100), int64(200)
 	floatValue1, floatValue2 := 307.72, 78.008
@@ -126,10 +135,10 @@ func TestManagedTypesContext_ClearStateStack(t *testing.T) {
 	require.Nil(t, err)
 	ec1, err := managedTypesCtx.GetEllipticCurve(ecHandle1)
 	require.Nil(t, err)
-	require.Equal(t, p224ec, ec1)
+	require.Equal(t, p224ec, ec1.CurveParams)
 	ec2, err := managedTypesCtx.GetEllipticCurve(ecHandle2)
 	require.Nil(t, err)
-	require.Equal(t, p256ec, ec2)
+	require.Equal(t, p256ec, ec2.CurveParams)
 
 	managedTypesCtx.InitState()
 	bigInt1, err = managedTypesCtx.GetBigInt(bigIntHandle1)
@@ -154,7 +163,7 @@ func TestManagedTypesContext_ClearStateStack(t *testing.T) {
 
 func TestManagedTypesContext_InitPushPopState(t *testing.T) {
 	t.Parallel()
-	host := &contextmock.VMHostStub{}
+	host := newManagedTypesTestHost()
 	intValue1, intValue2, intValue3 := int64(100), int64(200), int64(-42)
 	floatValue1, floatValue2, floatValue3 := 307.72, 78.008, -37.84732
 	p224ec, p256ec, p384ec, p521ec := elliptic.P224().Params(), elliptic.P256().Params(), elliptic.P384().Params(), elliptic.P521().Params()
@@ -194,10 +203,10 @@ func TestManagedTypesContext_InitPushPopState(t *testing.T) {
 
 	ec1, err := managedTypesCtx.GetEllipticCurve(ecHandle1)
 	require.Nil(t, err)
-	require.Equal(t, p224ec, ec1)
+	require.Equal(t, p224ec, ec1.CurveParams)
 	ec2, err := managedTypesCtx.GetEllipticCurve(ecHandle2)
 	require.Nil(t, err)
-	require.Equal(t, p256ec, ec2)
+	require.Equal(t, p256ec, ec2.CurveParams)
 
 	mBufferHandle1 := managedTypesCtx.NewManagedBufferFromBytes(mBytes)
 	mBuffer, _ := managedTypesCtx.GetBytes(mBufferHandle1)
@@ -283,7 +292,7 @@ func TestManagedTypesContext_InitPushPopState(t *testing.T) {
 	require.Equal(t, int32(0), ecHandle3)
 	ec3, err := managedTypesCtx.GetEllipticCurve(ecHandle3)
 	require.Nil(t, err)
-	require.Equal(t, p384ec, ec3)
+	require.Equal(t, p384ec, ec3.CurveParams)
 
 	p384NormalGasCostMultiplier := managedTypesCtx.Get100xCurveGasCostMultiplier(ecHandle3)
 	require.Equal(t, int32(200), p384NormalGasCostMultiplier)
@@ -353,7 +362,7 @@ func TestManagedTypesContext_InitPushPopState(t *testing.T) {
 	ecIndex4 := managedTypesCtx.PutEllipticCurve(p521ec)
 	require.Equal(t, int32(0), ecIndex4)
 	ec4, err := managedTypesCtx.GetEllipticCurve(ecIndex4)
-	require.Equal(t, p521ec, ec4)
+	require.Equal(t, p521ec, ec4.CurveParams)
 	require.Nil(t, err)
 
 	p521NormalGasCostMultiplier := managedTypesCtx.Get100xCurveGasCostMultiplier(ecIndex4)
@@ -376,7 +385,7 @@ func TestManagedTypesContext_InitPushPopState(t *testing.T) {
 	require.Nil(t, err)
 
 	ec4, err = managedTypesCtx.GetEllipticCurve(ecIndex4)
-	require.Equal(t, p521ec, ec4)
+	require.Equal(t, p521ec, ec4.CurveParams)
 	require.Nil(t, err)
 	// Restore the first active state by popping to the active state (which is
 	// lost).
@@ -407,15 +416,15 @@ func TestManagedTypesContext_InitPushPopState(t *testing.T) {
 
 	ec1, err = managedTypesCtx.GetEllipticCurve(ecHandle1)
 	require.Nil(t, err)
-	require.Equal(t, p224ec, ec1)
+	require.Equal(t, p224ec, ec1.CurveParams)
 	ec2, err = managedTypesCtx.GetEllipticCurve(ecHandle2)
 	require.Nil(t, err)
-	require.Equal(t, p256ec, ec2)
+	require.Equal(t, p256ec, ec2.CurveParams)
 }
 
 func TestManagedTypesContext_PutGetBigInt(t *testing.T) {
 	t.Parallel()
-	host := &contextmock.VMHostStub{}
+	host := newManagedTypesTestHost()
 
 	intValue1, intValue2, intValue3, intValue4 := int64(100), int64(200), int64(-42), int64(-80)
 	managedTypesCtx, _ := NewManagedTypesContext(host)
@@ -467,7 +476,7 @@ func TestManagedTypesContext_PutGetBigInt(t *testing.T) {
 
 func TestManagedTypesContext_PutGetBigFloat(t *testing.T) {
 	t.Parallel()
-	host := &contextmock.VMHostStub{}
+	host := newManagedTypesTestHost()
 
 	floatValue1, floatValue2, floatValue3, floatValue4 := 23.56, 62.8453, -8234.6512, -0.0001
 	managedTypesCtx, _ := NewManagedTypesContext(host)
@@ -549,7 +558,7 @@ func TestManagedTypesContext_PutGetBigFloat(t *testing.T) {
 }
 func TestManagedTypesContext_NewBigIntCopied(t *testing.T) {
 	t.Parallel()
-	host := &contextmock.VMHostStub{}
+	host := newManagedTypesTestHost()
 	managedTypesCtx, _ := NewManagedTypesContext(host)
 
 	originalBigInt := big.NewInt(3)
@@ -564,7 +573,7 @@ func TestManagedTypesContext_NewBigIntCopied(t *testing.T) {
 
 func TestManagedTypesContext_PutGetEllipticCurves(t *testing.T) {
 	t.Parallel()
-	host := &contextmock.VMHostStub{}
+	host := newManagedTypesTestHost()
 
 	p224ec, p256ec, p384ec, p521ec := elliptic.P224().Params(), elliptic.P256().Params(), elliptic.P384().Params(), elliptic.P521().Params()
 	managedTypesCtx, _ := NewManagedTypesContext(host)
@@ -587,10 +596,10 @@ func TestManagedTypesContext_PutGetEllipticCurves(t *testing.T) {
 
 	ec1, err := managedTypesCtx.GetEllipticCurve(ecHandle1)
 	require.Nil(t, err)
-	require.Equal(t, p224ec, ec1)
+	require.Equal(t, p224ec, ec1.CurveParams)
 	ec2, err := managedTypesCtx.GetEllipticCurve(ecHandle2)
 	require.Nil(t, err)
-	require.Equal(t, p256ec, ec2)
+	require.Equal(t, p256ec, ec2.CurveParams)
 	ec4, err := managedTypesCtx.GetEllipticCurve(int32(3))
 	require.Nil(t, ec4)
 	require.Equal(t, vmhost.ErrNoEllipticCurveUnderThisHandle, err)
@@ -599,12 +608,47 @@ func TestManagedTypesContext_PutGetEllipticCurves(t *testing.T) {
 	require.Equal(t, int32(3), ecHandle4)
 	ec4, err = managedTypesCtx.GetEllipticCurve(ecHandle4)
 	require.Nil(t, err)
-	require.Equal(t, p521ec, ec4)
+	require.Equal(t, p521ec, ec4.CurveParams)
+}
+
+func TestManagedTypesContext_CustomEllipticCurveGasCostMultipliers(t *testing.T) {
+	t.Parallel()
+	host := newManagedTypesTestHost()
+	managedTypesCtx, _ := NewManagedTypesContext(host)
+
+	putCurve := func(fieldOrder *big.Int) int32 {
+		return managedTypesCtx.PutEllipticCurve(&elliptic.CurveParams{P: fieldOrder, BitSize: fieldOrder.BitLen()})
+	}
+	powerOfTwo := func(exponent uint) *big.Int {
+		return new(big.Int).Lsh(big.NewInt(1), exponent)
+	}
+
+	// smaller fields cost as much as the p224 curve
+	ecHandle := putCurve(new(big.Int).Sub(powerOfTwo(192), big.NewInt(1)))
+	require.Equal(t, int32(100), managedTypesCtx.Get100xCurveGasCostMultiplier(ecHandle))
+	require.Equal(t, int32(100), managedTypesCtx.GetScalarMult100xCurveGasCostMultiplier(ecHandle))
+	require.Equal(t, int32(2000), managedTypesCtx.GetUCompressed100xCurveGasCostMultiplier(ecHandle))
+
+	// fields between the NIST ones cost as much as the next larger one
+	ecHandle = putCurve(new(big.Int).Sub(powerOfTwo(320), big.NewInt(1)))
+	require.Equal(t, int32(200), managedTypesCtx.Get100xCurveGasCostMultiplier(ecHandle))
+	require.Equal(t, int32(150), managedTypesCtx.GetScalarMult100xCurveGasCostMultiplier(ecHandle))
+	require.Equal(t, int32(200), managedTypesCtx.GetUCompressed100xCurveGasCostMultiplier(ecHandle))
+
+	// a field order of 1 mod 4 makes unmarshalling compressed points as expensive as for the p224 curve
+	ecHandle = putCurve(new(big.Int).Add(powerOfTwo(255), big.NewInt(1)))
+	require.Equal(t, int32(135), managedTypesCtx.Get100xCurveGasCostMultiplier(ecHandle))
+	require.Equal(t, int32(2285), managedTypesCtx.GetUCompressed100xCurveGasCostMultiplier(ecHandle))
+
+	ecHandle = putCurve(new(big.Int).Sub(powerOfTwo(600), big.NewInt(1)))
+	require.Equal(t, int32(-1), managedTypesCtx.Get100xCurveGasCostMultiplier(ecHandle))
+	require.Equal(t, int32(-1), managedTypesCtx.GetScalarMult100xCurveGasCostMultiplier(ecHandle))
+	require.Equal(t, int32(-1), managedTypesCtx.GetUCompressed100xCurveGasCostMultiplier(ecHandle))
 }
 
 func TestManagedTypesContext_ManagedBuffersFunctionalities(t *testing.T) {
 	t.Parallel()
-	host := &contextmock.VMHostStub{}
+	host := newManagedTypesTestHost()
 	managedTypesCtx, _ := NewManagedTypesContext(host)
 	mBytes := []byte{2, 234, 64, 255}
 	emptyBuffer := make([]byte, 0)
@@ -622,8 +666,8 @@ func TestManagedTypesContext_ManagedBuffersFunctionalities(t *testing.T) {
 	require.Equal(t, vmhost.ErrNoManagedBufferUnderThisHandle, err)
 	lengthOfmBuffer := managedTypesCtx.GetLength(noBufHandle)
 	require.Equal(t, int32(-1), lengthOfmBuffer)
-	isSuccess := managedTypesCtx.AppendBytes(noBufHandle, mBytes)
-	require.False(t, isSuccess)
+	err = managedTypesCtx.AppendBytes(noBufHandle, mBytes)
+	require.Equal(t, vmhost.ErrNoManagedBufferUnderThisHandle, err)
 	newBuf, err = managedTypesCtx.InsertSlice(noBufHandle, 0, mBytes)
 	require.Nil(t, newBuf)
 	require.Equal(t, vmhost.ErrNoManagedBufferUnderThisHandle, err)
@@ -674,16 +718,16 @@ func TestManagedTypesContext_ManagedBuffersFunctionalities(t *testing.T) {
 	require.Equal(t, emptyBuffer, newBuf)
 
 	// Append, GetLength
-	isSuccess = managedTypesCtx.AppendBytes(mBufferHandle1, mBytes)
-	require.True(t, isSuccess)
+	err = managedTypesCtx.AppendBytes(mBufferHandle1, mBytes)
+	require.Nil(t, err)
 	lengthOfmBuffer = managedTypesCtx.GetLength(mBufferHandle1)
 	require.Equal(t, int32(4), lengthOfmBuffer)
-	isSuccess = managedTypesCtx.AppendBytes(mBufferHandle1, mBytes)
-	require.True(t, isSuccess)
+	err = managedTypesCtx.AppendBytes(mBufferHandle1, mBytes)
+	require.Nil(t, err)
 	mBufferBytes, _ = managedTypesCtx.GetBytes(mBufferHandle1)
 	require.Equal(t, append(mBytes, mBytes...), mBufferBytes)
-	isSuccess = managedTypesCtx.AppendBytes(mBufferHandle1, emptyBuffer)
-	require.True(t, isSuccess)
+	err = managedTypesCtx.AppendBytes(mBufferHandle1, emptyBuffer)
+	require.Nil(t, err)
 	mBufferBytes, _ = managedTypesCtx.GetBytes(mBufferHandle1)
 	require.Equal(t, append(mBytes, mBytes...), mBufferBytes)
 
@@ -713,7 +757,7 @@ func TestManagedTypesContext_ManagedBuffersFunctionalities(t *testing.T) {
 
 func TestManagedTypesContext_PopSetActiveStateIfStackIsEmptyShouldNotPanic(t *testing.T) {
 	t.Parallel()
-	host := &contextmock.VMHostStub{}
+	host := newManagedTypesTestHost()
 
 	managedTypesCtx, _ := NewManagedTypesContext(host)
 	managedTypesCtx.PopSetActiveState()
@@ -723,10 +767,120 @@ func TestManagedTypesContext_PopSetActiveStateIfStackIsEmptyShouldNotPanic(t *te
 
 func TestManagedTypesContext_PopDiscardIfStackIsEmptyShouldNotPanic(t *testing.T) {
 	t.Parallel()
-	host := &contextmock.VMHostStub{}
+	host := newManagedTypesTestHost()
 
 	managedTypesCtx, _ := NewManagedTypesContext(host)
 	managedTypesCtx.PopDiscard()
 
 	require.Equal(t, 0, len(managedTypesCtx.managedTypesStack))
 }
+
+func TestManagedTypesContext_MemoryUsage(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule := config.MakeGasMapForTests()
+	gasSchedule["MaxPerTransaction"]["MemoryBudget"] = 1 << 30
+	metering := &contextmock.MeteringContextMock{}
+	metering.SetGasSchedule(gasSchedule)
+	instanceTracker, _ := NewInstanceTracker()
+	host := &contextmock.VMHostMock{
+		MeteringContext:          metering,
+		RuntimeContext:           &contextmock.RuntimeContextMock{InstanceTracker: instanceTracker},
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{IsMemoryBudgetFlagEnabledField: true},
+	}
+
+	managedTypesCtx, _ := NewManagedTypesContext(host)
+	host.ManagedTypesContext = managedTypesCtx
+	managedTypesCtx.InitState()
+	require.Equal(t, uint64(0), managedTypesCtx.MemoryUsage())
+
+	mBufferHandle := managedTypesCtx.NewManagedBufferFromBytes(make([]byte, 100))
+	require.Equal(t, uint64(managedValueMemoryOverhead+100), managedTypesCtx.MemoryUsage())
+
+	managedTypesCtx.AppendBytes(mBufferHandle, make([]byte, 50))
+	require.Equal(t, uint64(managedValueMemoryOverhead+150), managedTypesCtx.MemoryUsage())
+
+	managedTypesCtx.SetBytes(mBufferHandle, make([]byte, 10))
+	require.Equal(t, uint64(managedValueMemoryOverhead+10), managedTypesCtx.MemoryUsage())
+
+	_, err := managedTypesCtx.DeleteSlice(mBufferHandle, 0, 5)
+	require.Nil(t, err)
+	require.Equal(t, uint64(managedValueMemoryOverhead+5), managedTypesCtx.MemoryUsage())
+
+	// big ints grown in place are accounted when the memory usage is settled
+	buffersUsage := managedTypesCtx.MemoryUsage()
+	bigIntHandle := managedTypesCtx.NewBigIntFromInt64(1)
+	bigInt := managedTypesCtx.GetBigIntOrCreate(bigIntHandle)
+	bigInt.Lsh(bigInt, 8*1000)
+	require.Equal(t, buffersUsage+bigIntMemorySize(bigInt), managedTypesCtx.MemoryUsage())
+	require.Greater(t, managedTypesCtx.MemoryUsage(), buffersUsage+1000)
+
+	// the copies of the big ints on the stack are counted too
+	usageBeforePush := managedTypesCtx.MemoryUsage()
+	managedTypesCtx.PushState()
+	require.Greater(t, managedTypesCtx.MemoryUsage(), usageBeforePush+1000)
+
+	managedTypesCtx.NewManagedBufferFromBytes(make([]byte, 1000))
+	managedTypesCtx.PopSetActiveState()
+	require.Equal(t, usageBeforePush, managedTypesCtx.MemoryUsage())
+
+	managedTypesCtx.InitState()
+	require.Equal(t, uint64(0), managedTypesCtx.MemoryUsage())
+}
+
+func TestManagedTypesContext_MemoryBudget(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule := config.MakeGasMapForTests()
+	gasSchedule["MaxPerTransaction"]["MemoryBudget"] = 1000
+	metering := &contextmock.MeteringContextMock{}
+	metering.SetGasSchedule(gasSchedule)
+	instanceTracker, _ := NewInstanceTracker()
+	runtime := &contextmock.RuntimeContextMock{InstanceTracker: instanceTracker}
+	enableEpochsHandler := &worldmock.EnableEpochsHandlerStub{}
+	host := &contextmock.VMHostMock{
+		MeteringContext:          metering,
+		RuntimeContext:           runtime,
+		EnableEpochsHandlerField: enableEpochsHandler,
+	}
+
+	managedTypesCtx, _ := NewManagedTypesContext(host)
+	host.ManagedTypesContext = managedTypesCtx
+	managedTypesCtx.InitState()
+
+	// no bound before the flag is activated
+	mBufferHandle := managedTypesCtx.NewManagedBufferFromBytes(make([]byte, 2000))
+	require.Equal(t, int32(2000), managedTypesCtx.GetLength(mBufferHandle))
+	require.Nil(t, runtime.FailExecutionErr)
+
+	enableEpochsHandler.IsMemoryBudgetFlagEnabledField = true
+	managedTypesCtx.InitState()
+	mBufferHandle = managedTypesCtx.NewManagedBufferFromBytes(make([]byte, 500))
+	require.Nil(t, runtime.FailExecutionErr)
+
+	// the allocations which would exceed the budget are refused beforehand
+	err := managedTypesCtx.SetBytes(mBufferHandle, make([]byte, 1000))
+	require.Equal(t, vmhost.ErrMemoryBudgetExceeded, err)
+	require.Equal(t, vmhost.ErrMemoryBudgetExceeded, runtime.FailExecutionErr)
+	require.Equal(t, int32(500), managedTypesCtx.GetLength(mBufferHandle))
+
+	runtime.FailExecutionErr = nil
+	err = managedTypesCtx.AppendBytes(mBufferHandle, make([]byte, 1000))
+	require.Equal(t, vmhost.ErrMemoryBudgetExceeded, err)
+	require.Equal(t, vmhost.ErrMemoryBudgetExceeded, runtime.FailExecutionErr)
+	require.Equal(t, int32(500), managedTypesCtx.GetLength(mBufferHandle))
+
+	_, err = managedTypesCtx.InsertSlice(mBufferHandle, 0, make([]byte, 1000))
+	require.Equal(t, vmhost.ErrMemoryBudgetExceeded, err)
+	require.Equal(t, int32(500), managedTypesCtx.GetLength(mBufferHandle))
+
+	mMapHandle := managedTypesCtx.NewManagedMap()
+	keyHandle := managedTypesCtx.NewManagedBufferFromBytes([]byte("key"))
+	err = managedTypesCtx.ManagedMapPut(mMapHandle, keyHandle, mBufferHandle)
+	require.Equal(t, vmhost.ErrMemoryBudgetExceeded, err)
+
+	runtime.FailExecutionErr = nil
+	require.Nil(t, managedTypesCtx.AppendBytes(mBufferHandle, make([]byte, 100)))
+	require.Nil(t, runtime.FailExecutionErr)
+	require.Equal(t, int32(600), managedTypesCtx.GetLength(mBufferHandle))
+}
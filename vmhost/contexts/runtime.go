@@ -173,21 +173,18 @@ func (context *runtimeContext) makeInstanceFromCompiledCode(gasLimit uint64, new
 
 	blockchain := context.host.Blockchain()
 	found, compiledCode := blockchain.GetCompiledCode(codeHash)
+	if !found {
+		compiledCode, found = context.getPersistedCompiledCode(codeHash)
+		if found {
+			blockchain.SaveCompiledCode(codeHash, compiledCode)
+		}
+	}
 	if !found {
 		logRuntime.Trace("instance creation", "code", "cached compilation", "error", "compiled code was not found")
 		return false
 	}
 
-	gasSchedule := context.host.Metering().GasSchedule()
-	options := executor.CompilationOptions{
-		GasLimit:           gasLimit,
-		UnmeteredLocals:    uint64(gasSchedule.WASMOpcodeCost.LocalsUnmetered),
-		MaxMemoryGrow:      uint64(gasSchedule.WASMOpcodeCost.MaxMemoryGrow),
-		MaxMemoryGrowDelta: uint64(gasSchedule.WASMOpcodeCost.MaxMemoryGrowDelta),
-		OpcodeTrace:        false,
-		Metering:           true,
-		RuntimeBreakpoints: true,
-	}
+	options := context.compilationOptions(gasLimit)
 	newInstance, err := context.vmExecutor.NewInstanceFromCompiledCodeWithOptions(compiledCode, options)
 	if err != nil {
 		logRuntime.Error("instance creation", "from", "cached compilation", "error", err)
@@ -205,9 +202,10 @@ func (context *runtimeContext) makeInstanceFromCompiledCode(gasLimit uint64, new
 	return true
 }
 
-func (context *runtimeContext) makeInstanceFromContractByteCode(contract []byte, gasLimit uint64, newCode bool) error {
+// compilationOptions returns the options with which the contracts are compiled and instantiated
+func (context *runtimeContext) compilationOptions(gasLimit uint64) executor.CompilationOptions {
 	gasSchedule := context.host.Metering().GasSchedule()
-	options := executor.CompilationOptions{
+	return executor.CompilationOptions{
 		GasLimit:           gasLimit,
 		UnmeteredLocals:    uint64(gasSchedule.WASMOpcodeCost.LocalsUnmetered),
 		MaxMemoryGrow:      uint64(gasSchedule.WASMOpcodeCost.MaxMemoryGrow),
@@ -216,6 +214,10 @@ func (context *runtimeContext) makeInstanceFromContractByteCode(contract []byte,
 		Metering:           true,
 		RuntimeBreakpoints: true,
 	}
+}
+
+func (context *runtimeContext) makeInstanceFromContractByteCode(contract []byte, gasLimit uint64, newCode bool) error {
+	options := context.compilationOptions(gasLimit)
 	newInstance, err := context.vmExecutor.NewInstanceWithOptions(contract, options)
 	if err != nil {
 		context.iTracker.UnsetInstance()
@@ -303,6 +305,7 @@ func (context *runtimeContext) saveCompiledCode() {
 	codeHash := context.iTracker.CodeHash()
 	blockchain := context.host.Blockchain()
 	blockchain.SaveCompiledCode(codeHash, compiledCode)
+	context.persistCompiledCode(codeHash, compiledCode)
 	logRuntime.Trace("save compiled code", "codeHash", codeHash)
 
 	found, _ := blockchain.GetCompiledCode(codeHash)
@@ -586,6 +589,9 @@ func (context *runtimeContext) FailExecution(err error) {
 		if errors.Is(err, vmhost.ErrNotEnoughGas) {
 			breakpoint = vmhost.BreakpointOutOfGas
 		}
+		if errors.Is(err, vmhost.ErrMemoryBudgetExceeded) {
+			breakpoint = vmhost.BreakpointMemoryBudgetExceeded
+		}
 	} else {
 		message = "execution failed"
 		context.AddError(errors.New(message))
@@ -0,0 +1,71 @@
+package contexts
+
+import (
+	"encoding/json"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// getPersistedCompiledCode looks up the compiled code in the CompiledCodeStore of the host, if any.
+func (context *runtimeContext) getPersistedCompiledCode(codeHash []byte) ([]byte, bool) {
+	store := context.host.CompiledCodeStore()
+	if check.IfNil(store) {
+		return nil, false
+	}
+	key, ok := context.compiledCodeKey(codeHash)
+	if !ok {
+		return nil, false
+	}
+
+	compiledCode, found := store.Get(key)
+	logRuntime.Trace("persisted compiled code", "codeHash", codeHash, "found", found)
+	return compiledCode, found
+}
+
+// persistCompiledCode saves the compiled code in the CompiledCodeStore of the host, if any.
+func (context *runtimeContext) persistCompiledCode(codeHash []byte, compiledCode []byte) {
+	store := context.host.CompiledCodeStore()
+	if check.IfNil(store) {
+		return
+	}
+	key, ok := context.compiledCodeKey(codeHash)
+	if !ok {
+		return
+	}
+
+	err := store.Put(key, compiledCode)
+	if err != nil {
+		logRuntime.Warn("persist compiled code", "codeHash", codeHash, "error", err)
+	}
+}
+
+// compiledCodeKey identifies the compiled code of the current executor, opcode costs and compilation options.
+// Compiled code of executors without a compiled code version cannot be persisted.
+func (context *runtimeContext) compiledCodeKey(codeHash []byte) (vmhost.CompiledCodeKey, bool) {
+	executorVersion := executor.CompiledCodeVersion(context.vmExecutor)
+	if len(executorVersion) == 0 {
+		return vmhost.CompiledCodeKey{}, false
+	}
+
+	opcodeCosts, err := json.Marshal(context.host.Metering().GasSchedule().WASMOpcodeCost)
+	if err != nil {
+		logRuntime.Warn("opcode cost fingerprint", "error", err)
+		return vmhost.CompiledCodeKey{}, false
+	}
+
+	// the gas limit is set on the instance, it is not compiled into the code
+	compilationOptions, err := json.Marshal(context.compilationOptions(0))
+	if err != nil {
+		logRuntime.Warn("compilation options fingerprint", "error", err)
+		return vmhost.CompiledCodeKey{}, false
+	}
+
+	return vmhost.CompiledCodeKey{
+		CodeHash:                      codeHash,
+		ExecutorVersion:               vmhost.VMVersion + "/" + executorVersion,
+		OpcodeCostFingerprint:         context.hasher.Compute(string(opcodeCosts)),
+		CompilationOptionsFingerprint: context.hasher.Compute(string(compilationOptions)),
+	}, true
+}
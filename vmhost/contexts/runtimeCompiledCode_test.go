@@ -0,0 +1,37 @@
+package contexts
+
+import (
+	"testing"
+
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/codestore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeContext_PersistedCompiledCode(t *testing.T) {
+	host := InitializeVMAndWasmer()
+	runtimeCtx := makeDefaultRuntimeContext(t, host)
+	codeHash := []byte("code hash")
+
+	// no store configured
+	runtimeCtx.persistCompiledCode(codeHash, []byte("compiled"))
+	_, found := runtimeCtx.getPersistedCompiledCode(codeHash)
+	require.False(t, found)
+
+	store, err := codestore.NewFileCompiledCodeStore(t.TempDir(), 1<<20)
+	require.Nil(t, err)
+	host.CompiledCodeStoreField = store
+
+	runtimeCtx.persistCompiledCode(codeHash, []byte("compiled"))
+	compiledCode, found := runtimeCtx.getPersistedCompiledCode(codeHash)
+	require.True(t, found)
+	require.Equal(t, []byte("compiled"), compiledCode)
+
+	// other opcode costs require other compiled code
+	metering := host.MeteringContext.(*contextmock.MeteringContextMock)
+	opcodeCosts := *metering.GasCost.WASMOpcodeCost
+	opcodeCosts.I64Add++
+	metering.GasCost.WASMOpcodeCost = &opcodeCosts
+	_, found = runtimeCtx.getPersistedCompiledCode(codeHash)
+	require.False(t, found)
+}
@@ -0,0 +1,89 @@
+//go:build go1.18
+// +build go1.18
+
+package contexts
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzStartWasmerInstance feeds arbitrary bytecode to the executor and to the contract validator, as happens
+// when a contract is deployed or upgraded. The bytecode must either be rejected with an error, or produce an
+// instance which satisfies the validator, and the outcome must be the same when the bytecode is loaded again.
+// Run it with e.g.
+//
+//	go test ./vmhost/contexts -run XXX -fuzz FuzzStartWasmerInstance
+func FuzzStartWasmerInstance(f *testing.F) {
+	contractCode := vmhost.GetSCCode(counterWasmCode)
+	f.Add(contractCode)
+	f.Add(contractCode[:len(contractCode)/2])
+	f.Add([]byte{})
+	f.Add([]byte("contract"))
+	f.Add([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00})
+
+	f.Fuzz(func(t *testing.T, code []byte) {
+		firstErr := startFuzzedInstance(t, code)
+		secondErr := startFuzzedInstance(t, code)
+		if firstErr == nil {
+			require.Nil(t, secondErr)
+			return
+		}
+		require.NotNil(t, secondErr)
+		require.Equal(t, firstErr.Error(), secondErr.Error())
+	})
+}
+
+func FuzzWasmValidator_FunctionName(f *testing.F) {
+	f.Add("getArgument")
+	f.Add("callBack")
+	f.Add("init")
+	f.Add("")
+	f.Add("9lives")
+	f.Add("some-name")
+	f.Add("_valid_name_1")
+
+	validator := newWASMValidator(testImportNames(), builtInFunctions.NewBuiltInFunctionContainer())
+	f.Fuzz(func(t *testing.T, name string) {
+		err := validator.verifyValidFunctionName(name)
+		if err != nil {
+			require.ErrorIs(t, err, vmhost.ErrInvalidFunctionName)
+			return
+		}
+		require.NotEmpty(t, name)
+		require.Less(t, len(name), 256)
+		require.True(t, validCharactersOnly(name))
+		require.False(t, isFirstCharacterNumeric(name))
+		require.False(t, validator.reserved.IsReserved(name))
+	})
+}
+
+func startFuzzedInstance(t *testing.T, code []byte) error {
+	host := InitializeVMAndWasmer()
+	host.EnableEpochsHandlerField = worldmock.EnableEpochsHandlerStubAllFlags()
+	runtimeCtx := makeDefaultRuntimeContext(t, host)
+	defer runtimeCtx.ClearWarmInstanceCache()
+
+	runtimeCtx.SetMaxInstanceStackSize(1)
+	runtimeCtx.MustVerifyNextContractCode()
+
+	gasLimit := uint64(100000000)
+	err := runtimeCtx.StartWasmerInstance(code, gasLimit, true)
+	if err != nil {
+		require.Nil(t, runtimeCtx.GetInstance())
+		return err
+	}
+
+	instance := runtimeCtx.GetInstance()
+	require.NotNil(t, instance)
+	require.True(t, instance.HasMemory())
+	require.Equal(t, uint64(len(code)), runtimeCtx.GetSCCodeSize())
+	for _, functionName := range instance.GetFunctionNames() {
+		require.Nil(t, runtimeCtx.validator.verifyValidFunctionName(functionName))
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+// Package coverage records which exported functions and which VM hooks of each contract were executed.
+package coverage
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var _ vmhost.ExecutionCoverage = (*Coverage)(nil)
+
+// contractCoverage holds the calls recorded for one code hash.
+type contractCoverage struct {
+	functionNames []string
+	addresses     map[string]struct{}
+	functionCalls map[string]uint64
+	vmHookCalls   map[string]uint64
+}
+
+// Coverage collects the calls of exported functions and VM hooks, per contract code hash,
+// across all the executions of one or more VM hosts.
+type Coverage struct {
+	mutCoverage sync.Mutex
+	contracts   map[string]*contractCoverage
+}
+
+// NewCoverage creates a new, empty Coverage.
+func NewCoverage() *Coverage {
+	return &Coverage{
+		contracts: make(map[string]*contractCoverage),
+	}
+}
+
+// HasContract returns true if the exported functions of the contract are already known.
+func (coverage *Coverage) HasContract(codeHash []byte) bool {
+	coverage.mutCoverage.Lock()
+	defer coverage.mutCoverage.Unlock()
+
+	contract, found := coverage.contracts[string(codeHash)]
+	return found && contract.functionNames != nil
+}
+
+// AddContract sets the exported functions of the contract.
+func (coverage *Coverage) AddContract(codeHash []byte, functionNames []string) {
+	coverage.mutCoverage.Lock()
+	defer coverage.mutCoverage.Unlock()
+
+	contract := coverage.getOrCreateContract(codeHash)
+	contract.functionNames = append([]string{}, functionNames...)
+}
+
+// AddFunctionCall records a call of an exported function of the contract.
+func (coverage *Coverage) AddFunctionCall(codeHash []byte, contractAddress []byte, functionName string) {
+	coverage.mutCoverage.Lock()
+	defer coverage.mutCoverage.Unlock()
+
+	contract := coverage.getOrCreateContract(codeHash)
+	contract.addresses[string(contractAddress)] = struct{}{}
+	contract.functionCalls[functionName]++
+}
+
+// AddVMHookCall records a VM hook call made by the contract.
+func (coverage *Coverage) AddVMHookCall(codeHash []byte, hookName string) {
+	coverage.mutCoverage.Lock()
+	defer coverage.mutCoverage.Unlock()
+
+	coverage.getOrCreateContract(codeHash).vmHookCalls[hookName]++
+}
+
+func (coverage *Coverage) getOrCreateContract(codeHash []byte) *contractCoverage {
+	contract, found := coverage.contracts[string(codeHash)]
+	if !found {
+		contract = &contractCoverage{
+			addresses:     make(map[string]struct{}),
+			functionCalls: make(map[string]uint64),
+			vmHookCalls:   make(map[string]uint64),
+		}
+		coverage.contracts[string(codeHash)] = contract
+	}
+	return contract
+}
+
+// Reset discards all the calls recorded so far.
+func (coverage *Coverage) Reset() {
+	coverage.mutCoverage.Lock()
+	defer coverage.mutCoverage.Unlock()
+
+	coverage.contracts = make(map[string]*contractCoverage)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (coverage *Coverage) IsInterfaceNil() bool {
+	return coverage == nil
+}
+
+// CallCount is the number of calls of a function or of a VM hook.
+type CallCount struct {
+	Name  string `json:"name"`
+	Calls uint64 `json:"calls"`
+}
+
+// ContractReport is the coverage of one contract code. Functions lists all the exported functions,
+// including those never called, while VMHooks only lists the VM hooks called by the contract.
+type ContractReport struct {
+	CodeHash           string       `json:"codeHash"`
+	Addresses          []string     `json:"addresses"`
+	FunctionsCovered   int          `json:"functionsCovered"`
+	FunctionsTotal     int          `json:"functionsTotal"`
+	Functions          []*CallCount `json:"functions"`
+	UncoveredFunctions []string     `json:"uncoveredFunctions"`
+	VMHooks            []*CallCount `json:"vmHooks"`
+}
+
+// Report is the coverage of all the contracts, sorted by code hash, together with the VM hooks
+// that no contract called.
+type Report struct {
+	Contracts        []*ContractReport `json:"contracts"`
+	VMHooksCalled    int               `json:"vmHooksCalled"`
+	VMHooksTotal     int               `json:"vmHooksTotal"`
+	VMHooksNotCalled []string          `json:"vmHooksNotCalled"`
+}
+
+// Report summarizes the calls recorded so far. Codes and addresses are hex-encoded.
+func (coverage *Coverage) Report() *Report {
+	coverage.mutCoverage.Lock()
+	defer coverage.mutCoverage.Unlock()
+
+	report := &Report{
+		Contracts: make([]*ContractReport, 0, len(coverage.contracts)),
+	}
+	calledHooks := make(map[string]struct{})
+	for codeHash, contract := range coverage.contracts {
+		report.Contracts = append(report.Contracts, newContractReport([]byte(codeHash), contract))
+		for hookName := range contract.vmHookCalls {
+			calledHooks[hookName] = struct{}{}
+		}
+	}
+	sort.Slice(report.Contracts, func(i, j int) bool {
+		return report.Contracts[i].CodeHash < report.Contracts[j].CodeHash
+	})
+
+	report.VMHooksNotCalled = make([]string, 0)
+	for _, hookName := range executorwrapper.VMHookNames() {
+		report.VMHooksTotal++
+		if _, called := calledHooks[hookName]; called {
+			report.VMHooksCalled++
+		} else {
+			report.VMHooksNotCalled = append(report.VMHooksNotCalled, hookName)
+		}
+	}
+	return report
+}
+
+func newContractReport(codeHash []byte, contract *contractCoverage) *ContractReport {
+	contractReport := &ContractReport{
+		CodeHash:           hex.EncodeToString(codeHash),
+		Addresses:          make([]string, 0, len(contract.addresses)),
+		Functions:          make([]*CallCount, 0, len(contract.functionNames)),
+		UncoveredFunctions: make([]string, 0),
+		VMHooks:            sortedCallCounts(contract.vmHookCalls),
+	}
+	for address := range contract.addresses {
+		contractReport.Addresses = append(contractReport.Addresses, hex.EncodeToString([]byte(address)))
+	}
+	sort.Strings(contractReport.Addresses)
+
+	// functions called without being exported, e.g. the callbacks of built-in functions, are listed as well
+	functionCalls := make(map[string]uint64, len(contract.functionCalls))
+	for _, functionName := range contract.functionNames {
+		functionCalls[functionName] = 0
+	}
+	for functionName, calls := range contract.functionCalls {
+		functionCalls[functionName] = calls
+	}
+	contractReport.Functions = sortedCallCounts(functionCalls)
+	for _, function := range contractReport.Functions {
+		if function.Calls > 0 {
+			contractReport.FunctionsCovered++
+		} else {
+			contractReport.UncoveredFunctions = append(contractReport.UncoveredFunctions, function.Name)
+		}
+	}
+	contractReport.FunctionsTotal = len(contractReport.Functions)
+	return contractReport
+}
+
+func sortedCallCounts(calls map[string]uint64) []*CallCount {
+	counts := make([]*CallCount, 0, len(calls))
+	for name, numCalls := range calls {
+		counts = append(counts, &CallCount{Name: name, Calls: numCalls})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].Name < counts[j].Name
+	})
+	return counts
+}
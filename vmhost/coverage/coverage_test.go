@@ -0,0 +1,78 @@
+package coverage
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoverage_Report(t *testing.T) {
+	coverage := NewCoverage()
+	adderHash := []byte{0xad}
+	counterHash := []byte{0xc0}
+
+	require.False(t, coverage.HasContract(adderHash))
+	coverage.AddContract(adderHash, []string{"init", "add", "getSum"})
+	require.True(t, coverage.HasContract(adderHash))
+	coverage.AddFunctionCall(adderHash, []byte("adder"), "init")
+	coverage.AddVMHookCall(adderHash, "bigIntGetUnsignedArgument")
+	coverage.AddFunctionCall(adderHash, []byte("adder"), "add")
+	coverage.AddVMHookCall(adderHash, "bigIntGetUnsignedArgument")
+	coverage.AddVMHookCall(adderHash, "bigIntAdd")
+	coverage.AddFunctionCall(adderHash, []byte("adder2"), "add")
+
+	coverage.AddContract(counterHash, []string{"increment"})
+	coverage.AddFunctionCall(counterHash, []byte("counter"), "callBack")
+
+	report := coverage.Report()
+	require.Len(t, report.Contracts, 2)
+
+	adder := report.Contracts[0]
+	require.Equal(t, "ad", adder.CodeHash)
+	require.Equal(t, []string{"6164646572", "616464657232"}, adder.Addresses)
+	require.Equal(t, []*CallCount{{Name: "add", Calls: 2}, {Name: "getSum", Calls: 0}, {Name: "init", Calls: 1}}, adder.Functions)
+	require.Equal(t, 2, adder.FunctionsCovered)
+	require.Equal(t, 3, adder.FunctionsTotal)
+	require.Equal(t, []string{"getSum"}, adder.UncoveredFunctions)
+	require.Equal(t, []*CallCount{{Name: "bigIntAdd", Calls: 1}, {Name: "bigIntGetUnsignedArgument", Calls: 2}}, adder.VMHooks)
+
+	// functions called without being exported are reported too
+	counter := report.Contracts[1]
+	require.Equal(t, []*CallCount{{Name: "callBack", Calls: 1}, {Name: "increment", Calls: 0}}, counter.Functions)
+
+	require.Equal(t, 2, report.VMHooksCalled)
+	require.Equal(t, len(executorwrapper.VMHookNames()), report.VMHooksTotal)
+	require.Len(t, report.VMHooksNotCalled, report.VMHooksTotal-2)
+	require.NotContains(t, report.VMHooksNotCalled, "bigIntAdd")
+}
+
+func TestCoverage_Reset(t *testing.T) {
+	coverage := NewCoverage()
+	coverage.AddFunctionCall([]byte{1}, []byte("sc"), "init")
+	coverage.Reset()
+	require.Empty(t, coverage.Report().Contracts)
+}
+
+func TestReport_Writers(t *testing.T) {
+	coverage := NewCoverage()
+	coverage.AddContract([]byte{0xad}, []string{"add", "<script>"})
+	coverage.AddFunctionCall([]byte{0xad}, []byte("adder"), "add")
+	report := coverage.Report()
+
+	jsonOutput := &bytes.Buffer{}
+	require.Nil(t, report.WriteJSON(jsonOutput))
+	decoded := &Report{}
+	require.Nil(t, json.Unmarshal(jsonOutput.Bytes(), decoded))
+	require.Equal(t, report, decoded)
+
+	htmlOutput := &bytes.Buffer{}
+	require.Nil(t, report.WriteHTML(htmlOutput))
+	html := htmlOutput.String()
+	require.True(t, strings.Contains(html, "Functions covered: 1 of 2 (50.0%)"))
+	require.True(t, strings.Contains(html, "&lt;script&gt;"))
+	require.False(t, strings.Contains(html, "<script>"))
+}
@@ -0,0 +1,66 @@
+package coverage
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+)
+
+var htmlReportTemplate = template.Must(template.New("coverage").Funcs(template.FuncMap{
+	"percentage": percentage,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Contract coverage</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+td, th { border: 1px solid #ccc; padding: 2px 8px; text-align: left; }
+td.calls { text-align: right; }
+tr.uncovered { background: #fdd; }
+tr.covered { background: #dfd; }
+code { word-break: break-all; }
+</style>
+</head>
+<body>
+<h1>Contract coverage</h1>
+<p>VM hooks called: {{.VMHooksCalled}} of {{.VMHooksTotal}} ({{percentage .VMHooksCalled .VMHooksTotal}})</p>
+{{range .Contracts}}
+<h2>Code hash <code>{{.CodeHash}}</code></h2>
+<p>Addresses: {{range .Addresses}}<code>{{.}}</code> {{end}}</p>
+<p>Functions covered: {{.FunctionsCovered}} of {{.FunctionsTotal}} ({{percentage .FunctionsCovered .FunctionsTotal}})</p>
+<table>
+<tr><th>Function</th><th>Calls</th></tr>
+{{range .Functions}}<tr class="{{if .Calls}}covered{{else}}uncovered{{end}}"><td>{{.Name}}</td><td class="calls">{{.Calls}}</td></tr>
+{{end}}</table>
+<table>
+<tr><th>VM hook</th><th>Calls</th></tr>
+{{range .VMHooks}}<tr><td>{{.Name}}</td><td class="calls">{{.Calls}}</td></tr>
+{{end}}</table>
+{{end}}
+<h2>VM hooks never called</h2>
+<p>{{range .VMHooksNotCalled}}<code>{{.}}</code> {{end}}</p>
+</body>
+</html>
+`))
+
+// WriteJSON writes the report as indented JSON.
+func (report *Report) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// WriteHTML writes the report as a standalone HTML page, with the uncovered functions highlighted.
+func (report *Report) WriteHTML(writer io.Writer) error {
+	return htmlReportTemplate.Execute(writer, report)
+}
+
+func percentage(part int, total int) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(part)*100/float64(total))
+}
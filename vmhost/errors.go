@@ -46,6 +46,9 @@ var ErrExecutionFailedWithTimeout = errors.New("execution failed with timeout")
 // ErrMemoryLimit signals that too much memory was allocated by the contract
 var ErrMemoryLimit = errors.New("memory limit reached")
 
+// ErrMemoryBudgetExceeded signals that the transaction holds more memory than its memory budget allows
+var ErrMemoryBudgetExceeded = errors.New("transaction memory budget exceeded")
+
 // ErrBadBounds signals that a certain variable is out of bounds
 var ErrBadBounds = errors.New("bad bounds")
 
@@ -133,6 +136,27 @@ var ErrNilDestinationCallVMOutput = errors.New("nil destination call VMOutput")
 // ErrAsyncCallNotFound signals that the requested AsyncCall was not found
 var ErrAsyncCallNotFound = errors.New("async call not found")
 
+// ErrAsyncCallGroupNotFound signals that the requested AsyncCallGroup was not found
+var ErrAsyncCallGroupNotFound = errors.New("async call group not found")
+
+// ErrInvalidAsyncCallDeadline signals that the deadline of an async call is not a future round
+var ErrInvalidAsyncCallDeadline = errors.New("async call deadline must be a future round")
+
+// ErrAsyncCallTimeoutsNotEnabled signals that async call deadlines and cancellation are not active yet
+var ErrAsyncCallTimeoutsNotEnabled = errors.New("async call deadlines and cancellation are not enabled")
+
+// ErrBLSMultiSigNotEnabled signals that the BLS aggregated signature and public key aggregation hooks are not active yet
+var ErrBLSMultiSigNotEnabled = errors.New("BLS aggregated signatures are not enabled")
+
+// ErrCustomEllipticCurvesNotEnabled signals that elliptic curves cannot be created from their parameters yet
+var ErrCustomEllipticCurvesNotEnabled = errors.New("custom elliptic curves are not enabled")
+
+// ErrBN254PairingCheckFailed signals that the product of the BN254 pairings given to a pairing check is not one
+var ErrBN254PairingCheckFailed = errors.New("BN254 pairing check failed")
+
+// ErrInvalidSignatureInBatch signals that a signature of a batch verified in fail-fast mode is invalid
+var ErrInvalidSignatureInBatch = errors.New("invalid signature in batch")
+
 // ErrAsyncNotAllowed signals that the requested AsyncCall is not allowed
 var ErrAsyncNotAllowed = errors.New("async call is not allowed at this location")
 
@@ -212,6 +236,9 @@ var ErrNoEllipticCurveUnderThisHandle = errors.New("no elliptic curve under the
 // ErrPointNotOnCurve signals that the point to be used is not on curve
 var ErrPointNotOnCurve = errors.New("point is not on curve")
 
+// ErrInvalidEllipticCurveParams signals that the parameters of a custom elliptic curve do not define a valid curve
+var ErrInvalidEllipticCurveParams = errors.New("invalid elliptic curve parameters")
+
 // ErrNoManagedBufferUnderThisHandle signals that there is no buffer for the given handle
 var ErrNoManagedBufferUnderThisHandle = errors.New("no managed buffer under the given handle")
 
@@ -289,3 +316,6 @@ var ErrCannotWriteOnReadOnly = errors.New("cannot write on read only mode")
 
 // ErrEmptyProtectedKeyPrefix signals that the protected key prefix is empty or nil
 var ErrEmptyProtectedKeyPrefix = errors.New("protectedKeyPrefix is empty or nil")
+
+// ErrExecutionAbortedByDebugger signals that the execution was aborted from the debugger
+var ErrExecutionAbortedByDebugger = errors.New("execution aborted by debugger")
@@ -139,3 +139,20 @@ func IfNil(checker nilInterfaceChecker) bool {
 type nilInterfaceChecker interface {
 	IsInterfaceNil() bool
 }
+
+// TransactionMemoryBudget returns the memory budget of the current transaction, in bytes, as set by the gas
+// schedule. Zero means no bound, which is always the case before the memory budget flag is activated.
+func TransactionMemoryBudget(host VMHost) uint64 {
+	if !host.EnableEpochsHandler().IsMemoryBudgetFlagEnabled() {
+		return 0
+	}
+	return host.Metering().GasSchedule().MaxPerTransaction.MemoryBudget
+}
+
+// TransactionMemoryUsage estimates the memory held by the current transaction, i.e. by the managed values
+// and by the WASM memory of all the instances on the call stack
+func TransactionMemoryUsage(host VMHost) uint64 {
+	managedTypesUsage := host.ManagedTypes().MemoryUsage()
+	instancesUsage := host.Runtime().GetInstanceTracker().MemoryUsage()
+	return managedTypesUsage + instancesUsage
+}
@@ -41,3 +41,21 @@ func TestInverseBytes(t *testing.T) {
 	result = InverseBytes([]byte("a"))
 	require.Equal(t, []byte("a"), result)
 }
+
+func TestAsyncCall_IsExpired(t *testing.T) {
+	t.Parallel()
+
+	asyncCall := &AsyncCall{}
+	require.False(t, asyncCall.HasDeadline())
+	require.False(t, asyncCall.IsExpired(1000))
+
+	asyncCall.DeadlineRound = 10
+	require.True(t, asyncCall.HasDeadline())
+	require.False(t, asyncCall.IsExpired(9))
+	require.False(t, asyncCall.IsExpired(10))
+	require.True(t, asyncCall.IsExpired(11))
+
+	serializable := asyncCall.toSerializable()
+	require.Equal(t, uint64(10), serializable.fromSerializable().DeadlineRound)
+	require.Equal(t, uint64(10), asyncCall.Clone().DeadlineRound)
+}
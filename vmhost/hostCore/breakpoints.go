@@ -39,6 +39,9 @@ func (host *vmHost) handleBreakpoint(breakpointValue vmhost.BreakpointValue) err
 	if breakpointValue == vmhost.BreakpointMemoryLimit {
 		return vmhost.ErrMemoryLimit
 	}
+	if breakpointValue == vmhost.BreakpointMemoryBudgetExceeded {
+		return vmhost.ErrMemoryBudgetExceeded
+	}
 
 	return vmhost.ErrUnhandledRuntimeBreakpoint
 }
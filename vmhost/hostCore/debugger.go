@@ -0,0 +1,315 @@
+package hostCore
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var _ vmhost.ExecutionDebugger = (*Debugger)(nil)
+
+// PauseReason describes why the Debugger paused an execution.
+type PauseReason int
+
+const (
+	// PauseAtFunctionBreakpoint means that an exported function with a breakpoint is about to be called.
+	PauseAtFunctionBreakpoint PauseReason = iota
+
+	// PauseAtVMHookBreakpoint means that a VM hook with a breakpoint is about to be called.
+	PauseAtVMHookBreakpoint
+
+	// PauseAtStep means that the previous pause was resumed with Step().
+	PauseAtStep
+)
+
+// String yields a human-readable name of the pause reason.
+func (reason PauseReason) String() string {
+	switch reason {
+	case PauseAtFunctionBreakpoint:
+		return "function breakpoint"
+	case PauseAtVMHookBreakpoint:
+		return "VM hook breakpoint"
+	case PauseAtStep:
+		return "step"
+	default:
+		return fmt.Sprintf("unknown pause reason %d", int(reason))
+	}
+}
+
+type debuggerCommand int
+
+const (
+	debuggerContinue debuggerCommand = iota
+	debuggerStep
+	debuggerAbort
+)
+
+// Debugger pauses contract executions before exported functions or VM hooks that have breakpoints.
+// Each pause is delivered on the Pauses() channel, and the execution stays blocked until
+// the receiver resumes it with Continue(), Step() or Abort(). The channel must be consumed
+// as long as there are breakpoints set, otherwise the execution blocks forever.
+type Debugger struct {
+	mutBreakpoints      sync.RWMutex
+	hookBreakpoints     map[string]struct{}
+	functionBreakpoints map[string]struct{}
+	stepping            bool
+
+	host   vmhost.VMHost
+	pauses chan *PausedExecution
+}
+
+// NewDebugger creates a new Debugger, without breakpoints.
+func NewDebugger() *Debugger {
+	return &Debugger{
+		hookBreakpoints:     make(map[string]struct{}),
+		functionBreakpoints: make(map[string]struct{}),
+		pauses:              make(chan *PausedExecution),
+	}
+}
+
+// AddVMHookBreakpoint pauses the execution before every call to the VM hook with the given name, e.g. "bigIntAdd".
+func (debugger *Debugger) AddVMHookBreakpoint(hookName string) {
+	debugger.mutBreakpoints.Lock()
+	debugger.hookBreakpoints[hookName] = struct{}{}
+	debugger.mutBreakpoints.Unlock()
+}
+
+// RemoveVMHookBreakpoint removes a breakpoint set by AddVMHookBreakpoint.
+func (debugger *Debugger) RemoveVMHookBreakpoint(hookName string) {
+	debugger.mutBreakpoints.Lock()
+	delete(debugger.hookBreakpoints, hookName)
+	debugger.mutBreakpoints.Unlock()
+}
+
+// AddFunctionBreakpoint pauses the execution before every call to the exported contract function with the given name.
+func (debugger *Debugger) AddFunctionBreakpoint(functionName string) {
+	debugger.mutBreakpoints.Lock()
+	debugger.functionBreakpoints[functionName] = struct{}{}
+	debugger.mutBreakpoints.Unlock()
+}
+
+// RemoveFunctionBreakpoint removes a breakpoint set by AddFunctionBreakpoint.
+func (debugger *Debugger) RemoveFunctionBreakpoint(functionName string) {
+	debugger.mutBreakpoints.Lock()
+	delete(debugger.functionBreakpoints, functionName)
+	debugger.mutBreakpoints.Unlock()
+}
+
+// Breakpoints returns the names of the VM hooks and of the functions with breakpoints, sorted.
+func (debugger *Debugger) Breakpoints() (hookNames []string, functionNames []string) {
+	debugger.mutBreakpoints.RLock()
+	defer debugger.mutBreakpoints.RUnlock()
+
+	return sortedNames(debugger.hookBreakpoints), sortedNames(debugger.functionBreakpoints)
+}
+
+// Pauses yields the channel on which the paused executions are delivered.
+func (debugger *Debugger) Pauses() <-chan *PausedExecution {
+	return debugger.pauses
+}
+
+// AttachHost is called by the VM host which uses the debugger.
+func (debugger *Debugger) AttachHost(host vmhost.VMHost) {
+	debugger.host = host
+}
+
+// BeforeFunctionCall pauses if the function has a breakpoint, or if stepping.
+func (debugger *Debugger) BeforeFunctionCall(functionName string) error {
+	reason, shouldPause := debugger.checkPause(debugger.functionBreakpoints, functionName, PauseAtFunctionBreakpoint)
+	if !shouldPause {
+		return nil
+	}
+
+	return debugger.pause(&PausedExecution{
+		Reason:       reason,
+		FunctionName: functionName,
+	})
+}
+
+// BeforeVMHookCall pauses if the VM hook has a breakpoint, or if stepping.
+func (debugger *Debugger) BeforeVMHookCall(hookName string, args []int64) error {
+	reason, shouldPause := debugger.checkPause(debugger.hookBreakpoints, hookName, PauseAtVMHookBreakpoint)
+	if !shouldPause {
+		return nil
+	}
+
+	return debugger.pause(&PausedExecution{
+		Reason:       reason,
+		FunctionName: debugger.host.Runtime().FunctionName(),
+		VMHookName:   hookName,
+		VMHookArgs:   args,
+	})
+}
+
+func (debugger *Debugger) checkPause(
+	breakpoints map[string]struct{},
+	name string,
+	breakpointReason PauseReason,
+) (PauseReason, bool) {
+	debugger.mutBreakpoints.RLock()
+	defer debugger.mutBreakpoints.RUnlock()
+
+	if debugger.stepping {
+		return PauseAtStep, true
+	}
+	_, hasBreakpoint := breakpoints[name]
+	return breakpointReason, hasBreakpoint
+}
+
+func (debugger *Debugger) pause(paused *PausedExecution) error {
+	paused.host = debugger.host
+	paused.resume = make(chan debuggerCommand, 1)
+	debugger.pauses <- paused
+
+	command := <-paused.resume
+	debugger.mutBreakpoints.Lock()
+	debugger.stepping = command == debuggerStep
+	debugger.mutBreakpoints.Unlock()
+
+	if command == debuggerAbort {
+		log.Debug("execution aborted by debugger", "function", paused.FunctionName, "hook", paused.VMHookName)
+		return vmhost.ErrExecutionAbortedByDebugger
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (debugger *Debugger) IsInterfaceNil() bool {
+	return debugger == nil
+}
+
+// DebuggerStorageUpdate is a storage update performed so far by the paused transaction.
+type DebuggerStorageUpdate struct {
+	Address []byte
+	Key     []byte
+	Value   []byte
+}
+
+// PausedExecution is a contract execution blocked by the Debugger. It can be inspected
+// until it is resumed by exactly one call to Continue(), Step() or Abort().
+type PausedExecution struct {
+	Reason       PauseReason
+	FunctionName string
+	VMHookName   string
+	VMHookArgs   []int64
+
+	host   vmhost.VMHost
+	resume chan debuggerCommand
+}
+
+// String yields a one-line description of the pause.
+func (paused *PausedExecution) String() string {
+	if len(paused.VMHookName) == 0 {
+		return fmt.Sprintf("%s: before function %s", paused.Reason, paused.FunctionName)
+	}
+
+	args := make([]string, len(paused.VMHookArgs))
+	for i, arg := range paused.VMHookArgs {
+		args[i] = fmt.Sprintf("%d", arg)
+	}
+	return fmt.Sprintf("%s: before VM hook %s(%s) in function %s",
+		paused.Reason, paused.VMHookName, strings.Join(args, ", "), paused.FunctionName)
+}
+
+// ContractAddress returns the address of the contract being executed.
+func (paused *PausedExecution) ContractAddress() []byte {
+	return paused.host.Runtime().GetContextAddress()
+}
+
+// GasLeft returns the gas left to the contract being executed.
+func (paused *PausedExecution) GasLeft() uint64 {
+	return paused.host.Metering().GasLeft()
+}
+
+// MemDump returns a copy of the WASM memory of the contract being executed.
+func (paused *PausedExecution) MemDump() []byte {
+	instance := paused.host.Runtime().GetInstance()
+	if check.IfNil(instance) {
+		return nil
+	}
+	return append([]byte{}, instance.MemDump()...)
+}
+
+// BigIntHandles returns the handles of all the big ints of the current managed types state.
+func (paused *PausedExecution) BigIntHandles() []int32 {
+	return paused.host.ManagedTypes().GetBigIntHandles()
+}
+
+// BigInt returns a copy of the big int with the given handle.
+func (paused *PausedExecution) BigInt(handle int32) (*big.Int, error) {
+	value, err := paused.host.ManagedTypes().GetBigInt(handle)
+	if err != nil {
+		return nil, err
+	}
+	return big.NewInt(0).Set(value), nil
+}
+
+// ManagedBufferHandles returns the handles of all the managed buffers of the current managed types state.
+func (paused *PausedExecution) ManagedBufferHandles() []int32 {
+	return paused.host.ManagedTypes().GetManagedBufferHandles()
+}
+
+// ManagedBuffer returns a copy of the bytes of the managed buffer with the given handle.
+func (paused *PausedExecution) ManagedBuffer(handle int32) ([]byte, error) {
+	value, err := paused.host.ManagedTypes().GetBytes(handle)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{}, value...), nil
+}
+
+// StorageUpdates returns the storage writes of the transaction so far, sorted by address and key.
+func (paused *PausedExecution) StorageUpdates() []*DebuggerStorageUpdate {
+	updates := make([]*DebuggerStorageUpdate, 0)
+	for _, account := range paused.host.Output().GetOutputAccounts() {
+		for _, storageUpdate := range account.StorageUpdates {
+			if !storageUpdate.Written {
+				continue
+			}
+			updates = append(updates, &DebuggerStorageUpdate{
+				Address: account.Address,
+				Key:     storageUpdate.Offset,
+				Value:   storageUpdate.Data,
+			})
+		}
+	}
+
+	sort.Slice(updates, func(i, j int) bool {
+		addressOrder := bytes.Compare(updates[i].Address, updates[j].Address)
+		if addressOrder != 0 {
+			return addressOrder < 0
+		}
+		return bytes.Compare(updates[i].Key, updates[j].Key) < 0
+	})
+	return updates
+}
+
+// Continue resumes the execution until the next breakpoint.
+func (paused *PausedExecution) Continue() {
+	paused.resume <- debuggerContinue
+}
+
+// Step resumes the execution until the next function or VM hook call.
+func (paused *PausedExecution) Step() {
+	paused.resume <- debuggerStep
+}
+
+// Abort fails the execution with ErrExecutionAbortedByDebugger.
+func (paused *PausedExecution) Abort() {
+	paused.resume <- debuggerAbort
+}
+
+func sortedNames(names map[string]struct{}) []string {
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,94 @@
+package hostCore
+
+import (
+	"testing"
+
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDebugger() *Debugger {
+	debugger := NewDebugger()
+	debugger.AttachHost(&contextmock.VMHostMock{
+		RuntimeContext:  &contextmock.RuntimeContextMock{CallFunction: "increment"},
+		MeteringContext: &contextmock.MeteringContextMock{GasLeftMock: 42},
+	})
+	return debugger
+}
+
+// runAsync runs the call on a separate goroutine, like the VM does with contract executions.
+func runAsync(call func() error) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- call()
+	}()
+	return result
+}
+
+func TestDebugger_NoBreakpoints(t *testing.T) {
+	debugger := newTestDebugger()
+	require.Nil(t, debugger.BeforeFunctionCall("increment"))
+	require.Nil(t, debugger.BeforeVMHookCall("bigIntAdd", []int64{1, 2, 3}))
+}
+
+func TestDebugger_FunctionBreakpointContinue(t *testing.T) {
+	debugger := newTestDebugger()
+	debugger.AddFunctionBreakpoint("increment")
+
+	result := runAsync(func() error {
+		return debugger.BeforeFunctionCall("increment")
+	})
+	paused := <-debugger.Pauses()
+	require.Equal(t, PauseAtFunctionBreakpoint, paused.Reason)
+	require.Equal(t, "increment", paused.FunctionName)
+	require.Equal(t, uint64(42), paused.GasLeft())
+	paused.Continue()
+	require.Nil(t, <-result)
+
+	require.Nil(t, debugger.BeforeVMHookCall("bigIntAdd", nil))
+}
+
+func TestDebugger_VMHookBreakpointAbort(t *testing.T) {
+	debugger := newTestDebugger()
+	debugger.AddVMHookBreakpoint("bigIntAdd")
+	debugger.AddFunctionBreakpoint("init")
+	debugger.RemoveFunctionBreakpoint("init")
+
+	hookNames, functionNames := debugger.Breakpoints()
+	require.Equal(t, []string{"bigIntAdd"}, hookNames)
+	require.Empty(t, functionNames)
+
+	result := runAsync(func() error {
+		return debugger.BeforeVMHookCall("bigIntAdd", []int64{1, 2, 3})
+	})
+	paused := <-debugger.Pauses()
+	require.Equal(t, PauseAtVMHookBreakpoint, paused.Reason)
+	require.Equal(t, "VM hook breakpoint: before VM hook bigIntAdd(1, 2, 3) in function increment", paused.String())
+	paused.Abort()
+	require.Equal(t, vmhost.ErrExecutionAbortedByDebugger, <-result)
+}
+
+func TestDebugger_Step(t *testing.T) {
+	debugger := newTestDebugger()
+	debugger.AddFunctionBreakpoint("increment")
+
+	result := runAsync(func() error {
+		err := debugger.BeforeFunctionCall("increment")
+		if err != nil {
+			return err
+		}
+		return debugger.BeforeVMHookCall("int64finish", []int64{7})
+	})
+	paused := <-debugger.Pauses()
+	paused.Step()
+
+	paused = <-debugger.Pauses()
+	require.Equal(t, PauseAtStep, paused.Reason)
+	require.Equal(t, "int64finish", paused.VMHookName)
+	paused.Continue()
+	require.Nil(t, <-result)
+
+	// stepping ends once continued
+	require.Nil(t, debugger.BeforeVMHookCall("int64finish", []int64{7}))
+}
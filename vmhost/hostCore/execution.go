@@ -837,7 +837,7 @@ func (host *vmHost) callSCMethodIndirect() error {
 		return err
 	}
 
-	err = host.Runtime().CallSCFunction(functionName)
+	err = host.callInstanceFunction(functionName)
 	if err != nil {
 		err = host.handleBreakpointIfAny(err)
 	}
@@ -1046,13 +1046,38 @@ func (host *vmHost) callUpgradeFunction() error {
 	return host.callSCFunction(vmhost.ContractsUpgradeFunctionName)
 }
 
+// callInstanceFunction calls the exported function on the current instance,
+// after giving the debugger, if any, the chance to pause or abort.
+func (host *vmHost) callInstanceFunction(functionName string) error {
+	runtime := host.Runtime()
+	if !check.IfNil(host.debugger) {
+		err := host.debugger.BeforeFunctionCall(functionName)
+		if err != nil {
+			runtime.FailExecution(err)
+			return err
+		}
+	}
+	if !check.IfNil(host.coverage) {
+		host.recordFunctionCoverage(functionName)
+	}
+	if check.IfNil(host.profiler) {
+		return runtime.CallSCFunction(functionName)
+	}
+
+	metering := host.Metering()
+	host.profiler.BeginFunction(runtime.GetContextAddress(), functionName, metering.GasLeft())
+	err := runtime.CallSCFunction(functionName)
+	host.profiler.EndFunction(metering.GasLeft())
+	return err
+}
+
 func (host *vmHost) callSCFunction(functionName string) error {
 	runtime := host.Runtime()
 	if !runtime.HasFunction(functionName) {
 		return nil
 	}
 
-	err := runtime.CallSCFunction(functionName)
+	err := host.callInstanceFunction(functionName)
 	if err != nil {
 		err = host.handleBreakpointIfAny(err)
 	}
@@ -1178,7 +1203,7 @@ func (host *vmHost) callFunctionAndExecuteAsync() (bool, error) {
 			return false, err
 		}
 
-		err = runtime.CallSCFunction(functionName)
+		err = host.callInstanceFunction(functionName)
 		if err != nil {
 			err = host.handleBreakpointIfAny(err)
 			log.Trace("breakpoint detected and handled", "err", err)
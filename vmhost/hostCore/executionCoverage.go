@@ -0,0 +1,32 @@
+package hostCore
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+)
+
+// recordFunctionCoverage reports the call of an exported function of the current contract,
+// together with all its exported functions, the first time the contract is seen.
+func (host *vmHost) recordFunctionCoverage(functionName string) {
+	runtime := host.Runtime()
+	codeHash := runtime.GetInstanceTracker().CodeHash()
+	if !host.coverage.HasContract(codeHash) {
+		instance := runtime.GetInstance()
+		if !check.IfNil(instance) {
+			host.coverage.AddContract(codeHash, instance.GetFunctionNames())
+		}
+	}
+	host.coverage.AddFunctionCall(codeHash, runtime.GetContextAddress(), functionName)
+}
+
+// coverageInterceptor reports each VM hook call, attributed to the contract that made it.
+type coverageInterceptor struct {
+	host *vmHost
+}
+
+// InterceptVMHookCall reports the VM hook call, then performs it.
+func (interceptor *coverageInterceptor) InterceptVMHookCall(call *executorwrapper.VMHookCall, invoke func() int64) int64 {
+	codeHash := interceptor.host.Runtime().GetInstanceTracker().CodeHash()
+	interceptor.host.coverage.AddVMHookCall(codeHash, call.Name)
+	return invoke()
+}
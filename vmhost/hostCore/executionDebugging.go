@@ -0,0 +1,26 @@
+package hostCore
+
+import (
+	"time"
+
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+)
+
+// debuggerExecutionTimeout replaces the execution timeout when a debugger is attached,
+// because executions can stay paused indefinitely.
+const debuggerExecutionTimeout = 24 * 365 * time.Hour
+
+// debuggerInterceptor gives the debugger the chance to pause before each VM hook call.
+type debuggerInterceptor struct {
+	host *vmHost
+}
+
+// InterceptVMHookCall fails the execution without calling the VM hook, if the debugger aborts it.
+func (interceptor *debuggerInterceptor) InterceptVMHookCall(call *executorwrapper.VMHookCall, invoke func() int64) int64 {
+	err := interceptor.host.debugger.BeforeVMHookCall(call.Name, call.Args)
+	if err != nil {
+		interceptor.host.Runtime().FailExecution(err)
+		return 0
+	}
+	return invoke()
+}
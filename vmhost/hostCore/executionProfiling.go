@@ -0,0 +1,19 @@
+package hostCore
+
+import (
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+)
+
+// profilerInterceptor reports the beginning and the end of each VM hook call to the profiler.
+type profilerInterceptor struct {
+	host *vmHost
+}
+
+// InterceptVMHookCall measures the gas left before and after the VM hook call.
+func (interceptor *profilerInterceptor) InterceptVMHookCall(call *executorwrapper.VMHookCall, invoke func() int64) int64 {
+	metering := interceptor.host.Metering()
+	interceptor.host.profiler.BeginVMHook(call.Name, metering.GasLeft())
+	result := invoke()
+	interceptor.host.profiler.EndVMHook(metering.GasLeft())
+	return result
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-vm-go/crypto"
 	"github.com/multiversx/mx-chain-vm-go/crypto/factory"
 	"github.com/multiversx/mx-chain-vm-go/executor"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
@@ -54,8 +55,14 @@ type vmHost struct {
 	builtInFuncContainer vmcommon.BuiltInFunctionContainer
 	esdtTransferParser   vmcommon.ESDTTransferParser
 	callArgsParser       vmhost.CallArgsParser
-	enableEpochsHandler  vmcommon.EnableEpochsHandler
+	enableEpochsHandler  vmhost.EnableEpochsHandler
 	activationEpochMap   map[uint32]struct{}
+	debugger             vmhost.ExecutionDebugger
+	compiledCodeStore    vmhost.CompiledCodeStore
+	profiler             vmhost.ExecutionProfiler
+	coverage             vmhost.ExecutionCoverage
+	asyncCallGraph       vmhost.AsyncCallGraphRecorder
+	vmHooks              *vmHooksSwitch
 }
 
 // NewVMHost creates a new VM vmHost
@@ -104,11 +111,20 @@ func NewVMHost(
 		callArgsParser:       parsers.NewCallArgsParser(),
 		executionTimeout:     minExecutionTimeout,
 		enableEpochsHandler:  hostParameters.EnableEpochsHandler,
+		compiledCodeStore:    hostParameters.CompiledCodeStore,
+		profiler:             hostParameters.Profiler,
+		coverage:             hostParameters.Coverage,
+		asyncCallGraph:       hostParameters.AsyncCallGraph,
 	}
 	newExecutionTimeout := time.Duration(hostParameters.TimeOutForSCExecutionInMilliseconds) * time.Millisecond
 	if newExecutionTimeout > minExecutionTimeout {
 		host.executionTimeout = newExecutionTimeout
 	}
+	if !check.IfNil(hostParameters.Debugger) {
+		host.debugger = hostParameters.Debugger
+		host.executionTimeout = debuggerExecutionTimeout
+		host.debugger.AttachHost(host)
+	}
 
 	var err error
 	host.blockchainContext, err = contexts.NewBlockchainContext(host, blockChainHook)
@@ -171,11 +187,11 @@ func NewVMHost(
 
 // Creates a new executor instance. Should only be called once per VM host instantiation.
 func (host *vmHost) createExecutor(hostParameters *vmhost.VMHostParameters) (executor.Executor, error) {
-	vmHooks := vmhooks.NewVMHooksImpl(host)
 	gasCostConfig, err := config.CreateGasConfig(host.gasSchedule)
 	if err != nil {
 		return nil, err
 	}
+	host.vmHooks = &vmHooksSwitch{VMHooks: host.createVMHooks(gasCostConfig)}
 
 	var vmExecutorFactory executor.ExecutorAbstractFactory
 
@@ -185,7 +201,7 @@ func (host *vmHost) createExecutor(hostParameters *vmhost.VMHostParameters) (exe
 		vmExecutorFactory = wasmer2.ExecutorFactory()
 	}
 	vmExecutorFactoryArgs := executor.ExecutorFactoryArgs{
-		VMHooks:                  vmHooks,
+		VMHooks:                  host.vmHooks,
 		OpcodeCosts:              gasCostConfig.WASMOpcodeCost,
 		RkyvSerializationEnabled: true,
 		WasmerSIGSEGVPassthrough: hostParameters.WasmerSIGSEGVPassthrough,
@@ -193,6 +209,26 @@ func (host *vmHost) createExecutor(hostParameters *vmhost.VMHostParameters) (exe
 	return vmExecutorFactory.CreateExecutor(vmExecutorFactoryArgs)
 }
 
+// createVMHooks chains the VM hooks with the interceptors required by the host and by the given gas costs.
+func (host *vmHost) createVMHooks(gasCostConfig *config.GasCost) executor.VMHooks {
+	var vmHooks executor.VMHooks = vmhooks.NewVMHooksImpl(host)
+	// without a memory budget in the gas schedule, the budget of the transactions is always zero
+	if gasCostConfig.MaxPerTransaction.MemoryBudget > 0 {
+		vmHooks = executorwrapper.NewInterceptorVMHooks(&memoryBudgetInterceptor{host: host}, vmHooks)
+	}
+	if !check.IfNil(host.coverage) {
+		vmHooks = executorwrapper.NewInterceptorVMHooks(&coverageInterceptor{host: host}, vmHooks)
+	}
+	if !check.IfNil(host.profiler) {
+		vmHooks = executorwrapper.NewInterceptorVMHooks(&profilerInterceptor{host: host}, vmHooks)
+	}
+	// the debugger goes outside the profiler, so that the time spent paused is not measured
+	if !check.IfNil(host.debugger) {
+		vmHooks = executorwrapper.NewInterceptorVMHooks(&debuggerInterceptor{host: host}, vmHooks)
+	}
+	return vmHooks
+}
+
 // GetVersion returns the VM version string
 func (host *vmHost) GetVersion() string {
 	return vmhost.VMVersion
@@ -234,10 +270,20 @@ func (host *vmHost) Storage() vmhost.StorageContext {
 }
 
 // EnableEpochsHandler returns the enableEpochsHandler instance of the host
-func (host *vmHost) EnableEpochsHandler() vmcommon.EnableEpochsHandler {
+func (host *vmHost) EnableEpochsHandler() vmhost.EnableEpochsHandler {
 	return host.enableEpochsHandler
 }
 
+// CompiledCodeStore returns the persistent store of compiled code, or nil if there is none
+func (host *vmHost) CompiledCodeStore() vmhost.CompiledCodeStore {
+	return host.compiledCodeStore
+}
+
+// AsyncCallGraph returns the recorder of the async call graph, if any
+func (host *vmHost) AsyncCallGraph() vmhost.AsyncCallGraphRecorder {
+	return host.asyncCallGraph
+}
+
 // ManagedTypes returns the ManagedTypeContext instance of the host
 func (host *vmHost) ManagedTypes() vmhost.ManagedTypesContext {
 	return host.managedTypesContext
@@ -325,6 +371,7 @@ func (host *vmHost) GasScheduleChange(newGasSchedule config.GasScheduleMap) {
 	}
 
 	host.runtimeContext.GetVMExecutor().SetOpcodeCosts(gasCostConfig.WASMOpcodeCost)
+	host.vmHooks.VMHooks = host.createVMHooks(gasCostConfig)
 
 	host.meteringContext.SetGasSchedule(newGasSchedule)
 	host.runtimeContext.ClearWarmInstanceCache()
@@ -0,0 +1,46 @@
+package hostCore
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// vmHooksSwitch forwards the VM hook calls to the current chain of VM hooks, which the host replaces when a new
+// gas schedule adds or removes the memory budget, without recreating the executor.
+type vmHooksSwitch struct {
+	executor.VMHooks
+}
+
+// memoryBudgetInterceptor fails the execution as soon as a VM hook call leaves the transaction with more
+// memory than its memory budget allows. The managed types refuse the allocations which would exceed the
+// budget beforehand, so this catches the big numbers grown in place and the growth of the WASM memory.
+type memoryBudgetInterceptor struct {
+	host *vmHost
+}
+
+// InterceptVMHookCall performs the VM hook call, then checks the memory budget of the transaction.
+func (interceptor *memoryBudgetInterceptor) InterceptVMHookCall(call *executorwrapper.VMHookCall, invoke func() int64) int64 {
+	result := invoke()
+
+	host := interceptor.host
+	budget := vmhost.TransactionMemoryBudget(host)
+	if budget == 0 {
+		return result
+	}
+
+	runtime := host.Runtime()
+	if runtime.GetRuntimeBreakpointValue() != vmhost.BreakpointNone {
+		return result
+	}
+
+	usage := vmhost.TransactionMemoryUsage(host)
+	if usage > budget {
+		log.Trace("transaction memory budget exceeded",
+			"hook", call.Name,
+			"usage", usage,
+			"budget", budget)
+		runtime.FailExecution(vmhost.ErrMemoryBudgetExceeded)
+	}
+	return result
+}
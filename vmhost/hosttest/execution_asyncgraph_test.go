@@ -0,0 +1,78 @@
+package hostCoretest
+
+import (
+	"testing"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/multiversx/mx-chain-vm-go/mock/contracts"
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/asyncgraph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecution_AsyncCallGraph_InShard(t *testing.T) {
+	testConfig := makeTestConfig()
+	testConfig.GasProvided = 1000
+
+	world := worldmock.NewMockWorld()
+	world.AcctMap.CreateAccount(test.UserAddress, world)
+	world.CurrentBlockInfo = &worldmock.BlockInfo{}
+
+	recorder := asyncgraph.NewRecorder()
+	executorFactory := mock.NewExecutorMockFactory(world)
+	host := test.NewTestHostBuilder(t).
+		WithExecutorFactory(executorFactory).
+		WithBlockchainHook(world).
+		WithAsyncCallGraph(recorder).
+		Build()
+	defer func() {
+		host.Reset()
+	}()
+
+	parentContract := test.CreateMockContractOnShard(test.ParentAddress, 0).
+		WithBalance(testConfig.ParentBalance).
+		WithConfig(testConfig).
+		WithMethods(contracts.PerformAsyncCallParentMock, contracts.CallBackParentMock)
+	parentContract.Initialize(t, host, executorFactory.LastCreatedExecutor, true)
+	childContract := test.CreateMockContractOnShard(test.ChildAddress, 0).
+		WithBalance(testConfig.ChildBalance).
+		WithConfig(testConfig).
+		WithMethods(contracts.TransferToThirdPartyAsyncChildMock)
+	childContract.Initialize(t, host, executorFactory.LastCreatedExecutor, true)
+
+	setZeroCodeCosts(host)
+	setAsyncCosts(host, testConfig.GasLockCost)
+	world.CreateStateBackup()
+
+	input := test.CreateTestContractCallInputBuilder().
+		WithCallerAddr(test.UserAddress).
+		WithRecipientAddr(test.ParentAddress).
+		WithGasProvided(testConfig.GasProvided).
+		WithFunction("performAsyncCall").
+		WithArguments([]byte{0}).
+		Build()
+	vmOutput, err := host.RunSmartContractCall(input)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	graph := recorder.Graph()
+	require.Len(t, graph.Roots, 1)
+	root := graph.Roots[0]
+	require.Equal(t, "performAsyncCall", root.Function)
+	require.Len(t, root.Groups, 1)
+	require.Equal(t, "testGroup", root.Groups[0].ID)
+	require.Len(t, root.Groups[0].Calls, 1)
+
+	asyncCall := root.Groups[0].Calls[0]
+	require.NotEmpty(t, asyncCall.CallID)
+	require.Equal(t, contracts.AsyncChildFunction, asyncCall.Function)
+	require.Equal(t, "SyncExecution", asyncCall.ExecutionMode)
+	require.Equal(t, vmcommon.Ok.String(), asyncCall.ReturnCode)
+	require.NotNil(t, asyncCall.Execution)
+	require.Equal(t, contracts.AsyncChildFunction, asyncCall.Execution.Function)
+	require.NotNil(t, asyncCall.Callback)
+	require.Equal(t, testConfig.SuccessCallback, asyncCall.Callback.Function)
+	require.Equal(t, vmcommon.Ok.String(), asyncCall.CallbackReturnCode)
+}
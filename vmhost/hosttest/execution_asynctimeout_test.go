@@ -0,0 +1,256 @@
+package hostCoretest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var childCalled = []byte("child called")
+
+// asyncCallWithDeadlineParentMock registers an async call to the child, with the deadline round given as first argument,
+// and cancels its group if the second argument is 1.
+func asyncCallWithDeadlineParentMock(parentInstance *contextmock.InstanceMock, _ interface{}) {
+	parentInstance.AddMockMethod("callChildWithDeadline", func() *contextmock.InstanceMock {
+		host := parentInstance.Host
+		managed := host.ManagedTypes()
+		arguments := host.Runtime().Arguments()
+
+		destHandle := managed.NewManagedBufferFromBytes(test.ChildAddress)
+		valueHandle := managed.NewBigIntFromInt64(0)
+		functionHandle := managed.NewManagedBufferFromBytes([]byte("doSomething"))
+		argumentsHandle := managed.NewManagedBuffer()
+		closureHandle := managed.NewManagedBuffer()
+		groupHandle := managed.NewManagedBufferFromBytes([]byte("timeoutGroup"))
+		deadlineRound := big.NewInt(0).SetBytes(arguments[0]).Int64()
+
+		result := vmhooks.ManagedCreateAsyncCallWithDeadlineWithHost(
+			host,
+			destHandle,
+			valueHandle,
+			functionHandle,
+			argumentsHandle,
+			nil,
+			nil,
+			1000,
+			0,
+			closureHandle,
+			groupHandle,
+			deadlineRound)
+		if result != 0 {
+			return parentInstance
+		}
+
+		if len(arguments) > 1 && arguments[1][0] == 1 {
+			vmhooks.ManagedCancelAsyncCallGroupWithHost(host, groupHandle)
+		}
+		return parentInstance
+	})
+}
+
+func asyncCallWithDeadlineChildMock(childInstance *contextmock.InstanceMock, _ interface{}) {
+	childInstance.AddMockMethod("doSomething", func() *contextmock.InstanceMock {
+		host := childInstance.Host
+		host.Output().Finish(childCalled)
+		return childInstance
+	})
+}
+
+func runAsyncCallWithDeadlineTest(
+	t *testing.T,
+	arguments [][]byte,
+	setup test.SetupFunction,
+	assertResults test.AssertResultsFunc,
+) {
+	template := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(asyncCallWithDeadlineParentMock),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(0).
+				WithMethods(asyncCallWithDeadlineChildMock),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(10000).
+			WithFunction("callChildWithDeadline").
+			WithArguments(arguments...).
+			Build())
+	if setup != nil {
+		template = template.WithSetup(setup)
+	}
+
+	_, err := template.AndAssertResults(assertResults)
+	assert.Nil(t, err)
+}
+
+func TestExecution_AsyncCallWithDeadline_Local(t *testing.T) {
+	runAsyncCallWithDeadlineTest(t, [][]byte{{10}}, nil,
+		func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnDataContains(childCalled)
+		})
+}
+
+func TestExecution_AsyncCallWithDeadline_Cancelled(t *testing.T) {
+	runAsyncCallWithDeadlineTest(t, [][]byte{{10}, {1}}, nil,
+		func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnDataDoesNotContain(childCalled)
+		})
+}
+
+func TestExecution_AsyncCallWithDeadline_PastDeadline(t *testing.T) {
+	setup := func(host vmhost.VMHost, world *worldmock.MockWorld) {
+		world.CurrentBlockInfo = &worldmock.BlockInfo{BlockRound: 10}
+	}
+	runAsyncCallWithDeadlineTest(t, [][]byte{{10}}, setup,
+		func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(vmhost.ErrInvalidAsyncCallDeadline.Error())
+		})
+}
+
+func TestExecution_AsyncCallWithDeadline_FlagDisabled(t *testing.T) {
+	setup := func(host vmhost.VMHost, world *worldmock.MockWorld) {
+		enableEpochsHandler, _ := host.EnableEpochsHandler().(*worldmock.EnableEpochsHandlerStub)
+		enableEpochsHandler.IsAsyncCallTimeoutsFlagEnabledField = false
+	}
+	runAsyncCallWithDeadlineTest(t, [][]byte{{10}}, setup,
+		func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(vmhost.ErrAsyncCallTimeoutsNotEnabled.Error())
+		})
+}
+
+func TestExecution_AsyncCallWithDeadline_CancelUnknownGroup(t *testing.T) {
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *contextmock.InstanceMock, _ interface{}) {
+					parentInstance.AddMockMethod("cancelGroup", func() *contextmock.InstanceMock {
+						host := parentInstance.Host
+						groupHandle := host.ManagedTypes().NewManagedBufferFromBytes([]byte("unknownGroup"))
+						vmhooks.ManagedCancelAsyncCallGroupWithHost(host, groupHandle)
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(10000).
+			WithFunction("cancelGroup").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(vmhost.ErrAsyncCallGroupNotFound.Error())
+		})
+	assert.Nil(t, err)
+}
+
+func TestExecution_MultiShardWorld_AsyncCallWithDeadline_TimedOut(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	hosts := make(map[uint32]vmhost.VMHost)
+	executorFactories := make(map[uint32]*contextmock.ExecutorMockFactory)
+	multiShardWorld, err := worldmock.NewMultiShardWorld(2, func(shardID uint32, world *worldmock.MockWorld) (vmcommon.VMExecutionHandler, error) {
+		executorFactory := contextmock.NewExecutorMockFactory(world)
+		host := test.NewTestHostBuilder(t).
+			WithExecutorFactory(executorFactory).
+			WithBlockchainHook(world).
+			Build()
+		setZeroCodeCosts(host)
+		setAsyncCosts(host, testConfig.GasLockCost)
+
+		hosts[shardID] = host
+		executorFactories[shardID] = executorFactory
+		return host, nil
+	})
+	require.Nil(t, err)
+	defer func() {
+		for _, host := range hosts {
+			host.Reset()
+		}
+	}()
+
+	_, err = multiShardWorld.CreateAccount(0, test.UserAddress)
+	require.Nil(t, err)
+
+	// the result of the call reaches the parent in round 2, after the deadline; the callback still
+	// receives the successful result of the child, together with the timeout flag
+	parentContract := test.CreateMockContractOnShard(test.ParentAddress, 0).
+		WithMethods(func(parentInstance *contextmock.InstanceMock, _ interface{}) {
+			parentInstance.AddMockMethod("callChild", func() *contextmock.InstanceMock {
+				host := parentInstance.Host
+				managed := host.ManagedTypes()
+				vmhooks.ManagedCreateAsyncCallWithDeadlineWithHost(
+					host,
+					managed.NewManagedBufferFromBytes(test.ChildAddress),
+					managed.NewBigIntFromInt64(0),
+					managed.NewManagedBufferFromBytes([]byte("doSomething")),
+					managed.NewManagedBuffer(),
+					[]byte("callBack"),
+					[]byte("callBack"),
+					1000,
+					1000,
+					managed.NewManagedBuffer(),
+					managed.NewManagedBufferFromBytes([]byte("timeoutGroup")),
+					1)
+				return parentInstance
+			})
+			parentInstance.AddMockMethod("callBack", func() *contextmock.InstanceMock {
+				host := parentInstance.Host
+				for _, argument := range host.Runtime().Arguments() {
+					host.Output().Finish(argument)
+				}
+				timedOut := vmhooks.ManagedIsCallbackTimedOutWithHost(host)
+				host.Output().Finish(big.NewInt(int64(timedOut)).Bytes())
+				return parentInstance
+			})
+		})
+	parentContract.Initialize(t, hosts[0], executorFactories[0].LastCreatedExecutor, false)
+	_, err = multiShardWorld.CreateSmartContractAccount(0, test.UserAddress, test.ParentAddress, test.ParentAddress)
+	require.Nil(t, err)
+
+	childContract := test.CreateMockContractOnShard(test.ChildAddress, 1).
+		WithMethods(asyncCallWithDeadlineChildMock)
+	childContract.Initialize(t, hosts[1], executorFactories[1].LastCreatedExecutor, false)
+	_, err = multiShardWorld.CreateSmartContractAccount(1, test.UserAddress, test.ChildAddress, test.ChildAddress)
+	require.Nil(t, err)
+
+	input := test.CreateTestContractCallInputBuilder().
+		WithCallerAddr(test.UserAddress).
+		WithRecipientAddr(test.ParentAddress).
+		WithGasProvided(10000).
+		WithFunction("callChild").
+		Build()
+
+	executedTx, err := multiShardWorld.RunSmartContractCall(input)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, executedTx.Output.ReturnCode)
+
+	executed, err := multiShardWorld.ProcessBlocksUntilIdle(5)
+	require.Nil(t, err)
+	require.Len(t, executed, 2)
+
+	asyncCall := executed[0]
+	require.Equal(t, vmcommon.Ok, asyncCall.Output.ReturnCode)
+	require.Contains(t, asyncCall.Output.ReturnData, childCalled)
+
+	callback := executed[1]
+	require.Equal(t, uint64(2), callback.Block)
+	require.Equal(t, vm.AsynchronousCallBack, callback.Input.CallType)
+	require.Equal(t, vmcommon.Ok, callback.Output.ReturnCode)
+	require.Equal(t, [][]byte{{0}, childCalled, {1}}, callback.Output.ReturnData)
+}
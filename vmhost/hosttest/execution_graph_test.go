@@ -314,9 +314,9 @@ func TestGraph_AsyncsOnMultiLevelFail1_CallGraph(t *testing.T) {
 	callGraph := test.MakeGraphAndImage(test.CreateGraphTestAsyncCallsAsync())
 	runGraphCallTestTemplateWithCustomAssertsConfig(t, callGraph, &assertsConfig{
 		assertsAfterEachRootCall: noAssertsAfterEachRootCall,
-		finalAsserts: func(t *testing.T, world *worldmock.MockWorld, expectedCallFinishData []*test.CallFinishDataItem, callsFinishData *test.CallsFinishData) {
-			checkThatStoreIsEmpty(t, world)
-			require.Equal(t, testcommon.ErrAsyncRegisterFail, callsFinishData.Data[1].FailError)
+		finalAsserts: func(tb testing.TB, world *worldmock.MockWorld, expectedCallFinishData []*test.CallFinishDataItem, callsFinishData *test.CallsFinishData) {
+			checkThatStoreIsEmpty(tb, world)
+			require.Equal(tb, testcommon.ErrAsyncRegisterFail, callsFinishData.Data[1].FailError)
 		},
 	})
 }
@@ -326,9 +326,9 @@ func TestGraph_AsyncsOnMultiLevelFail2_CallGraph(t *testing.T) {
 	callGraph := test.MakeGraphAndImage(test.CreateGraphTestSyncAndAsync5())
 	runGraphCallTestTemplateWithCustomAssertsConfig(t, callGraph, &assertsConfig{
 		assertsAfterEachRootCall: noAssertsAfterEachRootCall,
-		finalAsserts: func(t *testing.T, world *worldmock.MockWorld, expectedCallFinishData []*test.CallFinishDataItem, callsFinishData *test.CallsFinishData) {
-			checkThatStoreIsEmpty(t, world)
-			require.Equal(t, testcommon.ErrAsyncRegisterFail, callsFinishData.Data[1].FailError)
+		finalAsserts: func(tb testing.TB, world *worldmock.MockWorld, expectedCallFinishData []*test.CallFinishDataItem, callsFinishData *test.CallsFinishData) {
+			checkThatStoreIsEmpty(tb, world)
+			require.Equal(tb, testcommon.ErrAsyncRegisterFail, callsFinishData.Data[1].FailError)
 		},
 	})
 }
@@ -749,7 +749,16 @@ func TestGraph_AsyncCall9_CrossShard_CallGraph(t *testing.T) {
 
 type assertsConfig struct {
 	assertsAfterEachRootCall func(*test.TestCallNode, *worldmock.MockWorld, *test.VMOutputVerifier, []string)
-	finalAsserts             func(t *testing.T, world *worldmock.MockWorld, expectedCallFinishData []*test.CallFinishDataItem, callsFinishData *test.CallsFinishData)
+	finalAsserts             func(tb testing.TB, world *worldmock.MockWorld, expectedCallFinishData []*test.CallFinishDataItem, callsFinishData *test.CallsFinishData)
+}
+
+// graphCallTestResult is the outcome of running a call graph, as transactions through the host
+type graphCallTestResult struct {
+	gasGraph        *test.TestCallGraph
+	world           *worldmock.MockWorld
+	callsFinishData *test.CallsFinishData
+	// gasRemaining is the gas remaining after all the transactions, which is refunded
+	gasRemaining uint64
 }
 
 var noAssertsAfterEachRootCall = func(*test.TestCallNode, *worldmock.MockWorld, *test.VMOutputVerifier, []string) {}
@@ -757,45 +766,41 @@ var noAssertsAfterEachRootCall = func(*test.TestCallNode, *worldmock.MockWorld,
 //nolint:all
 var assertsConfigForR1MultiLevel = &assertsConfig{
 	assertsAfterEachRootCall: noAssertsAfterEachRootCall,
-	finalAsserts: func(t *testing.T, world *worldmock.MockWorld, expectedCallFinishData []*test.CallFinishDataItem, callsFinishData *test.CallsFinishData) {
-		checkCallFinishDataForGraphTesting(t, expectedCallFinishData, callsFinishData.Data)
+	finalAsserts: func(tb testing.TB, world *worldmock.MockWorld, expectedCallFinishData []*test.CallFinishDataItem, callsFinishData *test.CallsFinishData) {
+		checkCallFinishDataForGraphTesting(tb, expectedCallFinishData, callsFinishData.Data)
 	},
 }
 
 func RunGraphCallTestTemplate(t *testing.T, callGraph *test.TestCallGraph) {
 	// regular tests, with full asserts
 	runGraphCallTestTemplateWithCustomAssertsConfig(t, callGraph, &assertsConfig{
-		assertsAfterEachRootCall: func(startNode *test.TestCallNode, world *worldmock.MockWorld, verify *test.VMOutputVerifier, expectedErrorsForRound []string) {
-			if startNode.ErrFail == nil {
-				verify.Ok().
-					HasRuntimeErrors(expectedErrorsForRound...)
-				// TODO matei-p will be implemented in R2
-				// GasRemaining(callGraph.StartNode.GasLimit - totalGasUsed)
-			} else {
-				verify.ReturnCode(vmcommon.ExecutionFailed).
-					GasRemaining(0).
-					HasRuntimeErrors(expectedErrorsForRound...)
-			}
-		},
-		finalAsserts: func(t *testing.T, world *worldmock.MockWorld, expectedCallFinishData []*test.CallFinishDataItem, callsFinishData *test.CallsFinishData) {
-			checkThatStoreIsEmpty(t, world)
-			checkCallFinishDataForGraphTesting(t, expectedCallFinishData, callsFinishData.Data)
+		assertsAfterEachRootCall: assertRootCallResults,
+		finalAsserts: func(tb testing.TB, world *worldmock.MockWorld, expectedCallFinishData []*test.CallFinishDataItem, callsFinishData *test.CallsFinishData) {
+			checkThatStoreIsEmpty(tb, world)
+			checkCallFinishDataForGraphTesting(tb, expectedCallFinishData, callsFinishData.Data)
 		},
 	})
 }
 
-func runGraphCallTestTemplateWithCustomAssertsConfig(t *testing.T, callGraph *test.TestCallGraph, assertsConfig *assertsConfig) {
+func assertRootCallResults(startNode *test.TestCallNode, world *worldmock.MockWorld, verify *test.VMOutputVerifier, expectedErrorsForRound []string) {
+	if startNode.ErrFail == nil {
+		verify.Ok().
+			HasRuntimeErrors(expectedErrorsForRound...)
+		// TODO matei-p will be implemented in R2
+		// GasRemaining(callGraph.StartNode.GasLimit - totalGasUsed)
+	} else {
+		verify.ReturnCode(vmcommon.ExecutionFailed).
+			GasRemaining(0).
+			HasRuntimeErrors(expectedErrorsForRound...)
+	}
+}
+
+func runGraphCallTestTemplateWithCustomAssertsConfig(t testing.TB, callGraph *test.TestCallGraph, assertsConfig *assertsConfig) *graphCallTestResult {
 	testConfig := makeTestConfig()
 	testConfig.GasProvided = callGraph.StartNode.GasLimit
 	testConfig.GasLockCost = test.DefaultCallGraphLockedGas
 
-	executionGraph := callGraph.CreateExecutionGraphFromCallGraph()
-
-	gasGraph := executionGraph.ComputeGasGraphFromExecutionGraph()
-	gasGraph.PropagateSyncFailures()
-	gasGraph.AssignExecutionRounds(t)
-	gasGraph.ComputeRemainingGasBeforeCallbacks(t)
-	gasGraph.ComputeRemainingGasAfterCallbacks()
+	gasGraph := computeGasGraph(callGraph)
 
 	startNode := gasGraph.GetStartNode()
 	crossShardCallsQueue := test.NewCrossShardCallQueue()
@@ -811,6 +816,7 @@ func runGraphCallTestTemplateWithCustomAssertsConfig(t *testing.T, callGraph *te
 	require.Equal(t, int(gasGraph.StartNode.GasLimit), int(totalGasUsed+totalGasRemaining), "Expected Gas Sanity Check")
 
 	crtTxNumber := 0
+	gasRemaining := uint64(0)
 
 	var currentVMOutput *vmcommon.VMOutput
 
@@ -877,6 +883,9 @@ func runGraphCallTestTemplateWithCustomAssertsConfig(t *testing.T, callGraph *te
 		contractsInitialized = true
 
 		extractAndPersistStores(t, world, currentVMOutput)
+		if currentVMOutput != nil {
+			gasRemaining += currentVMOutput.GasRemaining
+		}
 
 		crossShardEdges := getCrossShardEdgesFromSubtree(gasGraph, startNode, crossShardCallsQueue)
 		extractOuptutTransferCalls(currentVMOutput, crossShardEdges, crossShardCallsQueue)
@@ -885,6 +894,24 @@ func runGraphCallTestTemplateWithCustomAssertsConfig(t *testing.T, callGraph *te
 	if assertsConfig.finalAsserts != nil {
 		assertsConfig.finalAsserts(t, world, expectedCallFinishData, callsFinishData)
 	}
+
+	return &graphCallTestResult{
+		gasGraph:        gasGraph,
+		world:           world,
+		callsFinishData: callsFinishData,
+		gasRemaining:    gasRemaining,
+	}
+}
+
+func computeGasGraph(callGraph *test.TestCallGraph) *test.TestCallGraph {
+	executionGraph := callGraph.CreateExecutionGraphFromCallGraph()
+
+	gasGraph := executionGraph.ComputeGasGraphFromExecutionGraph()
+	gasGraph.PropagateSyncFailures()
+	gasGraph.AssignExecutionRounds(nil)
+	gasGraph.ComputeRemainingGasBeforeCallbacks(nil)
+	gasGraph.ComputeRemainingGasAfterCallbacks()
+	return gasGraph
 }
 
 func createAsyncArgumentsFromAsyncData(
@@ -0,0 +1,77 @@
+package hostCoretest
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/stretchr/testify/require"
+)
+
+// callGraphFilesDir holds the call graph tests written as JSON or YAML files, see test.TestCallGraphFile
+const callGraphFilesDir = "../../test/callgraphs"
+
+func TestGraph_CallGraphFiles(t *testing.T) {
+	paths := make([]string, 0)
+	for _, pattern := range []string{"*.json", "*.yaml", "*.yml"} {
+		matches, err := filepath.Glob(filepath.Join(callGraphFilesDir, pattern))
+		require.Nil(t, err)
+		paths = append(paths, matches...)
+	}
+	sort.Strings(paths)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		path := path
+		t.Run(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)), func(t *testing.T) {
+			RunGraphCallTestFromFile(t, path)
+		})
+	}
+}
+
+// RunGraphCallTestFromFile loads a call graph file, checks the expected outcomes it declares against
+// the computed gas graph, then runs the call graph like RunGraphCallTestTemplate does.
+func RunGraphCallTestFromFile(t *testing.T, path string) {
+	graphFile, err := test.LoadTestCallGraphFile(path)
+	require.Nil(t, err)
+
+	// computing the gas graph changes the nodes of the call graph, so the test runs on a fresh one
+	callGraph, err := graphFile.BuildTestCallGraph()
+	require.Nil(t, err)
+	if graphFile.Expected != nil {
+		checkCallGraphFileExpectations(t, computeGasGraph(callGraph), graphFile.Expected)
+	}
+
+	callGraph, err = graphFile.BuildTestCallGraph()
+	require.Nil(t, err)
+	RunGraphCallTestTemplate(t, callGraph)
+}
+
+func checkCallGraphFileExpectations(t *testing.T, gasGraph *test.TestCallGraph, expected *test.TestCallGraphFileExpected) {
+	totalGasUsed, totalGasRemaining := computeExpectedTotalGasValues(gasGraph)
+	if expected.GasUsed != nil {
+		require.Equal(t, *expected.GasUsed, totalGasUsed, "total gas used")
+	}
+	if expected.GasRemaining != nil {
+		require.Equal(t, *expected.GasRemaining, totalGasRemaining, "total gas remaining")
+	}
+	if expected.Calls == nil {
+		return
+	}
+
+	computedCalls := computeExpectedValues(gasGraph)
+	require.Equal(t, len(expected.Calls), len(computedCalls), "number of finished calls")
+	for i, expectedCall := range expected.Calls {
+		contractID, functionName, err := test.ParseTestCallGraphFileCall(expectedCall.Call)
+		require.Nil(t, err)
+
+		computedCall := computedCalls[i]
+		contractAndFunction := string(test.MakeTestSCAddress(contractID)) + "_" + functionName + test.TestReturnDataSuffix
+		require.Equal(t, contractAndFunction, computedCall.ContractAndFunction, "call %d", i)
+		require.Equal(t, expectedCall.GasProvided, computedCall.GasProvided, "gas provided to %s", expectedCall.Call)
+		require.Equal(t, expectedCall.GasRemaining, computedCall.GasRemaining, "gas remaining for %s", expectedCall.Call)
+		require.Equal(t, expectedCall.Fail, computedCall.FailError != nil, "failure of %s", expectedCall.Call)
+	}
+}
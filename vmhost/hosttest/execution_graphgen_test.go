@@ -0,0 +1,219 @@
+package hostCoretest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"runtime"
+	"testing"
+
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/asyncstorage"
+	"github.com/stretchr/testify/require"
+)
+
+var graphFuzzFlag = flag.Bool("graphFuzz", false, "Generate call graphs of all the shapes supported by the generator")
+
+var graphSeedFlag = flag.Int64("graphSeed", 0, "Seed of a generated call graph to replay")
+
+var graphIterationsFlag = flag.Int("graphIterations", 50, "Number of generated call graphs to run, with the seeds 1, 2, ...")
+
+var graphProtectedKeyPrefix = []byte("E" + "L" + "R" + "O" + "N" + "D")
+
+// TestGraph_GeneratedCallGraphs runs random call graphs and checks the invariants of async execution on them.
+// By default, only the shapes on which the host and the gas graph are known to agree are generated: async calls
+// made by the start node and no failing sync calls. Use -graphFuzz to generate all the shapes, which finds
+// the corner cases where they disagree.
+func TestGraph_GeneratedCallGraphs(t *testing.T) {
+	seeds := make([]int64, 0)
+	if *graphSeedFlag != 0 {
+		seeds = append(seeds, *graphSeedFlag)
+	} else {
+		for seed := int64(1); seed <= int64(*graphIterationsFlag); seed++ {
+			seeds = append(seeds, seed)
+		}
+	}
+
+	config := test.DefaultTestCallGraphGeneratorConfig()
+	if !*graphFuzzFlag {
+		config.MaxAsyncCallerDepth = 0
+		config.SyncFailProbability = 0
+	}
+
+	for _, seed := range seeds {
+		graphFile := test.GenerateTestCallGraphFile(seed, config)
+		failure := checkGeneratedCallGraph(t, graphFile)
+		if len(failure) == 0 {
+			continue
+		}
+
+		minimalGraphFile := test.ShrinkTestCallGraphFile(graphFile, func(candidate *test.TestCallGraphFile) bool {
+			return len(checkGeneratedCallGraph(t, candidate)) > 0
+		})
+		encoded, err := json.MarshalIndent(minimalGraphFile, "", "  ")
+		require.Nil(t, err)
+		t.Errorf("generated call graph %d failed: %s\nreplay it with -graphSeed=%d; the smallest graph which still fails is:\n%s\nand it fails with: %s",
+			seed, failure, seed, encoded, checkGeneratedCallGraph(t, minimalGraphFile))
+	}
+}
+
+func TestGraph_ShrinkTestCallGraphFile(t *testing.T) {
+	graphFile := test.GenerateTestCallGraphFile(1, &test.TestCallGraphGeneratorConfig{
+		StartGasLimit:           10000,
+		MaxDepth:                3,
+		MaxFanOut:               3,
+		MaxAsyncCallsPerNode:    3,
+		MaxExtraGasLocked:       100,
+		AsyncCallProbability:    1,
+		CallbackProbability:     1,
+		CallbackFailProbability: 1,
+	})
+	require.Greater(t, len(graphFile.Edges), 1)
+
+	hasCallbackFail := func(candidate *test.TestCallGraphFile) bool {
+		for _, edge := range candidate.Edges {
+			if edge.CallbackFail {
+				return true
+			}
+		}
+		return false
+	}
+	minimalGraphFile := test.ShrinkTestCallGraphFile(graphFile, hasCallbackFail)
+	require.Len(t, minimalGraphFile.Edges, 1)
+	require.True(t, minimalGraphFile.Edges[0].CallbackFail)
+	require.Equal(t, graphFile.Start.Call, minimalGraphFile.Edges[0].From)
+}
+
+// checkGeneratedCallGraph runs a call graph and checks the invariants of async execution, returning the first
+// failure, if any. Besides the expected return data and gas of every call, it checks that the gas used and the
+// gas refunded add up to the gas limit of the transaction, that every async call and callback ran at most once,
+// as expected, and that no async context was left in storage.
+func checkGeneratedCallGraph(t *testing.T, graphFile *test.TestCallGraphFile) string {
+	callGraph, err := graphFile.BuildTestCallGraph()
+	if err != nil {
+		return err.Error()
+	}
+
+	recorder := &graphCheckRecorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			r := recover()
+			if r != nil {
+				recorder.fail(fmt.Sprintf("panic: %v", r))
+			}
+		}()
+
+		result := runGraphCallTestTemplateWithCustomAssertsConfig(recorder, callGraph, &assertsConfig{
+			assertsAfterEachRootCall: assertRootCallResults,
+		})
+		checkNoPersistedAsyncContexts(recorder, result.world)
+		checkThatStoreIsEmpty(recorder, result.world)
+		checkAsyncCallsResolvedOnce(recorder, result)
+		checkGasConserved(recorder, result)
+		checkCallFinishDataForGraphTesting(recorder, computeExpectedValues(result.gasGraph), result.callsFinishData.Data)
+	}()
+	<-done
+
+	return recorder.failure
+}
+
+func checkNoPersistedAsyncContexts(tb testing.TB, world *worldmock.MockWorld) {
+	inspector, err := asyncstorage.NewInspector(graphProtectedKeyPrefix)
+	require.Nil(tb, err)
+
+	persisted := inspector.Inspect(asyncstorage.AccountsFromWorld(world))
+	if len(persisted) == 0 {
+		return
+	}
+	report := &bytes.Buffer{}
+	_ = asyncstorage.WriteReport(report, persisted)
+	require.Fail(tb, "async contexts left in storage", report.String())
+}
+
+func checkAsyncCallsResolvedOnce(tb testing.TB, result *graphCallTestResult) {
+	expectedRuns := make(map[string]int)
+	for _, node := range result.gasGraph.Nodes {
+		if node.IsAsync() || node.IsCallback() {
+			expectedRuns[graphCallFinishDataName(node)] = 0
+		}
+	}
+	for _, expected := range computeExpectedValues(result.gasGraph) {
+		if _, isAsync := expectedRuns[expected.ContractAndFunction]; isAsync {
+			expectedRuns[expected.ContractAndFunction]++
+		}
+	}
+
+	actualRuns := make(map[string]int)
+	for _, actual := range result.callsFinishData.Data {
+		actualRuns[actual.ContractAndFunction]++
+	}
+	for name, expected := range expectedRuns {
+		require.Equal(tb, expected, actualRuns[name], "number of runs of '%s'", name)
+	}
+}
+
+func checkGasConserved(tb testing.TB, result *graphCallTestResult) {
+	gasLimit := result.gasGraph.StartNode.GasLimit
+	totalGasUsed, _ := computeExpectedTotalGasValues(result.gasGraph)
+	require.Equal(tb, int(gasLimit), int(totalGasUsed+result.gasRemaining), "gas used and gas refunded must add up to the gas limit")
+}
+
+func graphCallFinishDataName(node *test.TestCallNode) string {
+	return string(node.Call.ContractAddress) + "_" + node.Call.FunctionName + test.TestReturnDataSuffix
+}
+
+// graphCheckRecorder records the first failure of a call graph check instead of failing the test, so that
+// a failing generated call graph can be shrunk. Like testing.T, it stops the check at FailNow, which means
+// the check must run in its own goroutine.
+type graphCheckRecorder struct {
+	testing.TB
+	failure string
+}
+
+func (recorder *graphCheckRecorder) fail(failure string) {
+	if len(recorder.failure) == 0 {
+		recorder.failure = failure
+	}
+}
+
+// Errorf -
+func (recorder *graphCheckRecorder) Errorf(format string, args ...interface{}) {
+	recorder.fail(fmt.Sprintf(format, args...))
+}
+
+// Error -
+func (recorder *graphCheckRecorder) Error(args ...interface{}) {
+	recorder.fail(fmt.Sprint(args...))
+}
+
+// Fatalf -
+func (recorder *graphCheckRecorder) Fatalf(format string, args ...interface{}) {
+	recorder.fail(fmt.Sprintf(format, args...))
+	recorder.FailNow()
+}
+
+// Fatal -
+func (recorder *graphCheckRecorder) Fatal(args ...interface{}) {
+	recorder.fail(fmt.Sprint(args...))
+	recorder.FailNow()
+}
+
+// Fail -
+func (recorder *graphCheckRecorder) Fail() {
+	recorder.fail("failed")
+}
+
+// FailNow -
+func (recorder *graphCheckRecorder) FailNow() {
+	recorder.fail("failed")
+	runtime.Goexit()
+}
+
+// Failed -
+func (recorder *graphCheckRecorder) Failed() bool {
+	return len(recorder.failure) > 0
+}
@@ -0,0 +1,107 @@
+package hostCoretest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/multiversx/mx-chain-vm-go/mock/contracts"
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecution_MultiShardWorld_AsyncCallCrossShard(t *testing.T) {
+	testConfig := makeTestConfig()
+	testConfig.GasProvided = 1000
+
+	hosts := make(map[uint32]vmhost.VMHost)
+	executorFactories := make(map[uint32]*mock.ExecutorMockFactory)
+	multiShardWorld, err := worldmock.NewMultiShardWorld(2, func(shardID uint32, world *worldmock.MockWorld) (vmcommon.VMExecutionHandler, error) {
+		executorFactory := mock.NewExecutorMockFactory(world)
+		host := test.NewTestHostBuilder(t).
+			WithExecutorFactory(executorFactory).
+			WithBlockchainHook(world).
+			Build()
+		setZeroCodeCosts(host)
+		setAsyncCosts(host, testConfig.GasLockCost)
+
+		hosts[shardID] = host
+		executorFactories[shardID] = executorFactory
+		return host, nil
+	})
+	require.Nil(t, err)
+	defer func() {
+		for _, host := range hosts {
+			host.Reset()
+		}
+	}()
+
+	_, err = multiShardWorld.CreateAccount(0, test.UserAddress)
+	require.Nil(t, err)
+	_, err = multiShardWorld.CreateAccount(0, test.ThirdPartyAddress)
+	require.Nil(t, err)
+	_, err = multiShardWorld.CreateAccount(1, test.VaultAddress)
+	require.Nil(t, err)
+
+	parentContract := test.CreateMockContractOnShard(test.ParentAddress, 0).
+		WithConfig(testConfig).
+		WithMethods(contracts.PerformAsyncCallParentMock, contracts.CallBackParentMock)
+	parentContract.Initialize(t, hosts[0], executorFactories[0].LastCreatedExecutor, false)
+	parentAccount, err := multiShardWorld.CreateSmartContractAccount(0, test.UserAddress, test.ParentAddress, test.ParentAddress)
+	require.Nil(t, err)
+	parentAccount.SetBalance(testConfig.ParentBalance)
+
+	childContract := test.CreateMockContractOnShard(test.ChildAddress, 1).
+		WithConfig(testConfig).
+		WithMethods(contracts.TransferToThirdPartyAsyncChildMock)
+	childContract.Initialize(t, hosts[1], executorFactories[1].LastCreatedExecutor, false)
+	childAccount, err := multiShardWorld.CreateSmartContractAccount(1, test.UserAddress, test.ChildAddress, test.ChildAddress)
+	require.Nil(t, err)
+	childAccount.SetBalance(testConfig.ChildBalance)
+
+	input := test.CreateTestContractCallInputBuilder().
+		WithCallerAddr(test.UserAddress).
+		WithRecipientAddr(test.ParentAddress).
+		WithGasProvided(testConfig.GasProvided).
+		WithFunction("performAsyncCall").
+		WithArguments([]byte{0}).
+		Build()
+
+	executedTx, err := multiShardWorld.RunSmartContractCall(input)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, executedTx.Output.ReturnCode)
+	require.Equal(t, uint32(0), executedTx.ShardID)
+	require.True(t, multiShardWorld.HasPendingMessages())
+
+	executed, err := multiShardWorld.ProcessBlocksUntilIdle(5)
+	require.Nil(t, err)
+	require.Len(t, executed, 2)
+
+	asyncCall := executed[0]
+	require.Equal(t, uint32(1), asyncCall.ShardID)
+	require.Equal(t, uint64(1), asyncCall.Block)
+	require.Equal(t, vm.AsynchronousCall, asyncCall.Input.CallType)
+	require.Equal(t, vmcommon.Ok, asyncCall.Output.ReturnCode)
+
+	callback := executed[1]
+	require.Equal(t, uint32(0), callback.ShardID)
+	require.Equal(t, uint64(2), callback.Block)
+	require.Equal(t, vm.AsynchronousCallBack, callback.Input.CallType)
+	require.Equal(t, vmcommon.Ok, callback.Output.ReturnCode)
+	require.Contains(t, callback.Output.ReturnData, []byte("succ"))
+
+	require.False(t, multiShardWorld.HasPendingMessages())
+	require.Equal(t, uint64(2), multiShardWorld.CurrentBlock)
+
+	// the parent paid the child and the third party, the child paid the third party and the vault
+	expectedParentBalance := testConfig.ParentBalance - testConfig.TransferFromParentToChild - testConfig.TransferToThirdParty
+	expectedChildBalance := testConfig.ChildBalance + testConfig.TransferFromParentToChild - testConfig.TransferToThirdParty - testConfig.TransferToVault
+	require.Equal(t, big.NewInt(expectedParentBalance), multiShardWorld.GetAccount(test.ParentAddress).Balance)
+	require.Equal(t, big.NewInt(expectedChildBalance), multiShardWorld.GetAccount(test.ChildAddress).Balance)
+	require.Equal(t, big.NewInt(2*testConfig.TransferToThirdParty), multiShardWorld.GetAccount(test.ThirdPartyAddress).Balance)
+	require.Equal(t, big.NewInt(testConfig.TransferToVault), multiShardWorld.GetAccount(test.VaultAddress).Balance)
+}
@@ -676,6 +676,37 @@ func TestExecution_CachingCompiledCode(t *testing.T) {
 	}
 }
 
+func TestExecution_TransactionMemoryBudget(t *testing.T) {
+	input := test.CreateTestContractCallInputBuilder().
+		WithGasProvided(100000).
+		WithFunction("mBufferAppendTest").
+		WithArguments([]byte{byte(10)}).
+		Build()
+
+	test.BuildInstanceCallTest(t).
+		WithContracts(
+			test.CreateInstanceContract(test.ParentAddress).
+				WithCode(test.GetTestSCCode("managed-buffers", "../../"))).
+		WithTransactionMemoryBudget(1 << 30).
+		WithInput(input).
+		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+
+	// the WASM memory of the instance alone exceeds the budget
+	test.BuildInstanceCallTest(t).
+		WithContracts(
+			test.CreateInstanceContract(test.ParentAddress).
+				WithCode(test.GetTestSCCode("managed-buffers", "../../"))).
+		WithTransactionMemoryBudget(1).
+		WithInput(input).
+		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				ReturnMessage(vmhost.ErrMemoryBudgetExceeded.Error()).
+				HasRuntimeErrors(vmhost.ErrMemoryBudgetExceeded.Error())
+		})
+}
+
 func TestExecution_ManagedBuffers(t *testing.T) {
 	var functionNumber = 0
 	var mBuffer = [...]string{"mBufferMethod", "mBufferNewTest", "mBufferNewFromBytesTest", "mBufferSetRandomTest",
@@ -2,9 +2,13 @@ package hostCoretest
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"strings"
 	"testing"
@@ -12,10 +16,18 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
 	"github.com/multiversx/mx-chain-scenario-go/esdtconvert"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/crypto/bn254"
 	"github.com/multiversx/mx-chain-vm-go/crypto/hashing"
+	vmsigning "github.com/multiversx/mx-chain-vm-go/crypto/signing"
 	"github.com/multiversx/mx-chain-vm-go/crypto/signing/secp256k1"
+	"github.com/multiversx/mx-chain-vm-go/crypto/weierstrass"
 	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
 	"github.com/multiversx/mx-chain-vm-go/mock/contracts"
 	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
@@ -353,6 +365,93 @@ func Test_ManagedRipemd160(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_ManagedHashesWithGasPerByte(t *testing.T) {
+	hasher := hashing.NewHasher()
+	data := []byte{1, 2, 3}
+	poseidonInput := make([]byte, 2*hashing.PoseidonElementLength)
+	poseidonInput[hashing.PoseidonElementLength-1] = 1
+	poseidonInput[2*hashing.PoseidonElementLength-1] = 2
+
+	testCases := []struct {
+		name  string
+		input []byte
+		hash  func([]byte) ([]byte, error)
+		hook  func(hooks *vmhooks.VMHooksImpl, inputHandle, outputHandle int32) int32
+	}{
+		{"sha512", data, hasher.Sha512, (*vmhooks.VMHooksImpl).ManagedSha512},
+		{"sha3256", data, hasher.Sha3256, (*vmhooks.VMHooksImpl).ManagedSha3256},
+		{"sha3512", data, hasher.Sha3512, (*vmhooks.VMHooksImpl).ManagedSha3512},
+		{"blake2b256", data, hasher.Blake2b256, (*vmhooks.VMHooksImpl).ManagedBlake2b256},
+		{"blake2b512", data, hasher.Blake2b512, (*vmhooks.VMHooksImpl).ManagedBlake2b512},
+		{"poseidon", poseidonInput, hasher.Poseidon, (*vmhooks.VMHooksImpl).ManagedPoseidon},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			expected, err := testCase.hash(testCase.input)
+			require.Nil(t, err)
+			testManagedHash(t, testCase.input, testCase.hook, expected, nil)
+		})
+	}
+
+	t.Run("poseidon invalid input", func(t *testing.T) {
+		testManagedHash(t, data, (*vmhooks.VMHooksImpl).ManagedPoseidon, nil, hashing.ErrInvalidPoseidonInputLength)
+	})
+}
+
+func testManagedHash(
+	t *testing.T,
+	input []byte,
+	hook func(hooks *vmhooks.VMHooksImpl, inputHandle, outputHandle int32) int32,
+	expectedResult []byte,
+	expectedErr error,
+) {
+	testConfig := baseTestConfig
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						inputHandle := managedTypes.NewManagedBufferFromBytes(input)
+						outputHandle := managedTypes.NewManagedBuffer()
+
+						result := hook(vmhooks.NewVMHooksImpl(host), inputHandle, outputHandle)
+						if expectedErr != nil {
+							return parentInstance
+						}
+
+						bytesResult, _ := managedTypes.GetBytes(outputHandle)
+						if result != 0 || !bytes.Equal(bytesResult, expectedResult) {
+							host.Runtime().SignalUserError("assert failed")
+						}
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			if expectedErr != nil {
+				verify.ExecutionFailed().
+					HasRuntimeErrors(expectedErr.Error())
+				return
+			}
+			verify.
+				Ok()
+		})
+	assert.Nil(t, err)
+}
+
 const blsCheckOK = "3e886a4c6e109a151f4105aee65a5192d150ef1fa68d3cd76964a0b086006dbe4324c989deb0e4416c6d6706db1b1910eb2732f08842fb4886067b9ed191109ac2188d76002d2e11da80a3f0ea89fee6b59c834cc478a6bd49cb8a193b1abb16@e96bd0f36b70c5ccc0c4396343bd7d8255b8a526c55fa1e218511fafe6539b8e@04725db195e37aa237cdbbda76270d4a229b6e7a3651104dc58c4349c0388e8546976fe54a04240530b99064e434c90f"
 
 func blsSplitString(t testing.TB, str string) ([]byte, []byte, []byte) {
@@ -414,6 +513,162 @@ func Test_ManagedVerifyBLS(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_ManagedVerifyBLSAggregated(t *testing.T) {
+	message := []byte("message signed by all the keys")
+	keys, aggregatedSig := blsAggregatedSignature(t, 3, message)
+
+	t.Run("valid signature", func(t *testing.T) {
+		testManagedVerifyBLSAggregated(t, true, keys, message, aggregatedSig, 0, nil)
+	})
+	t.Run("other message", func(t *testing.T) {
+		testManagedVerifyBLSAggregated(t, true, keys, []byte("other message"), aggregatedSig, -1, crypto.ErrAggSigNotValid)
+	})
+	t.Run("missing key", func(t *testing.T) {
+		testManagedVerifyBLSAggregated(t, true, keys[:2], message, aggregatedSig, -1, crypto.ErrAggSigNotValid)
+	})
+	t.Run("flag not enabled", func(t *testing.T) {
+		testManagedVerifyBLSAggregated(t, false, keys, message, aggregatedSig, 1, vmhost.ErrBLSMultiSigNotEnabled)
+	})
+	t.Run("not enough gas for the keys", func(t *testing.T) {
+		tooManyKeys := make([][]byte, int(baseTestConfig.GasProvided)+1)
+		testManagedVerifyBLSAggregated(t, true, tooManyKeys, message, aggregatedSig, 1, vmhost.ErrNotEnoughGas)
+	})
+}
+
+func testManagedVerifyBLSAggregated(
+	t *testing.T,
+	flagEnabled bool,
+	keys [][]byte,
+	message []byte,
+	sig []byte,
+	expectedResult int32,
+	expectedErr error,
+) {
+	testConfig := baseTestConfig
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						enableEpochsHandler, _ := host.EnableEpochsHandler().(*worldmock.EnableEpochsHandlerStub)
+						enableEpochsHandler.IsBLSMultiSigFlagEnabledField = flagEnabled
+
+						managedTypes := host.ManagedTypes()
+						keysHandle := managedTypes.NewManagedBuffer()
+						managedTypes.WriteManagedVecOfManagedBuffers(keys, keysHandle)
+						messageHandle := managedTypes.NewManagedBufferFromBytes(message)
+						sigHandle := managedTypes.NewManagedBufferFromBytes(sig)
+
+						result := vmhooks.NewVMHooksImpl(host).ManagedVerifyBLSAggregated(
+							keysHandle,
+							messageHandle,
+							sigHandle)
+						if result != expectedResult {
+							host.Runtime().SignalUserError("assert failed")
+						}
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			if expectedErr == nil {
+				verify.Ok()
+				return
+			}
+			if expectedErr == vmhost.ErrNotEnoughGas {
+				verify.OutOfGas().
+					HasRuntimeErrors(expectedErr.Error())
+				return
+			}
+			verify.ExecutionFailed().
+				HasRuntimeErrors(expectedErr.Error())
+		})
+	assert.Nil(t, err)
+}
+
+func Test_ManagedAggregateBLSPublicKeys(t *testing.T) {
+	testConfig := baseTestConfig
+	message := []byte("message signed by all the keys")
+	keys, aggregatedSig := blsAggregatedSignature(t, 3, message)
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						keysHandle := managedTypes.NewManagedBuffer()
+						managedTypes.WriteManagedVecOfManagedBuffers(keys, keysHandle)
+						aggregatedKeyHandle := managedTypes.NewManagedBuffer()
+
+						result := vmhooks.NewVMHooksImpl(host).ManagedAggregateBLSPublicKeys(keysHandle, aggregatedKeyHandle)
+						if result != 0 {
+							host.Runtime().SignalUserError("assert failed")
+							return parentInstance
+						}
+
+						aggregatedKey, err := managedTypes.GetBytes(aggregatedKeyHandle)
+						require.Nil(t, err)
+						require.Nil(t, host.Crypto().VerifyBLS(aggregatedKey, message, aggregatedSig))
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.
+				Ok()
+		})
+	assert.Nil(t, err)
+}
+
+func blsAggregatedSignature(t testing.TB, numKeys int, message []byte) ([][]byte, []byte) {
+	suite := mcl.NewSuiteBLS12()
+	keyGenerator := signing.NewKeyGenerator(suite)
+	hasher, err := blake2b.NewBlake2bWithSize(multisig.HasherOutputSize)
+	require.Nil(t, err)
+	multiSigner := &multisig.BlsMultiSigner{Hasher: hasher}
+
+	keys := make([][]byte, 0, numKeys)
+	publicKeys := make([]crypto.PublicKey, 0, numKeys)
+	sigs := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		privateKey, publicKey := keyGenerator.GeneratePair()
+		sig, err := multiSigner.SignShare(privateKey, message)
+		require.Nil(t, err)
+
+		key, err := publicKey.ToByteArray()
+		require.Nil(t, err)
+
+		keys = append(keys, key)
+		publicKeys = append(publicKeys, publicKey)
+		sigs = append(sigs, sig)
+	}
+
+	aggregatedSig, err := multiSigner.AggregateSignatures(suite, sigs, publicKeys)
+	require.Nil(t, err)
+
+	return keys, aggregatedSig
+}
+
 func Test_ManagedVerifyEd25519(t *testing.T) {
 	testConfig := baseTestConfig
 
@@ -464,6 +719,175 @@ func Test_ManagedVerifyEd25519(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_ManagedVerifyEd25519Batch(t *testing.T) {
+	keys, messages, sigs := ed25519Batch(t, 3)
+	invalidSigs := [][]byte{sigs[0], sigs[2], sigs[1]}
+
+	t.Run("all valid", func(t *testing.T) {
+		testManagedSignatureBatch(t, keys, messages, sigs, func(hooks *vmhooks.VMHooksImpl, keysHandle, messagesHandle, sigsHandle int32) bool {
+			return hooks.ManagedVerifyEd25519Batch(keysHandle, messagesHandle, sigsHandle) == 0
+		}, nil)
+	})
+	t.Run("fail fast", func(t *testing.T) {
+		testManagedSignatureBatch(t, keys, messages, invalidSigs, func(hooks *vmhooks.VMHooksImpl, keysHandle, messagesHandle, sigsHandle int32) bool {
+			return hooks.ManagedVerifyEd25519Batch(keysHandle, messagesHandle, sigsHandle) == -1
+		}, vmhost.ErrInvalidSignatureInBatch)
+	})
+	t.Run("bitmap", func(t *testing.T) {
+		testManagedSignatureBatch(t, keys, messages, invalidSigs, func(hooks *vmhooks.VMHooksImpl, keysHandle, messagesHandle, sigsHandle int32) bool {
+			return checkBatchBitmap(hooks, []byte{0x01}, func(resultHandle int32) int32 {
+				return hooks.ManagedVerifyEd25519BatchBitmap(keysHandle, messagesHandle, sigsHandle, resultHandle)
+			})
+		}, nil)
+	})
+	t.Run("not enough gas for the signatures", func(t *testing.T) {
+		// the gas is charged before any vector is read, so the length mismatch is never reached
+		tooManyKeys := make([][]byte, 100001)
+		testManagedSignatureBatch(t, tooManyKeys, nil, nil, func(hooks *vmhooks.VMHooksImpl, keysHandle, messagesHandle, sigsHandle int32) bool {
+			return hooks.ManagedVerifyEd25519Batch(keysHandle, messagesHandle, sigsHandle) == 1
+		}, vmhost.ErrNotEnoughGas)
+	})
+}
+
+func Test_ManagedVerifySecp256r1(t *testing.T) {
+	keys, messages, sigs := secp256r1Batch(t, 10)
+	invalidSigs := make([][]byte, len(sigs))
+	copy(invalidSigs, sigs)
+	invalidSigs[3], invalidSigs[9] = sigs[9], sigs[3]
+
+	t.Run("single", func(t *testing.T) {
+		testManagedSignatureBatch(t, keys, messages, sigs, func(hooks *vmhooks.VMHooksImpl, _, _, _ int32) bool {
+			managedTypes := hooks.GetManagedTypesContext()
+			keyHandle := managedTypes.NewManagedBufferFromBytes(keys[0])
+			messageHandle := managedTypes.NewManagedBufferFromBytes(messages[0])
+			sigHandle := managedTypes.NewManagedBufferFromBytes(sigs[0])
+			return hooks.ManagedVerifySecp256r1(keyHandle, messageHandle, sigHandle) == 0
+		}, nil)
+	})
+	t.Run("fail fast", func(t *testing.T) {
+		testManagedSignatureBatch(t, keys, messages, invalidSigs, func(hooks *vmhooks.VMHooksImpl, keysHandle, messagesHandle, sigsHandle int32) bool {
+			return hooks.ManagedVerifySecp256r1Batch(keysHandle, messagesHandle, sigsHandle) == -1
+		}, vmhost.ErrInvalidSignatureInBatch)
+	})
+	t.Run("bitmap", func(t *testing.T) {
+		testManagedSignatureBatch(t, keys, messages, invalidSigs, func(hooks *vmhooks.VMHooksImpl, keysHandle, messagesHandle, sigsHandle int32) bool {
+			return checkBatchBitmap(hooks, []byte{0xf7, 0x01}, func(resultHandle int32) int32 {
+				return hooks.ManagedVerifySecp256r1BatchBitmap(keysHandle, messagesHandle, sigsHandle, resultHandle)
+			})
+		}, nil)
+	})
+	t.Run("length mismatch", func(t *testing.T) {
+		testManagedSignatureBatch(t, keys, messages[1:], sigs, func(hooks *vmhooks.VMHooksImpl, keysHandle, messagesHandle, sigsHandle int32) bool {
+			return hooks.ManagedVerifySecp256r1Batch(keysHandle, messagesHandle, sigsHandle) == 1
+		}, vmsigning.ErrBatchLengthMismatch)
+	})
+}
+
+func testManagedSignatureBatch(
+	t *testing.T,
+	keys [][]byte,
+	messages [][]byte,
+	sigs [][]byte,
+	callHook func(hooks *vmhooks.VMHooksImpl, keysHandle, messagesHandle, sigsHandle int32) bool,
+	expectedErr error,
+) {
+	testConfig := *baseTestConfig
+	testConfig.GasProvided = 100000
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(&testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						keysHandle := managedTypes.NewManagedBuffer()
+						managedTypes.WriteManagedVecOfManagedBuffers(keys, keysHandle)
+						messagesHandle := managedTypes.NewManagedBuffer()
+						managedTypes.WriteManagedVecOfManagedBuffers(messages, messagesHandle)
+						sigsHandle := managedTypes.NewManagedBuffer()
+						managedTypes.WriteManagedVecOfManagedBuffers(sigs, sigsHandle)
+
+						if !callHook(vmhooks.NewVMHooksImpl(host), keysHandle, messagesHandle, sigsHandle) {
+							host.Runtime().SignalUserError("assert failed")
+						}
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			if expectedErr == nil {
+				verify.Ok()
+				return
+			}
+			if expectedErr == vmhost.ErrNotEnoughGas {
+				verify.OutOfGas().
+					HasRuntimeErrors(expectedErr.Error())
+				return
+			}
+			verify.ExecutionFailed().
+				HasRuntimeErrors(expectedErr.Error())
+		})
+	assert.Nil(t, err)
+}
+
+func checkBatchBitmap(hooks *vmhooks.VMHooksImpl, expectedBitmap []byte, callHook func(resultHandle int32) int32) bool {
+	managedTypes := hooks.GetManagedTypesContext()
+	resultHandle := managedTypes.NewManagedBuffer()
+	if callHook(resultHandle) != 0 {
+		return false
+	}
+
+	bitmap, err := managedTypes.GetBytes(resultHandle)
+	return err == nil && bytes.Equal(expectedBitmap, bitmap)
+}
+
+func ed25519Batch(t *testing.T, size int) ([][]byte, [][]byte, [][]byte) {
+	keys := make([][]byte, 0, size)
+	messages := make([][]byte, 0, size)
+	sigs := make([][]byte, 0, size)
+	for i := 0; i < size; i++ {
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.Nil(t, err)
+		message := []byte(fmt.Sprintf("message %d", i))
+
+		keys = append(keys, publicKey)
+		messages = append(messages, message)
+		sigs = append(sigs, ed25519.Sign(privateKey, message))
+	}
+	return keys, messages, sigs
+}
+
+func secp256r1Batch(t *testing.T, size int) ([][]byte, [][]byte, [][]byte) {
+	keys := make([][]byte, 0, size)
+	messages := make([][]byte, 0, size)
+	sigs := make([][]byte, 0, size)
+	for i := 0; i < size; i++ {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.Nil(t, err)
+		message := []byte(fmt.Sprintf("message %d", i))
+		hash := sha256.Sum256(message)
+		r, s, err := ecdsa.Sign(rand.Reader, privateKey, hash[:])
+		require.Nil(t, err)
+		sig := make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+
+		keys = append(keys, elliptic.MarshalCompressed(elliptic.P256(), privateKey.X, privateKey.Y))
+		messages = append(messages, message)
+		sigs = append(sigs, sig)
+	}
+	return keys, messages, sigs
+}
+
 func Test_VerifySecp256k1(t *testing.T) {
 	testConfig := baseTestConfig
 
@@ -1050,6 +1474,194 @@ func Test_ManagedCreateEC(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_ManagedCreateCustomEC(t *testing.T) {
+	brainpoolP256t1 := weierstrass.NewCurveFromParams(&elliptic.CurveParams{
+		P:       bigIntFromHex("a9fb57dba1eea9bc3e660a909d838d726e3bf623d52620282013481d1f6e5377"),
+		N:       bigIntFromHex("a9fb57dba1eea9bc3e660a909d838d718c397aa3b561a6f7901e0e82974856a7"),
+		B:       bigIntFromHex("662c61c430d84ea4fe66a7733d0b76b7bf93ebc4af2f49256ae58101fee92b04"),
+		Gx:      bigIntFromHex("a3e8eb3cc1cfe7b7732213b23a656149afa142c47aafbc2b79a191562e1305f4"),
+		Gy:      bigIntFromHex("2d996c823439c56d7f7b22e14644417e69bcb6de39d027001dabe8f35b25c9be"),
+		BitSize: 256,
+	})
+	secp256k1Params := &elliptic.CurveParams{
+		P:       bigIntFromHex("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"),
+		N:       bigIntFromHex("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"),
+		B:       big.NewInt(7),
+		Gx:      bigIntFromHex("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"),
+		Gy:      bigIntFromHex("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"),
+		BitSize: 256,
+	}
+	secp256k1 := weierstrass.NewCurve(secp256k1Params, big.NewInt(0))
+	starkP := bigIntFromHex("800000000000011000000000000000000000000000000000000000000000001")
+	stark := weierstrass.NewCurve(&elliptic.CurveParams{
+		P:       starkP,
+		N:       bigIntFromHex("800000000000010ffffffffffffffffb781126dcae7b2321e66a241adc64d2f"),
+		B:       bigIntFromHex("6f21413efbe40de150e596d72f7a8c5609ad26c15c915c1f4cdfcb99cee9e89"),
+		Gx:      bigIntFromHex("1ef15c18599971b7beced415a40f0c7deacfd9b0d1819e03d723d8bc943cfca"),
+		Gy:      bigIntFromHex("5668060aa49730b7be4801df46ec62de53ecd11abe43a32873000c36e8dc1f"),
+		BitSize: starkP.BitLen(),
+	}, big.NewInt(1))
+	p256 := weierstrass.NewCurveFromParams(elliptic.P256().Params())
+	wrongSizeOfFieldParams := *p256.CurveParams
+	wrongSizeOfFieldParams.BitSize = 255
+	wrongOrderParams := *p256.CurveParams
+	wrongOrderParams.N = p256.P
+	// with a = -3 and b = 2, the discriminant 4a^3 + 27b^2 is zero
+	singularParams := *secp256k1Params
+	singularParams.B = big.NewInt(2)
+
+	t.Run("p256", func(t *testing.T) {
+		testManagedCreateCustomEC(t, p256, true, nil)
+	})
+	t.Run("brainpoolP256t1", func(t *testing.T) {
+		testManagedCreateCustomEC(t, brainpoolP256t1, true, nil)
+	})
+	t.Run("secp256k1", func(t *testing.T) {
+		testManagedCreateCustomEC(t, secp256k1, true, nil)
+	})
+	t.Run("stark", func(t *testing.T) {
+		testManagedCreateCustomEC(t, stark, true, nil)
+	})
+	t.Run("flag not enabled", func(t *testing.T) {
+		testManagedCreateCustomEC(t, secp256k1, false, vmhost.ErrCustomEllipticCurvesNotEnabled)
+	})
+	t.Run("wrong a", func(t *testing.T) {
+		testManagedCreateCustomEC(t, weierstrass.NewCurveFromParams(secp256k1Params), true, vmhost.ErrInvalidEllipticCurveParams)
+	})
+	t.Run("singular", func(t *testing.T) {
+		testManagedCreateCustomEC(t, weierstrass.NewCurveFromParams(&singularParams), true, vmhost.ErrInvalidEllipticCurveParams)
+	})
+	t.Run("wrong size of field", func(t *testing.T) {
+		testManagedCreateCustomEC(t, weierstrass.NewCurveFromParams(&wrongSizeOfFieldParams), true, vmhost.ErrInvalidEllipticCurveParams)
+	})
+	t.Run("wrong base point order", func(t *testing.T) {
+		testManagedCreateCustomEC(t, weierstrass.NewCurveFromParams(&wrongOrderParams), true, vmhost.ErrInvalidEllipticCurveParams)
+	})
+}
+
+func testManagedCreateCustomEC(t *testing.T, curve *weierstrass.Curve, flagEnabled bool, expectedErr error) {
+	testConfig := *baseTestConfig
+	testConfig.GasProvided = 100000
+	scalar := []byte{7}
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(&testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						enableEpochsHandler, _ := host.EnableEpochsHandler().(*worldmock.EnableEpochsHandlerStub)
+						enableEpochsHandler.IsCustomEllipticCurvesFlagEnabledField = flagEnabled
+
+						managedTypes := host.ManagedTypes()
+						vmHooks := vmhooks.NewVMHooksImpl(host)
+
+						ecHandle := vmHooks.ManagedCreateCustomEC(
+							managedTypes.NewBigInt(curve.P),
+							managedTypes.NewBigInt(curve.N),
+							managedTypes.NewBigInt(curve.A),
+							managedTypes.NewBigInt(curve.B),
+							managedTypes.NewBigInt(curve.Gx),
+							managedTypes.NewBigInt(curve.Gy),
+							int32(curve.BitSize))
+						if expectedErr != nil {
+							return parentInstance
+						}
+						if ecHandle < 0 {
+							host.Runtime().SignalUserError("assert failed")
+							return parentInstance
+						}
+
+						xResultHandle := managedTypes.NewBigIntFromInt64(0)
+						yResultHandle := managedTypes.NewBigIntFromInt64(0)
+						dataHandle := managedTypes.NewManagedBufferFromBytes(scalar)
+						result := vmHooks.ManagedScalarBaseMultEC(xResultHandle, yResultHandle, ecHandle, dataHandle)
+						xResult, _ := managedTypes.GetBigInt(xResultHandle)
+						yResult, _ := managedTypes.GetBigInt(yResultHandle)
+						expectedX, expectedY := curve.ScalarBaseMult(scalar)
+						if result != 0 || xResult.Cmp(expectedX) != 0 || yResult.Cmp(expectedY) != 0 {
+							host.Runtime().SignalUserError("assert failed")
+							return parentInstance
+						}
+
+						if vmHooks.IsOnCurveEC(ecHandle, xResultHandle, yResultHandle) != 1 {
+							host.Runtime().SignalUserError("assert failed")
+						}
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			if expectedErr != nil {
+				verify.ExecutionFailed().
+					HasRuntimeErrors(expectedErr.Error())
+				return
+			}
+			verify.
+				Ok()
+		})
+	assert.Nil(t, err)
+}
+
+func TestIsOnCurveEC_NoCurveUnderHandle(t *testing.T) {
+	t.Run("flag enabled", func(t *testing.T) {
+		testIsOnCurveECNoCurveUnderHandle(t, true, -1)
+	})
+	t.Run("flag disabled", func(t *testing.T) {
+		testIsOnCurveECNoCurveUnderHandle(t, false, 1)
+	})
+}
+
+func testIsOnCurveECNoCurveUnderHandle(t *testing.T, flagEnabled bool, expectedResult int32) {
+	testConfig := *baseTestConfig
+	testConfig.GasProvided = 100000
+	result := int32(0)
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(&testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						enableEpochsHandler, _ := host.EnableEpochsHandler().(*worldmock.EnableEpochsHandlerStub)
+						enableEpochsHandler.IsFixVMHooksErrorHandlingFlagEnabledField = flagEnabled
+
+						managedTypes := host.ManagedTypes()
+						vmHooks := vmhooks.NewVMHooksImpl(host)
+						result = vmHooks.IsOnCurveEC(123, managedTypes.NewBigIntFromInt64(1), managedTypes.NewBigIntFromInt64(2))
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				HasRuntimeErrors(vmhost.ErrNoEllipticCurveUnderThisHandle.Error())
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func bigIntFromHex(value string) *big.Int {
+	result, _ := new(big.Int).SetString(value, 16)
+	return result
+}
+
 func checkCreateECSuccess(host vmhost.VMHost, name string, ecParams *elliptic.CurveParams) bool {
 	managedTypes := host.ManagedTypes()
 	dataHandle := managedTypes.NewManagedBufferFromBytes([]byte(name))
@@ -1067,6 +1679,197 @@ func checkCreateECSuccess(host vmhost.VMHost, name string, ecParams *elliptic.Cu
 	return true
 }
 
+const bn254G1Generator = "0000000000000000000000000000000000000000000000000000000000000001" +
+	"0000000000000000000000000000000000000000000000000000000000000002"
+
+const bn254G1GeneratorNegated = "0000000000000000000000000000000000000000000000000000000000000001" +
+	"30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd45"
+
+const bn254G1GeneratorDoubled = "030644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd3" +
+	"15ed738c0e0a7c92e7845f96b2ae9c0a68a6a449e3538fc7ff3ebf7a5a18a2c4"
+
+const bn254G2Generator = "198e9393920d483a7260bfb731fb5d25f1aa493335a9e71297e485b7aef312c2" +
+	"1800deef121f1e76426a00665e5c4479674322d4f75edadd46debd5cd992f6ed" +
+	"090689d0585ff075ec9e99ad690c3395bc4b313370b38ef355acdadcd122975b" +
+	"12c85ea5db8c6deb4aab71808dcb408fe3d1e7690c43d37b4ce6cc0166fa7daa"
+
+func Test_ManagedAddBN254G1(t *testing.T) {
+	testConfig := baseTestConfig
+	generator, _ := hex.DecodeString(bn254G1Generator)
+	negated, _ := hex.DecodeString(bn254G1GeneratorNegated)
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						vmHooks := vmhooks.NewVMHooksImpl(host)
+						generatorHandle := managedTypes.NewManagedBufferFromBytes(generator)
+						negatedHandle := managedTypes.NewManagedBufferFromBytes(negated)
+						resultHandle := managedTypes.NewManagedBuffer()
+
+						result := vmHooks.ManagedAddBN254G1(generatorHandle, generatorHandle, resultHandle)
+						sum, _ := managedTypes.GetBytes(resultHandle)
+						if result != 0 || hex.EncodeToString(sum) != bn254G1GeneratorDoubled {
+							host.Runtime().SignalUserError("assert failed")
+							return parentInstance
+						}
+
+						result = vmHooks.ManagedAddBN254G1(generatorHandle, negatedHandle, resultHandle)
+						sum, _ = managedTypes.GetBytes(resultHandle)
+						if result != 0 || !bytes.Equal(sum, make([]byte, len(generator))) {
+							host.Runtime().SignalUserError("assert failed")
+							return parentInstance
+						}
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.
+				Ok()
+		})
+	assert.Nil(t, err)
+}
+
+func Test_ManagedScalarMulBN254G1(t *testing.T) {
+	testConfig := baseTestConfig
+	generator, _ := hex.DecodeString(bn254G1Generator)
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						pointHandle := managedTypes.NewManagedBufferFromBytes(generator)
+						scalarHandle := managedTypes.NewManagedBufferFromBytes([]byte{2})
+						resultHandle := managedTypes.NewManagedBuffer()
+
+						result := vmhooks.NewVMHooksImpl(host).ManagedScalarMulBN254G1(pointHandle, scalarHandle, resultHandle)
+						product, _ := managedTypes.GetBytes(resultHandle)
+						if result != 0 || hex.EncodeToString(product) != bn254G1GeneratorDoubled {
+							host.Runtime().SignalUserError("assert failed")
+						}
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.
+				Ok()
+		})
+	assert.Nil(t, err)
+}
+
+func Test_ManagedPairingCheckBN254(t *testing.T) {
+	g1Generator, _ := hex.DecodeString(bn254G1Generator)
+	g1GeneratorNegated, _ := hex.DecodeString(bn254G1GeneratorNegated)
+	g2Generator, _ := hex.DecodeString(bn254G2Generator)
+
+	t.Run("product is one", func(t *testing.T) {
+		testManagedPairingCheckBN254(t,
+			[][]byte{g1Generator, g1GeneratorNegated},
+			[][]byte{g2Generator, g2Generator},
+			0,
+			nil)
+	})
+	t.Run("product is not one", func(t *testing.T) {
+		testManagedPairingCheckBN254(t,
+			[][]byte{g1Generator, g1Generator},
+			[][]byte{g2Generator, g2Generator},
+			-1,
+			vmhost.ErrBN254PairingCheckFailed)
+	})
+	t.Run("invalid point", func(t *testing.T) {
+		testManagedPairingCheckBN254(t,
+			[][]byte{g1Generator},
+			[][]byte{g1Generator},
+			1,
+			bn254.ErrInvalidPointLength)
+	})
+	t.Run("not enough gas for the pairs", func(t *testing.T) {
+		tooManyPoints := make([][]byte, int(baseTestConfig.GasProvided)+1)
+		testManagedPairingCheckBN254(t,
+			tooManyPoints,
+			tooManyPoints,
+			1,
+			vmhost.ErrNotEnoughGas)
+	})
+}
+
+func testManagedPairingCheckBN254(
+	t *testing.T,
+	g1Points [][]byte,
+	g2Points [][]byte,
+	expectedResult int32,
+	expectedErr error,
+) {
+	testConfig := baseTestConfig
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("testFunction", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						g1PointsHandle := managedTypes.NewManagedBuffer()
+						managedTypes.WriteManagedVecOfManagedBuffers(g1Points, g1PointsHandle)
+						g2PointsHandle := managedTypes.NewManagedBuffer()
+						managedTypes.WriteManagedVecOfManagedBuffers(g2Points, g2PointsHandle)
+
+						result := vmhooks.NewVMHooksImpl(host).ManagedPairingCheckBN254(g1PointsHandle, g2PointsHandle)
+						if result != expectedResult {
+							host.Runtime().SignalUserError("assert failed")
+						}
+
+						return parentInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("testFunction").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			if expectedErr == nil {
+				verify.Ok()
+				return
+			}
+			if expectedErr == vmhost.ErrNotEnoughGas {
+				verify.OutOfGas().
+					HasRuntimeErrors(expectedErr.Error())
+				return
+			}
+			verify.ExecutionFailed().
+				HasRuntimeErrors(expectedErr.Error())
+		})
+	assert.Nil(t, err)
+}
+
 func Test_ManagedDeleteContract(t *testing.T) {
 	testConfig := baseTestConfig
 
@@ -0,0 +1,881 @@
+//go:build go1.18
+// +build go1.18
+
+package hostCoretest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	worldmock "github.com/multiversx/mx-chain-vm-go/mock/world"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+	"github.com/stretchr/testify/require"
+)
+
+// The fuzz targets below interpret the fuzzed bytes as a sequence of hook calls made by a mock contract.
+// Each call starts with a byte selecting the hook from its family, followed by the bytes of its arguments.
+// The raw bytes are also copied to the start of the contract memory, and a few managed buffers, big ints and
+// big floats are created from them beforehand, so that the hooks find both valid and invalid data and handles.
+// Every input is run twice, checking that no hook panics, that both runs produce the same output and gas,
+// and that a hook which fails the execution returns its error code. Run them with e.g.
+//
+//	go test ./vmhost/hosttest -run XXX -fuzz FuzzManBufOpsHooks
+
+const fuzzedHooksGasProvided = 1000000
+
+const fuzzedHooksNumManagedValues = 4
+
+type fuzzedHookResult int
+
+const (
+	// fuzzedHookNoResult marks hooks without an error code
+	fuzzedHookNoResult fuzzedHookResult = iota
+	// fuzzedHookStatus marks hooks which return 0 on success and any other value on failure
+	fuzzedHookStatus
+	// fuzzedHookValue marks hooks which return a value, or -1 on failure
+	fuzzedHookValue
+)
+
+type fuzzedHook struct {
+	name   string
+	result fuzzedHookResult
+	call   func(hooks *vmhooks.VMHooksImpl, input *hookFuzzInput) int64
+}
+
+func FuzzBaseOpsHooks(f *testing.F) {
+	fuzzHookFamily(f, baseOpsFuzzedHooks)
+}
+
+func FuzzManBufOpsHooks(f *testing.F) {
+	fuzzHookFamily(f, manBufOpsFuzzedHooks)
+}
+
+func FuzzBigIntOpsHooks(f *testing.F) {
+	fuzzHookFamily(f, bigIntOpsFuzzedHooks)
+}
+
+func FuzzBigFloatOpsHooks(f *testing.F) {
+	fuzzHookFamily(f, bigFloatOpsFuzzedHooks)
+}
+
+func FuzzCryptoHooks(f *testing.F) {
+	fuzzHookFamily(f, cryptoFuzzedHooks)
+}
+
+func FuzzManagedHooks(f *testing.F) {
+	fuzzHookFamily(f, managedFuzzedHooks)
+}
+
+func fuzzHookFamily(f *testing.F, family []*fuzzedHook) {
+	// one call to every hook of the family, with small arguments
+	everyHook := make([]byte, 0)
+	for i := range family {
+		everyHook = append(everyHook, byte(i), 2, 3, 1, 16, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2)
+	}
+	f.Add(everyHook)
+	f.Add([]byte{})
+	f.Add([]byte{0})
+	f.Add(bytes.Repeat([]byte{0xff}, 64))
+	f.Add([]byte("\x00\x01\x02\x03\x04\x05\x06\x07\x08\x09\x0a\x0b\x0c\x0d\x0e\x0f"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		firstRun := runFuzzedHooks(t, family, data)
+		require.Empty(t, firstRun.failures)
+
+		secondRun := runFuzzedHooks(t, family, data)
+		require.Equal(t, firstRun.results, secondRun.results, "hook results must be deterministic")
+		requireSameVMOutput(t, firstRun.vmOutput, secondRun.vmOutput)
+		require.LessOrEqual(t, firstRun.vmOutput.GasRemaining, uint64(fuzzedHooksGasProvided))
+	})
+}
+
+type fuzzedHooksRun struct {
+	vmOutput *vmcommon.VMOutput
+	results  []string
+	failures []string
+}
+
+func runFuzzedHooks(t *testing.T, family []*fuzzedHook, data []byte) *fuzzedHooksRun {
+	run := &fuzzedHooksRun{}
+
+	vmOutput, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithConfig(baseTestConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("fuzzHooks", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						instance := mock.GetMockInstance(host)
+						executeFuzzedHooks(host, instance, family, data, run)
+						return instance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(fuzzedHooksGasProvided).
+			WithFunction("fuzzHooks").
+			WithArguments([]byte("argument"), big.NewInt(-12345).Bytes()).
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			createMockBuiltinFunctions(t, host, world)
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {})
+	require.Nil(t, err)
+	require.NotNil(t, vmOutput)
+
+	run.vmOutput = vmOutput
+	return run
+}
+
+func executeFuzzedHooks(host vmhost.VMHost, instance *mock.InstanceMock, family []*fuzzedHook, data []byte, run *fuzzedHooksRun) {
+	memory := instance.Memory.Data()
+	copy(memory, data)
+
+	input := &hookFuzzInput{data: data, memoryLength: int32(len(memory))}
+	managedTypes := host.ManagedTypes()
+	for i := 0; i < fuzzedHooksNumManagedValues; i++ {
+		managedTypes.NewManagedBufferFromBytes(input.bytes(int(input.next() % 40)))
+		managedTypes.NewBigIntFromInt64(input.int64())
+		_, _ = managedTypes.PutBigFloat(big.NewFloat(float64(input.int32()) / 7))
+	}
+
+	runtime := host.Runtime()
+	metering := host.Metering()
+	vmHooks := vmhooks.NewVMHooksImpl(host)
+	for !input.done() {
+		hook := family[int(input.next())%len(family)]
+		result, panicValue := callFuzzedHook(hook, vmHooks, input)
+		if panicValue != nil {
+			run.failures = append(run.failures, fmt.Sprintf("%s panicked: %v", hook.name, panicValue))
+			return
+		}
+		run.results = append(run.results, fmt.Sprintf("%s=%d", hook.name, result))
+
+		breakpoint := runtime.GetRuntimeBreakpointValue()
+		if breakpoint == vmhost.BreakpointExecutionFailed {
+			failure := checkFuzzedHookErrorCode(hook, result)
+			if len(failure) > 0 {
+				run.failures = append(run.failures, failure)
+			}
+		}
+		// like the wasm executors, stop at the first breakpoint or when the gas runs out
+		if breakpoint != vmhost.BreakpointNone || metering.GasLeft() == 0 {
+			return
+		}
+	}
+}
+
+func callFuzzedHook(hook *fuzzedHook, vmHooks *vmhooks.VMHooksImpl, input *hookFuzzInput) (result int64, panicValue interface{}) {
+	defer func() {
+		panicValue = recover()
+	}()
+	return hook.call(vmHooks, input), nil
+}
+
+func checkFuzzedHookErrorCode(hook *fuzzedHook, result int64) string {
+	switch hook.result {
+	case fuzzedHookStatus:
+		if result == 0 {
+			return fmt.Sprintf("%s failed the execution, but returned 0", hook.name)
+		}
+	case fuzzedHookValue:
+		if result != -1 {
+			return fmt.Sprintf("%s failed the execution, but returned %d instead of -1", hook.name, result)
+		}
+	}
+	return ""
+}
+
+func requireSameVMOutput(t *testing.T, expected *vmcommon.VMOutput, actual *vmcommon.VMOutput) {
+	require.Equal(t, expected.ReturnCode, actual.ReturnCode, "return code")
+	require.Equal(t, expected.ReturnMessage, actual.ReturnMessage, "return message")
+	require.Equal(t, expected.GasRemaining, actual.GasRemaining, "gas remaining")
+	require.Equal(t, expected.GasRefund, actual.GasRefund, "gas refund")
+	require.Equal(t, expected.ReturnData, actual.ReturnData, "return data")
+	require.Equal(t, len(expected.OutputAccounts), len(actual.OutputAccounts), "output accounts")
+	for address, expectedAccount := range expected.OutputAccounts {
+		actualAccount := actual.OutputAccounts[address]
+		require.NotNil(t, actualAccount, "output account")
+		require.Equal(t, expectedAccount.StorageUpdates, actualAccount.StorageUpdates, "storage updates")
+		require.Equal(t, expectedAccount.BalanceDelta, actualAccount.BalanceDelta, "balance delta")
+	}
+	require.Equal(t, len(expected.Logs), len(actual.Logs), "logs")
+}
+
+// hookFuzzInput decodes the arguments of the fuzzed hooks. Handles, pointers and lengths are drawn from small
+// ranges around the valid values, while an exhausted input decodes as zeros.
+type hookFuzzInput struct {
+	data         []byte
+	position     int
+	memoryLength int32
+}
+
+func (input *hookFuzzInput) done() bool {
+	return input.position >= len(input.data)
+}
+
+func (input *hookFuzzInput) next() byte {
+	if input.done() {
+		return 0
+	}
+	value := input.data[input.position]
+	input.position++
+	return value
+}
+
+func (input *hookFuzzInput) bytes(length int) []byte {
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = input.next()
+	}
+	return result
+}
+
+func (input *hookFuzzInput) int32() int32 {
+	return int32(binary.BigEndian.Uint32(input.bytes(4)))
+}
+
+func (input *hookFuzzInput) int64() int64 {
+	return int64(binary.BigEndian.Uint64(input.bytes(8)))
+}
+
+// handle is between -2 and 2*fuzzedHooksNumManagedValues, mostly hitting the managed values created beforehand
+func (input *hookFuzzInput) handle() int32 {
+	return int32(input.next()%(2*fuzzedHooksNumManagedValues+3)) - 2
+}
+
+// smallInt is between -4 and 59, e.g. an argument index, a bit count or an exponent
+func (input *hookFuzzInput) smallInt() int32 {
+	return int32(input.next()%64) - 4
+}
+
+// memPtr mostly points at the start of the memory, sometimes past its end or before it
+func (input *hookFuzzInput) memPtr() executor.MemPtr {
+	value := input.next()
+	switch value % 16 {
+	case 0:
+		return executor.MemPtr(-int32(value % 5))
+	case 1:
+		return executor.MemPtr(input.memoryLength - int32(value%64))
+	default:
+		return executor.MemPtr(value)
+	}
+}
+
+// memLength is mostly small, sometimes negative or larger than the memory
+func (input *hookFuzzInput) memLength() executor.MemLength {
+	value := input.next()
+	switch value % 16 {
+	case 0:
+		return executor.MemLength(-int32(value%3) - 1)
+	case 1:
+		return executor.MemLength(input.memoryLength + int32(value))
+	default:
+		return executor.MemLength(value % 96)
+	}
+}
+
+var baseOpsFuzzedHooks = []*fuzzedHook{
+	{"getGasLeft", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return h.GetGasLeft()
+	}},
+	{"getSCAddress", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.GetSCAddress(in.memPtr())
+		return 0
+	}},
+	{"getOwnerAddress", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.GetOwnerAddress(in.memPtr())
+		return 0
+	}},
+	{"getShardOfAddress", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetShardOfAddress(in.memPtr()))
+	}},
+	{"isSmartContract", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.IsSmartContract(in.memPtr()))
+	}},
+	{"signalError", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.SignalError(in.memPtr(), in.memLength())
+		return 0
+	}},
+	{"getExternalBalance", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.GetExternalBalance(in.memPtr(), in.memPtr())
+		return 0
+	}},
+	{"getBlockHash", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetBlockHash(int64(in.smallInt()), in.memPtr()))
+	}},
+	{"validateTokenIdentifier", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ValidateTokenIdentifier(in.handle()))
+	}},
+	{"getArgumentLength", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetArgumentLength(in.smallInt()))
+	}},
+	{"getArgument", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetArgument(in.smallInt(), in.memPtr()))
+	}},
+	{"getFunction", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetFunction(in.memPtr()))
+	}},
+	{"getNumArguments", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetNumArguments())
+	}},
+	{"storageStore", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.StorageStore(in.memPtr(), in.memLength(), in.memPtr(), in.memLength()))
+	}},
+	{"storageLoadLength", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.StorageLoadLength(in.memPtr(), in.memLength()))
+	}},
+	{"storageLoad", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.StorageLoad(in.memPtr(), in.memLength(), in.memPtr()))
+	}},
+	{"getCaller", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.GetCaller(in.memPtr())
+		return 0
+	}},
+	{"getCallValue", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetCallValue(in.memPtr()))
+	}},
+	{"getESDTValueByIndex", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetESDTValueByIndex(in.memPtr(), in.smallInt()))
+	}},
+	{"getESDTTokenNameByIndex", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetESDTTokenNameByIndex(in.memPtr(), in.smallInt()))
+	}},
+	{"getNumESDTTransfers", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetNumESDTTransfers())
+	}},
+	{"writeEventLog", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.WriteEventLog(in.smallInt()%4, in.memPtr(), in.memPtr(), in.memPtr(), in.memLength())
+		return 0
+	}},
+	{"getBlockRandomSeed", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.GetBlockRandomSeed(in.memPtr())
+		return 0
+	}},
+	{"finish", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.Finish(in.memPtr(), in.memLength())
+		return 0
+	}},
+	{"getNumReturnData", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetNumReturnData())
+	}},
+	{"getReturnDataSize", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetReturnDataSize(in.smallInt()))
+	}},
+	{"getReturnData", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetReturnData(in.smallInt(), in.memPtr()))
+	}},
+	{"deleteFromReturnData", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.DeleteFromReturnData(in.smallInt())
+		return 0
+	}},
+	{"getOriginalTxHash", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.GetOriginalTxHash(in.memPtr())
+		return 0
+	}},
+}
+
+var manBufOpsFuzzedHooks = []*fuzzedHook{
+	{"mBufferNew", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferNew())
+	}},
+	{"mBufferNewFromBytes", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferNewFromBytes(in.memPtr(), in.memLength()))
+	}},
+	{"mBufferGetLength", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferGetLength(in.handle()))
+	}},
+	{"mBufferGetBytes", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferGetBytes(in.handle(), in.memPtr()))
+	}},
+	{"mBufferGetByteSlice", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferGetByteSlice(in.handle(), in.smallInt(), in.smallInt(), in.memPtr()))
+	}},
+	{"mBufferCopyByteSlice", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferCopyByteSlice(in.handle(), in.smallInt(), in.smallInt(), in.handle()))
+	}},
+	{"mBufferEq", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferEq(in.handle(), in.handle()))
+	}},
+	{"mBufferSetBytes", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferSetBytes(in.handle(), in.memPtr(), in.memLength()))
+	}},
+	{"mBufferSetByteSlice", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferSetByteSlice(in.handle(), in.smallInt(), in.memLength(), in.memPtr()))
+	}},
+	{"mBufferAppend", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferAppend(in.handle(), in.handle()))
+	}},
+	{"mBufferAppendBytes", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferAppendBytes(in.handle(), in.memPtr(), in.memLength()))
+	}},
+	{"mBufferToBigIntUnsigned", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferToBigIntUnsigned(in.handle(), in.handle()))
+	}},
+	{"mBufferToBigIntSigned", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferToBigIntSigned(in.handle(), in.handle()))
+	}},
+	{"mBufferFromBigIntUnsigned", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferFromBigIntUnsigned(in.handle(), in.handle()))
+	}},
+	{"mBufferFromBigIntSigned", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferFromBigIntSigned(in.handle(), in.handle()))
+	}},
+	{"mBufferToBigFloat", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferToBigFloat(in.handle(), in.handle()))
+	}},
+	{"mBufferFromBigFloat", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferFromBigFloat(in.handle(), in.handle()))
+	}},
+	{"mBufferStorageStore", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferStorageStore(in.handle(), in.handle()))
+	}},
+	{"mBufferStorageLoad", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferStorageLoad(in.handle(), in.handle()))
+	}},
+	{"mBufferGetArgument", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferGetArgument(in.smallInt(), in.handle()))
+	}},
+	{"mBufferFinish", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferFinish(in.handle()))
+	}},
+	{"mBufferSetRandom", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferSetRandom(in.handle(), in.smallInt()))
+	}},
+}
+
+var bigIntOpsFuzzedHooks = []*fuzzedHook{
+	{"bigIntGetUnsignedArgument", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntGetUnsignedArgument(in.smallInt(), in.handle())
+		return 0
+	}},
+	{"bigIntGetSignedArgument", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntGetSignedArgument(in.smallInt(), in.handle())
+		return 0
+	}},
+	{"bigIntStorageStoreUnsigned", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntStorageStoreUnsigned(in.memPtr(), in.memLength(), in.handle()))
+	}},
+	{"bigIntStorageLoadUnsigned", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntStorageLoadUnsigned(in.memPtr(), in.memLength(), in.handle()))
+	}},
+	{"bigIntNew", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntNew(in.int64()))
+	}},
+	{"bigIntUnsignedByteLength", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntUnsignedByteLength(in.handle()))
+	}},
+	{"bigIntSignedByteLength", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntSignedByteLength(in.handle()))
+	}},
+	{"bigIntGetUnsignedBytes", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntGetUnsignedBytes(in.handle(), in.memPtr()))
+	}},
+	{"bigIntGetSignedBytes", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntGetSignedBytes(in.handle(), in.memPtr()))
+	}},
+	{"bigIntSetUnsignedBytes", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntSetUnsignedBytes(in.handle(), in.memPtr(), in.memLength())
+		return 0
+	}},
+	{"bigIntSetSignedBytes", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntSetSignedBytes(in.handle(), in.memPtr(), in.memLength())
+		return 0
+	}},
+	{"bigIntIsInt64", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntIsInt64(in.handle()))
+	}},
+	{"bigIntGetInt64", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return h.BigIntGetInt64(in.handle())
+	}},
+	{"bigIntSetInt64", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntSetInt64(in.handle(), in.int64())
+		return 0
+	}},
+	{"bigIntAdd", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntAdd(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntSub", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntSub(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntMul", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntMul(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntTDiv", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntTDiv(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntTMod", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntTMod(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntEDiv", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntEDiv(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntEMod", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntEMod(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntSqrt", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntSqrt(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntPow", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntPow(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntLog2", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntLog2(in.handle()))
+	}},
+	{"bigIntAbs", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntAbs(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntNeg", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntNeg(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntSign", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntSign(in.handle()))
+	}},
+	{"bigIntCmp", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigIntCmp(in.handle(), in.handle()))
+	}},
+	{"bigIntNot", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntNot(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntAnd", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntAnd(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntOr", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntOr(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntXor", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntXor(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigIntShr", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntShr(in.handle(), in.handle(), in.smallInt())
+		return 0
+	}},
+	{"bigIntShl", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntShl(in.handle(), in.handle(), in.smallInt())
+		return 0
+	}},
+	{"bigIntFinishUnsigned", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntFinishUnsigned(in.handle())
+		return 0
+	}},
+	{"bigIntFinishSigned", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntFinishSigned(in.handle())
+		return 0
+	}},
+	{"bigIntToString", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigIntToString(in.handle(), in.handle())
+		return 0
+	}},
+}
+
+var bigFloatOpsFuzzedHooks = []*fuzzedHook{
+	{"bigFloatNewFromParts", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigFloatNewFromParts(in.int32(), in.int32(), in.smallInt()*8-256))
+	}},
+	{"bigFloatNewFromFrac", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigFloatNewFromFrac(in.int64(), int64(in.smallInt())))
+	}},
+	{"bigFloatNewFromSci", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigFloatNewFromSci(in.int64(), int64(in.smallInt()*8-256)))
+	}},
+	{"bigFloatAdd", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatAdd(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatSub", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatSub(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatMul", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatMul(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatDiv", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatDiv(in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatNeg", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatNeg(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatClone", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatClone(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatCmp", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigFloatCmp(in.handle(), in.handle()))
+	}},
+	{"bigFloatAbs", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatAbs(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatSign", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigFloatSign(in.handle()))
+	}},
+	{"bigFloatSqrt", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatSqrt(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatPow", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatPow(in.handle(), in.handle(), in.smallInt())
+		return 0
+	}},
+	{"bigFloatFloor", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatFloor(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatCeil", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatCeil(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatTruncate", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatTruncate(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatSetInt64", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatSetInt64(in.handle(), in.int64())
+		return 0
+	}},
+	{"bigFloatIsInt", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.BigFloatIsInt(in.handle()))
+	}},
+	{"bigFloatSetBigInt", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatSetBigInt(in.handle(), in.handle())
+		return 0
+	}},
+	{"bigFloatGetConstPi", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.BigFloatGetConstPi(in.handle())
+		return 0
+	}},
+	{"mBufferToBigFloat", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferToBigFloat(in.handle(), in.handle()))
+	}},
+	{"mBufferFromBigFloat", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.MBufferFromBigFloat(in.handle(), in.handle()))
+	}},
+}
+
+var cryptoFuzzedHooks = []*fuzzedHook{
+	{"sha256", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.Sha256(in.memPtr(), in.memLength(), in.memPtr()))
+	}},
+	{"managedSha256", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedSha256(in.handle(), in.handle()))
+	}},
+	{"keccak256", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.Keccak256(in.memPtr(), in.memLength(), in.memPtr()))
+	}},
+	{"managedKeccak256", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedKeccak256(in.handle(), in.handle()))
+	}},
+	{"ripemd160", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.Ripemd160(in.memPtr(), in.memLength(), in.memPtr()))
+	}},
+	{"managedRipemd160", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedRipemd160(in.handle(), in.handle()))
+	}},
+	{"managedSha512", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedSha512(in.handle(), in.handle()))
+	}},
+	{"managedSha3256", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedSha3256(in.handle(), in.handle()))
+	}},
+	{"managedSha3512", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedSha3512(in.handle(), in.handle()))
+	}},
+	{"managedBlake2b256", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedBlake2b256(in.handle(), in.handle()))
+	}},
+	{"managedBlake2b512", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedBlake2b512(in.handle(), in.handle()))
+	}},
+	{"managedPoseidon", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedPoseidon(in.handle(), in.handle()))
+	}},
+	{"verifyBLS", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.VerifyBLS(in.memPtr(), in.memPtr(), in.memLength(), in.memPtr()))
+	}},
+	{"managedVerifyBLS", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedVerifyBLS(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedVerifyBLSAggregated", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedVerifyBLSAggregated(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedAggregateBLSPublicKeys", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedAggregateBLSPublicKeys(in.handle(), in.handle()))
+	}},
+	{"verifyEd25519", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.VerifyEd25519(in.memPtr(), in.memPtr(), in.memLength(), in.memPtr()))
+	}},
+	{"managedVerifyEd25519", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedVerifyEd25519(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedVerifyEd25519Batch", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedVerifyEd25519Batch(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedVerifyEd25519BatchBitmap", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedVerifyEd25519BatchBitmap(in.handle(), in.handle(), in.handle(), in.handle()))
+	}},
+	{"verifySecp256k1", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.VerifySecp256k1(in.memPtr(), in.memLength(), in.memPtr(), in.memLength(), in.memPtr()))
+	}},
+	{"managedVerifySecp256k1", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedVerifySecp256k1(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedVerifyCustomSecp256k1", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedVerifyCustomSecp256k1(in.handle(), in.handle(), in.handle(), in.smallInt()))
+	}},
+	{"managedVerifySecp256r1", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedVerifySecp256r1(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedVerifySecp256r1Batch", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedVerifySecp256r1Batch(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedVerifySecp256r1BatchBitmap", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedVerifySecp256r1BatchBitmap(in.handle(), in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedEncodeSecp256k1DerSignature", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedEncodeSecp256k1DerSignature(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedCreateEC", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedCreateEC(in.handle()))
+	}},
+	{"managedCreateCustomEC", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedCreateCustomEC(in.handle(), in.handle(), in.handle(), in.handle(), in.handle(), in.handle(), in.smallInt()))
+	}},
+	{"getCurveLengthEC", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetCurveLengthEC(in.handle()))
+	}},
+	{"getPrivKeyByteLengthEC", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.GetPrivKeyByteLengthEC(in.handle()))
+	}},
+	{"isOnCurveEC", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.IsOnCurveEC(in.handle(), in.handle(), in.handle()))
+	}},
+	{"addEC", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.AddEC(in.handle(), in.handle(), in.handle(), in.handle(), in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"doubleEC", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.DoubleEC(in.handle(), in.handle(), in.handle(), in.handle(), in.handle())
+		return 0
+	}},
+	{"managedScalarBaseMultEC", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedScalarBaseMultEC(in.handle(), in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedMarshalEC", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedMarshalEC(in.handle(), in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedUnmarshalEC", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedUnmarshalEC(in.handle(), in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedAddBN254G1", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedAddBN254G1(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedScalarMulBN254G1", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedScalarMulBN254G1(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedPairingCheckBN254", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedPairingCheckBN254(in.handle(), in.handle()))
+	}},
+}
+
+var managedFuzzedHooks = []*fuzzedHook{
+	{"managedSCAddress", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedSCAddress(in.handle())
+		return 0
+	}},
+	{"managedOwnerAddress", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedOwnerAddress(in.handle())
+		return 0
+	}},
+	{"managedCaller", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedCaller(in.handle())
+		return 0
+	}},
+	{"managedSignalError", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedSignalError(in.handle())
+		return 0
+	}},
+	{"managedWriteLog", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedWriteLog(in.handle(), in.handle())
+		return 0
+	}},
+	{"managedGetOriginalTxHash", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedGetOriginalTxHash(in.handle())
+		return 0
+	}},
+	{"managedGetStateRootHash", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedGetStateRootHash(in.handle())
+		return 0
+	}},
+	{"managedGetBlockRandomSeed", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedGetBlockRandomSeed(in.handle())
+		return 0
+	}},
+	{"managedGetReturnData", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedGetReturnData(in.smallInt(), in.handle())
+		return 0
+	}},
+	{"managedGetMultiESDTCallValue", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedGetMultiESDTCallValue(in.handle())
+		return 0
+	}},
+	{"managedGetESDTBalance", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedGetESDTBalance(in.handle(), in.handle(), int64(in.smallInt()), in.handle())
+		return 0
+	}},
+	{"managedIsESDTFrozen", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedIsESDTFrozen(in.handle(), in.handle(), int64(in.smallInt())))
+	}},
+	{"managedIsESDTLimitedTransfer", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedIsESDTLimitedTransfer(in.handle()))
+	}},
+	{"managedIsESDTPaused", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedIsESDTPaused(in.handle()))
+	}},
+	{"managedBufferToHex", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		h.ManagedBufferToHex(in.handle(), in.handle())
+		return 0
+	}},
+	{"managedMapNew", fuzzedHookNoResult, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedMapNew())
+	}},
+	{"managedMapPut", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedMapPut(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedMapGet", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedMapGet(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedMapRemove", fuzzedHookStatus, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedMapRemove(in.handle(), in.handle(), in.handle()))
+	}},
+	{"managedMapContains", fuzzedHookValue, func(h *vmhooks.VMHooksImpl, in *hookFuzzInput) int64 {
+		return int64(h.ManagedMapContains(in.handle(), in.handle()))
+	}},
+}
@@ -10,6 +10,7 @@ import (
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-go/config"
 	"github.com/multiversx/mx-chain-vm-go/crypto"
+	"github.com/multiversx/mx-chain-vm-go/crypto/weierstrass"
 	"github.com/multiversx/mx-chain-vm-go/executor"
 )
 
@@ -39,7 +40,9 @@ type VMHost interface {
 	Output() OutputContext
 	Metering() MeteringContext
 	Storage() StorageContext
-	EnableEpochsHandler() vmcommon.EnableEpochsHandler
+	EnableEpochsHandler() EnableEpochsHandler
+	CompiledCodeStore() CompiledCodeStore
+	AsyncCallGraph() AsyncCallGraphRecorder
 
 	ExecuteESDTTransfer(transfersArgs *ESDTTransfersArgs, callType vm.CallType) (*vmcommon.VMOutput, uint64, error)
 	CreateNewContract(input *vmcommon.ContractCreateInput) ([]byte, error)
@@ -166,6 +169,8 @@ type InstanceTracker interface {
 	StateStack
 
 	TrackedInstances() map[string]executor.Instance
+	CodeHash() []byte
+	MemoryUsage() uint64
 }
 
 // ManagedTypesContext defines the functionality needed for interacting with the big int context
@@ -173,6 +178,7 @@ type ManagedTypesContext interface {
 	StateStack
 
 	GetRandReader() io.Reader
+	MemoryUsage() uint64
 	ConsumeGasForThisBigIntNumberOfBytes(byteLen *big.Int)
 	ConsumeGasForThisIntNumberOfBytes(byteLen int)
 	ConsumeGasForBytes(bytes []byte)
@@ -182,6 +188,7 @@ type ManagedTypesContext interface {
 	NewBigIntFromInt64(int64Value int64) int32
 	GetBigIntOrCreate(handle int32) *big.Int
 	GetBigInt(id int32) (*big.Int, error)
+	GetBigIntHandles() []int32
 	GetTwoBigInt(handle1 int32, handle2 int32) (*big.Int, *big.Int, error)
 	PutBigFloat(value *big.Float) (int32, error)
 	BigFloatPrecIsNotValid(precision uint) bool
@@ -191,7 +198,8 @@ type ManagedTypesContext interface {
 	GetBigFloat(handle int32) (*big.Float, error)
 	GetTwoBigFloats(handle1 int32, handle2 int32) (*big.Float, *big.Float, error)
 	PutEllipticCurve(ec *elliptic.CurveParams) int32
-	GetEllipticCurve(handle int32) (*elliptic.CurveParams, error)
+	PutCustomEllipticCurve(ec *weierstrass.Curve) int32
+	GetEllipticCurve(handle int32) (*weierstrass.Curve, error)
 	GetEllipticCurveSizeOfField(ecHandle int32) int32
 	Get100xCurveGasCostMultiplier(ecHandle int32) int32
 	GetScalarMult100xCurveGasCostMultiplier(ecHandle int32) int32
@@ -199,9 +207,10 @@ type ManagedTypesContext interface {
 	GetPrivateKeyByteLengthEC(ecHandle int32) int32
 	NewManagedBuffer() int32
 	NewManagedBufferFromBytes(bytes []byte) int32
-	SetBytes(mBufferHandle int32, bytes []byte)
+	SetBytes(mBufferHandle int32, bytes []byte) error
 	GetBytes(mBufferHandle int32) ([]byte, error)
-	AppendBytes(mBufferHandle int32, bytes []byte) bool
+	GetManagedBufferHandles() []int32
+	AppendBytes(mBufferHandle int32, bytes []byte) error
 	GetLength(mBufferHandle int32) int32
 	GetSlice(mBufferHandle int32, startPosition int32, lengthOfSlice int32) ([]byte, error)
 	DeleteSlice(mBufferHandle int32, startPosition int32, lengthOfSlice int32) ([]byte, error)
@@ -349,6 +358,7 @@ type AsyncContext interface {
 	Execute() error
 	RegisterAsyncCall(groupID string, call *AsyncCall) error
 	RegisterLegacyAsyncCall(address []byte, data []byte, value []byte) error
+	CancelCallGroup(groupID string) error
 
 	LoadParentContext() error
 	Save() error
@@ -385,6 +395,7 @@ type AsyncContext interface {
 
 	SetCallbackParentCall(asyncCall *AsyncCall)
 	GetCallbackClosure() ([]byte, error)
+	IsCallbackTimedOut() bool
 
 	GetAsyncCallByCallID(callID []byte) AsyncCallLocation
 	LoadParentContextFromStackOrStorage() (AsyncContext, error)
@@ -402,6 +413,17 @@ type AsyncContext interface {
 	SetCallIDForCallInGroup(groupIndex int, callIndex int, callID []byte)
 }
 
+// EnableEpochsHandler is used to verify which flags are set in the current epoch, extending
+// vmcommon.EnableEpochsHandler with the flags of the VM features it does not define yet
+type EnableEpochsHandler interface {
+	vmcommon.EnableEpochsHandler
+	IsAsyncCallTimeoutsFlagEnabled() bool
+	IsBLSMultiSigFlagEnabled() bool
+	IsMemoryBudgetFlagEnabled() bool
+	IsCustomEllipticCurvesFlagEnabled() bool
+	IsFixVMHooksErrorHandlingFlagEnabled() bool
+}
+
 // AsyncCallLocation defines the functionality for async calls
 type AsyncCallLocation interface {
 	GetAsyncCall() *AsyncCall
@@ -425,3 +447,52 @@ type HashComputer interface {
 	Size() int
 	IsInterfaceNil() bool
 }
+
+// ExecutionDebugger is consulted before contract functions and VM hooks are called,
+// and may pause the execution there. Returning an error aborts the execution.
+type ExecutionDebugger interface {
+	AttachHost(host VMHost)
+	BeforeFunctionCall(functionName string) error
+	BeforeVMHookCall(hookName string, args []int64) error
+	IsInterfaceNil() bool
+}
+
+// ExecutionProfiler is notified when contract functions and VM hooks begin and end,
+// together with the gas left at that point, in order to measure where gas and time go.
+type ExecutionProfiler interface {
+	BeginFunction(contractAddress []byte, functionName string, gasLeft uint64)
+	EndFunction(gasLeft uint64)
+	BeginVMHook(hookName string, gasLeft uint64)
+	EndVMHook(gasLeft uint64)
+	IsInterfaceNil() bool
+}
+
+// ExecutionCoverage records which exported functions and which VM hooks were called, per contract code hash.
+// HasContract and AddContract let the host provide the exported functions of each contract only once.
+type ExecutionCoverage interface {
+	HasContract(codeHash []byte) bool
+	AddContract(codeHash []byte, functionNames []string)
+	AddFunctionCall(codeHash []byte, contractAddress []byte, functionName string)
+	AddVMHookCall(codeHash []byte, hookName string)
+	IsInterfaceNil() bool
+}
+
+// AsyncCallGraphRecorder is notified by the async context as contracts start executing, as async calls are
+// registered, started and finished, and as their callbacks finish, in order to rebuild the actual async call tree.
+// Contexts, async calls and callbacks are related to each other by their call IDs.
+type AsyncCallGraphRecorder interface {
+	BeginContext(address []byte, callID []byte, callerCallID []byte, input *vmcommon.ContractCallInput)
+	RegisterAsyncCall(callerCallID []byte, groupID string, asyncCall *AsyncCall)
+	StartAsyncCall(callerCallID []byte, asyncCall *AsyncCall)
+	FinishAsyncCall(callID []byte, returnCode vmcommon.ReturnCode, gasRemaining uint64)
+	FinishCallback(callID []byte, returnCode vmcommon.ReturnCode, gasRemaining uint64, gasAccumulated uint64)
+	IsInterfaceNil() bool
+}
+
+// CompiledCodeStore keeps compiled contract code beyond the lifetime of the VM host,
+// so that contracts do not need to be compiled again by other processes.
+type CompiledCodeStore interface {
+	Get(key CompiledCodeKey) ([]byte, bool)
+	Put(key CompiledCodeKey, compiledCode []byte) error
+	IsInterfaceNil() bool
+}
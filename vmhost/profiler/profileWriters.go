@@ -0,0 +1,212 @@
+package profiler
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// ProfileValue selects the value written to flame graph data.
+type ProfileValue int
+
+const (
+	// ProfileGas selects the gas consumed.
+	ProfileGas ProfileValue = iota
+
+	// ProfileWallTime selects the wall-clock time, in nanoseconds.
+	ProfileWallTime
+)
+
+// Field numbers from the pprof profile.proto.
+const (
+	profileSampleType    = 1
+	profileSample        = 2
+	profileLocation      = 4
+	profileFunction      = 5
+	profileStringTable   = 6
+	profileTimeNanos     = 9
+	profileDurationNanos = 10
+	profilePeriodType    = 11
+	profilePeriod        = 12
+
+	valueTypeType = 1
+	valueTypeUnit = 2
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+
+	lineFunctionID = 1
+
+	functionID         = 1
+	functionName       = 2
+	functionSystemName = 3
+)
+
+const protoWireVarint = 0
+const protoWireBytes = 2
+
+// WritePprof writes the samples as a gzipped pprof profile, with the gas, the wall-clock time
+// and the number of calls as sample values. It can be opened with "go tool pprof".
+func (profiler *Profiler) WritePprof(writer io.Writer) error {
+	profiler.mutProfiler.Lock()
+	samples := profiler.sortedSamples()
+	startTime := profiler.startTime
+	duration := profiler.now().Sub(startTime)
+	profiler.mutProfiler.Unlock()
+
+	table := newStringTable()
+	profile := make([]byte, 0)
+	for _, valueType := range [][2]string{{"gas", "units"}, {"wall", "nanoseconds"}, {"calls", "count"}} {
+		profile = appendBytesField(profile, profileSampleType, encodeValueType(table, valueType[0], valueType[1]))
+	}
+
+	// there is one location and one function for each distinct frame name, with the same ID
+	frameIDs := make(map[string]uint64)
+	frameNames := make([]string, 0)
+	for _, sample := range samples {
+		locationIDs := make([]uint64, len(sample.Stack))
+		for i, name := range sample.Stack {
+			id, found := frameIDs[name]
+			if !found {
+				frameNames = append(frameNames, name)
+				id = uint64(len(frameNames))
+				frameIDs[name] = id
+			}
+			// pprof expects the innermost frame first
+			locationIDs[len(sample.Stack)-1-i] = id
+		}
+
+		encodedSample := appendPackedVarintsField(nil, sampleLocationID, locationIDs)
+		encodedSample = appendPackedVarintsField(encodedSample, sampleValue, []uint64{
+			sample.Gas,
+			uint64(sample.WallTime.Nanoseconds()),
+			sample.Calls,
+		})
+		profile = appendBytesField(profile, profileSample, encodedSample)
+	}
+
+	for i, name := range frameNames {
+		id := uint64(i + 1)
+		line := appendVarintField(nil, lineFunctionID, id)
+		location := appendVarintField(nil, locationID, id)
+		location = appendBytesField(location, locationLine, line)
+		profile = appendBytesField(profile, profileLocation, location)
+
+		function := appendVarintField(nil, functionID, id)
+		function = appendVarintField(function, functionName, table.index(name))
+		function = appendVarintField(function, functionSystemName, table.index(name))
+		profile = appendBytesField(profile, profileFunction, function)
+	}
+
+	profile = appendVarintField(profile, profileTimeNanos, uint64(startTime.UnixNano()))
+	profile = appendVarintField(profile, profileDurationNanos, uint64(duration.Nanoseconds()))
+	profile = appendBytesField(profile, profilePeriodType, encodeValueType(table, "gas", "units"))
+	profile = appendVarintField(profile, profilePeriod, 1)
+	for _, value := range table.values {
+		profile = appendBytesField(profile, profileStringTable, []byte(value))
+	}
+
+	gzipWriter := gzip.NewWriter(writer)
+	_, err := gzipWriter.Write(profile)
+	if err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// WriteFoldedStacks writes the samples in the folded stacks format used by flame graph tools,
+// one "outer;inner value" line per call stack.
+func (profiler *Profiler) WriteFoldedStacks(writer io.Writer, value ProfileValue) error {
+	bufferedWriter := bufio.NewWriter(writer)
+	for _, sample := range profiler.Samples() {
+		stackValue := sample.Gas
+		if value == ProfileWallTime {
+			stackValue = uint64(sample.WallTime.Nanoseconds())
+		}
+		if stackValue == 0 {
+			continue
+		}
+
+		_, err := fmt.Fprintf(bufferedWriter, "%s %d\n", strings.Join(sample.Stack, ";"), stackValue)
+		if err != nil {
+			return err
+		}
+	}
+	return bufferedWriter.Flush()
+}
+
+func (profiler *Profiler) sortedSamples() []*Sample {
+	keys := make([]string, 0, len(profiler.samples))
+	for key := range profiler.samples {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	samples := make([]*Sample, len(keys))
+	for i, key := range keys {
+		sample := *profiler.samples[key]
+		sample.Stack = append([]string{}, sample.Stack...)
+		samples[i] = &sample
+	}
+	return samples
+}
+
+// stringTable is the pprof string table, whose first entry must be the empty string.
+type stringTable struct {
+	indexes map[string]uint64
+	values  []string
+}
+
+func newStringTable() *stringTable {
+	return &stringTable{
+		indexes: map[string]uint64{"": 0},
+		values:  []string{""},
+	}
+}
+
+func (table *stringTable) index(value string) uint64 {
+	index, found := table.indexes[value]
+	if !found {
+		index = uint64(len(table.values))
+		table.indexes[value] = index
+		table.values = append(table.values, value)
+	}
+	return index
+}
+
+func encodeValueType(table *stringTable, valueType string, unit string) []byte {
+	encoded := appendVarintField(nil, valueTypeType, table.index(valueType))
+	return appendVarintField(encoded, valueTypeUnit, table.index(unit))
+}
+
+func appendVarint(buffer []byte, value uint64) []byte {
+	varint := make([]byte, binary.MaxVarintLen64)
+	length := binary.PutUvarint(varint, value)
+	return append(buffer, varint[:length]...)
+}
+
+func appendVarintField(buffer []byte, field int, value uint64) []byte {
+	buffer = appendVarint(buffer, uint64(field<<3|protoWireVarint))
+	return appendVarint(buffer, value)
+}
+
+func appendBytesField(buffer []byte, field int, value []byte) []byte {
+	buffer = appendVarint(buffer, uint64(field<<3|protoWireBytes))
+	buffer = appendVarint(buffer, uint64(len(value)))
+	return append(buffer, value...)
+}
+
+func appendPackedVarintsField(buffer []byte, field int, values []uint64) []byte {
+	packed := make([]byte, 0, len(values))
+	for _, value := range values {
+		packed = appendVarint(packed, value)
+	}
+	return appendBytesField(buffer, field, packed)
+}
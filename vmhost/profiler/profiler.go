@@ -0,0 +1,163 @@
+// Package profiler attributes the gas and the time spent by contracts to their functions and to the VM hooks they call.
+package profiler
+
+import (
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var _ vmhost.ExecutionProfiler = (*Profiler)(nil)
+
+// stackSeparator joins the frame names into sample keys, it cannot appear in frame names.
+const stackSeparator = "\x00"
+
+// frame is a contract function or a VM hook call that has not ended yet.
+type frame struct {
+	name      string
+	gasBegin  uint64
+	timeBegin time.Time
+	childGas  uint64
+	childTime time.Duration
+}
+
+// Sample holds the gas and the time spent in a call stack, excluding the nested calls.
+type Sample struct {
+	// Stack holds the frame names, starting with the outermost one.
+	Stack    []string
+	Gas      uint64
+	WallTime time.Duration
+	Calls    uint64
+}
+
+// Profiler records the gas and the wall-clock time spent in each contract function and VM hook,
+// across all the executions of a VM host. Contract functions are named "function@contractAddress",
+// VM hooks are named "family/hook", e.g. "bigIntOps/bigIntAdd".
+type Profiler struct {
+	mutProfiler sync.Mutex
+	frames      []*frame
+	samples     map[string]*Sample
+	startTime   time.Time
+	now         func() time.Time
+}
+
+// NewProfiler creates a new, empty Profiler.
+func NewProfiler() *Profiler {
+	return newProfilerWithClock(time.Now)
+}
+
+func newProfilerWithClock(now func() time.Time) *Profiler {
+	return &Profiler{
+		frames:    make([]*frame, 0),
+		samples:   make(map[string]*Sample),
+		startTime: now(),
+		now:       now,
+	}
+}
+
+// BeginFunction is called before a contract function is executed.
+func (profiler *Profiler) BeginFunction(contractAddress []byte, functionName string, gasLeft uint64) {
+	profiler.beginFrame(functionName+"@"+hex.EncodeToString(contractAddress), gasLeft)
+}
+
+// EndFunction is called after a contract function is executed.
+func (profiler *Profiler) EndFunction(gasLeft uint64) {
+	profiler.endFrame(gasLeft)
+}
+
+// BeginVMHook is called before a VM hook is executed.
+func (profiler *Profiler) BeginVMHook(hookName string, gasLeft uint64) {
+	family := executorwrapper.VMHookFamily(hookName)
+	if len(family) == 0 {
+		family = "unknown"
+	}
+	profiler.beginFrame(family+"/"+hookName, gasLeft)
+}
+
+// EndVMHook is called after a VM hook is executed.
+func (profiler *Profiler) EndVMHook(gasLeft uint64) {
+	profiler.endFrame(gasLeft)
+}
+
+func (profiler *Profiler) beginFrame(name string, gasLeft uint64) {
+	profiler.mutProfiler.Lock()
+	defer profiler.mutProfiler.Unlock()
+
+	profiler.frames = append(profiler.frames, &frame{
+		name:      name,
+		gasBegin:  gasLeft,
+		timeBegin: profiler.now(),
+	})
+}
+
+// endFrame records the gas and the time of the innermost frame, excluding those of its nested frames.
+func (profiler *Profiler) endFrame(gasLeft uint64) {
+	profiler.mutProfiler.Lock()
+	defer profiler.mutProfiler.Unlock()
+
+	numFrames := len(profiler.frames)
+	if numFrames == 0 {
+		return
+	}
+	ended := profiler.frames[numFrames-1]
+
+	totalGas := saturatingSub(ended.gasBegin, gasLeft)
+	totalTime := profiler.now().Sub(ended.timeBegin)
+
+	stack := make([]string, numFrames)
+	for i, currentFrame := range profiler.frames {
+		stack[i] = currentFrame.name
+	}
+	stackKey := strings.Join(stack, stackSeparator)
+	sample, found := profiler.samples[stackKey]
+	if !found {
+		sample = &Sample{Stack: stack}
+		profiler.samples[stackKey] = sample
+	}
+	sample.Gas += saturatingSub(totalGas, ended.childGas)
+	if totalTime > ended.childTime {
+		sample.WallTime += totalTime - ended.childTime
+	}
+	sample.Calls++
+
+	profiler.frames = profiler.frames[:numFrames-1]
+	if numFrames > 1 {
+		parent := profiler.frames[numFrames-2]
+		parent.childGas += totalGas
+		parent.childTime += totalTime
+	}
+}
+
+// Samples returns a copy of the samples recorded so far, sorted by stack.
+func (profiler *Profiler) Samples() []*Sample {
+	profiler.mutProfiler.Lock()
+	defer profiler.mutProfiler.Unlock()
+
+	return profiler.sortedSamples()
+}
+
+// Reset discards all the samples recorded so far.
+func (profiler *Profiler) Reset() {
+	profiler.mutProfiler.Lock()
+	defer profiler.mutProfiler.Unlock()
+
+	profiler.frames = make([]*frame, 0)
+	profiler.samples = make(map[string]*Sample)
+	profiler.startTime = profiler.now()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (profiler *Profiler) IsInterfaceNil() bool {
+	return profiler == nil
+}
+
+func saturatingSub(a uint64, b uint64) uint64 {
+	if a < b {
+		return 0
+	}
+	return a - b
+}
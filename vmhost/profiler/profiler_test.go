@@ -0,0 +1,113 @@
+package profiler
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestProfiler yields a profiler whose clock advances by one millisecond at each reading.
+func newTestProfiler() *Profiler {
+	currentTime := time.Unix(1000, 0)
+	return newProfilerWithClock(func() time.Time {
+		currentTime = currentTime.Add(time.Millisecond)
+		return currentTime
+	})
+}
+
+// profileNestedCall profiles a function which calls bigIntAdd, then another contract through executeOnDestContext.
+func profileNestedCall(profiler *Profiler) {
+	profiler.BeginFunction([]byte{1}, "outer", 1000)
+	profiler.BeginVMHook("bigIntAdd", 990)
+	profiler.EndVMHook(980)
+	profiler.BeginVMHook("executeOnDestContext", 970)
+	profiler.BeginFunction([]byte{2}, "inner", 500)
+	profiler.BeginVMHook("mBufferAppend", 450)
+	profiler.EndVMHook(440)
+	profiler.EndFunction(400)
+	profiler.EndVMHook(800)
+	profiler.EndFunction(700)
+}
+
+func TestProfiler_Samples(t *testing.T) {
+	profiler := newTestProfiler()
+	profileNestedCall(profiler)
+
+	samples := profiler.Samples()
+	require.Len(t, samples, 5)
+
+	outer := samples[0]
+	require.Equal(t, []string{"outer@01"}, outer.Stack)
+	require.Equal(t, uint64(300-10-170), outer.Gas)
+	require.Equal(t, uint64(1), outer.Calls)
+
+	bigIntAdd := samples[4]
+	require.Equal(t, []string{"outer@01", "bigIntOps/bigIntAdd"}, bigIntAdd.Stack)
+	require.Equal(t, uint64(10), bigIntAdd.Gas)
+	require.Equal(t, time.Millisecond, bigIntAdd.WallTime)
+
+	executeOnDestContext := samples[1]
+	require.Equal(t, []string{"outer@01", "baseOps/executeOnDestContext"}, executeOnDestContext.Stack)
+	require.Equal(t, uint64(170-100), executeOnDestContext.Gas)
+
+	inner := samples[2]
+	require.Equal(t, []string{"outer@01", "baseOps/executeOnDestContext", "inner@02"}, inner.Stack)
+	require.Equal(t, uint64(90), inner.Gas)
+
+	mBufferAppend := samples[3]
+	require.Equal(t, []string{"outer@01", "baseOps/executeOnDestContext", "inner@02", "manBufOps/mBufferAppend"}, mBufferAppend.Stack)
+	require.Equal(t, uint64(10), mBufferAppend.Gas)
+
+	// the samples of repeated calls add up
+	profileNestedCall(profiler)
+	samples = profiler.Samples()
+	require.Len(t, samples, 5)
+	require.Equal(t, uint64(20), samples[4].Gas)
+	require.Equal(t, uint64(2), samples[4].Calls)
+
+	profiler.Reset()
+	require.Empty(t, profiler.Samples())
+}
+
+func TestProfiler_UnbalancedEndIgnored(t *testing.T) {
+	profiler := newTestProfiler()
+	profiler.EndFunction(100)
+	require.Empty(t, profiler.Samples())
+}
+
+func TestProfiler_WriteFoldedStacks(t *testing.T) {
+	profiler := newTestProfiler()
+	profileNestedCall(profiler)
+
+	output := &bytes.Buffer{}
+	require.Nil(t, profiler.WriteFoldedStacks(output, ProfileGas))
+	require.Equal(t, "outer@01 120\n"+
+		"outer@01;baseOps/executeOnDestContext 70\n"+
+		"outer@01;baseOps/executeOnDestContext;inner@02 90\n"+
+		"outer@01;baseOps/executeOnDestContext;inner@02;manBufOps/mBufferAppend 10\n"+
+		"outer@01;bigIntOps/bigIntAdd 10\n",
+		output.String())
+}
+
+func TestProfiler_WritePprof(t *testing.T) {
+	profiler := newTestProfiler()
+	profileNestedCall(profiler)
+
+	output := &bytes.Buffer{}
+	require.Nil(t, profiler.WritePprof(output))
+
+	gzipReader, err := gzip.NewReader(output)
+	require.Nil(t, err)
+	profile, err := ioutil.ReadAll(gzipReader)
+	require.Nil(t, err)
+
+	// the first sample type is gas, in units: field 1, holding the string table indexes 1 and 2
+	require.Equal(t, []byte{0x0a, 0x04, 0x08, 0x01, 0x10, 0x02}, profile[:6])
+	for _, name := range []string{"gas", "wall", "calls", "outer@01", "bigIntOps/bigIntAdd", "manBufOps/mBufferAppend"} {
+		require.True(t, bytes.Contains(profile, []byte(name)), name)
+	}
+}
@@ -1160,8 +1160,6 @@ func CreateAsyncCallWithTypedArgs(host vmhost.VMHost,
 	callbackClosure []byte) int32 {
 
 	metering := host.Metering()
-	runtime := host.Runtime()
-	async := host.Async()
 
 	metering.StartGasTracing(createAsyncCallName)
 
@@ -1180,12 +1178,20 @@ func CreateAsyncCallWithTypedArgs(host vmhost.VMHost,
 		CallbackClosure: callbackClosure,
 	}
 
+	return registerAsyncCallWithHost(host, "", asyncCall)
+}
+
+func registerAsyncCallWithHost(host vmhost.VMHost, groupID string, asyncCall *vmhost.AsyncCall) int32 {
+	metering := host.Metering()
+	runtime := host.Runtime()
+	async := host.Async()
+
 	if asyncCall.HasDefinedAnyCallback() {
 		gasToUse := metering.GasSchedule().BaseOpsAPICost.SetAsyncCallback
 		metering.UseAndTraceGas(gasToUse)
 	}
 
-	err := async.RegisterAsyncCall("", asyncCall)
+	err := async.RegisterAsyncCall(groupID, asyncCall)
 	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
 		return 1
 	}
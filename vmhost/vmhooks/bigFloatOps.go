@@ -584,11 +584,18 @@ func (context *VMHooksImpl) BigFloatSetBigInt(destinationHandle, bigIntHandle in
 	gasToUse := metering.GasSchedule().BigFloatAPICost.BigFloatSetBigInt
 	metering.UseAndTraceGas(gasToUse)
 
+	fixErrorHandling := context.GetVMHost().EnableEpochsHandler().IsFixVMHooksErrorHandlingFlagEnabled()
 	bigIntValue, err := managedType.GetBigInt(bigIntHandle)
-	managedType.ConsumeGasForBigIntCopy(bigIntValue)
+	if !fixErrorHandling {
+		// before the fix, the copy was charged before checking the handle, panicking on a missing big int
+		managedType.ConsumeGasForBigIntCopy(bigIntValue)
+	}
 	if context.WithFault(err, runtime.BigIntAPIErrorShouldFailExecution()) {
 		return
 	}
+	if fixErrorHandling {
+		managedType.ConsumeGasForBigIntCopy(bigIntValue)
+	}
 	resultSetInt := big.NewFloat(0).SetInt(bigIntValue)
 	setResultIfNotInfinity(context.GetVMHost(), resultSetInt, destinationHandle)
 }
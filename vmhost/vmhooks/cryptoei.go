@@ -2,8 +2,12 @@ package vmhooks
 
 import (
 	"crypto/elliptic"
+	"fmt"
+	"math/big"
 
+	"github.com/multiversx/mx-chain-vm-go/crypto/hashing"
 	"github.com/multiversx/mx-chain-vm-go/crypto/signing/secp256k1"
+	"github.com/multiversx/mx-chain-vm-go/crypto/weierstrass"
 	"github.com/multiversx/mx-chain-vm-go/executor"
 	"github.com/multiversx/mx-chain-vm-go/math"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
@@ -16,14 +20,28 @@ const ed25519SignatureLength = 64
 const secp256k1CompressedPublicKeyLength = 33
 const secp256k1UncompressedPublicKeyLength = 65
 const curveNameLength = 4
+const managedVecHandleLength = 4
+const maxCustomCurveSizeOfField = 521
+const customCurvePrimalityRounds = 20
 
 const (
 	sha256Name                      = "sha256"
 	keccak256Name                   = "keccak256"
 	ripemd160Name                   = "ripemd160"
+	sha512Name                      = "sha512"
+	sha3256Name                     = "sha3256"
+	sha3512Name                     = "sha3512"
+	blake2b256Name                  = "blake2b256"
+	blake2b512Name                  = "blake2b512"
+	poseidonName                    = "poseidon"
 	verifyBLSName                   = "verifyBLS"
+	verifyBLSAggregatedName         = "verifyBLSAggregated"
+	aggregateBLSPublicKeysName      = "aggregateBLSPublicKeys"
 	verifyEd25519Name               = "verifyEd25519"
+	verifyEd25519BatchName          = "verifyEd25519Batch"
 	verifyCustomSecp256k1Name       = "verifyCustomSecp256k1"
+	verifySecp256r1Name             = "verifySecp256r1"
+	verifySecp256r1BatchName        = "verifySecp256r1Batch"
 	encodeSecp256k1DerSignatureName = "encodeSecp256k1DerSignature"
 	addECName                       = "addEC"
 	doubleECName                    = "doubleEC"
@@ -36,9 +54,13 @@ const (
 	unmarshalCompressedECName       = "unmarshalCompressedEC"
 	generateKeyECName               = "generateKeyEC"
 	createECName                    = "createEC"
+	createCustomECName              = "createCustomEC"
 	getCurveLengthECName            = "getCurveLengthEC"
 	getPrivKeyByteLengthECName      = "getPrivKeyByteLengthEC"
 	ellipticCurveGetValuesName      = "ellipticCurveGetValues"
+	addBN254G1Name                  = "addBN254G1"
+	scalarMulBN254G1Name            = "scalarMulBN254G1"
+	pairingCheckBN254Name           = "pairingCheckBN254"
 )
 
 // Sha256 VMHooks implementation.
@@ -222,6 +244,159 @@ func ManagedRipemd160WithHost(host vmhost.VMHost, inputHandle int32, outputHandl
 	return 0
 }
 
+// ManagedSha512 VMHooks implementation.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedSha512(inputHandle, outputHandle int32) int32 {
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return context.managedHashWithGasPerByte(
+		sha512Name,
+		gasSchedule.CryptoAPICost.SHA512,
+		gasSchedule.CryptoAPICost.SHA512PerByte,
+		context.GetCryptoContext().Sha512,
+		inputHandle,
+		outputHandle,
+	)
+}
+
+// ManagedSha3256 VMHooks implementation.
+// The hash is SHA3-256 as standardized in FIPS 202, which differs from the legacy Keccak256.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedSha3256(inputHandle, outputHandle int32) int32 {
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return context.managedHashWithGasPerByte(
+		sha3256Name,
+		gasSchedule.CryptoAPICost.SHA3256,
+		gasSchedule.CryptoAPICost.SHA3256PerByte,
+		context.GetCryptoContext().Sha3256,
+		inputHandle,
+		outputHandle,
+	)
+}
+
+// ManagedSha3512 VMHooks implementation.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedSha3512(inputHandle, outputHandle int32) int32 {
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return context.managedHashWithGasPerByte(
+		sha3512Name,
+		gasSchedule.CryptoAPICost.SHA3512,
+		gasSchedule.CryptoAPICost.SHA3512PerByte,
+		context.GetCryptoContext().Sha3512,
+		inputHandle,
+		outputHandle,
+	)
+}
+
+// ManagedBlake2b256 VMHooks implementation.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedBlake2b256(inputHandle, outputHandle int32) int32 {
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return context.managedHashWithGasPerByte(
+		blake2b256Name,
+		gasSchedule.CryptoAPICost.Blake2b256,
+		gasSchedule.CryptoAPICost.Blake2b256PerByte,
+		context.GetCryptoContext().Blake2b256,
+		inputHandle,
+		outputHandle,
+	)
+}
+
+// ManagedBlake2b512 VMHooks implementation.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedBlake2b512(inputHandle, outputHandle int32) int32 {
+	gasSchedule := context.GetMeteringContext().GasSchedule()
+	return context.managedHashWithGasPerByte(
+		blake2b512Name,
+		gasSchedule.CryptoAPICost.Blake2b512,
+		gasSchedule.CryptoAPICost.Blake2b512PerByte,
+		context.GetCryptoContext().Blake2b512,
+		inputHandle,
+		outputHandle,
+	)
+}
+
+// ManagedPoseidon VMHooks implementation.
+// The input holds 1 to 16 elements of the BN254 scalar field, as 32 bytes big endian each,
+// and the result is a single field element, encoded the same way. Besides the base cost, each field multiplication
+// of the permutation is charged, and their number grows with the square of the number of elements.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedPoseidon(inputHandle, outputHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(poseidonName)
+
+	metering.UseAndTraceGas(metering.GasSchedule().CryptoAPICost.Poseidon)
+
+	inputBytes, err := managedType.GetBytes(inputHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(inputBytes)
+
+	numMultiplications := hashing.PoseidonFieldMultiplications(len(inputBytes) / hashing.PoseidonElementLength)
+	gasToUse := math.MulUint64(metering.GasSchedule().CryptoAPICost.PoseidonPerFieldMultiplication, numMultiplications)
+	metering.UseAndTraceGas(gasToUse)
+
+	result, err := context.GetCryptoContext().Poseidon(inputBytes)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	err = managedType.SetBytes(outputHandle, result)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	return 0
+}
+
+func (context *VMHooksImpl) managedHashWithGasPerByte(
+	hashName string,
+	gasCost uint64,
+	gasCostPerByte uint64,
+	hash func(data []byte) ([]byte, error),
+	inputHandle int32,
+	outputHandle int32,
+) int32 {
+	managedType := context.GetManagedTypesContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(hashName)
+
+	metering.UseAndTraceGas(gasCost)
+
+	inputBytes, err := managedType.GetBytes(inputHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(inputBytes)
+
+	gasToUse := math.MulUint64(gasCostPerByte, uint64(len(inputBytes)))
+	metering.UseAndTraceGas(gasToUse)
+
+	result, err := hash(inputBytes)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	managedType.SetBytes(outputHandle, result)
+
+	return 0
+}
+
 // VerifyBLS VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) VerifyBLS(
@@ -319,6 +494,126 @@ func ManagedVerifyBLSWithHost(
 	return 0
 }
 
+// ManagedVerifyBLSAggregated VMHooks implementation.
+// Verifies a BLS multi-signature aggregated from the signatures of a managed vector of public keys over the same message.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedVerifyBLSAggregated(
+	keysHandle int32,
+	messageHandle int32,
+	sigHandle int32,
+) int32 {
+	host := context.GetVMHost()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
+	crypto := context.GetCryptoContext()
+	metering.StartGasTracing(verifyBLSAggregatedName)
+
+	if !host.EnableEpochsHandler().IsBLSMultiSigFlagEnabled() {
+		_ = context.WithFault(vmhost.ErrBLSMultiSigNotEnabled, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	numKeys, err := context.managedVecLength(keysHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse := math.MulUint64(metering.GasSchedule().CryptoAPICost.VerifyBLSAggregatedPerKey, numKeys)
+	gasToUse = math.AddUint64(metering.GasSchedule().CryptoAPICost.VerifyBLSAggregated, gasToUse)
+	err = metering.UseGasBounded(gasToUse)
+	if err != nil {
+		_ = context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	keys, _, err := managedType.ReadManagedVecOfManagedBuffers(keysHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	msgBytes, err := managedType.GetBytes(messageHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(msgBytes)
+
+	sigBytes, err := managedType.GetBytes(sigHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(sigBytes)
+
+	invalidSigErr := crypto.VerifyAggregatedBLS(keys, msgBytes, sigBytes)
+	if invalidSigErr != nil {
+		context.WithFault(invalidSigErr, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+// ManagedAggregateBLSPublicKeys VMHooks implementation.
+// Writes the public key able to verify the signatures aggregated from a managed vector of public keys.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedAggregateBLSPublicKeys(
+	keysHandle int32,
+	resultHandle int32,
+) int32 {
+	host := context.GetVMHost()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
+	crypto := context.GetCryptoContext()
+	metering.StartGasTracing(aggregateBLSPublicKeysName)
+
+	if !host.EnableEpochsHandler().IsBLSMultiSigFlagEnabled() {
+		_ = context.WithFault(vmhost.ErrBLSMultiSigNotEnabled, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	numKeys, err := context.managedVecLength(keysHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse := math.MulUint64(metering.GasSchedule().CryptoAPICost.AggregateBLSKeysPerKey, numKeys)
+	gasToUse = math.AddUint64(metering.GasSchedule().CryptoAPICost.AggregateBLSKeys, gasToUse)
+	err = metering.UseGasBounded(gasToUse)
+	if err != nil {
+		_ = context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	keys, _, err := managedType.ReadManagedVecOfManagedBuffers(keysHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	aggregatedKey, err := crypto.AggregateBLSPublicKeys(keys)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	managedType.SetBytes(resultHandle, aggregatedKey)
+	return 0
+}
+
+// managedVecLength returns the number of items of a managed vector of managed buffers without loading them,
+// so that the gas depending on it can be charged before any of the items is read
+func (context *VMHooksImpl) managedVecLength(managedVecHandle int32) (uint64, error) {
+	managedVecBytes, err := context.GetManagedTypesContext().GetBytes(managedVecHandle)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(len(managedVecBytes) / managedVecHandleLength), nil
+}
+
 // VerifyEd25519 VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) VerifyEd25519(
@@ -412,6 +707,131 @@ func ManagedVerifyEd25519WithHost(
 	return 0
 }
 
+// ManagedVerifyEd25519Batch VMHooks implementation.
+// Verifies the Ed25519 signatures of managed vectors of keys, messages and signatures, failing on the first invalid one.
+// The signatures are verified one after the other and each of them costs as much as a single verification.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedVerifyEd25519Batch(
+	keysHandle, messagesHandle, sigsHandle int32,
+) int32 {
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(verifyEd25519BatchName)
+
+	gasSchedule := metering.GasSchedule()
+	results, ok := context.verifySignatureBatch(
+		keysHandle, messagesHandle, sigsHandle,
+		gasSchedule.CryptoAPICost.VerifyEd25519Batch,
+		gasSchedule.CryptoAPICost.VerifyEd25519BatchPerSignature,
+		context.GetCryptoContext().VerifyEd25519Batch,
+	)
+	if !ok {
+		return 1
+	}
+
+	return context.failOnInvalidSignature(results)
+}
+
+// ManagedVerifyEd25519BatchBitmap VMHooks implementation.
+// Verifies the Ed25519 signatures of managed vectors of keys, messages and signatures, writing a bitmap of the
+// results, where bit i%8 of byte i/8 is set if signature i is valid.
+// The signatures are verified one after the other and each of them costs as much as a single verification.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedVerifyEd25519BatchBitmap(
+	keysHandle, messagesHandle, sigsHandle, resultHandle int32,
+) int32 {
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(verifyEd25519BatchName)
+
+	gasSchedule := metering.GasSchedule()
+	results, ok := context.verifySignatureBatch(
+		keysHandle, messagesHandle, sigsHandle,
+		gasSchedule.CryptoAPICost.VerifyEd25519Batch,
+		gasSchedule.CryptoAPICost.VerifyEd25519BatchPerSignature,
+		context.GetCryptoContext().VerifyEd25519Batch,
+	)
+	if !ok {
+		return 1
+	}
+
+	err := context.GetManagedTypesContext().SetBytes(resultHandle, batchResultsBitmap(results))
+	if context.WithFault(err, context.GetRuntimeContext().ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	return 0
+}
+
+func (context *VMHooksImpl) verifySignatureBatch(
+	keysHandle, messagesHandle, sigsHandle int32,
+	batchCost uint64,
+	perSignatureCost uint64,
+	verifyBatch func(keys [][]byte, msgs [][]byte, sigs [][]byte) ([]bool, error),
+) ([]bool, bool) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
+
+	numSignatures, err := context.managedVecLength(keysHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return nil, false
+	}
+
+	gasToUse := math.AddUint64(batchCost, math.MulUint64(perSignatureCost, numSignatures))
+	err = metering.UseGasBounded(gasToUse)
+	if err != nil {
+		_ = context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution())
+		return nil, false
+	}
+
+	keys, _, err := managedType.ReadManagedVecOfManagedBuffers(keysHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return nil, false
+	}
+
+	msgs, _, err := managedType.ReadManagedVecOfManagedBuffers(messagesHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return nil, false
+	}
+
+	sigs, _, err := managedType.ReadManagedVecOfManagedBuffers(sigsHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return nil, false
+	}
+
+	results, err := verifyBatch(keys, msgs, sigs)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return nil, false
+	}
+
+	return results, true
+}
+
+func (context *VMHooksImpl) failOnInvalidSignature(results []bool) int32 {
+	runtime := context.GetRuntimeContext()
+	for i, valid := range results {
+		if !valid {
+			err := fmt.Errorf("%w at index %d", vmhost.ErrInvalidSignatureInBatch, i)
+			context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution())
+			return -1
+		}
+	}
+
+	return 0
+}
+
+func batchResultsBitmap(results []bool) []byte {
+	bitmap := make([]byte, (len(results)+7)/8)
+	for i, valid := range results {
+		if valid {
+			bitmap[i/8] |= 1 << (i % 8)
+		}
+	}
+	return bitmap
+}
+
 // VerifyCustomSecp256k1 VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) VerifyCustomSecp256k1(
@@ -570,6 +990,107 @@ func ManagedVerifySecp256k1WithHost(
 	)
 }
 
+// ManagedVerifySecp256r1 VMHooks implementation.
+// Verifies a Secp256r1 (NIST P-256) ECDSA signature, given as the 64 bytes r || s, over the SHA-256 hash of the message.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedVerifySecp256r1(
+	keyHandle, messageHandle, sigHandle int32,
+) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
+	crypto := context.GetCryptoContext()
+	metering.StartGasTracing(verifySecp256r1Name)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.VerifySecp256r1
+	metering.UseAndTraceGas(gasToUse)
+
+	keyBytes, err := managedType.GetBytes(keyHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(keyBytes)
+
+	msgBytes, err := managedType.GetBytes(messageHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(msgBytes)
+
+	sigBytes, err := managedType.GetBytes(sigHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(sigBytes)
+
+	invalidSigErr := crypto.VerifySecp256r1(keyBytes, msgBytes, sigBytes)
+	if invalidSigErr != nil {
+		context.WithFault(invalidSigErr, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
+
+// ManagedVerifySecp256r1Batch VMHooks implementation.
+// Verifies the Secp256r1 signatures of managed vectors of keys, messages and signatures, failing on the first invalid one.
+// The signatures are verified one after the other and each of them costs as much as a single verification.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedVerifySecp256r1Batch(
+	keysHandle, messagesHandle, sigsHandle int32,
+) int32 {
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(verifySecp256r1BatchName)
+
+	gasSchedule := metering.GasSchedule()
+	results, ok := context.verifySignatureBatch(
+		keysHandle, messagesHandle, sigsHandle,
+		gasSchedule.CryptoAPICost.VerifySecp256r1Batch,
+		gasSchedule.CryptoAPICost.VerifySecp256r1BatchPerSignature,
+		context.GetCryptoContext().VerifySecp256r1Batch,
+	)
+	if !ok {
+		return 1
+	}
+
+	return context.failOnInvalidSignature(results)
+}
+
+// ManagedVerifySecp256r1BatchBitmap VMHooks implementation.
+// Verifies the Secp256r1 signatures of managed vectors of keys, messages and signatures, writing a bitmap of the
+// results, where bit i%8 of byte i/8 is set if signature i is valid.
+// The signatures are verified one after the other and each of them costs as much as a single verification.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedVerifySecp256r1BatchBitmap(
+	keysHandle, messagesHandle, sigsHandle, resultHandle int32,
+) int32 {
+	metering := context.GetMeteringContext()
+	metering.StartGasTracing(verifySecp256r1BatchName)
+
+	gasSchedule := metering.GasSchedule()
+	results, ok := context.verifySignatureBatch(
+		keysHandle, messagesHandle, sigsHandle,
+		gasSchedule.CryptoAPICost.VerifySecp256r1Batch,
+		gasSchedule.CryptoAPICost.VerifySecp256r1BatchPerSignature,
+		context.GetCryptoContext().VerifySecp256r1Batch,
+	)
+	if !ok {
+		return 1
+	}
+
+	err := context.GetManagedTypesContext().SetBytes(resultHandle, batchResultsBitmap(results))
+	if context.WithFault(err, context.GetRuntimeContext().ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	return 0
+}
+
 // EncodeSecp256k1DerSignature VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) EncodeSecp256k1DerSignature(
@@ -759,7 +1280,10 @@ func (context *VMHooksImpl) IsOnCurveEC(
 	curveMultiplier := managedType.Get100xCurveGasCostMultiplier(ecHandle)
 	if curveMultiplier < 0 {
 		_ = context.WithFault(vmhost.ErrNoEllipticCurveUnderThisHandle, runtime.CryptoAPIErrorShouldFailExecution())
-		return 1
+		if !context.GetVMHost().EnableEpochsHandler().IsFixVMHooksErrorHandlingFlagEnabled() {
+			return 1
+		}
+		return -1
 	}
 	gasToUse := metering.GasSchedule().CryptoAPICost.IsOnCurveECC * uint64(curveMultiplier) / 100
 	metering.UseAndTraceGas(gasToUse)
@@ -1646,6 +2170,112 @@ func ManagedCreateECWithHost(host vmhost.VMHost, dataHandle int32) int32 {
 	return -1
 }
 
+// ManagedCreateCustomEC VMHooks implementation.
+// Creates the short Weierstrass curve y^2 = x^3 + ax + b from its field order, base point order, a, b and base point,
+// as big ints, and returns its handle, or -1 if they do not define a valid curve.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedCreateCustomEC(
+	fieldOrderHandle int32,
+	basePointOrderHandle int32,
+	eqCoefficientHandle int32,
+	eqConstantHandle int32,
+	xBasePointHandle int32,
+	yBasePointHandle int32,
+	sizeOfField int32,
+) int32 {
+	host := context.GetVMHost()
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+	runtime := context.GetRuntimeContext()
+
+	metering.StartGasTracing(createCustomECName)
+
+	if !host.EnableEpochsHandler().IsCustomEllipticCurvesFlagEnabled() {
+		_ = context.WithFault(vmhost.ErrCustomEllipticCurvesNotEnabled, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.EllipticCurveNewCustom
+	metering.UseAndTraceGas(gasToUse)
+
+	fieldOrder, basePointOrder, err := managedType.GetTwoBigInt(fieldOrderHandle, basePointOrderHandle)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	// the validation checks the primality of the orders and multiplies the base point by its order
+	fieldByteLength := uint64(fieldOrder.BitLen()+7) / 8
+	gasToUse = math.MulUint64(metering.GasSchedule().CryptoAPICost.EllipticCurveNewCustomPerByte, fieldByteLength)
+	metering.UseAndTraceGas(gasToUse)
+
+	eqCoefficient, eqConstant, err := managedType.GetTwoBigInt(eqCoefficientHandle, eqConstantHandle)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	xBasePoint, yBasePoint, err := managedType.GetTwoBigInt(xBasePointHandle, yBasePointHandle)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return -1
+	}
+	managedType.ConsumeGasForBigIntCopy(fieldOrder, basePointOrder, eqCoefficient, eqConstant, xBasePoint, yBasePoint)
+
+	curveParams := &elliptic.CurveParams{
+		P:       new(big.Int).Set(fieldOrder),
+		N:       new(big.Int).Set(basePointOrder),
+		B:       new(big.Int).Set(eqConstant),
+		Gx:      new(big.Int).Set(xBasePoint),
+		Gy:      new(big.Int).Set(yBasePoint),
+		BitSize: int(sizeOfField),
+	}
+	err = checkCustomEllipticCurve(curveParams, eqCoefficient)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return -1
+	}
+
+	return managedType.PutCustomEllipticCurve(weierstrass.NewCurve(curveParams, eqCoefficient))
+}
+
+// checkCustomEllipticCurve verifies that the parameters define a non-singular curve y^2 = x^3 + ax + b over a
+// prime field, with a base point of prime order.
+func checkCustomEllipticCurve(curveParams *elliptic.CurveParams, eqCoefficient *big.Int) error {
+	if curveParams.BitSize <= 0 || curveParams.BitSize > maxCustomCurveSizeOfField || curveParams.P.BitLen() != curveParams.BitSize {
+		return fmt.Errorf("%w: the size of field must be the bit length of the field order, at most %d",
+			vmhost.ErrInvalidEllipticCurveParams, maxCustomCurveSizeOfField)
+	}
+	if curveParams.P.Cmp(big.NewInt(3)) <= 0 || !curveParams.P.ProbablyPrime(customCurvePrimalityRounds) {
+		return fmt.Errorf("%w: the field order is not a prime greater than 3", vmhost.ErrInvalidEllipticCurveParams)
+	}
+	if curveParams.N.Sign() <= 0 || curveParams.N.BitLen() > curveParams.BitSize+1 || !curveParams.N.ProbablyPrime(customCurvePrimalityRounds) {
+		return fmt.Errorf("%w: the base point order is not a prime of the size of the field", vmhost.ErrInvalidEllipticCurveParams)
+	}
+
+	for _, value := range []*big.Int{eqCoefficient, curveParams.B, curveParams.Gx, curveParams.Gy} {
+		if value.Sign() < 0 || value.Cmp(curveParams.P) >= 0 {
+			return fmt.Errorf("%w: the curve values must be elements of the field", vmhost.ErrInvalidEllipticCurveParams)
+		}
+	}
+
+	// the point at infinity is encoded as (0, 0), which must not be a point of the curve
+	if curveParams.B.Sign() == 0 {
+		return fmt.Errorf("%w: the constant b must not be zero", vmhost.ErrInvalidEllipticCurveParams)
+	}
+
+	curve := weierstrass.NewCurve(curveParams, eqCoefficient)
+	if !curve.IsNonSingular() {
+		return fmt.Errorf("%w: the curve is singular", vmhost.ErrInvalidEllipticCurveParams)
+	}
+	if !curve.IsOnCurve(curve.Gx, curve.Gy) {
+		return fmt.Errorf("%w: the base point is not on the curve", vmhost.ErrInvalidEllipticCurveParams)
+	}
+	x, y := curve.ScalarMult(curve.Gx, curve.Gy, curve.N.Bytes())
+	if x.Sign() != 0 || y.Sign() != 0 {
+		return fmt.Errorf("%w: the base point order is wrong", vmhost.ErrInvalidEllipticCurveParams)
+	}
+
+	return nil
+}
+
 // GetCurveLengthEC VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) GetCurveLengthEC(ecHandle int32) int32 {
@@ -1715,3 +2345,133 @@ func (context *VMHooksImpl) EllipticCurveGetValues(ecHandle int32, fieldOrderHan
 	yBasePoint.Set(ec.Gy)
 	return ecHandle
 }
+
+// ManagedAddBN254G1 VMHooks implementation.
+// Adds two G1 points of the BN254 curve, encoded as in the Ethereum precompiles.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedAddBN254G1(
+	point1Handle int32,
+	point2Handle int32,
+	resultHandle int32,
+) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
+	crypto := context.GetCryptoContext()
+	metering.StartGasTracing(addBN254G1Name)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.AddBN254G1
+	metering.UseAndTraceGas(gasToUse)
+
+	point1, err := managedType.GetBytes(point1Handle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(point1)
+
+	point2, err := managedType.GetBytes(point2Handle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(point2)
+
+	sum, err := crypto.AddBN254G1(point1, point2)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	managedType.SetBytes(resultHandle, sum)
+	return 0
+}
+
+// ManagedScalarMulBN254G1 VMHooks implementation.
+// Multiplies a G1 point of the BN254 curve by a big endian scalar.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedScalarMulBN254G1(
+	pointHandle int32,
+	scalarHandle int32,
+	resultHandle int32,
+) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
+	crypto := context.GetCryptoContext()
+	metering.StartGasTracing(scalarMulBN254G1Name)
+
+	gasToUse := metering.GasSchedule().CryptoAPICost.ScalarMulBN254G1
+	metering.UseAndTraceGas(gasToUse)
+
+	point, err := managedType.GetBytes(pointHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(point)
+
+	scalar, err := managedType.GetBytes(scalarHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	managedType.ConsumeGasForBytes(scalar)
+
+	product, err := crypto.ScalarMulBN254G1(point, scalar)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	managedType.SetBytes(resultHandle, product)
+	return 0
+}
+
+// ManagedPairingCheckBN254 VMHooks implementation.
+// Checks that the product of the pairings of the BN254 points from two managed vectors, of G1 and G2 points, is one.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedPairingCheckBN254(
+	g1PointsHandle int32,
+	g2PointsHandle int32,
+) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	managedType := context.GetManagedTypesContext()
+	crypto := context.GetCryptoContext()
+	metering.StartGasTracing(pairingCheckBN254Name)
+
+	numPairs, err := context.managedVecLength(g1PointsHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	gasToUse := math.MulUint64(metering.GasSchedule().CryptoAPICost.PairingCheckBN254PerPair, numPairs)
+	gasToUse = math.AddUint64(metering.GasSchedule().CryptoAPICost.PairingCheckBN254, gasToUse)
+	err = metering.UseGasBounded(gasToUse)
+	if err != nil {
+		_ = context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution())
+		return 1
+	}
+
+	g1Points, _, err := managedType.ReadManagedVecOfManagedBuffers(g1PointsHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	g2Points, _, err := managedType.ReadManagedVecOfManagedBuffers(g2PointsHandle)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
+
+	isOne, err := crypto.PairingCheckBN254(g1Points, g2Points)
+	if context.WithFault(err, runtime.CryptoAPIErrorShouldFailExecution()) {
+		return 1
+	}
+	if !isOne {
+		context.WithFault(vmhost.ErrBN254PairingCheckFailed, runtime.CryptoAPIErrorShouldFailExecution())
+		return -1
+	}
+
+	return 0
+}
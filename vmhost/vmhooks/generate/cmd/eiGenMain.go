@@ -48,13 +48,18 @@ func main() {
 
 	writeVMHooks(eiMetadata)
 	writeVMHooksWrapper(eiMetadata)
+	writeVMHooksInterceptor(eiMetadata)
+	writeVMHookMetadata(eiMetadata)
 	writeWasmer1ImportsCgo(eiMetadata)
 	if wasmer2Branch {
-		writeWasmer2ImportsCgo(eiMetadata)
-		writeWasmer2Names(eiMetadata)
+		wasmer2Metadata := eiMetadata.Wasmer2Metadata()
+		writeWasmer2ImportsCgo(wasmer2Metadata)
+		writeWasmer2Names(wasmer2Metadata)
 	}
 
 	writeNamesForMockExecutor(eiMetadata)
+	writeInterpreterImports(eiMetadata)
+	writeInterpreterNames(eiMetadata)
 
 	tryCreateRustOutputDirectory()
 
@@ -92,6 +97,18 @@ func writeVMHooksWrapper(eiMetadata *eapigen.EIMetadata) {
 	eapigen.WriteVMHooksWrapper(out, eiMetadata)
 }
 
+func writeVMHooksInterceptor(eiMetadata *eapigen.EIMetadata) {
+	out := eapigen.NewEIGenWriter(pathToApiPackage, "../../executor/wrapper/interceptorVMHooks.go")
+	defer out.Close()
+	eapigen.WriteVMHooksInterceptor(out, eiMetadata)
+}
+
+func writeVMHookMetadata(eiMetadata *eapigen.EIMetadata) {
+	out := eapigen.NewEIGenWriter(pathToApiPackage, "../../executor/wrapper/vmHookMetadata.go")
+	defer out.Close()
+	eapigen.WriteVMHookMetadata(out, eiMetadata)
+}
+
 func writeWasmer1ImportsCgo(eiMetadata *eapigen.EIMetadata) {
 	out := eapigen.NewEIGenWriter(pathToApiPackage, "../../wasmer/wasmerImportsCgo.go")
 	defer out.Close()
@@ -116,6 +133,18 @@ func writeNamesForMockExecutor(eiMetadata *eapigen.EIMetadata) {
 	eapigen.WriteNames(out, "mock", eiMetadata)
 }
 
+func writeInterpreterImports(eiMetadata *eapigen.EIMetadata) {
+	out := eapigen.NewEIGenWriter(pathToApiPackage, "../../interpreter/interpreterImports.go")
+	defer out.Close()
+	eapigen.WriteInterpreterImports(out, eiMetadata)
+}
+
+func writeInterpreterNames(eiMetadata *eapigen.EIMetadata) {
+	out := eapigen.NewEIGenWriter(pathToApiPackage, "../../interpreter/interpreterNames.go")
+	defer out.Close()
+	eapigen.WriteNames(out, "interpreter", eiMetadata)
+}
+
 func tryCreateRustOutputDirectory() {
 	outputDirPath := filepath.Join(pathToApiPackage, "generate/cmd/output")
 	if _, err := os.Stat(outputDirPath); errors.Is(err, os.ErrNotExist) {
@@ -23,9 +23,10 @@ type EIFunctionResult struct {
 
 // EIFunction holds data about one function in the VM EI.
 type EIFunction struct {
-	Name      string
-	Arguments []*EIFunctionArg
-	Result    *EIFunctionResult
+	Name                string
+	Arguments           []*EIFunctionArg
+	Result              *EIFunctionResult
+	ExcludedFromWasmer2 bool
 }
 
 // EIGroup groups EI functions into bundles.
@@ -41,3 +42,17 @@ type EIMetadata struct {
 	Groups       []*EIGroup
 	AllFunctions []*EIFunction
 }
+
+// Wasmer2Metadata returns the EI functions available on wasmer2, i.e. all but the ones marked with @exclude(Wasmer2).
+func (eiMetadata *EIMetadata) Wasmer2Metadata() *EIMetadata {
+	wasmer2Metadata := &EIMetadata{
+		Groups:       eiMetadata.Groups,
+		AllFunctions: make([]*EIFunction, 0, len(eiMetadata.AllFunctions)),
+	}
+	for _, funcMetadata := range eiMetadata.AllFunctions {
+		if !funcMetadata.ExcludedFromWasmer2 {
+			wasmer2Metadata.AllFunctions = append(wasmer2Metadata.AllFunctions, funcMetadata)
+		}
+	}
+	return wasmer2Metadata
+}
@@ -81,6 +81,12 @@ func isEIInterfaceMethod(decl *ast.FuncDecl) bool {
 	return strings.Contains(text, "@autogenerate(VMHooks)")
 }
 
+// isExcludedFromWasmer2 marks the hooks missing from the prebuilt wasmer2 library, whose hook pointers
+// struct cannot change its layout until a new version of the library is released
+func isExcludedFromWasmer2(decl *ast.FuncDecl) bool {
+	return strings.Contains(decl.Doc.Text(), "@exclude(Wasmer2)")
+}
+
 func validateReceiver(decl *ast.FuncDecl) error {
 	if decl.Recv == nil {
 		return errors.New("receiver expected")
@@ -116,9 +122,10 @@ func extractEIFunction(decl *ast.FuncDecl) (*EIFunction, error) {
 		return nil, err
 	}
 	eiFunction := &EIFunction{
-		Name:      decl.Name.Name,
-		Arguments: arguments,
-		Result:    result,
+		Name:                decl.Name.Name,
+		Arguments:           arguments,
+		Result:              result,
+		ExcludedFromWasmer2: isExcludedFromWasmer2(decl),
 	}
 
 	return eiFunction, nil
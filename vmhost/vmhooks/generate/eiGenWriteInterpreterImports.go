@@ -0,0 +1,99 @@
+package vmhooksgenerate
+
+import (
+	"fmt"
+)
+
+// WriteInterpreterImports generates the table through which the pure Go interpreter calls the VM hooks.
+func WriteInterpreterImports(out *eiGenWriter, eiMetadata *EIMetadata) {
+	autoGeneratedGoHeader(out, "interpreter")
+	out.WriteString(`
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// vmHookImport describes a VM hook, as seen from WASM.
+type vmHookImport struct {
+	signature *functionType
+	invoke    func(vmHooks executor.VMHooks, args []uint64) uint64
+}
+
+var vmHookImports = map[string]*vmHookImport{`)
+
+	for _, funcMetadata := range eiMetadata.AllFunctions {
+		out.WriteString(fmt.Sprintf("\n\t\"%s\": {", lowerInitial(funcMetadata.Name)))
+		out.WriteString("\n\t\tsignature: &functionType{")
+		out.WriteString("\n\t\t\tparams:  []valueType{")
+		for argIndex, arg := range funcMetadata.Arguments {
+			if argIndex > 0 {
+				out.WriteString(", ")
+			}
+			out.WriteString(interpreterValueType(arg.Type))
+		}
+		out.WriteString("},")
+		out.WriteString("\n\t\t\tresults: []valueType{")
+		if funcMetadata.Result != nil {
+			out.WriteString(interpreterValueType(funcMetadata.Result.Type))
+		}
+		out.WriteString("},")
+		out.WriteString("\n\t\t},")
+
+		argsName := "args"
+		if len(funcMetadata.Arguments) == 0 {
+			argsName = "_"
+		}
+		out.WriteString(fmt.Sprintf("\n\t\tinvoke: func(vmHooks executor.VMHooks, %s []uint64) uint64 {", argsName))
+		out.WriteString("\n\t\t\t")
+		if funcMetadata.Result != nil {
+			out.WriteString("result := ")
+		}
+		out.WriteString(fmt.Sprintf("vmHooks.%s(", upperInitial(funcMetadata.Name)))
+		for argIndex, arg := range funcMetadata.Arguments {
+			if argIndex > 0 {
+				out.WriteString(", ")
+			}
+			out.WriteString(interpreterArgConversion(arg.Type, argIndex))
+		}
+		out.WriteString(")")
+		if funcMetadata.Result != nil {
+			out.WriteString(fmt.Sprintf("\n\t\t\treturn %s", interpreterResultConversion(funcMetadata.Result.Type)))
+		} else {
+			out.WriteString("\n\t\t\treturn 0")
+		}
+		out.WriteString("\n\t\t},")
+		out.WriteString("\n\t},")
+	}
+
+	out.WriteString(`
+}
+`)
+}
+
+func interpreterValueType(eiType EIType) string {
+	switch eiType {
+	case EITypeInt64:
+		return "valueTypeI64"
+	default:
+		return "valueTypeI32"
+	}
+}
+
+func interpreterArgConversion(eiType EIType, argIndex int) string {
+	switch eiType {
+	case EITypeMemPtr:
+		return fmt.Sprintf("executor.MemPtr(int32(args[%d]))", argIndex)
+	case EITypeInt64:
+		return fmt.Sprintf("int64(args[%d])", argIndex)
+	default:
+		return fmt.Sprintf("int32(args[%d])", argIndex)
+	}
+}
+
+func interpreterResultConversion(eiType EIType) string {
+	switch eiType {
+	case EITypeInt64:
+		return "uint64(result)"
+	default:
+		return "uint64(uint32(result))"
+	}
+}
@@ -0,0 +1,48 @@
+package vmhooksgenerate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WriteVMHookMetadata generates the description of each VM hook: its family, which is the name
+// of the source file where the VM hook is implemented, and the names and types of its arguments.
+func WriteVMHookMetadata(out *eiGenWriter, eiMetadata *EIMetadata) {
+	autoGeneratedGoHeader(out, "executorwrapper")
+	out.WriteString(`
+var vmHookMetadata = map[string]*VMHookMetadata{`)
+
+	for _, group := range eiMetadata.Groups {
+		family := strings.TrimSuffix(group.SourcePath, ".go")
+		for _, funcMetadata := range group.Functions {
+			out.WriteString(fmt.Sprintf("\n\t\"%s\": {", lowerInitial(funcMetadata.Name)))
+			out.WriteString(fmt.Sprintf("\n\t\tFamily:     \"%s\",", family))
+			out.WriteString("\n\t\tArgNames:   []string{")
+			for argIndex, arg := range funcMetadata.Arguments {
+				if argIndex > 0 {
+					out.WriteString(", ")
+				}
+				out.WriteString(fmt.Sprintf("\"%s\"", arg.Name))
+			}
+			out.WriteString("},")
+			out.WriteString("\n\t\tArgTypes:   []string{")
+			for argIndex, arg := range funcMetadata.Arguments {
+				if argIndex > 0 {
+					out.WriteString(", ")
+				}
+				out.WriteString(fmt.Sprintf("\"%s\"", vmHooksType(arg.Type)))
+			}
+			out.WriteString("},")
+			resultType := ""
+			if funcMetadata.Result != nil {
+				resultType = vmHooksType(funcMetadata.Result.Type)
+			}
+			out.WriteString(fmt.Sprintf("\n\t\tResultType: \"%s\",", resultType))
+			out.WriteString("\n\t},")
+		}
+	}
+
+	out.WriteString(`
+}
+`)
+}
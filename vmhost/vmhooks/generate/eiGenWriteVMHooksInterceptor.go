@@ -0,0 +1,79 @@
+package vmhooksgenerate
+
+import (
+	"fmt"
+)
+
+// WriteVMHooksInterceptor generates a VMHooks implementation that routes every call through a VMHookInterceptor.
+func WriteVMHooksInterceptor(out *eiGenWriter, eiMetadata *EIMetadata) {
+	autoGeneratedGoHeader(out, "executorwrapper")
+	out.WriteString(`
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// InterceptorVMHooks passes all VM hook calls through an interceptor,
+// which decides whether and how the wrapped VM hooks are called.
+type InterceptorVMHooks struct {
+	interceptor    VMHookInterceptor
+	wrappedVMHooks executor.VMHooks
+}
+
+// NewInterceptorVMHooks creates a new InterceptorVMHooks.
+// The wrapped VM hooks can be nil, if the interceptor never invokes them.
+func NewInterceptorVMHooks(interceptor VMHookInterceptor, wrappedVMHooks executor.VMHooks) *InterceptorVMHooks {
+	return &InterceptorVMHooks{
+		interceptor:    interceptor,
+		wrappedVMHooks: wrappedVMHooks,
+	}
+}
+`)
+
+	for _, funcMetadata := range eiMetadata.AllFunctions {
+		out.WriteString(fmt.Sprintf("\n// %s VM hook interceptor", upperInitial(funcMetadata.Name)))
+		out.WriteString(fmt.Sprintf("\nfunc (w *InterceptorVMHooks) %s(", upperInitial(funcMetadata.Name)))
+		for argIndex, arg := range funcMetadata.Arguments {
+			if argIndex > 0 {
+				out.WriteString(", ")
+			}
+			out.WriteString(fmt.Sprintf("%s %s", arg.Name, vmHooksWrapperType(arg.Type)))
+		}
+		out.WriteString(")")
+		if funcMetadata.Result != nil {
+			out.WriteString(fmt.Sprintf(" %s", vmHooksWrapperType(funcMetadata.Result.Type)))
+		}
+		out.WriteString(" {")
+
+		out.WriteString(fmt.Sprintf("\n\tcall := &VMHookCall{Name: \"%s\", Args: []int64{", lowerInitial(funcMetadata.Name)))
+		for argIndex, arg := range funcMetadata.Arguments {
+			if argIndex > 0 {
+				out.WriteString(", ")
+			}
+			out.WriteString(fmt.Sprintf("int64(%s)", arg.Name))
+		}
+		out.WriteString("}}")
+
+		out.WriteString("\n\t")
+		if funcMetadata.Result != nil {
+			out.WriteString("result := ")
+		}
+		out.WriteString("w.interceptor.InterceptVMHookCall(call, func() int64 {")
+		out.WriteString("\n\t\t")
+		if funcMetadata.Result != nil {
+			out.WriteString("return int64(")
+		}
+		out.WriteString(fmt.Sprintf("w.wrappedVMHooks.%s(", upperInitial(funcMetadata.Name)))
+		writeCommaSeparatedArgumentNames(out, funcMetadata.Arguments)
+		out.WriteString(")")
+		if funcMetadata.Result != nil {
+			out.WriteString(")")
+		} else {
+			out.WriteString("\n\t\treturn 0")
+		}
+		out.WriteString("\n\t})")
+		if funcMetadata.Result != nil {
+			out.WriteString(fmt.Sprintf("\n\treturn %s(result)", vmHooksWrapperType(funcMetadata.Result.Type)))
+		}
+		out.WriteString("\n}\n")
+	}
+}
@@ -227,7 +227,10 @@ func (context *VMHooksImpl) MBufferSetBytes(mBufferHandle int32, dataOffset exec
 		return 1
 	}
 	managedType.ConsumeGasForBytes(data)
-	managedType.SetBytes(mBufferHandle, data)
+	err = managedType.SetBytes(mBufferHandle, data)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
+		return 1
+	}
 
 	return 0
 }
@@ -314,9 +317,8 @@ func (context *VMHooksImpl) MBufferAppend(accumulatorHandle int32, dataHandle in
 	}
 	managedType.ConsumeGasForBytes(dataBufferBytes)
 
-	isSuccess := managedType.AppendBytes(accumulatorHandle, dataBufferBytes)
-	if !isSuccess {
-		_ = context.WithFault(vmhost.ErrNoManagedBufferUnderThisHandle, runtime.ManagedBufferAPIErrorShouldFailExecution())
+	err = managedType.AppendBytes(accumulatorHandle, dataBufferBytes)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -339,9 +341,8 @@ func (context *VMHooksImpl) MBufferAppendBytes(accumulatorHandle int32, dataOffs
 		return 1
 	}
 
-	isSuccess := managedType.AppendBytes(accumulatorHandle, data)
-	if !isSuccess {
-		_ = context.WithFault(vmhost.ErrNoManagedBufferUnderThisHandle, runtime.ManagedBufferAPIErrorShouldFailExecution())
+	err = managedType.AppendBytes(accumulatorHandle, data)
+	if context.WithFault(err, runtime.ManagedBufferAPIErrorShouldFailExecution()) {
 		return 1
 	}
 
@@ -29,7 +29,10 @@ const (
 	managedUpgradeFromSourceContractName    = "managedUpgradeFromSourceContract"
 	managedAsyncCallName                    = "managedAsyncCall"
 	managedCreateAsyncCallName              = "managedCreateAsyncCall"
+	managedCreateAsyncCallWithDeadlineName  = "managedCreateAsyncCallWithDeadline"
+	managedCancelAsyncCallGroupName         = "managedCancelAsyncCallGroup"
 	managedGetCallbackClosure               = "managedGetCallbackClosure"
+	managedIsCallbackTimedOutName           = "managedIsCallbackTimedOut"
 	managedGetMultiESDTCallValueName        = "managedGetMultiESDTCallValue"
 	managedGetESDTBalanceName               = "managedGetESDTBalance"
 	managedGetESDTTokenDataName             = "managedGetESDTTokenData"
@@ -461,6 +464,198 @@ func (context *VMHooksImpl) ManagedCreateAsyncCall(
 		callbackClosure)
 }
 
+// ManagedCreateAsyncCallWithDeadline VMHooks implementation.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedCreateAsyncCallWithDeadline(
+	destHandle int32,
+	valueHandle int32,
+	functionHandle int32,
+	argumentsHandle int32,
+	successOffset executor.MemPtr,
+	successLength executor.MemLength,
+	errorOffset executor.MemPtr,
+	errorLength executor.MemLength,
+	gas int64,
+	extraGasForCallback int64,
+	callbackClosureHandle int32,
+	groupHandle int32,
+	deadlineRound int64,
+) int32 {
+	host := context.GetVMHost()
+	runtime := host.Runtime()
+
+	successFunc, err := context.MemLoad(successOffset, successLength)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return 1
+	}
+
+	errorFunc, err := context.MemLoad(errorOffset, errorLength)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return 1
+	}
+
+	return ManagedCreateAsyncCallWithDeadlineWithHost(
+		host,
+		destHandle,
+		valueHandle,
+		functionHandle,
+		argumentsHandle,
+		successFunc,
+		errorFunc,
+		gas,
+		extraGasForCallback,
+		callbackClosureHandle,
+		groupHandle,
+		deadlineRound)
+}
+
+// ManagedCreateAsyncCallWithDeadlineWithHost registers an async call in the given group, like managedCreateAsyncCall,
+// but with a deadline round. The callback receives the actual result of the call even when it arrives later,
+// and can tell a late result with managedIsCallbackTimedOut.
+func ManagedCreateAsyncCallWithDeadlineWithHost(
+	host vmhost.VMHost,
+	destHandle int32,
+	valueHandle int32,
+	functionHandle int32,
+	argumentsHandle int32,
+	successFunc []byte,
+	errorFunc []byte,
+	gas int64,
+	extraGasForCallback int64,
+	callbackClosureHandle int32,
+	groupHandle int32,
+	deadlineRound int64,
+) int32 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+
+	metering.StartGasTracing(managedCreateAsyncCallWithDeadlineName)
+
+	if !host.EnableEpochsHandler().IsAsyncCallTimeoutsFlagEnabled() {
+		_ = WithFaultAndHost(host, vmhost.ErrAsyncCallTimeoutsNotEnabled, runtime.BaseOpsErrorShouldFailExecution())
+		return 1
+	}
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.CreateAsyncCallWithDeadline
+	metering.UseAndTraceGas(gasToUse)
+
+	if deadlineRound <= 0 || uint64(deadlineRound) <= host.Blockchain().CurrentRound() {
+		_ = WithFaultAndHost(host, vmhost.ErrInvalidAsyncCallDeadline, runtime.BaseOpsErrorShouldFailExecution())
+		return 1
+	}
+
+	vmInput, err := readDestinationFunctionArguments(host, destHandle, functionHandle, argumentsHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return 1
+	}
+
+	value, err := managedType.GetBigInt(valueHandle)
+	if err != nil {
+		_ = WithFaultAndHost(host, vmhost.ErrArgOutOfRange, runtime.BaseOpsErrorShouldFailExecution())
+		return 1
+	}
+
+	callbackClosure, err := managedType.GetBytes(callbackClosureHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return 1
+	}
+
+	groupID, err := managedType.GetBytes(groupHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return 1
+	}
+
+	asyncCall := &vmhost.AsyncCall{
+		Status:          vmhost.AsyncCallPending,
+		Destination:     vmInput.destination,
+		Data:            []byte(makeCrossShardCallFromInput(vmInput.function, vmInput.arguments)),
+		ValueBytes:      value.Bytes(),
+		GasLimit:        uint64(gas),
+		SuccessCallback: string(successFunc),
+		ErrorCallback:   string(errorFunc),
+		GasLocked:       uint64(extraGasForCallback),
+		CallbackClosure: callbackClosure,
+		DeadlineRound:   uint64(deadlineRound),
+	}
+
+	return registerAsyncCallWithHost(host, string(groupID), asyncCall)
+}
+
+// ManagedCancelAsyncCallGroup VMHooks implementation.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedCancelAsyncCallGroup(groupHandle int32) int32 {
+	host := context.GetVMHost()
+	return ManagedCancelAsyncCallGroupWithHost(host, groupHandle)
+}
+
+// ManagedCancelAsyncCallGroupWithHost removes a group of async calls registered by the current execution,
+// before any of them is executed, and gives back the gas they consumed.
+func ManagedCancelAsyncCallGroupWithHost(host vmhost.VMHost, groupHandle int32) int32 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	async := host.Async()
+	managedType := host.ManagedTypes()
+
+	metering.StartGasTracing(managedCancelAsyncCallGroupName)
+
+	if !host.EnableEpochsHandler().IsAsyncCallTimeoutsFlagEnabled() {
+		_ = WithFaultAndHost(host, vmhost.ErrAsyncCallTimeoutsNotEnabled, runtime.BaseOpsErrorShouldFailExecution())
+		return 1
+	}
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.CancelAsyncCallGroup
+	metering.UseAndTraceGas(gasToUse)
+
+	groupID, err := managedType.GetBytes(groupHandle)
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return 1
+	}
+
+	err = async.CancelCallGroup(string(groupID))
+	if WithFaultAndHost(host, err, runtime.BaseOpsErrorShouldFailExecution()) {
+		return 1
+	}
+
+	return 0
+}
+
+// ManagedIsCallbackTimedOut VMHooks implementation.
+// Not available to the contracts run by wasmer2, the default executor, until libvmexeccapi exports it.
+// @autogenerate(VMHooks)
+// @exclude(Wasmer2)
+func (context *VMHooksImpl) ManagedIsCallbackTimedOut() int32 {
+	host := context.GetVMHost()
+	return ManagedIsCallbackTimedOutWithHost(host)
+}
+
+// ManagedIsCallbackTimedOutWithHost returns 1 if the callback being executed received the result of an async call
+// after the deadline round of the call, and 0 otherwise, including outside callbacks.
+func ManagedIsCallbackTimedOutWithHost(host vmhost.VMHost) int32 {
+	runtime := host.Runtime()
+	metering := host.Metering()
+	async := host.Async()
+
+	metering.StartGasTracing(managedIsCallbackTimedOutName)
+
+	if !host.EnableEpochsHandler().IsAsyncCallTimeoutsFlagEnabled() {
+		_ = WithFaultAndHost(host, vmhost.ErrAsyncCallTimeoutsNotEnabled, runtime.BaseOpsErrorShouldFailExecution())
+		return -1
+	}
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.IsCallbackTimedOut
+	metering.UseAndTraceGas(gasToUse)
+
+	if async.IsCallbackTimedOut() {
+		return 1
+	}
+	return 0
+}
+
 // ManagedGetCallbackClosure VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) ManagedGetCallbackClosure(
@@ -73,6 +73,11 @@ func (wasmerExecutor *WasmerExecutor) initVMHooks(vmHooks executor.VMHooks) {
 	wasmerExecutor.vmHooksPtr = uintptr(unsafe.Pointer(&wasmerExecutor.vmHooks))
 }
 
+// CompiledCodeVersion identifies the format of the Wasmer 1 compiled code.
+func (wasmerExecutor *WasmerExecutor) CompiledCodeVersion() string {
+	return "wasmer1"
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (wasmerExecutor *WasmerExecutor) IsInterfaceNil() bool {
 	return wasmerExecutor == nil
@@ -106,6 +106,9 @@ package wasmer
 // extern void      v1_5_managedGetESDTTokenData(void* context, int32_t addressHandle, int32_t tokenIDHandle, long long nonce, int32_t valueHandle, int32_t propertiesHandle, int32_t hashHandle, int32_t nameHandle, int32_t attributesHandle, int32_t creatorHandle, int32_t royaltiesHandle, int32_t urisHandle);
 // extern void      v1_5_managedAsyncCall(void* context, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle);
 // extern int32_t   v1_5_managedCreateAsyncCall(void* context, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t successOffset, int32_t successLength, int32_t errorOffset, int32_t errorLength, long long gas, long long extraGasForCallback, int32_t callbackClosureHandle);
+// extern int32_t   v1_5_managedCreateAsyncCallWithDeadline(void* context, int32_t destHandle, int32_t valueHandle, int32_t functionHandle, int32_t argumentsHandle, int32_t successOffset, int32_t successLength, int32_t errorOffset, int32_t errorLength, long long gas, long long extraGasForCallback, int32_t callbackClosureHandle, int32_t groupHandle, long long deadlineRound);
+// extern int32_t   v1_5_managedCancelAsyncCallGroup(void* context, int32_t groupHandle);
+// extern int32_t   v1_5_managedIsCallbackTimedOut(void* context);
 // extern void      v1_5_managedGetCallbackClosure(void* context, int32_t callbackClosureHandle);
 // extern void      v1_5_managedUpgradeFromSourceContract(void* context, int32_t destHandle, long long gas, int32_t valueHandle, int32_t addressHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
 // extern void      v1_5_managedUpgradeContract(void* context, int32_t destHandle, long long gas, int32_t valueHandle, int32_t codeHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle);
@@ -231,14 +234,27 @@ package wasmer
 // extern int32_t   v1_5_managedKeccak256(void* context, int32_t inputHandle, int32_t outputHandle);
 // extern int32_t   v1_5_ripemd160(void* context, int32_t dataOffset, int32_t length, int32_t resultOffset);
 // extern int32_t   v1_5_managedRipemd160(void* context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t   v1_5_managedSha512(void* context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t   v1_5_managedSha3256(void* context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t   v1_5_managedSha3512(void* context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t   v1_5_managedBlake2b256(void* context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t   v1_5_managedBlake2b512(void* context, int32_t inputHandle, int32_t outputHandle);
+// extern int32_t   v1_5_managedPoseidon(void* context, int32_t inputHandle, int32_t outputHandle);
 // extern int32_t   v1_5_verifyBLS(void* context, int32_t keyOffset, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
 // extern int32_t   v1_5_managedVerifyBLS(void* context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t   v1_5_managedVerifyBLSAggregated(void* context, int32_t keysHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t   v1_5_managedAggregateBLSPublicKeys(void* context, int32_t keysHandle, int32_t resultHandle);
 // extern int32_t   v1_5_verifyEd25519(void* context, int32_t keyOffset, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
 // extern int32_t   v1_5_managedVerifyEd25519(void* context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t   v1_5_managedVerifyEd25519Batch(void* context, int32_t keysHandle, int32_t messagesHandle, int32_t sigsHandle);
+// extern int32_t   v1_5_managedVerifyEd25519BatchBitmap(void* context, int32_t keysHandle, int32_t messagesHandle, int32_t sigsHandle, int32_t resultHandle);
 // extern int32_t   v1_5_verifyCustomSecp256k1(void* context, int32_t keyOffset, int32_t keyLength, int32_t messageOffset, int32_t messageLength, int32_t sigOffset, int32_t hashType);
 // extern int32_t   v1_5_managedVerifyCustomSecp256k1(void* context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle, int32_t hashType);
 // extern int32_t   v1_5_verifySecp256k1(void* context, int32_t keyOffset, int32_t keyLength, int32_t messageOffset, int32_t messageLength, int32_t sigOffset);
 // extern int32_t   v1_5_managedVerifySecp256k1(void* context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t   v1_5_managedVerifySecp256r1(void* context, int32_t keyHandle, int32_t messageHandle, int32_t sigHandle);
+// extern int32_t   v1_5_managedVerifySecp256r1Batch(void* context, int32_t keysHandle, int32_t messagesHandle, int32_t sigsHandle);
+// extern int32_t   v1_5_managedVerifySecp256r1BatchBitmap(void* context, int32_t keysHandle, int32_t messagesHandle, int32_t sigsHandle, int32_t resultHandle);
 // extern int32_t   v1_5_encodeSecp256k1DerSignature(void* context, int32_t rOffset, int32_t rLength, int32_t sOffset, int32_t sLength, int32_t sigOffset);
 // extern int32_t   v1_5_managedEncodeSecp256k1DerSignature(void* context, int32_t rHandle, int32_t sHandle, int32_t sigHandle);
 // extern void      v1_5_addEC(void* context, int32_t xResultHandle, int32_t yResultHandle, int32_t ecHandle, int32_t fstPointXHandle, int32_t fstPointYHandle, int32_t sndPointXHandle, int32_t sndPointYHandle);
@@ -260,9 +276,13 @@ package wasmer
 // extern int32_t   v1_5_managedGenerateKeyEC(void* context, int32_t xPubKeyHandle, int32_t yPubKeyHandle, int32_t ecHandle, int32_t resultHandle);
 // extern int32_t   v1_5_createEC(void* context, int32_t dataOffset, int32_t dataLength);
 // extern int32_t   v1_5_managedCreateEC(void* context, int32_t dataHandle);
+// extern int32_t   v1_5_managedCreateCustomEC(void* context, int32_t fieldOrderHandle, int32_t basePointOrderHandle, int32_t eqCoefficientHandle, int32_t eqConstantHandle, int32_t xBasePointHandle, int32_t yBasePointHandle, int32_t sizeOfField);
 // extern int32_t   v1_5_getCurveLengthEC(void* context, int32_t ecHandle);
 // extern int32_t   v1_5_getPrivKeyByteLengthEC(void* context, int32_t ecHandle);
 // extern int32_t   v1_5_ellipticCurveGetValues(void* context, int32_t ecHandle, int32_t fieldOrderHandle, int32_t basePointOrderHandle, int32_t eqConstantHandle, int32_t xBasePointHandle, int32_t yBasePointHandle);
+// extern int32_t   v1_5_managedAddBN254G1(void* context, int32_t point1Handle, int32_t point2Handle, int32_t resultHandle);
+// extern int32_t   v1_5_managedScalarMulBN254G1(void* context, int32_t pointHandle, int32_t scalarHandle, int32_t resultHandle);
+// extern int32_t   v1_5_managedPairingCheckBN254(void* context, int32_t g1PointsHandle, int32_t g2PointsHandle);
 import "C"
 
 import (
@@ -749,6 +769,21 @@ func populateWasmerImports(imports *wasmerImports) error {
 		return err
 	}
 
+	err = imports.append("managedCreateAsyncCallWithDeadline", v1_5_managedCreateAsyncCallWithDeadline, C.v1_5_managedCreateAsyncCallWithDeadline)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedCancelAsyncCallGroup", v1_5_managedCancelAsyncCallGroup, C.v1_5_managedCancelAsyncCallGroup)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedIsCallbackTimedOut", v1_5_managedIsCallbackTimedOut, C.v1_5_managedIsCallbackTimedOut)
+	if err != nil {
+		return err
+	}
+
 	err = imports.append("managedGetCallbackClosure", v1_5_managedGetCallbackClosure, C.v1_5_managedGetCallbackClosure)
 	if err != nil {
 		return err
@@ -1374,6 +1409,36 @@ func populateWasmerImports(imports *wasmerImports) error {
 		return err
 	}
 
+	err = imports.append("managedSha512", v1_5_managedSha512, C.v1_5_managedSha512)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedSha3256", v1_5_managedSha3256, C.v1_5_managedSha3256)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedSha3512", v1_5_managedSha3512, C.v1_5_managedSha3512)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedBlake2b256", v1_5_managedBlake2b256, C.v1_5_managedBlake2b256)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedBlake2b512", v1_5_managedBlake2b512, C.v1_5_managedBlake2b512)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedPoseidon", v1_5_managedPoseidon, C.v1_5_managedPoseidon)
+	if err != nil {
+		return err
+	}
+
 	err = imports.append("verifyBLS", v1_5_verifyBLS, C.v1_5_verifyBLS)
 	if err != nil {
 		return err
@@ -1384,6 +1449,16 @@ func populateWasmerImports(imports *wasmerImports) error {
 		return err
 	}
 
+	err = imports.append("managedVerifyBLSAggregated", v1_5_managedVerifyBLSAggregated, C.v1_5_managedVerifyBLSAggregated)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedAggregateBLSPublicKeys", v1_5_managedAggregateBLSPublicKeys, C.v1_5_managedAggregateBLSPublicKeys)
+	if err != nil {
+		return err
+	}
+
 	err = imports.append("verifyEd25519", v1_5_verifyEd25519, C.v1_5_verifyEd25519)
 	if err != nil {
 		return err
@@ -1394,6 +1469,16 @@ func populateWasmerImports(imports *wasmerImports) error {
 		return err
 	}
 
+	err = imports.append("managedVerifyEd25519Batch", v1_5_managedVerifyEd25519Batch, C.v1_5_managedVerifyEd25519Batch)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedVerifyEd25519BatchBitmap", v1_5_managedVerifyEd25519BatchBitmap, C.v1_5_managedVerifyEd25519BatchBitmap)
+	if err != nil {
+		return err
+	}
+
 	err = imports.append("verifyCustomSecp256k1", v1_5_verifyCustomSecp256k1, C.v1_5_verifyCustomSecp256k1)
 	if err != nil {
 		return err
@@ -1414,6 +1499,21 @@ func populateWasmerImports(imports *wasmerImports) error {
 		return err
 	}
 
+	err = imports.append("managedVerifySecp256r1", v1_5_managedVerifySecp256r1, C.v1_5_managedVerifySecp256r1)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedVerifySecp256r1Batch", v1_5_managedVerifySecp256r1Batch, C.v1_5_managedVerifySecp256r1Batch)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedVerifySecp256r1BatchBitmap", v1_5_managedVerifySecp256r1BatchBitmap, C.v1_5_managedVerifySecp256r1BatchBitmap)
+	if err != nil {
+		return err
+	}
+
 	err = imports.append("encodeSecp256k1DerSignature", v1_5_encodeSecp256k1DerSignature, C.v1_5_encodeSecp256k1DerSignature)
 	if err != nil {
 		return err
@@ -1519,6 +1619,11 @@ func populateWasmerImports(imports *wasmerImports) error {
 		return err
 	}
 
+	err = imports.append("managedCreateCustomEC", v1_5_managedCreateCustomEC, C.v1_5_managedCreateCustomEC)
+	if err != nil {
+		return err
+	}
+
 	err = imports.append("getCurveLengthEC", v1_5_getCurveLengthEC, C.v1_5_getCurveLengthEC)
 	if err != nil {
 		return err
@@ -1534,6 +1639,21 @@ func populateWasmerImports(imports *wasmerImports) error {
 		return err
 	}
 
+	err = imports.append("managedAddBN254G1", v1_5_managedAddBN254G1, C.v1_5_managedAddBN254G1)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedScalarMulBN254G1", v1_5_managedScalarMulBN254G1, C.v1_5_managedScalarMulBN254G1)
+	if err != nil {
+		return err
+	}
+
+	err = imports.append("managedPairingCheckBN254", v1_5_managedPairingCheckBN254, C.v1_5_managedPairingCheckBN254)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -2107,6 +2227,24 @@ func v1_5_managedCreateAsyncCall(context unsafe.Pointer, destHandle int32, value
 	return vmHooks.ManagedCreateAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle, executor.MemPtr(successOffset), successLength, executor.MemPtr(errorOffset), errorLength, gas, extraGasForCallback, callbackClosureHandle)
 }
 
+//export v1_5_managedCreateAsyncCallWithDeadline
+func v1_5_managedCreateAsyncCallWithDeadline(context unsafe.Pointer, destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset int32, successLength int32, errorOffset int32, errorLength int32, gas int64, extraGasForCallback int64, callbackClosureHandle int32, groupHandle int32, deadlineRound int64) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedCreateAsyncCallWithDeadline(destHandle, valueHandle, functionHandle, argumentsHandle, executor.MemPtr(successOffset), successLength, executor.MemPtr(errorOffset), errorLength, gas, extraGasForCallback, callbackClosureHandle, groupHandle, deadlineRound)
+}
+
+//export v1_5_managedCancelAsyncCallGroup
+func v1_5_managedCancelAsyncCallGroup(context unsafe.Pointer, groupHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedCancelAsyncCallGroup(groupHandle)
+}
+
+//export v1_5_managedIsCallbackTimedOut
+func v1_5_managedIsCallbackTimedOut(context unsafe.Pointer) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedIsCallbackTimedOut()
+}
+
 //export v1_5_managedGetCallbackClosure
 func v1_5_managedGetCallbackClosure(context unsafe.Pointer, callbackClosureHandle int32) {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -2857,6 +2995,42 @@ func v1_5_managedRipemd160(context unsafe.Pointer, inputHandle int32, outputHand
 	return vmHooks.ManagedRipemd160(inputHandle, outputHandle)
 }
 
+//export v1_5_managedSha512
+func v1_5_managedSha512(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedSha512(inputHandle, outputHandle)
+}
+
+//export v1_5_managedSha3256
+func v1_5_managedSha3256(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedSha3256(inputHandle, outputHandle)
+}
+
+//export v1_5_managedSha3512
+func v1_5_managedSha3512(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedSha3512(inputHandle, outputHandle)
+}
+
+//export v1_5_managedBlake2b256
+func v1_5_managedBlake2b256(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedBlake2b256(inputHandle, outputHandle)
+}
+
+//export v1_5_managedBlake2b512
+func v1_5_managedBlake2b512(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedBlake2b512(inputHandle, outputHandle)
+}
+
+//export v1_5_managedPoseidon
+func v1_5_managedPoseidon(context unsafe.Pointer, inputHandle int32, outputHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedPoseidon(inputHandle, outputHandle)
+}
+
 //export v1_5_verifyBLS
 func v1_5_verifyBLS(context unsafe.Pointer, keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -2869,6 +3043,18 @@ func v1_5_managedVerifyBLS(context unsafe.Pointer, keyHandle int32, messageHandl
 	return vmHooks.ManagedVerifyBLS(keyHandle, messageHandle, sigHandle)
 }
 
+//export v1_5_managedVerifyBLSAggregated
+func v1_5_managedVerifyBLSAggregated(context unsafe.Pointer, keysHandle int32, messageHandle int32, sigHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedVerifyBLSAggregated(keysHandle, messageHandle, sigHandle)
+}
+
+//export v1_5_managedAggregateBLSPublicKeys
+func v1_5_managedAggregateBLSPublicKeys(context unsafe.Pointer, keysHandle int32, resultHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedAggregateBLSPublicKeys(keysHandle, resultHandle)
+}
+
 //export v1_5_verifyEd25519
 func v1_5_verifyEd25519(context unsafe.Pointer, keyOffset int32, messageOffset int32, messageLength int32, sigOffset int32) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -2881,6 +3067,18 @@ func v1_5_managedVerifyEd25519(context unsafe.Pointer, keyHandle int32, messageH
 	return vmHooks.ManagedVerifyEd25519(keyHandle, messageHandle, sigHandle)
 }
 
+//export v1_5_managedVerifyEd25519Batch
+func v1_5_managedVerifyEd25519Batch(context unsafe.Pointer, keysHandle int32, messagesHandle int32, sigsHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedVerifyEd25519Batch(keysHandle, messagesHandle, sigsHandle)
+}
+
+//export v1_5_managedVerifyEd25519BatchBitmap
+func v1_5_managedVerifyEd25519BatchBitmap(context unsafe.Pointer, keysHandle int32, messagesHandle int32, sigsHandle int32, resultHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedVerifyEd25519BatchBitmap(keysHandle, messagesHandle, sigsHandle, resultHandle)
+}
+
 //export v1_5_verifyCustomSecp256k1
 func v1_5_verifyCustomSecp256k1(context unsafe.Pointer, keyOffset int32, keyLength int32, messageOffset int32, messageLength int32, sigOffset int32, hashType int32) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -2905,6 +3103,24 @@ func v1_5_managedVerifySecp256k1(context unsafe.Pointer, keyHandle int32, messag
 	return vmHooks.ManagedVerifySecp256k1(keyHandle, messageHandle, sigHandle)
 }
 
+//export v1_5_managedVerifySecp256r1
+func v1_5_managedVerifySecp256r1(context unsafe.Pointer, keyHandle int32, messageHandle int32, sigHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedVerifySecp256r1(keyHandle, messageHandle, sigHandle)
+}
+
+//export v1_5_managedVerifySecp256r1Batch
+func v1_5_managedVerifySecp256r1Batch(context unsafe.Pointer, keysHandle int32, messagesHandle int32, sigsHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedVerifySecp256r1Batch(keysHandle, messagesHandle, sigsHandle)
+}
+
+//export v1_5_managedVerifySecp256r1BatchBitmap
+func v1_5_managedVerifySecp256r1BatchBitmap(context unsafe.Pointer, keysHandle int32, messagesHandle int32, sigsHandle int32, resultHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedVerifySecp256r1BatchBitmap(keysHandle, messagesHandle, sigsHandle, resultHandle)
+}
+
 //export v1_5_encodeSecp256k1DerSignature
 func v1_5_encodeSecp256k1DerSignature(context unsafe.Pointer, rOffset int32, rLength int32, sOffset int32, sLength int32, sigOffset int32) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -3031,6 +3247,12 @@ func v1_5_managedCreateEC(context unsafe.Pointer, dataHandle int32) int32 {
 	return vmHooks.ManagedCreateEC(dataHandle)
 }
 
+//export v1_5_managedCreateCustomEC
+func v1_5_managedCreateCustomEC(context unsafe.Pointer, fieldOrderHandle int32, basePointOrderHandle int32, eqCoefficientHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32, sizeOfField int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedCreateCustomEC(fieldOrderHandle, basePointOrderHandle, eqCoefficientHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle, sizeOfField)
+}
+
 //export v1_5_getCurveLengthEC
 func v1_5_getCurveLengthEC(context unsafe.Pointer, ecHandle int32) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -3048,3 +3270,21 @@ func v1_5_ellipticCurveGetValues(context unsafe.Pointer, ecHandle int32, fieldOr
 	vmHooks := getVMHooksFromContextRawPtr(context)
 	return vmHooks.EllipticCurveGetValues(ecHandle, fieldOrderHandle, basePointOrderHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle)
 }
+
+//export v1_5_managedAddBN254G1
+func v1_5_managedAddBN254G1(context unsafe.Pointer, point1Handle int32, point2Handle int32, resultHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedAddBN254G1(point1Handle, point2Handle, resultHandle)
+}
+
+//export v1_5_managedScalarMulBN254G1
+func v1_5_managedScalarMulBN254G1(context unsafe.Pointer, pointHandle int32, scalarHandle int32, resultHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedScalarMulBN254G1(pointHandle, scalarHandle, resultHandle)
+}
+
+//export v1_5_managedPairingCheckBN254
+func v1_5_managedPairingCheckBN254(context unsafe.Pointer, g1PointsHandle int32, g2PointsHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedPairingCheckBN254(g1PointsHandle, g2PointsHandle)
+}
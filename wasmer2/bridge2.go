@@ -1,10 +1,20 @@
 package wasmer2
 
 // #cgo LDFLAGS: -Wl,-rpath,${SRCDIR} -L${SRCDIR}
-// #cgo linux,amd64 LDFLAGS:-lvmexeccapi
+// #cgo linux,amd64 LDFLAGS:-lvmexeccapi -ldl
 // #cgo darwin,amd64 LDFLAGS:-lvmexeccapi
+// #define _GNU_SOURCE
+// #include <dlfcn.h>
 // #include "./libvmexeccapi.h"
 //
+// static const char* vm_exec_library_path() {
+// 	Dl_info info;
+// 	if (dladdr((void*)&vm_exec_new_executor, &info) == 0) {
+// 		return NULL;
+// 	}
+// 	return info.dli_fname;
+// }
+//
 import "C"
 import (
 	"unsafe"
@@ -35,6 +45,14 @@ func cWasmerSetLogLevel(
 	))
 }
 
+func cWasmerLibraryPath() string {
+	path := C.vm_exec_library_path()
+	if path == nil {
+		return ""
+	}
+	return C.GoString(path)
+}
+
 func cWasmerForceInstallSighandlers() {
 	C.vm_force_sighandler_reinstall()
 }
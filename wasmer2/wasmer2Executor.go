@@ -1,6 +1,10 @@
 package wasmer2
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"sync"
 	"unsafe"
 
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -126,6 +130,36 @@ func (wasmerExecutor *Wasmer2Executor) NewInstanceFromCompiledCodeWithOptions(
 	return newInstance(c_instance)
 }
 
+// CompiledCodeVersion identifies the format of the Wasmer 2 compiled code, which depends on the build of the
+// native library. It is empty, so compiled code is not persisted, if the loaded library cannot be read.
+func (wasmerExecutor *Wasmer2Executor) CompiledCodeVersion() string {
+	libraryVersion := nativeLibraryVersion()
+	if len(libraryVersion) == 0 {
+		return ""
+	}
+	return "wasmer2/" + libraryVersion
+}
+
+var libraryVersionOnce sync.Once
+var libraryVersion string
+
+// nativeLibraryVersion returns a digest of the file from which the native library was loaded.
+func nativeLibraryVersion() string {
+	libraryVersionOnce.Do(func() {
+		libraryPath := cWasmerLibraryPath()
+		if len(libraryPath) == 0 {
+			return
+		}
+		library, err := ioutil.ReadFile(libraryPath)
+		if err != nil {
+			return
+		}
+		digest := sha256.Sum256(library)
+		libraryVersion = hex.EncodeToString(digest[:])
+	})
+	return libraryVersion
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (wasmerExecutor *Wasmer2Executor) IsInterfaceNil() bool {
 	return wasmerExecutor == nil